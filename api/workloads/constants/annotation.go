@@ -28,6 +28,16 @@ const (
 	// to skip exclusive-topology affinity injection for that pod.
 	DisableExclusiveKeyAnnotationKey = RBGPrefix + "role-disable-exclusive"
 
+	// GroupColocationTopologyKey declares the topology domain (e.g. topology.kubernetes.io/zone)
+	// all pods of a RoleBasedGroup are co-located in. Unlike GroupExclusiveTopologyKey, other
+	// groups may share the domain.
+	// Example: rbg.workloads.x-k8s.io/group-colocation-topology: "topology.kubernetes.io/zone"
+	GroupColocationTopologyKey = RBGPrefix + "group-colocation-topology"
+
+	// DisableColocationAnnotationKey can be set to "true" on a Pod template
+	// to skip co-location affinity injection for that pod.
+	DisableColocationAnnotationKey = RBGPrefix + "role-disable-colocation"
+
 	// GangSchedulingAnnotationKey enables gang scheduling for a RoleBasedGroup when set to "true".
 	// When enabled, the controller will create a PodGroup CR managed by the scheduler
 	// configured via --scheduler-name flag (scheduler-plugins or volcano).
@@ -48,6 +58,36 @@ const (
 	// GangSchedulingVolcanoQueueKey specifies the Queue for volcano gang scheduling.
 	// Example: rbg.workloads.x-k8s.io/group-gang-scheduling-volcano-queue: "default"
 	GangSchedulingVolcanoQueueKey = RBGPrefix + "group-gang-scheduling-volcano-queue"
+
+	// StagedAdmissionAnnotationKey creates the pods of a RoleBasedGroup with the
+	// StagedAdmissionSchedulingGate when set to "true". The controller removes the gate once the
+	// group is admitted, the dependencies of the roles are ready and all of their pods are created,
+	// so that no pod holds GPUs while other pods of the gang cannot be created.
+	// Example: rbg.workloads.x-k8s.io/group-staged-admission: "true"
+	StagedAdmissionAnnotationKey = RBGPrefix + "group-staged-admission"
+
+	// ChangeCauseAnnotationKey records why the spec of a RoleBasedGroup was changed.
+	// It is copied to the ControllerRevision created for that change so it shows up in rollout history.
+	// Example: kubernetes.io/change-cause: "bump vllm to v0.9.0"
+	ChangeCauseAnnotationKey = "kubernetes.io/change-cause"
+
+	// PausedAnnotationKey freezes the reconciliation of a RoleBasedGroup when set to "true".
+	// While paused the controller only refreshes the status and reports a Paused condition;
+	// no child objects are created, updated, restarted or deleted.
+	// Example: rbg.workloads.x-k8s.io/paused: "true"
+	PausedAnnotationKey = RBGPrefix + "paused"
+
+	// RollbackToAnnotationKey asks the controller to roll a RoleBasedGroup back to a recorded revision.
+	// The controller restores the spec stored in the ControllerRevision with that number, keeping the
+	// current replicas, and removes the annotation once handled.
+	// Example: rbg.workloads.x-k8s.io/rollback-to: "3"
+	RollbackToAnnotationKey = RBGPrefix + "rollback-to"
+
+	// RolloutStatusAnnotationKey records on a ControllerRevision whether the roles it rolled out became
+	// ready within their progressDeadlineSeconds. The controller never rolls back automatically to a
+	// Failed revision, and no longer checks the deadlines of a Complete one.
+	// Example: rbg.workloads.x-k8s.io/rollout-status: "Failed"
+	RolloutStatusAnnotationKey = RBGPrefix + "rollout-status"
 )
 
 // Role level annotations
@@ -69,6 +109,16 @@ const (
 	// so parsing must use strings.LastIndex to correctly split at the last "/"
 	// as the apiVersion/kind delimiter. Do NOT use strings.Split.
 	RoleWorkloadTypeAnnotationKey = RBGPrefix + "role-workload-type"
+
+	// RoleRestartedAtAnnotationKey triggers a rolling restart of a role when its value changes.
+	// It is copied to the pod template of the role workload, and pods are always recreated
+	// for it, even when the rollout strategy prefers in-place updates.
+	// Example: rbg.workloads.x-k8s.io/role-restarted-at: "2026-01-02T15:04:05Z"
+	RoleRestartedAtAnnotationKey = RBGPrefix + "role-restarted-at"
+
+	// WorkloadSpecHashAnnotationKey records the hash of the configuration the controller last
+	// applied to the workload or service of a role. It is managed by the controller.
+	WorkloadSpecHashAnnotationKey = RBGPrefix + "workload-spec-hash"
 )
 
 // SystemManagedRoleAnnotations is the set of role-level annotations that are
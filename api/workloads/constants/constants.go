@@ -26,6 +26,14 @@ const (
 	RBGPrefix      = "rbg.workloads.x-k8s.io/"
 )
 
+// OrderedTerminationFinalizer holds the deletion of a RoleBasedGroup with a termination policy
+// until its roles were terminated in order.
+const OrderedTerminationFinalizer = RBGPrefix + "ordered-termination"
+
+// StagedAdmissionSchedulingGate holds the pods of a RoleBasedGroup with staged admission back from
+// scheduling until the controller removes it.
+const StagedAdmissionSchedulingGate = RBGPrefix + "staged-admission"
+
 // ========== Enum Types ==========
 
 // InstancePatternType defines supported organization patterns
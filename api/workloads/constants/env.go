@@ -29,6 +29,16 @@ const (
 	EnvRBGRoleName = "RBG_ROLE_NAME"
 )
 
+// BlueGreen verification Job environment variables
+const (
+	// EnvRBGGroupSetName is the name of the RBGSet whose new groups are verified
+	EnvRBGGroupSetName = "RBG_GROUPSET_NAME"
+
+	// EnvRBGGroupSetRevision is the revision of the new groups, the value of their
+	// rbg.workloads.x-k8s.io/groupset-revision label
+	EnvRBGGroupSetRevision = "RBG_GROUPSET_REVISION"
+)
+
 // StatefulSet / LeaderWorkerSet specific environment variables
 const (
 	// EnvRBGRoleIndex is the ordered index of Pod in Role
@@ -67,6 +77,14 @@ const (
 	EnvRBGSize = "RBG_LWP_GROUP_SIZE"
 )
 
+// Cross-role environment variables
+const (
+	// EnvRBGRoleAddressesFmt lists the comma-separated addresses of a role, the verb is the role name
+	// upper-cased with '-' replaced by '_'. It is only injected into the pods referencing it.
+	// Source: <workload>-<index>.<svcName> per replica of stateful roles, the headless service otherwise
+	EnvRBGRoleAddressesFmt = "RBG_ROLE_%s_ADDRESSES"
+)
+
 // System environment variable prefix for filtering
 const (
 	// EnvRBGPrefix is the prefix for all RBG system environment variables
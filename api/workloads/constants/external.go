@@ -28,6 +28,14 @@ const (
 	LwsWorkerIndexLabelKey = LeaderWorkerSetPrefix + "worker-index"
 )
 
+// Kueue labels
+const (
+	KueuePrefix = "kueue.x-k8s.io/"
+
+	// KueueQueueNameLabelKey names the Kueue LocalQueue a RoleBasedGroup is submitted to.
+	KueueQueueNameLabelKey = KueuePrefix + "queue-name"
+)
+
 const (
 	// InstancePodReadyConditionType corresponding condition status was set to "False" by multiple writers.
 	InstancePodReadyConditionType v1.PodConditionType = "InstancePodReady"
@@ -44,4 +52,12 @@ const (
 	StatefulSetWorkloadType     string = "apps/v1/StatefulSet"
 	RoleInstanceSetWorkloadType string = "workloads.x-k8s.io/v1alpha2/RoleInstanceSet"
 	LeaderWorkerSetWorkloadType string = "leaderworkerset.x-k8s.io/v1/LeaderWorkerSet"
+	JobWorkloadType             string = "batch/v1/Job"
+
+	// JobSetWorkloadType is not reconciled yet, roles of this type are rejected by the webhook.
+	JobSetWorkloadType string = "jobset.x-k8s.io/v1alpha2/JobSet"
+
+	// OpenKruise workloads, https://openkruise.io
+	KruiseCloneSetWorkloadType    string = "apps.kruise.io/v1alpha1/CloneSet"
+	KruiseStatefulSetWorkloadType string = "apps.kruise.io/v1beta1/StatefulSet"
 )
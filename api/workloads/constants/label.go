@@ -25,6 +25,11 @@ const (
 
 	// GroupSetIndexLabelKey identifies the index of the RBG within the RBGSet
 	GroupSetIndexLabelKey = RBGPrefix + "groupset-index"
+
+	// GroupSetRevisionLabelKey identifies the revision of the group template a RBG was created from by
+	// the BlueGreen update strategy of its RBGSet. It is propagated to the pods along with
+	// GroupSetNameLabelKey, so that Services can select the pods of one revision.
+	GroupSetRevisionLabelKey = RBGPrefix + "groupset-revision"
 )
 
 // Group level labels
@@ -56,6 +61,10 @@ const (
 	// RoleRevisionLabelKeyFmt is the labels key used to store the revision hash of
 	// a specific Role template.
 	RoleRevisionLabelKeyFmt = RBGPrefix + "role-revision-%s"
+
+	// InferencePoolLabelKey names the pool of spec.networking.inferencePools the pods of a role
+	// serve. The InferencePool created for the pool selects the pods by it.
+	InferencePoolLabelKey = RBGPrefix + "inference-pool"
 )
 
 // RoleInstance level labels
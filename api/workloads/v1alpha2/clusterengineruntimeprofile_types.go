@@ -34,6 +34,13 @@ type ClusterEngineRuntimeProfileSpec struct {
 	Containers []v1.Container `json:"containers,omitempty"`
 	// +optional
 	Volumes []v1.Volume `json:"volumes,omitempty"`
+	// Env is added to the engine containers of the roles using the profile, the injectContainers of the
+	// role or all the containers of its template. Variables already set by a container are kept.
+	// +optional
+	Env []v1.EnvVar `json:"env,omitempty"`
+	// VolumeMounts are added to the engine containers like env, e.g. to share a volume with a sidecar.
+	// +optional
+	VolumeMounts []v1.VolumeMount `json:"volumeMounts,omitempty"`
 	// +kubebuilder:validation:Enum=NoUpdate;RollingUpdate
 	// +kubebuilder:default=NoUpdate
 	UpdateStrategy string `json:"updateStrategy"`
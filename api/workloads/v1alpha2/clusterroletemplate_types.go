@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RoleTemplateKind is the kind of a templateRef to a template of spec.roleTemplates.
+	RoleTemplateKind = "RoleTemplate"
+	// ClusterRoleTemplateKind is the kind of a templateRef to a ClusterRoleTemplate.
+	ClusterRoleTemplateKind = "ClusterRoleTemplate"
+)
+
+// ClusterRoleTemplateSpec defines the desired state of ClusterRoleTemplate.
+type ClusterRoleTemplateSpec struct {
+	// Template is the Pod template of the roles referencing the ClusterRoleTemplate, e.g. the engine
+	// container with its probes and volumes. Roles customize it with the patch of their templateRef.
+	// +kubebuilder:validation:Required
+	Template corev1.PodTemplateSpec `json:"template"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+
+// ClusterRoleTemplate is the Schema for the clusterroletemplates API. It holds a role definition
+// maintained once for the cluster and shared by the RoleBasedGroups referencing it.
+type ClusterRoleTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterRoleTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterRoleTemplateList contains a list of ClusterRoleTemplate.
+type ClusterRoleTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterRoleTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterRoleTemplate{}, &ClusterRoleTemplateList{})
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -145,6 +146,52 @@ func (rbg *RoleBasedGroup) GetExclusiveKey() (topologyKey string, found bool) {
 	return
 }
 
+// GetColocationKey returns the co-location topology key from annotations.
+func (rbg *RoleBasedGroup) GetColocationKey() (topologyKey string, found bool) {
+	topologyKey, found = rbg.Annotations[constants.GroupColocationTopologyKey]
+	return
+}
+
+// IsBlueGreen reports whether the groups of the set are updated by the BlueGreen strategy.
+func (rbgset *RoleBasedGroupSet) IsBlueGreen() bool {
+	return rbgset.Spec.UpdateStrategy != nil && rbgset.Spec.UpdateStrategy.Type == GroupSetBlueGreenUpdateStrategyType
+}
+
+// IsPaused returns true if the reconciliation of the group is paused via spec.paused or annotation.
+func (rbg *RoleBasedGroup) IsPaused() bool {
+	return rbg.Spec.Paused || rbg.Annotations[constants.PausedAnnotationKey] == "true"
+}
+
+// IsSuspended returns true if the workloads of the group are requested to be kept at zero replicas.
+func (rbg *RoleBasedGroup) IsSuspended() bool {
+	return rbg.Spec.Suspend != nil && *rbg.Spec.Suspend
+}
+
+// UsesStagedAdmission returns true if the pods of the group are created with the staged admission
+// scheduling gate.
+func (rbg *RoleBasedGroup) UsesStagedAdmission() bool {
+	return rbg.Annotations[constants.StagedAdmissionAnnotationKey] == "true"
+}
+
+// GetInferencePool returns the InferencePool the pods of the role serve, or nil.
+func (rbg *RoleBasedGroup) GetInferencePool(roleName string) *InferencePoolSpec {
+	if rbg.Spec.Networking == nil {
+		return nil
+	}
+	for i := range rbg.Spec.Networking.InferencePools {
+		pool := &rbg.Spec.Networking.InferencePools[i]
+		if slices.Contains(pool.Roles, roleName) {
+			return pool
+		}
+	}
+	return nil
+}
+
+// GetInferencePoolName returns the name of the InferencePool created for a pool of the group.
+func (rbg *RoleBasedGroup) GetInferencePoolName(pool *InferencePoolSpec) string {
+	return fmt.Sprintf("%s-%s", rbg.Name, pool.Name)
+}
+
 // GenGroupUniqueKey generates a unique key for the group.
 func (rbg *RoleBasedGroup) GenGroupUniqueKey() string {
 	return sha1Hash(fmt.Sprintf("%s/%s", rbg.GetNamespace(), rbg.GetName()))
@@ -232,6 +279,12 @@ func (r *RoleSpec) UsesRoleTemplate() bool {
 	return r.GetTemplateRef() != nil
 }
 
+// UsesClusterRoleTemplate returns true if the templateRef of the role references a ClusterRoleTemplate.
+func (r *RoleSpec) UsesClusterRoleTemplate() bool {
+	ref := r.GetTemplateRef()
+	return ref != nil && ref.Kind == ClusterRoleTemplateKind
+}
+
 // GetEffectiveTemplateName returns the name of the template this role uses.
 // Returns empty string if the role doesn't use a template.
 func (r *RoleSpec) GetEffectiveTemplateName() string {
@@ -287,8 +340,28 @@ func (r *RoleSpec) HasTemplate() bool {
 // It handles both templateRef mode (find RoleTemplate and apply patch) and inline template mode.
 // Returns a deep-copied PodTemplateSpec to prevent mutations to the original source.
 // This method is used by both PodReconciler and RoleInstanceSetReconciler to ensure consistent behavior.
+// Roles referencing a ClusterRoleTemplate are resolved with ResolveTemplate instead.
 func (r *RoleSpec) GetResolvedTemplate(rbg *RoleBasedGroup) (corev1.PodTemplateSpec, error) {
-	if r.UsesRoleTemplate() {
+	return r.ResolveTemplate(rbg, nil)
+}
+
+// ResolveTemplate resolves the base template for a role like GetResolvedTemplate, taking the
+// ClusterRoleTemplate the role references from clusterTemplates, by name.
+func (r *RoleSpec) ResolveTemplate(
+	rbg *RoleBasedGroup, clusterTemplates map[string]*ClusterRoleTemplate,
+) (corev1.PodTemplateSpec, error) {
+	if r.UsesClusterRoleTemplate() {
+		clusterTemplate, ok := clusterTemplates[r.GetEffectiveTemplateName()]
+		if !ok {
+			return corev1.PodTemplateSpec{}, fmt.Errorf("clusterRoleTemplate %s of role %s not resolved",
+				r.GetEffectiveTemplateName(), r.Name)
+		}
+		merged, err := applyStrategicMergePatch(clusterTemplate.Spec.Template, r.GetTemplatePatch())
+		if err != nil {
+			return corev1.PodTemplateSpec{}, fmt.Errorf("failed to apply templatePatch: %w", err)
+		}
+		return merged, nil
+	} else if r.UsesRoleTemplate() {
 		// Template mode: find template and apply patch
 		roleTemplate, err := rbg.FindRoleTemplate(r.GetEffectiveTemplateName())
 		if err != nil {
@@ -400,9 +473,10 @@ func IsStatefulRole(role *RoleSpec) bool {
 		return false
 	}
 	switch role.GetWorkloadType() {
-	case constants.DeploymentWorkloadType:
+	case constants.DeploymentWorkloadType, constants.JobWorkloadType, constants.KruiseCloneSetWorkloadType:
 		return false
-	case constants.StatefulSetWorkloadType, constants.LeaderWorkerSetWorkloadType, "":
+	case constants.StatefulSetWorkloadType, constants.LeaderWorkerSetWorkloadType,
+		constants.KruiseStatefulSetWorkloadType, "":
 		return true
 	case constants.RoleInstanceSetWorkloadType:
 		pattern := constants.InstancePatternType(role.Annotations[constants.RoleInstancePatternKey])
@@ -277,6 +277,50 @@ func TestRoleSpec_GetResolvedTemplate_NoTemplateError(t *testing.T) {
 	assert.Contains(t, err.Error(), "has no template or templateRef set")
 }
 
+// TestRoleSpec_ResolveTemplate_ClusterRoleTemplate tests that roles referencing a
+// ClusterRoleTemplate are resolved from the given cluster templates only.
+func TestRoleSpec_ResolveTemplate_ClusterRoleTemplate(t *testing.T) {
+	clusterTemplate := &ClusterRoleTemplate{
+		Spec: ClusterRoleTemplateSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "engine", Image: "vllm:v1", Args: []string{"--port=8000"}}},
+				},
+			},
+		},
+	}
+	role := &RoleSpec{
+		Name:     "decode",
+		Replicas: ptr.To(int32(2)),
+		Pattern: Pattern{
+			StandalonePattern: &StandalonePattern{
+				TemplateSource: TemplateSource{
+					TemplateRef: &TemplateRef{
+						Name:  "vllm",
+						Kind:  ClusterRoleTemplateKind,
+						Patch: &runtime.RawExtension{Raw: []byte(`{"spec":{"containers":[{"name":"engine","args":["--port=9000"]}]}}`)},
+					},
+				},
+			},
+		},
+	}
+	// A RoleTemplate of the same name is not used for the role.
+	rbg := &RoleBasedGroup{
+		Spec: RoleBasedGroupSpec{
+			RoleTemplates: []RoleTemplate{{Name: "vllm", Template: corev1.PodTemplateSpec{}}},
+		},
+	}
+
+	_, err := role.GetResolvedTemplate(rbg)
+	assert.ErrorContains(t, err, "clusterRoleTemplate vllm of role decode not resolved")
+
+	result, err := role.ResolveTemplate(rbg, map[string]*ClusterRoleTemplate{"vllm": clusterTemplate})
+	assert.NoError(t, err)
+	assert.Equal(t, "vllm:v1", result.Spec.Containers[0].Image)
+	assert.Equal(t, []string{"--port=9000"}, result.Spec.Containers[0].Args)
+	assert.Equal(t, []string{"--port=8000"}, clusterTemplate.Spec.Template.Spec.Containers[0].Args)
+}
+
 // Test_applyStrategicMergePatch tests the applyStrategicMergePatch helper function.
 func Test_applyStrategicMergePatch(t *testing.T) {
 	base := corev1.PodTemplateSpec{
@@ -515,3 +559,28 @@ func TestRoleSpec_GetWorkloadSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestRoleBasedGroup_IsPaused(t *testing.T) {
+	rbg := &RoleBasedGroup{}
+	assert.False(t, rbg.IsPaused())
+
+	rbg.Annotations = map[string]string{constants.PausedAnnotationKey: "true"}
+	assert.True(t, rbg.IsPaused())
+
+	rbg.Annotations[constants.PausedAnnotationKey] = "false"
+	assert.False(t, rbg.IsPaused())
+
+	rbg.Spec.Paused = true
+	assert.True(t, rbg.IsPaused())
+}
+
+func TestRoleBasedGroup_IsSuspended(t *testing.T) {
+	rbg := &RoleBasedGroup{}
+	assert.False(t, rbg.IsSuspended())
+
+	rbg.Spec.Suspend = ptr.To(false)
+	assert.False(t, rbg.IsSuspended())
+
+	rbg.Spec.Suspend = ptr.To(true)
+	assert.True(t, rbg.IsSuspended())
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -41,16 +42,22 @@ type RoleTemplate struct {
 	Template corev1.PodTemplateSpec `json:"template"`
 }
 
-// TemplateRef references a RoleTemplate defined in spec.roleTemplates
+// TemplateRef references a RoleTemplate defined in spec.roleTemplates or a ClusterRoleTemplate
 // with optional customizations via strategic merge patch.
 type TemplateRef struct {
-	// Name of the RoleTemplate to reference.
+	// Name of the RoleTemplate or ClusterRoleTemplate to reference.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=63
 	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
 	Name string `json:"name"`
 
+	// Kind of the referenced template, RoleTemplate for a template of spec.roleTemplates or
+	// ClusterRoleTemplate for a template shared by the groups of the cluster. Defaults to RoleTemplate.
+	// +optional
+	// +kubebuilder:validation:Enum=RoleTemplate;ClusterRoleTemplate
+	Kind string `json:"kind,omitempty"`
+
 	// Patch specifies modifications to apply to the referenced template.
 	// Uses strategic merge patch semantics.
 	// +optional
@@ -77,13 +84,391 @@ type RoleBasedGroupSpec struct {
 	// +listType=map
 	// +listMapKey=name
 	RoleTemplates []RoleTemplate `json:"roleTemplates,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Suspend keeps the workloads of all roles at zero replicas while true. A group labeled with
+	// kueue.x-k8s.io/queue-name is also kept suspended until its Kueue Workload is admitted.
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// Activation resumes the group from spec.suspend once a request reaches the activator endpoint of the
+	// controller, so that rarely used models are only served on demand.
+	// +optional
+	Activation *ActivationPolicy `json:"activation,omitempty"`
+
+	// Paused stops the controller from creating, updating or deleting the child objects of the group while
+	// its status keeps being reported, like the rbg.workloads.x-k8s.io/paused annotation.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// DependsOn lists the RoleBasedGroups in the namespace of the group, e.g. an embedding service, that
+	// have to report Ready before the roles of the group are created or scaled up.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	DependsOn []GroupDependency `json:"dependsOn,omitempty"`
+
+	// TerminationPolicy orders the termination of the roles when the group is deleted or scaled in.
+	// Roles terminate in parallel when unset.
+	// +optional
+	TerminationPolicy *TerminationPolicy `json:"terminationPolicy,omitempty"`
+
+	// AdoptionPolicy defines whether existing workloads named after a role and not controlled by
+	// the group are adopted, e.g. after a migration. Defaults to Never.
+	// +optional
+	AdoptionPolicy AdoptionPolicy `json:"adoptionPolicy,omitempty"`
+
+	// AutoRollback defines whether a rollout whose roles miss their progressDeadlineSeconds is reverted
+	// to the previous revision. Defaults to Never.
+	// +optional
+	AutoRollback AutoRollbackPolicy `json:"autoRollback,omitempty"`
+
+	// PlacementPolicy is translated into the affinities and topology spread constraints of the pods of
+	// every role.
+	// +optional
+	PlacementPolicy *PlacementPolicy `json:"placementPolicy,omitempty"`
+
+	// FailurePolicy restarts the roles of the group together once one of them is deemed unrecoverable.
+	// It is checked before the restartPolicy of the role.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// MultiCluster distributes the workloads of the roles across the member clusters of a Karmada
+	// control plane the controller runs against. The status of the workloads is aggregated back by Karmada.
+	// +optional
+	MultiCluster *MultiClusterPolicy `json:"multiCluster,omitempty"`
+
+	// Networking exposes the roles of the group to the gateways of the Gateway API inference extension.
+	// +optional
+	Networking *NetworkingPolicy `json:"networking,omitempty"`
+
+	// Monitoring has the controller maintain the Prometheus Operator monitors scraping the metrics of
+	// the roles of the group.
+	// +optional
+	Monitoring *MonitoringPolicy `json:"monitoring,omitempty"`
+
+	// Autoscaling scales roles of the group together, e.g. prefill and decode, to keep the latency and
+	// queue length of its requests within their objectives with as few GPUs as its budget allows.
+	// +optional
+	Autoscaling *GroupAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// ActivationPolicy defines how a suspended group is resumed on demand.
+type ActivationPolicy struct {
+	// RetryAfterSeconds is the delay the activator asks its callers to retry after while the roles of
+	// the group are not ready. Defaults to 10.
+	// +optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	RetryAfterSeconds int32 `json:"retryAfterSeconds,omitempty"`
+}
+
+// GroupDependency references a RoleBasedGroup the group depends on.
+type GroupDependency struct {
+	// Name of the RoleBasedGroup in the namespace of the group.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// AutoRollbackPolicy defines whether the group reverts its failed rollouts.
+// +kubebuilder:validation:Enum={Never,OnProgressDeadlineExceeded}
+type AutoRollbackPolicy string
+
+const (
+	// AutoRollbackNever only reports the roles that missed their progress deadline.
+	AutoRollbackNever AutoRollbackPolicy = "Never"
+
+	// AutoRollbackOnProgressDeadlineExceeded restores the spec of the previous revision once a role
+	// rolled out by the current revision missed its progress deadline.
+	AutoRollbackOnProgressDeadlineExceeded AutoRollbackPolicy = "OnProgressDeadlineExceeded"
+)
+
+// AdoptionPolicy defines whether the group adopts the existing workloads of its roles.
+// +kubebuilder:validation:Enum={Never,Orphans}
+type AdoptionPolicy string
+
+const (
+	// AdoptionPolicyNever fails the reconciliation of a role whose workload exists and is not
+	// controlled by the group.
+	AdoptionPolicyNever AdoptionPolicy = "Never"
+
+	// AdoptionPolicyOrphans adopts the existing workloads of the roles without a controller, by
+	// patching the group in as their controller owner.
+	AdoptionPolicyOrphans AdoptionPolicy = "Orphans"
+)
+
+// TerminationPolicy defines the order the roles of a group are terminated in.
+type TerminationPolicy struct {
+	// Order lists the roles in the order they are terminated, e.g. the router before the prefill and
+	// decode roles so no new requests reach a role being drained. A role is deleted or scaled in once
+	// the pods of the roles before it finished terminating within their terminationGracePeriodSeconds.
+	// Roles not listed terminate together after the listed ones.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Order []string `json:"order"`
+}
+
+// FailurePolicy defines when a role is unrecoverable and what is restarted with it.
+type FailurePolicy struct {
+	// MaxPodRestarts is the number of container restarts of a pod after which its role is deemed
+	// unrecoverable.
+	// +kubebuilder:validation:Minimum=1
+	MaxPodRestarts int32 `json:"maxPodRestarts"`
+
+	// Action is what is restarted once a role is unrecoverable. Defaults to RestartGroup.
+	// +optional
+	// +kubebuilder:default=RestartGroup
+	Action FailurePolicyAction `json:"action,omitempty"`
+}
+
+// MultiClusterPolicy defines the member clusters the workloads of a group are propagated to.
+type MultiClusterPolicy struct {
+	// Clusters are the names of the Karmada member clusters the roles run in.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Clusters []string `json:"clusters"`
+
+	// ReplicaScheduling defines how the replicas of every role are spread over the clusters.
+	// Defaults to Divided.
+	// +optional
+	// +kubebuilder:default=Divided
+	ReplicaScheduling MultiClusterReplicaScheduling `json:"replicaScheduling,omitempty"`
+}
+
+// MultiClusterReplicaScheduling defines how the replicas of a role are spread over the member clusters.
+// +kubebuilder:validation:Enum={Divided,Duplicated}
+type MultiClusterReplicaScheduling string
+
+const (
+	// MultiClusterReplicaDivided splits the replicas of a role evenly over the clusters.
+	MultiClusterReplicaDivided MultiClusterReplicaScheduling = "Divided"
+
+	// MultiClusterReplicaDuplicated runs all replicas of a role in every cluster.
+	MultiClusterReplicaDuplicated MultiClusterReplicaScheduling = "Duplicated"
+)
+
+// NetworkingPolicy defines how the roles of a group are exposed to gateways.
+type NetworkingPolicy struct {
+	// InferencePools are the InferencePools of the Gateway API inference extension created over the roles
+	// of the group, named <group>-<name>. HTTPRoutes reference them as their backends.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	InferencePools []InferencePoolSpec `json:"inferencePools"`
+}
+
+// InferencePoolSpec defines an InferencePool over roles of the group.
+type InferencePoolSpec struct {
+	// Name of the pool, unique within the group.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Roles are the roles whose pods serve the pool, e.g. the prefill and decode roles behind an endpoint
+	// picker that disaggregates them. A role belongs to at most one pool.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Roles []string `json:"roles"`
+
+	// TargetPort is the port the model servers of the pods listen on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	TargetPort int32 `json:"targetPort"`
+
+	// EndpointPicker is the extension the gateway asks which pod of the pool a request is routed to.
+	EndpointPicker EndpointPickerRef `json:"endpointPicker"`
+}
+
+// EndpointPickerRef references the Service of an endpoint picker.
+type EndpointPickerRef struct {
+	// Name of the Service of the endpoint picker, in the namespace of the group.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Port of the Service the endpoint picker serves on. Defaults to 9002.
+	// +optional
+	// +kubebuilder:default=9002
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+
+	// FailureMode defines whether requests are routed while the endpoint picker is unavailable.
+	// Defaults to FailClose.
+	// +optional
+	// +kubebuilder:default=FailClose
+	FailureMode EndpointPickerFailureMode `json:"failureMode,omitempty"`
+}
+
+// EndpointPickerFailureMode defines how a gateway behaves while the endpoint picker is unavailable.
+// +kubebuilder:validation:Enum={FailOpen,FailClose}
+type EndpointPickerFailureMode string
+
+const (
+	// EndpointPickerFailOpen routes the requests to any pod of the pool.
+	EndpointPickerFailOpen EndpointPickerFailureMode = "FailOpen"
+
+	// EndpointPickerFailClose rejects the requests.
+	EndpointPickerFailClose EndpointPickerFailureMode = "FailClose"
+)
+
+// MonitoringPolicy defines the monitors scraping the metrics of the roles of a group.
+type MonitoringPolicy struct {
+	// Kind of the monitor created for every role. Defaults to PodMonitor.
+	// +optional
+	// +kubebuilder:default=PodMonitor
+	Kind MonitorKind `json:"kind,omitempty"`
+
+	// Interval between scrapes, e.g. 30s. The scrape interval of Prometheus applies when unset.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	Interval string `json:"interval,omitempty"`
+
+	// Labels are added to the monitors, e.g. to match the monitor selector of a Prometheus.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Roles are the roles whose metrics are scraped.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Roles []RoleMonitoring `json:"roles"`
+}
+
+// RoleMonitoring defines where a role serves its metrics.
+type RoleMonitoring struct {
+	// Name of the role.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Port is the name of the container port the metrics are served on.
+	// +kubebuilder:validation:MinLength=1
+	Port string `json:"port"`
+
+	// Path the metrics are served under. Defaults to /metrics.
+	// +optional
+	// +kubebuilder:default="/metrics"
+	Path string `json:"path,omitempty"`
+}
+
+// MonitorKind is the kind of the Prometheus Operator monitor created for a role.
+// +kubebuilder:validation:Enum={PodMonitor,ServiceMonitor}
+type MonitorKind string
+
+const (
+	// PodMonitorKind scrapes the pods of the role directly.
+	PodMonitorKind MonitorKind = "PodMonitor"
+
+	// ServiceMonitorKind scrapes the pods of the role through its headless Service.
+	ServiceMonitorKind MonitorKind = "ServiceMonitor"
+)
+
+// GroupAutoscaling defines how roles of a group are scaled in ratio by the service level objectives of
+// the group. The roles are scaled by units, a unit being the replicas every role has per unit.
+// +kubebuilder:validation:XValidation:rule="has(self.ttft) || has(self.tpot) || has(self.queueLength)",message="at least one of ttft, tpot and queueLength is required"
+type GroupAutoscaling struct {
+	// Roles are the roles scaled together and their replicas per unit, e.g. 2 prefill and 1 decode
+	// replicas.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Roles []GroupAutoscalingRole `json:"roles"`
+
+	// MinUnits is the lowest number of units the roles are scaled to. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MinUnits *int32 `json:"minUnits,omitempty"`
+
+	// MaxGPUs is the GPU budget of the group. The roles are scaled to no more units than fit in it
+	// besides the GPUs of the other roles of the group, even if it takes fewer than minUnits.
+	// +kubebuilder:validation:Minimum=1
+	MaxGPUs int64 `json:"maxGPUs"`
+
+	// ServerAddress is the URL of the Prometheus server the objectives are queried from, e.g.
+	// http://prometheus.monitoring:9090.
+	// +kubebuilder:validation:MinLength=1
+	ServerAddress string `json:"serverAddress"`
+
+	// TTFT is the objective of the time to first token of the requests, in seconds.
+	// +optional
+	TTFT *ServiceLevelObjective `json:"ttft,omitempty"`
+
+	// TPOT is the objective of the time per output token of the requests, in seconds.
+	// +optional
+	TPOT *ServiceLevelObjective `json:"tpot,omitempty"`
+
+	// QueueLength is the objective of the number of requests waiting in the engines of the group.
+	// +optional
+	QueueLength *ServiceLevelObjective `json:"queueLength,omitempty"`
+
+	// ScaleDownStabilizationSeconds is how long the highest number of units recommended is kept before
+	// the roles are scaled down. Defaults to 300.
+	// +optional
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	ScaleDownStabilizationSeconds *int32 `json:"scaleDownStabilizationSeconds,omitempty"`
+}
+
+// GroupAutoscalingRole defines the share of a role in a unit of a group scaled by its objectives.
+type GroupAutoscalingRole struct {
+	// Name of the role.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Replicas is the number of replicas of the role per unit.
+	// +kubebuilder:validation:Minimum=1
+	Replicas int32 `json:"replicas"`
+}
+
+// ServiceLevelObjective defines a Prometheus query over the requests of a group and the value it is
+// kept at.
+type ServiceLevelObjective struct {
+	// Query is an instant PromQL query whose result, summed over its series, is the value of the group,
+	// e.g. histogram_quantile(0.9, sum by (le) (rate(vllm:time_to_first_token_seconds_bucket[1m]))).
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+
+	// Target is the value the objective is kept at or below.
+	Target resource.Quantity `json:"target"`
+}
+
+// FailurePolicyAction defines what is restarted once a role is unrecoverable.
+// +kubebuilder:validation:Enum={RestartGroup,RestartDependents}
+type FailurePolicyAction string
+
+const (
+	// FailurePolicyRestartGroup recreates the workloads of all roles of the group, e.g. when every
+	// rank of a distributed engine has to start again.
+	FailurePolicyRestartGroup FailurePolicyAction = "RestartGroup"
+
+	// FailurePolicyRestartDependents recreates the workloads of the unrecoverable role and of the roles
+	// depending on it, directly or not.
+	FailurePolicyRestartDependents FailurePolicyAction = "RestartDependents"
+)
+
+// PlacementPolicy defines how the pods of the roles of a group are placed across topology domains.
+type PlacementPolicy struct {
+	// TopologyKey is the node label the domains are told apart by.
+	// +optional
+	// +kubebuilder:default="kubernetes.io/hostname"
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// SpreadRolesAcrossNodes spreads the pods of every role evenly over the domains, on a best-effort
+	// basis, so that losing one domain does not take a whole role down.
+	// +optional
+	SpreadRolesAcrossNodes bool `json:"spreadRolesAcrossNodes,omitempty"`
+
+	// CoLocateRoles lists the roles whose pods are placed in one domain, e.g. prefill and decode to
+	// keep the KV cache transfer within a rack.
+	// +optional
+	// +listType=set
+	CoLocateRoles []string `json:"coLocateRoles,omitempty"`
 }
 
 // RolloutStrategy defines the strategy that the rbg controller
 // will use to perform replica updates of role.
 type RolloutStrategy struct {
-	// Type defines the rollout strategy, it can only be "RollingUpdate" for now.
-	// +kubebuilder:validation:Enum={RollingUpdate}
+	// Type defines the rollout strategy, "RollingUpdate" or "OnDelete".
+	// OnDelete is only supported by StatefulSet roles.
+	// +kubebuilder:validation:Enum={RollingUpdate,OnDelete}
 	// +kubebuilder:default=RollingUpdate
 	Type RolloutStrategyType `json:"type"`
 
@@ -98,6 +483,9 @@ type RolloutStrategyType string
 const (
 	// RollingUpdateStrategyType - Replace pods one by one.
 	RollingUpdateStrategyType RolloutStrategyType = "RollingUpdate"
+
+	// OnDeleteStrategyType - Only update pods when they are deleted manually.
+	OnDeleteStrategyType RolloutStrategyType = "OnDelete"
 )
 
 // UpdateStrategyType defines the strategy type for in-place update.
@@ -160,10 +548,14 @@ const (
 
 	// RecreateRoleInstanceOnPodRestart - Recreate the role instance on pod restart.
 	RecreateRoleInstanceOnPodRestart RestartPolicyType = "RecreateRoleInstanceOnPodRestart"
+
+	// RecreateRoleOnPodRestart - Recreate all pods of the role on pod restart, the other roles keep running.
+	RecreateRoleOnPodRestart RestartPolicyType = "RecreateRoleOnPodRestart"
 )
 
 // RoleSpec defines the specification for a role in the group
 // +kubebuilder:validation:XValidation:rule="!(has(self.standalonePattern) && has(self.leaderWorkerPattern))",message="standalonePattern and leaderWorkerPattern are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.rolloutStrategy) || self.rolloutStrategy.type != 'OnDelete' || (has(self.annotations) && 'rbg.workloads.x-k8s.io/role-workload-type' in self.annotations && self.annotations['rbg.workloads.x-k8s.io/role-workload-type'] == 'apps/v1/StatefulSet')",message="rolloutStrategy type OnDelete is only supported by StatefulSet roles"
 type RoleSpec struct {
 	// Unique identifier for the role
 	// +kubebuilder:validation:Required
@@ -187,7 +579,7 @@ type RoleSpec struct {
 	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
 
 	// RestartPolicy defines the restart policy when pod failures happen.
-	// +kubebuilder:validation:Enum={None,RecreateRBGOnPodRestart,RecreateRoleInstanceOnPodRestart}
+	// +kubebuilder:validation:Enum={None,RecreateRBGOnPodRestart,RecreateRoleInstanceOnPodRestart,RecreateRoleOnPodRestart}
 	// +optional
 	RestartPolicy RestartPolicyType `json:"restartPolicy,omitempty"`
 
@@ -214,12 +606,45 @@ type RoleSpec struct {
 	// +kubebuilder:default=0
 	MinReadySeconds int32 `json:"minReadySeconds,omitempty" protobuf:"varint,9,opt,name=minReadySeconds"`
 
+	// ProgressDeadlineSeconds is the number of seconds a new revision of the role has to become ready
+	// and updated before its rollout is considered failed. No deadline applies when unset.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+
 	// PodManagementPolicy controls how RoleInstances are created during initial scale-up.
 	// Parallel (default) creates all instances simultaneously.
 	// OrderedReady creates instances one by one, waiting for each to be ready.
 	// +optional
 	// +kubebuilder:default=Parallel
 	PodManagementPolicy constants.PodManagementPolicyType `json:"podManagementPolicy,omitempty"`
+
+	// PriorityClassName is the priority class of the pods of this role. It overrides the one of the pod
+	// template, so that roles of a group can be given different priorities during preemption.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// ScaleDownPolicy ranks the pods of the role before its replicas are reduced, so that the cheapest
+	// ones are removed first. It is honored by the Deployment and CloneSet workloads.
+	// +optional
+	ScaleDownPolicy *ScaleDownPolicy `json:"scaleDownPolicy,omitempty"`
+
+	// NodeFailurePolicy force-deletes the pods of the role left on a node that stopped responding, so
+	// that they are recreated on healthy nodes instead of waiting for the default eviction timeouts.
+	// +optional
+	NodeFailurePolicy *NodeFailurePolicy `json:"nodeFailurePolicy,omitempty"`
+
+	// TTLSecondsAfterFinished deletes the Job of a one-shot role, along with its pods, once it finished
+	// for this many seconds. The role keeps reporting the outcome of the run, and the Job only runs again
+	// when the role is updated. It is only honored by Job roles.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Autoscaling scales the replicas of the role by a metric of its engines, without an external
+	// autoscaler such as KEDA. It cannot be combined with the scaling adapter.
+	// +optional
+	Autoscaling *RoleAutoscaling `json:"autoscaling,omitempty"`
 }
 
 // GetWorkloadType returns the workload type for this role.
@@ -386,6 +811,110 @@ type ScalingAdapter struct {
 	Labels map[string]string `json:"labels,omitempty"`
 }
 
+// ScaleDownPolicyType defines how the pods of a role are ranked for a scale-down.
+// +kubebuilder:validation:Enum={PodAge,EngineLoad}
+type ScaleDownPolicyType string
+
+const (
+	// PodAgeScaleDownPolicy removes the youngest pods first.
+	PodAgeScaleDownPolicy ScaleDownPolicyType = "PodAge"
+
+	// EngineLoadScaleDownPolicy removes the least busy pods first, as reported by the engine metrics.
+	EngineLoadScaleDownPolicy ScaleDownPolicyType = "EngineLoad"
+)
+
+// NodeFailurePolicy defines when the pods of a role are taken off a failed node.
+//
+// A force-deleted pod may still be running on a partitioned node, so the policy is meant for
+// stateless engines: a StatefulSet pod recreated elsewhere runs twice with the same identity until
+// the node is back.
+type NodeFailurePolicy struct {
+	// NotReadySeconds is how long the node of a pod has to be NotReady or Unknown before the pod is
+	// force-deleted. Defaults to 60.
+	// +optional
+	// +kubebuilder:default=60
+	// +kubebuilder:validation:Minimum=0
+	NotReadySeconds int32 `json:"notReadySeconds,omitempty"`
+}
+
+// ScaleDownPolicy defines which pods of a role are removed first when it is scaled down.
+// +kubebuilder:validation:XValidation:rule="self.type != 'EngineLoad' || has(self.engineLoad)",message="engineLoad is required by the EngineLoad type"
+type ScaleDownPolicy struct {
+	// Type is how the pods are ranked, the rank is written to the controller.kubernetes.io/pod-deletion-cost
+	// annotation of the pods.
+	Type ScaleDownPolicyType `json:"type"`
+
+	// EngineLoad is the engine metric the load of the pods is read from.
+	// +optional
+	EngineLoad *EngineLoadMetric `json:"engineLoad,omitempty"`
+}
+
+// EngineLoadMetric defines the Prometheus gauge the load of an engine pod is read from.
+type EngineLoadMetric struct {
+	// Port is the port the engine serves its Prometheus metrics on.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Path is the HTTP path of the metrics.
+	// +optional
+	// +kubebuilder:default="/metrics"
+	Path string `json:"path,omitempty"`
+
+	// Metric is the name of the gauge holding the load, e.g. vllm:num_requests_running.
+	// The values of all its series are summed.
+	// +kubebuilder:validation:MinLength=1
+	Metric string `json:"metric"`
+}
+
+// RoleAutoscaling defines how the replicas of a role follow a metric of its engines.
+// +kubebuilder:validation:XValidation:rule="has(self.engineMetric) != has(self.prometheus)",message="exactly one of engineMetric and prometheus is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.engineMetric) || !has(self.minReplicas) || self.minReplicas >= 1",message="engineMetric requires minReplicas of at least 1"
+type RoleAutoscaling struct {
+	// MinReplicas is the lowest number of replicas the role is scaled to. A role is only scaled to
+	// zero by a Prometheus metric, as a role without pods reports no engine metric. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the highest number of replicas the role is scaled to.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// EngineMetric is scraped from the ready pods of the role, the values of all pods are summed.
+	// +optional
+	EngineMetric *EngineLoadMetric `json:"engineMetric,omitempty"`
+
+	// Prometheus is a query whose result, summed over its series, is the value of the whole role.
+	// +optional
+	Prometheus *PrometheusMetric `json:"prometheus,omitempty"`
+
+	// Target is the value of the metric a single replica is meant to handle, e.g. the number of
+	// queued requests. The role is scaled to the value of the metric divided by the target.
+	Target resource.Quantity `json:"target"`
+
+	// ScaleDownStabilizationSeconds is how long the highest number of replicas recommended is kept
+	// before the role is scaled down, so that a short dip of the metric does not remove replicas.
+	// Defaults to 300.
+	// +optional
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	ScaleDownStabilizationSeconds *int32 `json:"scaleDownStabilizationSeconds,omitempty"`
+}
+
+// PrometheusMetric defines a Prometheus query the load of a role is read from.
+type PrometheusMetric struct {
+	// ServerAddress is the URL of the Prometheus server, e.g. http://prometheus.monitoring:9090.
+	// +kubebuilder:validation:MinLength=1
+	ServerAddress string `json:"serverAddress"`
+
+	// Query is an instant PromQL query, e.g. sum(vllm:num_requests_waiting{pod=~"llm-decode-.*"}).
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+}
+
 // RoleBasedGroupStatus defines the observed state of RoleBasedGroup.
 type RoleBasedGroupStatus struct {
 	// The generation observed by the controller
@@ -415,6 +944,27 @@ type RoleStatus struct {
 
 	// Total number of updated replicas
 	UpdatedReplicas int32 `json:"updatedReplicas"`
+
+	// CurrentRevision is the revision hash of the role the replicas last fully converged to.
+	// It differs from UpdateRevision while the role is being updated.
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+
+	// UpdateRevision is the revision hash of the role in the latest revision of the group.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+
+	// CompletedRevision is the revision hash of the role the Job of a one-shot role last completed at.
+	// The role keeps reporting its completion at this revision once the Job is deleted after its
+	// ttlSecondsAfterFinished.
+	// +optional
+	CompletedRevision string `json:"completedRevision,omitempty"`
+
+	// Conditions track the condition of the role, derived from the status of its workload
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +genclient
@@ -449,6 +999,43 @@ const (
 
 	// RoleBasedGroupRestartInProgress means rbg is restarting.
 	RoleBasedGroupRestartInProgress RoleBasedGroupConditionType = "RestartInProgress"
+
+	// RoleBasedGroupPaused means the reconciliation of rbg is paused.
+	RoleBasedGroupPaused RoleBasedGroupConditionType = "Paused"
+
+	// RoleBasedGroupWaitingForDependencies means the group waits for the RoleBasedGroups in spec.dependsOn,
+	// or some roles wait for their dependencies, to be ready before being created or scaled up.
+	RoleBasedGroupWaitingForDependencies RoleBasedGroupConditionType = "WaitingForDependencies"
+
+	// RoleBasedGroupSuspended means the workloads of rbg are kept at zero replicas, either by
+	// spec.suspend or because the group waits for its admission by Kueue.
+	RoleBasedGroupSuspended RoleBasedGroupConditionType = "Suspended"
+
+	// RoleBasedGroupPropagated means the workloads of a multi-cluster rbg are applied to their member clusters.
+	RoleBasedGroupPropagated RoleBasedGroupConditionType = "Propagated"
+)
+
+type RoleConditionType string
+
+// These are built-in conditions of a role.
+const (
+	// RoleReady means all desired replicas of the role are ready.
+	RoleReady RoleConditionType = "Ready"
+
+	// RoleProgressing means the workload of the role is being created, scaled or updated.
+	RoleProgressing RoleConditionType = "Progressing"
+
+	// RoleDegraded means the workload of the role settled with fewer ready replicas than desired.
+	RoleDegraded RoleConditionType = "Degraded"
+
+	// RoleSuspended means the role is kept at zero replicas because the rbg is suspended.
+	RoleSuspended RoleConditionType = "Suspended"
+
+	// RoleComplete means all pods of a Job role succeeded.
+	RoleComplete RoleConditionType = "Complete"
+
+	// RoleFailed means the Job of the role failed and no longer retries its pods.
+	RoleFailed RoleConditionType = "Failed"
 )
 
 // +kubebuilder:object:root=true
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha2
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -43,6 +45,64 @@ type RoleBasedGroupSetSpec struct {
 
 	// GroupTemplate describes the RoleBasedGroup that will be created.
 	GroupTemplate RoleBasedGroupTemplateSpec `json:"groupTemplate"`
+
+	// UpdateStrategy defines how the groups are updated when the group template changes.
+	// +optional
+	UpdateStrategy *RoleBasedGroupSetUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// RoleBasedGroupSetUpdateStrategyType defines how the groups of a RoleBasedGroupSet are updated.
+// +kubebuilder:validation:Enum={InPlace,BlueGreen}
+type RoleBasedGroupSetUpdateStrategyType string
+
+const (
+	// GroupSetInPlaceUpdateStrategyType updates the existing groups to the new template, each group
+	// rolls out its roles according to their rollout strategy.
+	GroupSetInPlaceUpdateStrategyType RoleBasedGroupSetUpdateStrategyType = "InPlace"
+
+	// GroupSetBlueGreenUpdateStrategyType creates a complete new set of groups at the new template and
+	// deletes the previous groups once the new ones are ready and verified.
+	GroupSetBlueGreenUpdateStrategyType RoleBasedGroupSetUpdateStrategyType = "BlueGreen"
+)
+
+// RoleBasedGroupSetUpdateStrategy defines how the groups are updated when the group template changes.
+type RoleBasedGroupSetUpdateStrategy struct {
+	// Type of the update strategy. Defaults to InPlace.
+	// +optional
+	// +kubebuilder:default=InPlace
+	Type RoleBasedGroupSetUpdateStrategyType `json:"type,omitempty"`
+
+	// BlueGreen configures the BlueGreen update strategy.
+	// +optional
+	BlueGreen *BlueGreenUpdateStrategy `json:"blueGreen,omitempty"`
+}
+
+// BlueGreenUpdateStrategy configures the switch from the previous groups to the new ones.
+type BlueGreenUpdateStrategy struct {
+	// Service is a Service managed by the controller that selects the pods of a role of the serving
+	// groups. Its selector is switched to the new groups once they are ready and verified.
+	// +optional
+	Service *BlueGreenService `json:"service,omitempty"`
+
+	// Verification is a Job run once the new groups are ready. The traffic is only switched and the
+	// previous groups deleted once the Job succeeded.
+	// +optional
+	Verification *batchv1.JobTemplateSpec `json:"verification,omitempty"`
+}
+
+// BlueGreenService describes the Service switched between the previous and the new groups.
+type BlueGreenService struct {
+	// Name of the Service. Defaults to the name of the RoleBasedGroupSet.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Role whose pods are selected by the Service.
+	// +kubebuilder:validation:MinLength=1
+	Role string `json:"role"`
+
+	// Ports exposed by the Service.
+	// +kubebuilder:validation:MinItems=1
+	Ports []corev1.ServicePort `json:"ports"`
 }
 
 type RoleBasedGroupSetConditionType string
@@ -63,6 +123,16 @@ type RoleBasedGroupSetStatus struct {
 	// +optional
 	ReadyReplicas int32 `json:"readyReplicas" protobuf:"varint,3,opt,name=readyReplicas"`
 
+	// CurrentRevision is the revision of the group template the serving groups were created from,
+	// only set by the BlueGreen update strategy.
+	// +optional
+	CurrentRevision string `json:"currentRevision,omitempty"`
+
+	// UpdateRevision is the revision of the current group template, only set by the BlueGreen update
+	// strategy.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+
 	// Conditions track the condition of the rbgs
 	// +patchMergeKey=type
 	// +patchStrategy=merge
@@ -126,9 +126,10 @@ func validateRoleTemplateFields(
 			)
 		}
 
-		// Cross-resource check: referenced template must exist.
+		// Cross-resource check: referenced template must exist. ClusterRoleTemplates are not part
+		// of the group and are looked up by the controller.
 		templateRef := role.GetTemplateRef()
-		if !validTemplateNames[templateRef.Name] {
+		if templateRef.Kind != ClusterRoleTemplateKind && !validTemplateNames[templateRef.Name] {
 			return fmt.Errorf(
 				"spec.roles[%d].templateRef.name: template %q not found in spec.roleTemplates",
 				index, templateRef.Name,
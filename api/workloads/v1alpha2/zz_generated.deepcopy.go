@@ -21,12 +21,28 @@ limitations under the License.
 package v1alpha2
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivationPolicy) DeepCopyInto(out *ActivationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivationPolicy.
+func (in *ActivationPolicy) DeepCopy() *ActivationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdapterScaleTargetRef) DeepCopyInto(out *AdapterScaleTargetRef) {
 	*out = *in
@@ -42,6 +58,53 @@ func (in *AdapterScaleTargetRef) DeepCopy() *AdapterScaleTargetRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenService) DeepCopyInto(out *BlueGreenService) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]v1.ServicePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenService.
+func (in *BlueGreenService) DeepCopy() *BlueGreenService {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenUpdateStrategy) DeepCopyInto(out *BlueGreenUpdateStrategy) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(BlueGreenService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(batchv1.JobTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenUpdateStrategy.
+func (in *BlueGreenUpdateStrategy) DeepCopy() *BlueGreenUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterEngineRuntimeProfile) DeepCopyInto(out *ClusterEngineRuntimeProfile) {
 	*out = *in
@@ -125,6 +188,20 @@ func (in *ClusterEngineRuntimeProfileSpec) DeepCopyInto(out *ClusterEngineRuntim
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEngineRuntimeProfileSpec.
@@ -152,6 +229,80 @@ func (in *ClusterEngineRuntimeProfileStatus) DeepCopy() *ClusterEngineRuntimePro
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRoleTemplate) DeepCopyInto(out *ClusterRoleTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRoleTemplate.
+func (in *ClusterRoleTemplate) DeepCopy() *ClusterRoleTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRoleTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRoleTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRoleTemplateList) DeepCopyInto(out *ClusterRoleTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRoleTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRoleTemplateList.
+func (in *ClusterRoleTemplateList) DeepCopy() *ClusterRoleTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRoleTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRoleTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRoleTemplateSpec) DeepCopyInto(out *ClusterRoleTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRoleTemplateSpec.
+func (in *ClusterRoleTemplateSpec) DeepCopy() *ClusterRoleTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRoleTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoordinatedPolicy) DeepCopyInto(out *CoordinatedPolicy) {
 	*out = *in
@@ -323,6 +474,36 @@ func (in *CustomComponentsPattern) DeepCopy() *CustomComponentsPattern {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointPickerRef) DeepCopyInto(out *EndpointPickerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPickerRef.
+func (in *EndpointPickerRef) DeepCopy() *EndpointPickerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointPickerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EngineLoadMetric) DeepCopyInto(out *EngineLoadMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EngineLoadMetric.
+func (in *EngineLoadMetric) DeepCopy() *EngineLoadMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(EngineLoadMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EngineRuntime) DeepCopyInto(out *EngineRuntime) {
 	*out = *in
@@ -350,6 +531,96 @@ func (in *EngineRuntime) DeepCopy() *EngineRuntime {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailurePolicy) DeepCopyInto(out *FailurePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicy.
+func (in *FailurePolicy) DeepCopy() *FailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupAutoscaling) DeepCopyInto(out *GroupAutoscaling) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]GroupAutoscalingRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinUnits != nil {
+		in, out := &in.MinUnits, &out.MinUnits
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTFT != nil {
+		in, out := &in.TTFT, &out.TTFT
+		*out = new(ServiceLevelObjective)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TPOT != nil {
+		in, out := &in.TPOT, &out.TPOT
+		*out = new(ServiceLevelObjective)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueLength != nil {
+		in, out := &in.QueueLength, &out.QueueLength
+		*out = new(ServiceLevelObjective)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDownStabilizationSeconds != nil {
+		in, out := &in.ScaleDownStabilizationSeconds, &out.ScaleDownStabilizationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAutoscaling.
+func (in *GroupAutoscaling) DeepCopy() *GroupAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupAutoscalingRole) DeepCopyInto(out *GroupAutoscalingRole) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupAutoscalingRole.
+func (in *GroupAutoscalingRole) DeepCopy() *GroupAutoscalingRole {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupAutoscalingRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupDependency) DeepCopyInto(out *GroupDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupDependency.
+func (in *GroupDependency) DeepCopy() *GroupDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InPlaceUpdateStrategy) DeepCopyInto(out *InPlaceUpdateStrategy) {
 	*out = *in
@@ -365,6 +636,27 @@ func (in *InPlaceUpdateStrategy) DeepCopy() *InPlaceUpdateStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InferencePoolSpec) DeepCopyInto(out *InferencePoolSpec) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.EndpointPicker = in.EndpointPicker
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferencePoolSpec.
+func (in *InferencePoolSpec) DeepCopy() *InferencePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InferencePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceComponent) DeepCopyInto(out *InstanceComponent) {
 	*out = *in
@@ -417,6 +709,90 @@ func (in *LeaderWorkerPattern) DeepCopy() *LeaderWorkerPattern {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringPolicy) DeepCopyInto(out *MonitoringPolicy) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleMonitoring, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringPolicy.
+func (in *MonitoringPolicy) DeepCopy() *MonitoringPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiClusterPolicy) DeepCopyInto(out *MultiClusterPolicy) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiClusterPolicy.
+func (in *MultiClusterPolicy) DeepCopy() *MultiClusterPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiClusterPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingPolicy) DeepCopyInto(out *NetworkingPolicy) {
+	*out = *in
+	if in.InferencePools != nil {
+		in, out := &in.InferencePools, &out.InferencePools
+		*out = make([]InferencePoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingPolicy.
+func (in *NetworkingPolicy) DeepCopy() *NetworkingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFailurePolicy) DeepCopyInto(out *NodeFailurePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFailurePolicy.
+func (in *NodeFailurePolicy) DeepCopy() *NodeFailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Pattern) DeepCopyInto(out *Pattern) {
 	*out = *in
@@ -447,6 +823,77 @@ func (in *Pattern) DeepCopy() *Pattern {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+	*out = *in
+	if in.CoLocateRoles != nil {
+		in, out := &in.CoLocateRoles, &out.CoLocateRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
+func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMetric.
+func (in *PrometheusMetric) DeepCopy() *PrometheusMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleAutoscaling) DeepCopyInto(out *RoleAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EngineMetric != nil {
+		in, out := &in.EngineMetric, &out.EngineMetric
+		*out = new(EngineLoadMetric)
+		**out = **in
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusMetric)
+		**out = **in
+	}
+	out.Target = in.Target.DeepCopy()
+	if in.ScaleDownStabilizationSeconds != nil {
+		in, out := &in.ScaleDownStabilizationSeconds, &out.ScaleDownStabilizationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAutoscaling.
+func (in *RoleAutoscaling) DeepCopy() *RoleAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleBasedGroup) DeepCopyInto(out *RoleBasedGroup) {
 	*out = *in
@@ -687,6 +1134,11 @@ func (in *RoleBasedGroupSetSpec) DeepCopyInto(out *RoleBasedGroupSetSpec) {
 		**out = **in
 	}
 	in.GroupTemplate.DeepCopyInto(&out.GroupTemplate)
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(RoleBasedGroupSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleBasedGroupSetSpec.
@@ -721,6 +1173,26 @@ func (in *RoleBasedGroupSetStatus) DeepCopy() *RoleBasedGroupSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleBasedGroupSetUpdateStrategy) DeepCopyInto(out *RoleBasedGroupSetUpdateStrategy) {
+	*out = *in
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleBasedGroupSetUpdateStrategy.
+func (in *RoleBasedGroupSetUpdateStrategy) DeepCopy() *RoleBasedGroupSetUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleBasedGroupSetUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleBasedGroupSpec) DeepCopyInto(out *RoleBasedGroupSpec) {
 	*out = *in
@@ -738,6 +1210,56 @@ func (in *RoleBasedGroupSpec) DeepCopyInto(out *RoleBasedGroupSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Activation != nil {
+		in, out := &in.Activation, &out.Activation
+		*out = new(ActivationPolicy)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]GroupDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.TerminationPolicy != nil {
+		in, out := &in.TerminationPolicy, &out.TerminationPolicy
+		*out = new(TerminationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementPolicy != nil {
+		in, out := &in.PlacementPolicy, &out.PlacementPolicy
+		*out = new(PlacementPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(FailurePolicy)
+		**out = **in
+	}
+	if in.MultiCluster != nil {
+		in, out := &in.MultiCluster, &out.MultiCluster
+		*out = new(MultiClusterPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(NetworkingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(GroupAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleBasedGroupSpec.
@@ -763,7 +1285,9 @@ func (in *RoleBasedGroupStatus) DeepCopyInto(out *RoleBasedGroupStatus) {
 	if in.RoleStatuses != nil {
 		in, out := &in.RoleStatuses, &out.RoleStatuses
 		*out = make([]RoleStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1275,6 +1799,21 @@ func (in *RoleInstanceTemplate) DeepCopy() *RoleInstanceTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleMonitoring) DeepCopyInto(out *RoleMonitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleMonitoring.
+func (in *RoleMonitoring) DeepCopy() *RoleMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
 	*out = *in
@@ -1327,6 +1866,31 @@ func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
 		*out = new(ScalingAdapter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownPolicy != nil {
+		in, out := &in.ScaleDownPolicy, &out.ScaleDownPolicy
+		*out = new(ScaleDownPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeFailurePolicy != nil {
+		in, out := &in.NodeFailurePolicy, &out.NodeFailurePolicy
+		*out = new(NodeFailurePolicy)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(RoleAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
@@ -1342,6 +1906,13 @@ func (in *RoleSpec) DeepCopy() *RoleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
@@ -1455,6 +2026,26 @@ func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleDownPolicy) DeepCopyInto(out *ScaleDownPolicy) {
+	*out = *in
+	if in.EngineLoad != nil {
+		in, out := &in.EngineLoad, &out.EngineLoad
+		*out = new(EngineLoadMetric)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleDownPolicy.
+func (in *ScaleDownPolicy) DeepCopy() *ScaleDownPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleDownPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingAdapter) DeepCopyInto(out *ScalingAdapter) {
 	*out = *in
@@ -1497,6 +2088,22 @@ func (in *ScalingCoordinationStrategy) DeepCopy() *ScalingCoordinationStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjective) DeepCopyInto(out *ServiceLevelObjective) {
+	*out = *in
+	out.Target = in.Target.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjective.
+func (in *ServiceLevelObjective) DeepCopy() *ServiceLevelObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StandalonePattern) DeepCopyInto(out *StandalonePattern) {
 	*out = *in
@@ -1558,6 +2165,26 @@ func (in *TemplateSource) DeepCopy() *TemplateSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerminationPolicy) DeepCopyInto(out *TerminationPolicy) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerminationPolicy.
+func (in *TerminationPolicy) DeepCopy() *TerminationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TerminationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 	*out = *in
@@ -131,12 +131,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &workloadsv1alpha1.WorkloadSpecApplyConfiguration{}
 
 		// Group=workloads.x-k8s.io, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithKind("ActivationPolicy"):
+		return &workloadsv1alpha2.ActivationPolicyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("AdapterScaleTargetRef"):
 		return &workloadsv1alpha2.AdapterScaleTargetRefApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("BlueGreenService"):
+		return &workloadsv1alpha2.BlueGreenServiceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("BlueGreenUpdateStrategy"):
+		return &workloadsv1alpha2.BlueGreenUpdateStrategyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ClusterEngineRuntimeProfile"):
 		return &workloadsv1alpha2.ClusterEngineRuntimeProfileApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ClusterEngineRuntimeProfileSpec"):
 		return &workloadsv1alpha2.ClusterEngineRuntimeProfileSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ClusterRoleTemplate"):
+		return &workloadsv1alpha2.ClusterRoleTemplateApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ClusterRoleTemplateSpec"):
+		return &workloadsv1alpha2.ClusterRoleTemplateSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("CoordinatedPolicy"):
 		return &workloadsv1alpha2.CoordinatedPolicyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("CoordinatedPolicyRule"):
@@ -149,16 +159,44 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &workloadsv1alpha2.CoordinatedPolicyStrategyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("CustomComponentsPattern"):
 		return &workloadsv1alpha2.CustomComponentsPatternApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("EndpointPickerRef"):
+		return &workloadsv1alpha2.EndpointPickerRefApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("EngineLoadMetric"):
+		return &workloadsv1alpha2.EngineLoadMetricApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("EngineRuntime"):
 		return &workloadsv1alpha2.EngineRuntimeApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FailurePolicy"):
+		return &workloadsv1alpha2.FailurePolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("GroupAutoscaling"):
+		return &workloadsv1alpha2.GroupAutoscalingApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("GroupAutoscalingRole"):
+		return &workloadsv1alpha2.GroupAutoscalingRoleApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("GroupDependency"):
+		return &workloadsv1alpha2.GroupDependencyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InPlaceUpdateStrategy"):
 		return &workloadsv1alpha2.InPlaceUpdateStrategyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("InferencePoolSpec"):
+		return &workloadsv1alpha2.InferencePoolSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InstanceComponent"):
 		return &workloadsv1alpha2.InstanceComponentApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("LeaderWorkerPattern"):
 		return &workloadsv1alpha2.LeaderWorkerPatternApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("MonitoringPolicy"):
+		return &workloadsv1alpha2.MonitoringPolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("MultiClusterPolicy"):
+		return &workloadsv1alpha2.MultiClusterPolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkingPolicy"):
+		return &workloadsv1alpha2.NetworkingPolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NodeFailurePolicy"):
+		return &workloadsv1alpha2.NodeFailurePolicyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("Pattern"):
 		return &workloadsv1alpha2.PatternApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("PlacementPolicy"):
+		return &workloadsv1alpha2.PlacementPolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("PrometheusMetric"):
+		return &workloadsv1alpha2.PrometheusMetricApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("RoleAutoscaling"):
+		return &workloadsv1alpha2.RoleAutoscalingApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleBasedGroup"):
 		return &workloadsv1alpha2.RoleBasedGroupApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleBasedGroupScalingAdapter"):
@@ -173,6 +211,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &workloadsv1alpha2.RoleBasedGroupSetSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleBasedGroupSetStatus"):
 		return &workloadsv1alpha2.RoleBasedGroupSetStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("RoleBasedGroupSetUpdateStrategy"):
+		return &workloadsv1alpha2.RoleBasedGroupSetUpdateStrategyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleBasedGroupSpec"):
 		return &workloadsv1alpha2.RoleBasedGroupSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleBasedGroupStatus"):
@@ -213,6 +253,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &workloadsv1alpha2.RoleInstanceStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleInstanceTemplate"):
 		return &workloadsv1alpha2.RoleInstanceTemplateApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("RoleMonitoring"):
+		return &workloadsv1alpha2.RoleMonitoringApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleSpec"):
 		return &workloadsv1alpha2.RoleSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RoleStatus"):
@@ -225,16 +267,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &workloadsv1alpha2.RollingUpdateCoordinationStrategyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("RolloutStrategy"):
 		return &workloadsv1alpha2.RolloutStrategyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ScaleDownPolicy"):
+		return &workloadsv1alpha2.ScaleDownPolicyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ScalingAdapter"):
 		return &workloadsv1alpha2.ScalingAdapterApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ScalingCoordinationStrategy"):
 		return &workloadsv1alpha2.ScalingCoordinationStrategyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ServiceLevelObjective"):
+		return &workloadsv1alpha2.ServiceLevelObjectiveApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("StandalonePattern"):
 		return &workloadsv1alpha2.StandalonePatternApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("TemplateRef"):
 		return &workloadsv1alpha2.TemplateRefApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("TemplateSource"):
 		return &workloadsv1alpha2.TemplateSourceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TerminationPolicy"):
+		return &workloadsv1alpha2.TerminationPolicyApplyConfiguration{}
 
 	}
 	return nil
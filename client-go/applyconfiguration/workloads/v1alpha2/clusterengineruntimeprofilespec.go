@@ -24,10 +24,12 @@ import (
 // ClusterEngineRuntimeProfileSpecApplyConfiguration represents a declarative configuration of the ClusterEngineRuntimeProfileSpec type for use
 // with apply.
 type ClusterEngineRuntimeProfileSpecApplyConfiguration struct {
-	InitContainers []v1.Container `json:"initContainers,omitempty"`
-	Containers     []v1.Container `json:"containers,omitempty"`
-	Volumes        []v1.Volume    `json:"volumes,omitempty"`
-	UpdateStrategy *string        `json:"updateStrategy,omitempty"`
+	InitContainers []v1.Container   `json:"initContainers,omitempty"`
+	Containers     []v1.Container   `json:"containers,omitempty"`
+	Volumes        []v1.Volume      `json:"volumes,omitempty"`
+	Env            []v1.EnvVar      `json:"env,omitempty"`
+	VolumeMounts   []v1.VolumeMount `json:"volumeMounts,omitempty"`
+	UpdateStrategy *string          `json:"updateStrategy,omitempty"`
 }
 
 // ClusterEngineRuntimeProfileSpecApplyConfiguration constructs a declarative configuration of the ClusterEngineRuntimeProfileSpec type for use with
@@ -66,6 +68,26 @@ func (b *ClusterEngineRuntimeProfileSpecApplyConfiguration) WithVolumes(values .
 	return b
 }
 
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *ClusterEngineRuntimeProfileSpecApplyConfiguration) WithEnv(values ...v1.EnvVar) *ClusterEngineRuntimeProfileSpecApplyConfiguration {
+	for i := range values {
+		b.Env = append(b.Env, values[i])
+	}
+	return b
+}
+
+// WithVolumeMounts adds the given value to the VolumeMounts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeMounts field.
+func (b *ClusterEngineRuntimeProfileSpecApplyConfiguration) WithVolumeMounts(values ...v1.VolumeMount) *ClusterEngineRuntimeProfileSpecApplyConfiguration {
+	for i := range values {
+		b.VolumeMounts = append(b.VolumeMounts, values[i])
+	}
+	return b
+}
+
 // WithUpdateStrategy sets the UpdateStrategy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the UpdateStrategy field is set to the value of the last call.
@@ -20,8 +20,9 @@ package v1alpha2
 // RoleBasedGroupSetSpecApplyConfiguration represents a declarative configuration of the RoleBasedGroupSetSpec type for use
 // with apply.
 type RoleBasedGroupSetSpecApplyConfiguration struct {
-	Replicas      *int32                                        `json:"replicas,omitempty"`
-	GroupTemplate *RoleBasedGroupTemplateSpecApplyConfiguration `json:"groupTemplate,omitempty"`
+	Replicas       *int32                                             `json:"replicas,omitempty"`
+	GroupTemplate  *RoleBasedGroupTemplateSpecApplyConfiguration      `json:"groupTemplate,omitempty"`
+	UpdateStrategy *RoleBasedGroupSetUpdateStrategyApplyConfiguration `json:"updateStrategy,omitempty"`
 }
 
 // RoleBasedGroupSetSpecApplyConfiguration constructs a declarative configuration of the RoleBasedGroupSetSpec type for use with
@@ -45,3 +46,11 @@ func (b *RoleBasedGroupSetSpecApplyConfiguration) WithGroupTemplate(value *RoleB
 	b.GroupTemplate = value
 	return b
 }
+
+// WithUpdateStrategy sets the UpdateStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateStrategy field is set to the value of the last call.
+func (b *RoleBasedGroupSetSpecApplyConfiguration) WithUpdateStrategy(value *RoleBasedGroupSetUpdateStrategyApplyConfiguration) *RoleBasedGroupSetSpecApplyConfiguration {
+	b.UpdateStrategy = value
+	return b
+}
@@ -27,6 +27,8 @@ type RoleBasedGroupSetStatusApplyConfiguration struct {
 	ObservedGeneration *int64                           `json:"observedGeneration,omitempty"`
 	Replicas           *int32                           `json:"replicas,omitempty"`
 	ReadyReplicas      *int32                           `json:"readyReplicas,omitempty"`
+	CurrentRevision    *string                          `json:"currentRevision,omitempty"`
+	UpdateRevision     *string                          `json:"updateRevision,omitempty"`
 	Conditions         []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
@@ -60,6 +62,22 @@ func (b *RoleBasedGroupSetStatusApplyConfiguration) WithReadyReplicas(value int3
 	return b
 }
 
+// WithCurrentRevision sets the CurrentRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentRevision field is set to the value of the last call.
+func (b *RoleBasedGroupSetStatusApplyConfiguration) WithCurrentRevision(value string) *RoleBasedGroupSetStatusApplyConfiguration {
+	b.CurrentRevision = &value
+	return b
+}
+
+// WithUpdateRevision sets the UpdateRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateRevision field is set to the value of the last call.
+func (b *RoleBasedGroupSetStatusApplyConfiguration) WithUpdateRevision(value string) *RoleBasedGroupSetStatusApplyConfiguration {
+	b.UpdateRevision = &value
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -17,11 +17,28 @@ limitations under the License.
 
 package v1alpha2
 
+import (
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
 // RoleBasedGroupSpecApplyConfiguration represents a declarative configuration of the RoleBasedGroupSpec type for use
 // with apply.
 type RoleBasedGroupSpecApplyConfiguration struct {
-	Roles         []RoleSpecApplyConfiguration     `json:"roles,omitempty"`
-	RoleTemplates []RoleTemplateApplyConfiguration `json:"roleTemplates,omitempty"`
+	Roles             []RoleSpecApplyConfiguration          `json:"roles,omitempty"`
+	RoleTemplates     []RoleTemplateApplyConfiguration      `json:"roleTemplates,omitempty"`
+	Suspend           *bool                                 `json:"suspend,omitempty"`
+	Activation        *ActivationPolicyApplyConfiguration   `json:"activation,omitempty"`
+	Paused            *bool                                 `json:"paused,omitempty"`
+	DependsOn         []GroupDependencyApplyConfiguration   `json:"dependsOn,omitempty"`
+	TerminationPolicy *TerminationPolicyApplyConfiguration  `json:"terminationPolicy,omitempty"`
+	AdoptionPolicy    *workloadsv1alpha2.AdoptionPolicy     `json:"adoptionPolicy,omitempty"`
+	AutoRollback      *workloadsv1alpha2.AutoRollbackPolicy `json:"autoRollback,omitempty"`
+	PlacementPolicy   *PlacementPolicyApplyConfiguration    `json:"placementPolicy,omitempty"`
+	FailurePolicy     *FailurePolicyApplyConfiguration      `json:"failurePolicy,omitempty"`
+	MultiCluster      *MultiClusterPolicyApplyConfiguration `json:"multiCluster,omitempty"`
+	Networking        *NetworkingPolicyApplyConfiguration   `json:"networking,omitempty"`
+	Monitoring        *MonitoringPolicyApplyConfiguration   `json:"monitoring,omitempty"`
+	Autoscaling       *GroupAutoscalingApplyConfiguration   `json:"autoscaling,omitempty"`
 }
 
 // RoleBasedGroupSpecApplyConfiguration constructs a declarative configuration of the RoleBasedGroupSpec type for use with
@@ -55,3 +72,112 @@ func (b *RoleBasedGroupSpecApplyConfiguration) WithRoleTemplates(values ...*Role
 	}
 	return b
 }
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithSuspend(value bool) *RoleBasedGroupSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithActivation sets the Activation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Activation field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithActivation(value *ActivationPolicyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.Activation = value
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithPaused(value bool) *RoleBasedGroupSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithDependsOn adds the given value to the DependsOn field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DependsOn field.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithDependsOn(values ...*GroupDependencyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDependsOn")
+		}
+		b.DependsOn = append(b.DependsOn, *values[i])
+	}
+	return b
+}
+
+// WithTerminationPolicy sets the TerminationPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TerminationPolicy field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithTerminationPolicy(value *TerminationPolicyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.TerminationPolicy = value
+	return b
+}
+
+// WithAdoptionPolicy sets the AdoptionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdoptionPolicy field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithAdoptionPolicy(value workloadsv1alpha2.AdoptionPolicy) *RoleBasedGroupSpecApplyConfiguration {
+	b.AdoptionPolicy = &value
+	return b
+}
+
+// WithAutoRollback sets the AutoRollback field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoRollback field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithAutoRollback(value workloadsv1alpha2.AutoRollbackPolicy) *RoleBasedGroupSpecApplyConfiguration {
+	b.AutoRollback = &value
+	return b
+}
+
+// WithPlacementPolicy sets the PlacementPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PlacementPolicy field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithPlacementPolicy(value *PlacementPolicyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.PlacementPolicy = value
+	return b
+}
+
+// WithFailurePolicy sets the FailurePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailurePolicy field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithFailurePolicy(value *FailurePolicyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.FailurePolicy = value
+	return b
+}
+
+// WithMultiCluster sets the MultiCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MultiCluster field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithMultiCluster(value *MultiClusterPolicyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.MultiCluster = value
+	return b
+}
+
+// WithNetworking sets the Networking field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Networking field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithNetworking(value *NetworkingPolicyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.Networking = value
+	return b
+}
+
+// WithMonitoring sets the Monitoring field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Monitoring field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithMonitoring(value *MonitoringPolicyApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.Monitoring = value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *RoleBasedGroupSpecApplyConfiguration) WithAutoscaling(value *GroupAutoscalingApplyConfiguration) *RoleBasedGroupSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
@@ -34,11 +34,17 @@ type RoleSpecApplyConfiguration struct {
 	RestartPolicy             *workloadsv1alpha2.RestartPolicyType `json:"restartPolicy,omitempty"`
 	Dependencies              []string                             `json:"dependencies,omitempty"`
 	PatternApplyConfiguration `json:",inline"`
-	ServicePorts              []v1.ServicePort                   `json:"servicePorts,omitempty"`
-	EngineRuntimes            []EngineRuntimeApplyConfiguration  `json:"engineRuntimes,omitempty"`
-	ScalingAdapter            *ScalingAdapterApplyConfiguration  `json:"scalingAdapter,omitempty"`
-	MinReadySeconds           *int32                             `json:"minReadySeconds,omitempty"`
-	PodManagementPolicy       *constants.PodManagementPolicyType `json:"podManagementPolicy,omitempty"`
+	ServicePorts              []v1.ServicePort                     `json:"servicePorts,omitempty"`
+	EngineRuntimes            []EngineRuntimeApplyConfiguration    `json:"engineRuntimes,omitempty"`
+	ScalingAdapter            *ScalingAdapterApplyConfiguration    `json:"scalingAdapter,omitempty"`
+	MinReadySeconds           *int32                               `json:"minReadySeconds,omitempty"`
+	ProgressDeadlineSeconds   *int32                               `json:"progressDeadlineSeconds,omitempty"`
+	PodManagementPolicy       *constants.PodManagementPolicyType   `json:"podManagementPolicy,omitempty"`
+	PriorityClassName         *string                              `json:"priorityClassName,omitempty"`
+	ScaleDownPolicy           *ScaleDownPolicyApplyConfiguration   `json:"scaleDownPolicy,omitempty"`
+	NodeFailurePolicy         *NodeFailurePolicyApplyConfiguration `json:"nodeFailurePolicy,omitempty"`
+	TTLSecondsAfterFinished   *int32                               `json:"ttlSecondsAfterFinished,omitempty"`
+	Autoscaling               *RoleAutoscalingApplyConfiguration   `json:"autoscaling,omitempty"`
 }
 
 // RoleSpecApplyConfiguration constructs a declarative configuration of the RoleSpec type for use with
@@ -180,6 +186,14 @@ func (b *RoleSpecApplyConfiguration) WithMinReadySeconds(value int32) *RoleSpecA
 	return b
 }
 
+// WithProgressDeadlineSeconds sets the ProgressDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProgressDeadlineSeconds field is set to the value of the last call.
+func (b *RoleSpecApplyConfiguration) WithProgressDeadlineSeconds(value int32) *RoleSpecApplyConfiguration {
+	b.ProgressDeadlineSeconds = &value
+	return b
+}
+
 // WithPodManagementPolicy sets the PodManagementPolicy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PodManagementPolicy field is set to the value of the last call.
@@ -187,3 +201,43 @@ func (b *RoleSpecApplyConfiguration) WithPodManagementPolicy(value constants.Pod
 	b.PodManagementPolicy = &value
 	return b
 }
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *RoleSpecApplyConfiguration) WithPriorityClassName(value string) *RoleSpecApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
+// WithScaleDownPolicy sets the ScaleDownPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleDownPolicy field is set to the value of the last call.
+func (b *RoleSpecApplyConfiguration) WithScaleDownPolicy(value *ScaleDownPolicyApplyConfiguration) *RoleSpecApplyConfiguration {
+	b.ScaleDownPolicy = value
+	return b
+}
+
+// WithNodeFailurePolicy sets the NodeFailurePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeFailurePolicy field is set to the value of the last call.
+func (b *RoleSpecApplyConfiguration) WithNodeFailurePolicy(value *NodeFailurePolicyApplyConfiguration) *RoleSpecApplyConfiguration {
+	b.NodeFailurePolicy = value
+	return b
+}
+
+// WithTTLSecondsAfterFinished sets the TTLSecondsAfterFinished field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTLSecondsAfterFinished field is set to the value of the last call.
+func (b *RoleSpecApplyConfiguration) WithTTLSecondsAfterFinished(value int32) *RoleSpecApplyConfiguration {
+	b.TTLSecondsAfterFinished = &value
+	return b
+}
+
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *RoleSpecApplyConfiguration) WithAutoscaling(value *RoleAutoscalingApplyConfiguration) *RoleSpecApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
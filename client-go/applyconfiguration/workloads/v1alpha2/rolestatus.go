@@ -17,13 +17,21 @@ limitations under the License.
 
 package v1alpha2
 
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
 // RoleStatusApplyConfiguration represents a declarative configuration of the RoleStatus type for use
 // with apply.
 type RoleStatusApplyConfiguration struct {
-	Name            *string `json:"name,omitempty"`
-	ReadyReplicas   *int32  `json:"readyReplicas,omitempty"`
-	Replicas        *int32  `json:"replicas,omitempty"`
-	UpdatedReplicas *int32  `json:"updatedReplicas,omitempty"`
+	Name              *string                          `json:"name,omitempty"`
+	ReadyReplicas     *int32                           `json:"readyReplicas,omitempty"`
+	Replicas          *int32                           `json:"replicas,omitempty"`
+	UpdatedReplicas   *int32                           `json:"updatedReplicas,omitempty"`
+	CurrentRevision   *string                          `json:"currentRevision,omitempty"`
+	UpdateRevision    *string                          `json:"updateRevision,omitempty"`
+	CompletedRevision *string                          `json:"completedRevision,omitempty"`
+	Conditions        []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // RoleStatusApplyConfiguration constructs a declarative configuration of the RoleStatus type for use with
@@ -63,3 +71,40 @@ func (b *RoleStatusApplyConfiguration) WithUpdatedReplicas(value int32) *RoleSta
 	b.UpdatedReplicas = &value
 	return b
 }
+
+// WithCurrentRevision sets the CurrentRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentRevision field is set to the value of the last call.
+func (b *RoleStatusApplyConfiguration) WithCurrentRevision(value string) *RoleStatusApplyConfiguration {
+	b.CurrentRevision = &value
+	return b
+}
+
+// WithUpdateRevision sets the UpdateRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateRevision field is set to the value of the last call.
+func (b *RoleStatusApplyConfiguration) WithUpdateRevision(value string) *RoleStatusApplyConfiguration {
+	b.UpdateRevision = &value
+	return b
+}
+
+// WithCompletedRevision sets the CompletedRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletedRevision field is set to the value of the last call.
+func (b *RoleStatusApplyConfiguration) WithCompletedRevision(value string) *RoleStatusApplyConfiguration {
+	b.CompletedRevision = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RoleStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *RoleStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -25,6 +25,7 @@ import (
 // with apply.
 type TemplateRefApplyConfiguration struct {
 	Name  *string               `json:"name,omitempty"`
+	Kind  *string               `json:"kind,omitempty"`
 	Patch *runtime.RawExtension `json:"patch,omitempty"`
 }
 
@@ -42,6 +43,14 @@ func (b *TemplateRefApplyConfiguration) WithName(value string) *TemplateRefApply
 	return b
 }
 
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *TemplateRefApplyConfiguration) WithKind(value string) *TemplateRefApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
 // WithPatch sets the Patch field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Patch field is set to the value of the last call.
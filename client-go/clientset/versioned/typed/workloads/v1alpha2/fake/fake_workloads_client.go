@@ -31,6 +31,10 @@ func (c *FakeWorkloadsV1alpha2) ClusterEngineRuntimeProfiles(namespace string) v
 	return newFakeClusterEngineRuntimeProfiles(c, namespace)
 }
 
+func (c *FakeWorkloadsV1alpha2) ClusterRoleTemplates(namespace string) v1alpha2.ClusterRoleTemplateInterface {
+	return newFakeClusterRoleTemplates(c, namespace)
+}
+
 func (c *FakeWorkloadsV1alpha2) CoordinatedPolicies(namespace string) v1alpha2.CoordinatedPolicyInterface {
 	return newFakeCoordinatedPolicies(c, namespace)
 }
@@ -19,6 +19,8 @@ package v1alpha2
 
 type ClusterEngineRuntimeProfileExpansion interface{}
 
+type ClusterRoleTemplateExpansion interface{}
+
 type CoordinatedPolicyExpansion interface{}
 
 type RoleBasedGroupExpansion interface{}
@@ -28,6 +28,7 @@ import (
 type WorkloadsV1alpha2Interface interface {
 	RESTClient() rest.Interface
 	ClusterEngineRuntimeProfilesGetter
+	ClusterRoleTemplatesGetter
 	CoordinatedPoliciesGetter
 	RoleBasedGroupsGetter
 	RoleBasedGroupScalingAdaptersGetter
@@ -45,6 +46,10 @@ func (c *WorkloadsV1alpha2Client) ClusterEngineRuntimeProfiles(namespace string)
 	return newClusterEngineRuntimeProfiles(c, namespace)
 }
 
+func (c *WorkloadsV1alpha2Client) ClusterRoleTemplates(namespace string) ClusterRoleTemplateInterface {
+	return newClusterRoleTemplates(c, namespace)
+}
+
 func (c *WorkloadsV1alpha2Client) CoordinatedPolicies(namespace string) CoordinatedPolicyInterface {
 	return newCoordinatedPolicies(c, namespace)
 }
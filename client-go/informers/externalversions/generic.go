@@ -69,6 +69,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=workloads.x-k8s.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithResource("clusterengineruntimeprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Workloads().V1alpha2().ClusterEngineRuntimeProfiles().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("clusterroletemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Workloads().V1alpha2().ClusterRoleTemplates().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("coordinatedpolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Workloads().V1alpha2().CoordinatedPolicies().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("rolebasedgroups"):
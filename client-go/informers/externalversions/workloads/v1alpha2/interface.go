@@ -25,6 +25,8 @@ import (
 type Interface interface {
 	// ClusterEngineRuntimeProfiles returns a ClusterEngineRuntimeProfileInformer.
 	ClusterEngineRuntimeProfiles() ClusterEngineRuntimeProfileInformer
+	// ClusterRoleTemplates returns a ClusterRoleTemplateInformer.
+	ClusterRoleTemplates() ClusterRoleTemplateInformer
 	// CoordinatedPolicies returns a CoordinatedPolicyInformer.
 	CoordinatedPolicies() CoordinatedPolicyInformer
 	// RoleBasedGroups returns a RoleBasedGroupInformer.
@@ -55,6 +57,11 @@ func (v *version) ClusterEngineRuntimeProfiles() ClusterEngineRuntimeProfileInfo
 	return &clusterEngineRuntimeProfileInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterRoleTemplates returns a ClusterRoleTemplateInformer.
+func (v *version) ClusterRoleTemplates() ClusterRoleTemplateInformer {
+	return &clusterRoleTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // CoordinatedPolicies returns a CoordinatedPolicyInformer.
 func (v *version) CoordinatedPolicies() CoordinatedPolicyInformer {
 	return &coordinatedPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
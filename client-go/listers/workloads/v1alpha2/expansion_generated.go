@@ -25,6 +25,14 @@ type ClusterEngineRuntimeProfileListerExpansion interface{}
 // ClusterEngineRuntimeProfileNamespaceLister.
 type ClusterEngineRuntimeProfileNamespaceListerExpansion interface{}
 
+// ClusterRoleTemplateListerExpansion allows custom methods to be added to
+// ClusterRoleTemplateLister.
+type ClusterRoleTemplateListerExpansion interface{}
+
+// ClusterRoleTemplateNamespaceListerExpansion allows custom methods to be added to
+// ClusterRoleTemplateNamespaceLister.
+type ClusterRoleTemplateNamespaceListerExpansion interface{}
+
 // CoordinatedPolicyListerExpansion allows custom methods to be added to
 // CoordinatedPolicyLister.
 type CoordinatedPolicyListerExpansion interface{}
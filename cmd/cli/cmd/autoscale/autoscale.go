@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/pkg/scale"
+	"sigs.k8s.io/yaml"
+)
+
+// Autoscalers the scaling policy can be created for.
+const (
+	engineHPA  = "hpa"
+	engineKEDA = "keda"
+)
+
+const defaultCPUPercent = 80
+
+var scaledObjectGVR = schema.GroupVersionResource{Group: "keda.sh", Version: "v1alpha1", Resource: "scaledobjects"}
+
+type AutoscaleOptions struct {
+	cf            *genericclioptions.ConfigFlags
+	role          string
+	min           int32
+	max           int32
+	engine        string
+	metric        string
+	target        string
+	cpuPercent    int32
+	prometheusURL string
+	query         string
+	dryRun        bool
+}
+
+var autoscaleOpts AutoscaleOptions
+
+func NewAutoscaleCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	autoscaleCmd := &cobra.Command{
+		Use:   "autoscale <rbgName> --role <roleName> --max <replicas>",
+		Short: "Attach an autoscaling policy to a role of a rbg",
+		Long: `Attach an autoscaling policy to a role of a rbg.
+
+The scaling adapter of the role is enabled when it is not, and an autoscaler targeting the
+RoleBasedGroupScalingAdapter <rbg>-<role> is created or updated, named after the adapter.
+
+With --engine=hpa (the default) a HorizontalPodAutoscaler scales on the average of the
+--metric pod metric, served by a custom metrics adapter such as the Prometheus adapter,
+or on the CPU utilization of the role when --metric is not set.
+
+With --engine=keda a KEDA ScaledObject scales on a Prometheus query, by default the sum of
+--metric over the pods of the role; --target is then the value per replica.`,
+		Example: `  kubectl rbg autoscale my-rbg --role decode --min 2 --max 8 --metric sglang:num_running_reqs --target 64
+  kubectl rbg autoscale my-rbg --role prefill --max 4 --cpu-percent 70
+  kubectl rbg autoscale my-rbg --role decode --min 0 --max 8 --engine keda \
+    --prometheus-url http://prometheus.monitoring:9090 --metric vllm:num_requests_waiting --target 10`,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateAutoscale(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(autoscaleOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(autoscaleOpts.cf)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := util.GetDefaultDynamicClient(autoscaleOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runAutoscale(context.Background(), rbgClient, k8sClient, dynamicClient,
+				args[0], util.GetNamespace(autoscaleOpts.cf), os.Stdout)
+		},
+	}
+	autoscaleOpts.cf = cf
+	autoscaleCmd.Flags().StringVar(&autoscaleOpts.role, "role", "", "Role to autoscale")
+	autoscaleCmd.Flags().Int32Var(&autoscaleOpts.min, "min", 1, "Minimum number of replicas of the role")
+	autoscaleCmd.Flags().Int32Var(&autoscaleOpts.max, "max", 0, "Maximum number of replicas of the role")
+	autoscaleCmd.Flags().StringVar(&autoscaleOpts.engine, "engine", engineHPA, "Autoscaler to configure, one of: hpa|keda")
+	autoscaleCmd.Flags().StringVar(&autoscaleOpts.metric, "metric", "",
+		"Metric exposed by the pods of the role to scale on, e.g. sglang:num_running_reqs")
+	autoscaleCmd.Flags().StringVar(&autoscaleOpts.target, "target", "", "Target value of --metric per replica")
+	autoscaleCmd.Flags().Int32Var(&autoscaleOpts.cpuPercent, "cpu-percent", 0,
+		fmt.Sprintf("Target CPU utilization of the role when --metric is not set, defaults to %d", defaultCPUPercent))
+	autoscaleCmd.Flags().StringVar(&autoscaleOpts.prometheusURL, "prometheus-url", "",
+		"Prometheus server KEDA queries, e.g. http://prometheus.monitoring:9090")
+	autoscaleCmd.Flags().StringVar(&autoscaleOpts.query, "query", "",
+		"Prometheus query KEDA scales on, defaults to the sum of --metric over the pods of the role")
+	autoscaleCmd.Flags().BoolVar(&autoscaleOpts.dryRun, "dry-run", false, "Only print the autoscaler manifest")
+
+	return autoscaleCmd
+}
+
+func validateAutoscale(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if autoscaleOpts.role == "" {
+		return fmt.Errorf("--role is required")
+	}
+	if autoscaleOpts.max <= 0 {
+		return fmt.Errorf("--max must be positive")
+	}
+	if autoscaleOpts.min > autoscaleOpts.max {
+		return fmt.Errorf("--min cannot be greater than --max")
+	}
+	if (autoscaleOpts.metric == "") != (autoscaleOpts.target == "") {
+		return fmt.Errorf("--metric and --target must be set together")
+	}
+	if autoscaleOpts.target != "" {
+		target, err := resource.ParseQuantity(autoscaleOpts.target)
+		if err != nil || target.Sign() <= 0 {
+			return fmt.Errorf("invalid --target %q, must be a positive quantity", autoscaleOpts.target)
+		}
+	}
+	if autoscaleOpts.cpuPercent < 0 {
+		return fmt.Errorf("--cpu-percent cannot be negative")
+	}
+	switch autoscaleOpts.engine {
+	case engineHPA:
+		if autoscaleOpts.min < 1 {
+			return fmt.Errorf("--min must be at least 1 with --engine=hpa, use --engine=keda to scale to zero")
+		}
+		if autoscaleOpts.metric != "" && autoscaleOpts.cpuPercent > 0 {
+			return fmt.Errorf("--metric and --cpu-percent are mutually exclusive with --engine=hpa")
+		}
+	case engineKEDA:
+		if autoscaleOpts.min < 0 {
+			return fmt.Errorf("--min cannot be negative")
+		}
+		if autoscaleOpts.prometheusURL == "" {
+			return fmt.Errorf("--prometheus-url is required with --engine=keda")
+		}
+		if autoscaleOpts.target == "" {
+			return fmt.Errorf("--target is required with --engine=keda")
+		}
+		if autoscaleOpts.query == "" && autoscaleOpts.metric == "" {
+			return fmt.Errorf("one of --metric or --query is required with --engine=keda")
+		}
+	default:
+		return fmt.Errorf("unsupported --engine %q, allowed engines are: %s|%s", autoscaleOpts.engine, engineHPA, engineKEDA)
+	}
+	return nil
+}
+
+func runAutoscale(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	index, err := util.RoleIndex(rbg, autoscaleOpts.role)
+	if err != nil {
+		return err
+	}
+	role := &rbg.Spec.Roles[index]
+	adapterName := scale.GenerateScalingAdapterName(rbg.Name, role.Name)
+
+	var object interface{}
+	if autoscaleOpts.engine == engineKEDA {
+		object = newScaledObject(rbg, role, adapterName)
+	} else {
+		object = newHPA(rbg, role, adapterName)
+	}
+	if autoscaleOpts.dryRun {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal autoscaler: %w", err)
+		}
+		_, err = out.Write(data)
+		return err
+	}
+
+	if !scale.IsScalingAdapterEnable(role) {
+		if err := enableScalingAdapter(ctx, rbgClient, rbg, index); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(out, "Enabled the scaling adapter of rbg %s role %s\n", name, role.Name)
+	}
+
+	switch o := object.(type) {
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		err = applyHPA(ctx, k8sClient, o)
+	case *unstructured.Unstructured:
+		err = applyScaledObject(ctx, dynamicClient, o)
+	}
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "rbg %s role %s autoscaled between %d and %d replicas by %s %s\n",
+		name, role.Name, autoscaleOpts.min, autoscaleOpts.max, autoscaleOpts.engine, adapterName)
+	return nil
+}
+
+func enableScalingAdapter(ctx context.Context, rbgClient versioned.Interface, rbg *workloadsv1alpha2.RoleBasedGroup, index int) error {
+	role := &rbg.Spec.Roles[index]
+	op := util.PatchOp{Op: "add", Path: "/scalingAdapter/enable", Value: true}
+	if role.ScalingAdapter == nil {
+		op = util.PatchOp{Op: "add", Path: "/scalingAdapter", Value: map[string]bool{"enable": true}}
+	}
+	patch, err := json.Marshal(util.GuardedRoleOps(index, role.Name, op))
+	if err != nil {
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(rbg.Namespace).Patch(
+		ctx, rbg.Name, types.JSONPatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to enable the scaling adapter of role %s: %w", role.Name, err)
+	}
+	return nil
+}
+
+func autoscalerLabels(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec) map[string]string {
+	return map[string]string{
+		constants.GroupNameLabelKey: rbg.Name,
+		constants.RoleNameLabelKey:  role.Name,
+	}
+}
+
+func newHPA(
+	rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, adapterName string,
+) *autoscalingv2.HorizontalPodAutoscaler {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{APIVersion: autoscalingv2.SchemeGroupVersion.String(), Kind: "HorizontalPodAutoscaler"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      adapterName,
+			Namespace: rbg.Namespace,
+			Labels:    autoscalerLabels(rbg, role),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: workloadsv1alpha2.GroupVersion.String(),
+				Kind:       "RoleBasedGroupScalingAdapter",
+				Name:       adapterName,
+			},
+			MinReplicas: ptr.To(autoscaleOpts.min),
+			MaxReplicas: autoscaleOpts.max,
+		},
+	}
+	if autoscaleOpts.metric != "" {
+		hpa.Spec.Metrics = []autoscalingv2.MetricSpec{{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: autoscaleOpts.metric},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: ptr.To(resource.MustParse(autoscaleOpts.target)),
+				},
+			},
+		}}
+		return hpa
+	}
+	cpuPercent := autoscaleOpts.cpuPercent
+	if cpuPercent == 0 {
+		cpuPercent = defaultCPUPercent
+	}
+	hpa.Spec.Metrics = []autoscalingv2.MetricSpec{{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: corev1.ResourceCPU,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(cpuPercent),
+			},
+		},
+	}}
+	return hpa
+}
+
+func newScaledObject(
+	rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, adapterName string,
+) *unstructured.Unstructured {
+	query := autoscaleOpts.query
+	if query == "" {
+		// Pods of a role are named after its workload.
+		query = fmt.Sprintf(`sum(%s{namespace="%s",pod=~"%s-.*"})`,
+			autoscaleOpts.metric, rbg.Namespace, rbg.GetWorkloadName(role))
+	}
+	labels := map[string]interface{}{}
+	for key, value := range autoscalerLabels(rbg, role) {
+		labels[key] = value
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": scaledObjectGVR.GroupVersion().String(),
+		"kind":       "ScaledObject",
+		"metadata": map[string]interface{}{
+			"name":      adapterName,
+			"namespace": rbg.Namespace,
+			"labels":    labels,
+		},
+		"spec": map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{
+				"apiVersion": workloadsv1alpha2.GroupVersion.String(),
+				"kind":       "RoleBasedGroupScalingAdapter",
+				"name":       adapterName,
+			},
+			"minReplicaCount": int64(autoscaleOpts.min),
+			"maxReplicaCount": int64(autoscaleOpts.max),
+			"triggers": []interface{}{
+				map[string]interface{}{
+					"type": "prometheus",
+					"metadata": map[string]interface{}{
+						"serverAddress": autoscaleOpts.prometheusURL,
+						"query":         query,
+						"threshold":     autoscaleOpts.target,
+					},
+				},
+			},
+		},
+	}}
+}
+
+func applyHPA(ctx context.Context, k8sClient kubernetes.Interface, hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+	client := k8sClient.AutoscalingV2().HorizontalPodAutoscalers(hpa.Namespace)
+	current, err := client.Get(ctx, hpa.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = client.Create(ctx, hpa, metav1.CreateOptions{})
+	case err == nil:
+		hpa.ResourceVersion = current.ResourceVersion
+		_, err = client.Update(ctx, hpa, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply HorizontalPodAutoscaler %s: %w", hpa.Name, err)
+	}
+	return nil
+}
+
+func applyScaledObject(ctx context.Context, dynamicClient dynamic.Interface, obj *unstructured.Unstructured) error {
+	client := dynamicClient.Resource(scaledObjectGVR).Namespace(obj.GetNamespace())
+	current, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = client.Create(ctx, obj, metav1.CreateOptions{})
+	case err == nil:
+		obj.SetResourceVersion(current.GetResourceVersion())
+		_, err = client.Update(ctx, obj, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply ScaledObject %s: %w", obj.GetName(), err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+func TestValidateAutoscale(t *testing.T) {
+	origOpts := autoscaleOpts
+	defer func() { autoscaleOpts = origOpts }()
+
+	base := AutoscaleOptions{role: "decode", min: 1, max: 8, engine: engineHPA}
+	autoscaleOpts = base
+	assert.NoError(t, validateAutoscale([]string{"llm"}))
+
+	cases := []struct {
+		mutate func(o *AutoscaleOptions)
+		err    string
+	}{
+		{func(o *AutoscaleOptions) { o.role = "" }, "--role is required"},
+		{func(o *AutoscaleOptions) { o.max = 0 }, "--max must be positive"},
+		{func(o *AutoscaleOptions) { o.min = 9 }, "--min cannot be greater than --max"},
+		{func(o *AutoscaleOptions) { o.metric = "sglang:num_running_reqs" }, "--metric and --target must be set together"},
+		{func(o *AutoscaleOptions) { o.metric, o.target = "sglang:num_running_reqs", "-1" },
+			`invalid --target "-1", must be a positive quantity`},
+		{func(o *AutoscaleOptions) { o.min = 0 }, "--min must be at least 1 with --engine=hpa, use --engine=keda to scale to zero"},
+		{func(o *AutoscaleOptions) { o.metric, o.target, o.cpuPercent = "sglang:num_running_reqs", "64", 70 },
+			"--metric and --cpu-percent are mutually exclusive with --engine=hpa"},
+		{func(o *AutoscaleOptions) { o.engine = engineKEDA }, "--prometheus-url is required with --engine=keda"},
+		{func(o *AutoscaleOptions) { o.engine, o.prometheusURL = engineKEDA, "http://prometheus:9090" },
+			"--target is required with --engine=keda"},
+		{func(o *AutoscaleOptions) { o.engine = "kpa" }, `unsupported --engine "kpa", allowed engines are: hpa|keda`},
+	}
+	for _, c := range cases {
+		autoscaleOpts = base
+		c.mutate(&autoscaleOpts)
+		assert.EqualError(t, validateAutoscale([]string{"llm"}), c.err)
+	}
+
+	autoscaleOpts = AutoscaleOptions{role: "decode", max: 8, engine: engineKEDA, prometheusURL: "http://prometheus:9090",
+		metric: "vllm:num_requests_waiting", target: "10"}
+	assert.NoError(t, validateAutoscale([]string{"llm"}))
+}
+
+func TestRunAutoscaleHPA(t *testing.T) {
+	origOpts := autoscaleOpts
+	defer func() { autoscaleOpts = origOpts }()
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithScalingAdapter(true).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	k8sClient := fake.NewSimpleClientset()
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+
+	autoscaleOpts = AutoscaleOptions{role: "decode", min: 2, max: 8, engine: engineHPA, metric: "sglang:num_running_reqs", target: "64"}
+	var out bytes.Buffer
+	require.NoError(t, runAutoscale(context.TODO(), rbgClient, k8sClient, dynamicClient, "llm", "default", &out))
+	assert.Equal(t, "Enabled the scaling adapter of rbg llm role decode\n"+
+		"rbg llm role decode autoscaled between 2 and 8 replicas by hpa llm-decode\n", out.String())
+
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "llm", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, rbg.Spec.Roles[1].ScalingAdapter.Enable)
+	hpa, err := k8sClient.AutoscalingV2().HorizontalPodAutoscalers("default").Get(context.TODO(), "llm-decode", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroupScalingAdapter", Name: "llm-decode",
+	}, hpa.Spec.ScaleTargetRef)
+	assert.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(8), hpa.Spec.MaxReplicas)
+	require.Len(t, hpa.Spec.Metrics, 1)
+	assert.Equal(t, "sglang:num_running_reqs", hpa.Spec.Metrics[0].Pods.Metric.Name)
+	assert.Equal(t, resource.MustParse("64"), *hpa.Spec.Metrics[0].Pods.Target.AverageValue)
+
+	// Running it again updates the autoscaler in place.
+	autoscaleOpts = AutoscaleOptions{role: "decode", min: 1, max: 4, engine: engineHPA}
+	out.Reset()
+	require.NoError(t, runAutoscale(context.TODO(), rbgClient, k8sClient, dynamicClient, "llm", "default", &out))
+	assert.NotContains(t, out.String(), "Enabled the scaling adapter")
+	hpa, err = k8sClient.AutoscalingV2().HorizontalPodAutoscalers("default").Get(context.TODO(), "llm-decode", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), hpa.Spec.MaxReplicas)
+	assert.Equal(t, int32(defaultCPUPercent), *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+
+	autoscaleOpts = AutoscaleOptions{role: "router", min: 1, max: 4, engine: engineHPA}
+	err = runAutoscale(context.TODO(), rbgClient, k8sClient, dynamicClient, "llm", "default", &out)
+	assert.EqualError(t, err, `role "router" not found in rbg llm`)
+}
+
+func TestRunAutoscaleKEDA(t *testing.T) {
+	origOpts := autoscaleOpts
+	defer func() { autoscaleOpts = origOpts }()
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithScalingAdapter(true).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	autoscaleOpts = AutoscaleOptions{role: "prefill", min: 0, max: 4, engine: engineKEDA,
+		prometheusURL: "http://prometheus:9090", metric: "vllm:num_requests_waiting", target: "10", dryRun: true}
+
+	var out bytes.Buffer
+	require.NoError(t, runAutoscale(context.TODO(), rbgClient, fake.NewSimpleClientset(), dynamicClient, "llm", "default", &out))
+	manifest := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &manifest))
+	assert.Equal(t, "ScaledObject", manifest["kind"])
+	_, err := dynamicClient.Resource(scaledObjectGVR).Namespace("default").Get(context.TODO(), "llm-prefill", metav1.GetOptions{})
+	assert.Error(t, err)
+
+	autoscaleOpts.dryRun = false
+	out.Reset()
+	require.NoError(t, runAutoscale(context.TODO(), rbgClient, fake.NewSimpleClientset(), dynamicClient, "llm", "default", &out))
+	assert.Equal(t, "rbg llm role prefill autoscaled between 0 and 4 replicas by keda llm-prefill\n", out.String())
+	obj, err := dynamicClient.Resource(scaledObjectGVR).Namespace("default").Get(context.TODO(), "llm-prefill", metav1.GetOptions{})
+	require.NoError(t, err)
+	spec := obj.Object["spec"].(map[string]interface{})
+	assert.Equal(t, int64(0), spec["minReplicaCount"])
+	trigger := spec["triggers"].([]interface{})[0].(map[string]interface{})["metadata"].(map[string]interface{})
+	assert.Equal(t, `sum(vllm:num_requests_waiting{namespace="default",pod=~"llm-prefill-.*"})`, trigger["query"])
+	assert.Equal(t, "10", trigger["threshold"])
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultRole = "router"
+	defaultPort = "8000"
+)
+
+type BenchOptions struct {
+	cf            *genericclioptions.ConfigFlags
+	role          string
+	port          string
+	model         string
+	isl           int
+	osl           int
+	concurrency   int
+	duration      time.Duration
+	sloTTFT       time.Duration
+	sloTPOT       time.Duration
+	sloPercentile float64
+}
+
+var benchOpts BenchOptions
+
+// endpointForwarder exposes the inference endpoint of the rbg locally and returns its base URL;
+// replaced in tests.
+var endpointForwarder = forwardEndpoint
+
+func NewBenchCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	benchCmd := &cobra.Command{
+		Use:                "bench <rbgName>",
+		Short:              "Drive load through the endpoint of a rbg and report latency and throughput percentiles",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateBench(args); err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(benchOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(benchOpts.cf)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			return runBench(ctx, k8sClient, config, args[0], util.GetNamespace(benchOpts.cf), os.Stdout)
+		},
+	}
+	benchOpts.cf = cf
+	benchCmd.Flags().StringVar(&benchOpts.role, "role", defaultRole, "Name of the role serving the endpoint")
+	benchCmd.Flags().StringVar(&benchOpts.port, "port", defaultPort, "Port number or name of the endpoint in the role pod")
+	benchCmd.Flags().StringVar(&benchOpts.model, "model", "", "Model to benchmark, defaults to the first model served by the endpoint")
+	benchCmd.Flags().IntVar(&benchOpts.isl, "isl", 1024, "Input sequence length of each request in tokens (approximate)")
+	benchCmd.Flags().IntVar(&benchOpts.osl, "osl", 256, "Output sequence length of each request in tokens")
+	benchCmd.Flags().IntVar(&benchOpts.concurrency, "concurrency", 8, "Number of requests kept in flight")
+	benchCmd.Flags().DurationVar(&benchOpts.duration, "duration", time.Minute, "How long to drive load")
+	benchCmd.Flags().DurationVar(&benchOpts.sloTTFT, "slo-ttft", 0, "Time to first token objective, e.g. 2s; not checked when unset")
+	benchCmd.Flags().DurationVar(&benchOpts.sloTPOT, "slo-tpot", 0, "Time per output token objective, e.g. 50ms; not checked when unset")
+	benchCmd.Flags().Float64Var(&benchOpts.sloPercentile, "slo-percentile", 90, "Percentile the objectives are checked against")
+
+	return benchCmd
+}
+
+func validateBench(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if benchOpts.role == "" {
+		return fmt.Errorf("--role cannot be empty")
+	}
+	if benchOpts.isl <= 0 || benchOpts.osl <= 0 {
+		return fmt.Errorf("--isl and --osl must be positive")
+	}
+	if benchOpts.concurrency <= 0 {
+		return fmt.Errorf("--concurrency must be positive")
+	}
+	if benchOpts.duration <= 0 {
+		return fmt.Errorf("--duration must be positive")
+	}
+	if benchOpts.sloPercentile <= 0 || benchOpts.sloPercentile > 100 {
+		return fmt.Errorf("--slo-percentile must be in (0, 100]")
+	}
+	return nil
+}
+
+func runBench(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, name, namespace string, out io.Writer) error {
+	baseURL, err := endpointForwarder(ctx, k8sClient, config, name, namespace)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{}
+	model := benchOpts.model
+	if model == "" {
+		if model, err = util.FirstServedModel(ctx, client, baseURL); err != nil {
+			return err
+		}
+	}
+
+	_, _ = fmt.Fprintf(out, "Benchmarking %s on rbg %s: isl=%d osl=%d concurrency=%d duration=%s\n",
+		model, name, benchOpts.isl, benchOpts.osl, benchOpts.concurrency, benchOpts.duration)
+	result := runLoad(ctx, client, loadConfig{
+		baseURL:     baseURL,
+		model:       model,
+		isl:         benchOpts.isl,
+		osl:         benchOpts.osl,
+		concurrency: benchOpts.concurrency,
+		duration:    benchOpts.duration,
+	})
+	if len(result.samples) == 0 {
+		if result.lastErr != nil {
+			return fmt.Errorf("no request completed, last error: %w", result.lastErr)
+		}
+		return fmt.Errorf("no request completed within %s", benchOpts.duration)
+	}
+	return printReport(out, result)
+}
+
+func printReport(out io.Writer, result *loadResult) error {
+	ttft, tpot, e2e := latencies(result.samples)
+	outputTokens := 0
+	for _, s := range result.samples {
+		outputTokens += s.outputTokens
+	}
+	seconds := result.elapsed.Seconds()
+
+	w := printers.GetNewTabWriter(out)
+	_, _ = fmt.Fprintf(w, "\nRequests:\t%d succeeded, %d failed\n", len(result.samples), result.failures)
+	if result.lastErr != nil {
+		_, _ = fmt.Fprintf(w, "Last error:\t%v\n", result.lastErr)
+	}
+	_, _ = fmt.Fprintf(w, "Duration:\t%s\n", result.elapsed.Round(time.Millisecond))
+	_, _ = fmt.Fprintf(w, "Request throughput:\t%.2f req/s\n", float64(len(result.samples))/seconds)
+	_, _ = fmt.Fprintf(w, "Output throughput:\t%.1f tok/s\n\n", float64(outputTokens)/seconds)
+
+	_, _ = fmt.Fprintln(w, "METRIC\tMEAN\tP50\tP90\tP99")
+	for _, row := range []struct {
+		name   string
+		values []time.Duration
+	}{{"TTFT", ttft}, {"TPOT", tpot}, {"E2E", e2e}} {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", row.name, formatLatency(mean(row.values)),
+			formatLatency(percentile(row.values, 50)), formatLatency(percentile(row.values, 90)),
+			formatLatency(percentile(row.values, 99)))
+	}
+
+	met := true
+	if benchOpts.sloTTFT > 0 || benchOpts.sloTPOT > 0 {
+		_, _ = fmt.Fprintf(w, "\nSLO\tOBJECTIVE\tP%g\tRESULT\n", benchOpts.sloPercentile)
+		for _, slo := range []struct {
+			name      string
+			objective time.Duration
+			values    []time.Duration
+		}{{"TTFT", benchOpts.sloTTFT, ttft}, {"TPOT", benchOpts.sloTPOT, tpot}} {
+			if slo.objective <= 0 {
+				continue
+			}
+			actual := percentile(slo.values, benchOpts.sloPercentile)
+			verdict := "PASS"
+			if actual > slo.objective {
+				verdict = "FAIL"
+				met = false
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", slo.name, formatLatency(slo.objective), formatLatency(actual), verdict)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if !met {
+		return fmt.Errorf("SLOs not met")
+	}
+	return nil
+}
+
+func formatLatency(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d)/float64(time.Millisecond))
+}
+
+// forwardEndpoint port-forwards a free local port to a ready pod of the role.
+func forwardEndpoint(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, name, namespace string) (string, error) {
+	pod, err := util.ReadyRolePod(ctx, k8sClient, namespace, name, benchOpts.role)
+	if err != nil {
+		return "", err
+	}
+	return util.ForwardLocalEndpoint(ctx, k8sClient, config, pod, benchOpts.port)
+}
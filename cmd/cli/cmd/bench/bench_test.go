@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+// newTestEndpoint streams osl tokens in chunks of two and reports the usage.
+func newTestEndpoint(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/models":
+			_, _ = w.Write([]byte(`{"data":[{"id":"qwen3"}]}`))
+		case "/v1/chat/completions":
+			var req completionRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "qwen3", req.Model)
+			assert.True(t, req.IgnoreEOS)
+			assert.Equal(t, 4, strings.Count(req.Messages[0].Content, "hello"))
+			for i := 0; i < req.MaxTokens; i += 2 {
+				_, _ = fmt.Fprint(w, "data: {\"choices\":[{\"delta\":{\"content\":\"ab\"}}]}\n\n")
+			}
+			_, _ = fmt.Fprintf(w, "data: {\"choices\":[],\"usage\":{\"completion_tokens\":%d}}\n\n", req.MaxTokens)
+			_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestValidateBench(t *testing.T) {
+	origOpts := benchOpts
+	defer func() { benchOpts = origOpts }()
+
+	valid := BenchOptions{role: "router", isl: 1, osl: 1, concurrency: 1, duration: time.Second, sloPercentile: 90}
+	benchOpts = valid
+	assert.NoError(t, validateBench([]string{"test-rbg"}))
+	assert.EqualError(t, validateBench([]string{""}), "rbg name is required")
+
+	benchOpts = valid
+	benchOpts.concurrency = 0
+	assert.EqualError(t, validateBench([]string{"test-rbg"}), "--concurrency must be positive")
+
+	benchOpts = valid
+	benchOpts.sloPercentile = 101
+	assert.EqualError(t, validateBench([]string{"test-rbg"}), "--slo-percentile must be in (0, 100]")
+}
+
+func TestPercentile(t *testing.T) {
+	values := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assert.Equal(t, time.Duration(5), percentile(values, 50))
+	assert.Equal(t, time.Duration(9), percentile(values, 90))
+	assert.Equal(t, time.Duration(10), percentile(values, 99))
+	assert.Equal(t, time.Duration(0), percentile(nil, 50))
+	assert.Equal(t, time.Duration(5), mean([]time.Duration{4, 6}))
+}
+
+func TestSampleTPOT(t *testing.T) {
+	tpot, ok := sample{ttft: 100 * time.Millisecond, e2e: 1100 * time.Millisecond, outputTokens: 11}.tpot()
+	assert.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, tpot)
+
+	_, ok = sample{outputTokens: 1}.tpot()
+	assert.False(t, ok)
+}
+
+func TestRunBench(t *testing.T) {
+	origOpts := benchOpts
+	origForwarder := endpointForwarder
+	defer func() {
+		benchOpts = origOpts
+		endpointForwarder = origForwarder
+	}()
+	server := newTestEndpoint(t)
+	defer server.Close()
+	endpointForwarder = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, _, _ string) (string, error) {
+		return server.URL, nil
+	}
+
+	benchOpts = BenchOptions{isl: 4, osl: 8, concurrency: 2, duration: 200 * time.Millisecond,
+		sloTTFT: time.Minute, sloPercentile: 90}
+	var out bytes.Buffer
+	assert.NoError(t, runBench(context.TODO(), fake.NewSimpleClientset(), &rest.Config{}, "test-rbg", "default", &out))
+	assert.Contains(t, out.String(), "Benchmarking qwen3 on rbg test-rbg")
+	assert.Contains(t, out.String(), "0 failed")
+	assert.Contains(t, out.String(), "TPOT")
+	assert.Regexp(t, `TTFT\s+60000.0ms\s+\S+\s+PASS`, out.String())
+
+	benchOpts.sloTPOT = time.Nanosecond
+	out.Reset()
+	assert.EqualError(t, runBench(context.TODO(), fake.NewSimpleClientset(), &rest.Config{}, "test-rbg", "default", &out),
+		"SLOs not met")
+	assert.Contains(t, out.String(), "FAIL")
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bench
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// promptWord is repeated to build prompts; common words encode to about one token each.
+const promptWord = "hello "
+
+type completionRequest struct {
+	Model         string         `json:"model"`
+	Messages      []message      `json:"messages"`
+	MaxTokens     int            `json:"max_tokens"`
+	MinTokens     int            `json:"min_tokens"`
+	IgnoreEOS     bool           `json:"ignore_eos"`
+	Stream        bool           `json:"stream"`
+	StreamOptions map[string]any `json:"stream_options"`
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type completionChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Usage *struct {
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// sample is the measurement of a single successful request.
+type sample struct {
+	ttft         time.Duration
+	e2e          time.Duration
+	outputTokens int
+}
+
+// tpot is the mean time per output token after the first one.
+func (s sample) tpot() (time.Duration, bool) {
+	if s.outputTokens < 2 {
+		return 0, false
+	}
+	return (s.e2e - s.ttft) / time.Duration(s.outputTokens-1), true
+}
+
+// loadConfig describes the load driven against the endpoint.
+type loadConfig struct {
+	baseURL     string
+	model       string
+	isl         int
+	osl         int
+	concurrency int
+	duration    time.Duration
+}
+
+// loadResult aggregates the samples of a load run.
+type loadResult struct {
+	samples  []sample
+	failures int
+	lastErr  error
+	elapsed  time.Duration
+}
+
+// runLoad keeps concurrency requests in flight until the duration has elapsed.
+func runLoad(ctx context.Context, client *http.Client, cfg loadConfig) *loadResult {
+	ctx, cancel := context.WithTimeout(ctx, cfg.duration)
+	defer cancel()
+
+	prompt := strings.Repeat(promptWord, cfg.isl)
+	result := &loadResult{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < cfg.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				s, err := sendRequest(ctx, client, cfg, prompt)
+				if ctx.Err() != nil {
+					// Requests cut off by the end of the run are not counted.
+					return
+				}
+				mu.Lock()
+				if err != nil {
+					result.failures++
+					result.lastErr = err
+				} else {
+					result.samples = append(result.samples, s)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	result.elapsed = time.Since(start)
+	return result
+}
+
+func sendRequest(ctx context.Context, client *http.Client, cfg loadConfig, prompt string) (sample, error) {
+	payload, err := json.Marshal(completionRequest{
+		Model:         cfg.model,
+		Messages:      []message{{Role: "user", Content: prompt}},
+		MaxTokens:     cfg.osl,
+		MinTokens:     cfg.osl,
+		IgnoreEOS:     true,
+		Stream:        true,
+		StreamOptions: map[string]any{"include_usage": true},
+	})
+	if err != nil {
+		return sample{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.baseURL+"/v1/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return sample{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return sample{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return sample{}, fmt.Errorf("endpoint returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var s sample
+	chunks := 0
+	usageTokens := -1
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			break
+		}
+		var chunk completionChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return sample{}, fmt.Errorf("failed to decode completion chunk: %w", err)
+		}
+		if chunk.Usage != nil {
+			usageTokens = chunk.Usage.CompletionTokens
+		}
+		for _, choice := range chunk.Choices {
+			if choice.Delta.Content == "" {
+				continue
+			}
+			if chunks == 0 {
+				s.ttft = time.Since(start)
+			}
+			chunks++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return sample{}, err
+	}
+	if chunks == 0 {
+		return sample{}, errors.New("endpoint returned no tokens")
+	}
+	s.e2e = time.Since(start)
+	// Servers may batch several tokens into one chunk, so prefer the reported usage.
+	s.outputTokens = chunks
+	if usageTokens > 0 {
+		s.outputTokens = usageTokens
+	}
+	return s, nil
+}
+
+// percentile returns the p-th percentile (0-100) of sorted values using the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func mean(values []time.Duration) time.Duration {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum time.Duration
+	for _, v := range values {
+		sum += v
+	}
+	return sum / time.Duration(len(values))
+}
+
+// latencies extracts the sorted TTFT, TPOT and end-to-end latencies of the samples.
+func latencies(samples []sample) (ttft, tpot, e2e []time.Duration) {
+	for _, s := range samples {
+		ttft = append(ttft, s.ttft)
+		e2e = append(e2e, s.e2e)
+		if v, ok := s.tpot(); ok {
+			tpot = append(tpot, v)
+		}
+	}
+	for _, values := range [][]time.Duration{ttft, tpot, e2e} {
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	}
+	return ttft, tpot, e2e
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chat
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultRole = "router"
+	defaultPort = "8000"
+)
+
+type ChatOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	role      string
+	port      string
+	model     string
+	system    string
+	prompt    string
+	maxTokens int
+	timeout   time.Duration
+}
+
+var chatOpts ChatOptions
+
+// endpointForwarder exposes the inference endpoint of the rbg locally and returns its base URL;
+// replaced in tests.
+var endpointForwarder = forwardEndpoint
+
+func NewChatCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	chatCmd := &cobra.Command{
+		Use:                "chat <rbgName>",
+		Short:              "Open an interactive chat session with the OpenAI-compatible endpoint of a rbg",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateChat(args); err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(chatOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(chatOpts.cf)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			return runChat(ctx, k8sClient, config, args[0], util.GetNamespace(chatOpts.cf), os.Stdin, os.Stdout)
+		},
+	}
+	chatOpts.cf = cf
+	chatCmd.Flags().StringVar(&chatOpts.role, "role", defaultRole, "Name of the role serving the endpoint")
+	chatCmd.Flags().StringVar(&chatOpts.port, "port", defaultPort, "Port number or name of the endpoint in the role pod")
+	chatCmd.Flags().StringVar(&chatOpts.model, "model", "", "Model to chat with, defaults to the first model served by the endpoint")
+	chatCmd.Flags().StringVar(&chatOpts.system, "system", "", "Optional system prompt")
+	chatCmd.Flags().StringVar(&chatOpts.prompt, "prompt", "", "Send a single prompt, print the reply and exit")
+	chatCmd.Flags().IntVar(&chatOpts.maxTokens, "max-tokens", 512, "Maximum number of tokens to generate per reply")
+	chatCmd.Flags().DurationVar(&chatOpts.timeout, "request-timeout", 5*time.Minute, "Timeout of a single chat completion")
+
+	return chatCmd
+}
+
+func validateChat(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if chatOpts.role == "" {
+		return fmt.Errorf("--role cannot be empty")
+	}
+	if chatOpts.maxTokens <= 0 {
+		return fmt.Errorf("--max-tokens must be positive")
+	}
+	return nil
+}
+
+func runChat(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	name, namespace string,
+	in io.Reader,
+	out io.Writer,
+) error {
+	baseURL, err := endpointForwarder(ctx, k8sClient, config, name, namespace)
+	if err != nil {
+		return err
+	}
+	client := &chatClient{
+		baseURL:   baseURL,
+		model:     chatOpts.model,
+		maxTokens: chatOpts.maxTokens,
+		http:      &http.Client{Timeout: chatOpts.timeout},
+	}
+	if err := client.resolveModel(ctx); err != nil {
+		return err
+	}
+
+	var history []message
+	if chatOpts.system != "" {
+		history = append(history, message{Role: "system", Content: chatOpts.system})
+	}
+	if chatOpts.prompt != "" {
+		_, err := client.complete(ctx, append(history, message{Role: "user", Content: chatOpts.prompt}), out)
+		return err
+	}
+
+	_, _ = fmt.Fprintf(out, "Chatting with %s on rbg %s, type /exit or press Ctrl-D to quit.\n", client.model, name)
+	scanner := bufio.NewScanner(in)
+	for {
+		_, _ = fmt.Fprint(out, ">>> ")
+		if !scanner.Scan() {
+			_, _ = fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		prompt := strings.TrimSpace(scanner.Text())
+		switch prompt {
+		case "":
+			continue
+		case "/exit", "/quit":
+			return nil
+		}
+		history = append(history, message{Role: "user", Content: prompt})
+		reply, err := client.complete(ctx, history, out)
+		if err != nil {
+			// Keep the session alive, the user can retry or quit.
+			history = history[:len(history)-1]
+			_, _ = fmt.Fprintf(out, "error: %v\n", err)
+			continue
+		}
+		history = append(history, message{Role: "assistant", Content: reply})
+	}
+}
+
+// forwardEndpoint port-forwards a free local port to a ready pod of the role.
+func forwardEndpoint(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, name, namespace string) (string, error) {
+	pod, err := util.ReadyRolePod(ctx, k8sClient, namespace, name, chatOpts.role)
+	if err != nil {
+		return "", err
+	}
+	return util.ForwardLocalEndpoint(ctx, k8sClient, config, pod, chatOpts.port)
+}
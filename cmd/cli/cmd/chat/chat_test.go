@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+// newTestEndpoint serves a model list and echoes the message count and the last user message.
+func newTestEndpoint(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/models":
+			_, _ = w.Write([]byte(`{"data":[{"id":"qwen3"}]}`))
+		case "/v1/chat/completions":
+			var req completionRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "qwen3", req.Model)
+			assert.True(t, req.Stream)
+			last := req.Messages[len(req.Messages)-1].Content
+			if last == "fail" {
+				http.Error(w, "boom", http.StatusInternalServerError)
+				return
+			}
+			for _, part := range []string{fmt.Sprintf("%d:", len(req.Messages)), last} {
+				_, _ = fmt.Fprintf(w, "data: {\"choices\":[{\"delta\":{\"content\":%q}}]}\n\n", part)
+			}
+			_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestValidateChat(t *testing.T) {
+	origOpts := chatOpts
+	defer func() { chatOpts = origOpts }()
+
+	chatOpts = ChatOptions{role: "router", maxTokens: 16}
+	assert.NoError(t, validateChat([]string{"test-rbg"}))
+	assert.EqualError(t, validateChat([]string{""}), "rbg name is required")
+
+	chatOpts.maxTokens = 0
+	assert.EqualError(t, validateChat([]string{"test-rbg"}), "--max-tokens must be positive")
+}
+
+func TestRunChat(t *testing.T) {
+	origOpts := chatOpts
+	origForwarder := endpointForwarder
+	defer func() {
+		chatOpts = origOpts
+		endpointForwarder = origForwarder
+	}()
+	server := newTestEndpoint(t)
+	defer server.Close()
+	endpointForwarder = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, _, _ string) (string, error) {
+		return server.URL, nil
+	}
+	client := fake.NewSimpleClientset()
+
+	t.Run("one-shot prompt", func(t *testing.T) {
+		chatOpts = ChatOptions{role: "router", maxTokens: 16, timeout: time.Second, system: "be brief", prompt: "hello"}
+		var out bytes.Buffer
+		assert.NoError(t, runChat(context.TODO(), client, &rest.Config{}, "test-rbg", "default", nil, &out))
+		assert.Equal(t, "2:hello\n", out.String())
+	})
+
+	t.Run("interactive session keeps history", func(t *testing.T) {
+		chatOpts = ChatOptions{role: "router", maxTokens: 16, timeout: time.Second}
+		var out bytes.Buffer
+		in := strings.NewReader("hi\nfail\n\nagain\n/exit\nignored\n")
+		assert.NoError(t, runChat(context.TODO(), client, &rest.Config{}, "test-rbg", "default", in, &out))
+		assert.Contains(t, out.String(), "Chatting with qwen3 on rbg test-rbg")
+		assert.Contains(t, out.String(), "1:hi\n")
+		assert.Contains(t, out.String(), "error: chat completion failed")
+		// The failed prompt is dropped, so the history is user, assistant, user.
+		assert.Contains(t, out.String(), "3:again\n")
+		assert.NotContains(t, out.String(), "ignored")
+	})
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chat
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+// message is a chat message of the OpenAI-compatible API.
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type completionRequest struct {
+	Model     string    `json:"model"`
+	Messages  []message `json:"messages"`
+	MaxTokens int       `json:"max_tokens,omitempty"`
+	Stream    bool      `json:"stream"`
+}
+
+// completionChunk is a server-sent event of a streaming chat completion.
+type completionChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+// chatClient talks to an OpenAI-compatible inference endpoint.
+type chatClient struct {
+	baseURL   string
+	model     string
+	maxTokens int
+	http      *http.Client
+}
+
+// resolveModel picks the first served model when none was given.
+func (c *chatClient) resolveModel(ctx context.Context) error {
+	if c.model != "" {
+		return nil
+	}
+	model, err := util.FirstServedModel(ctx, c.http, c.baseURL)
+	if err != nil {
+		return err
+	}
+	c.model = model
+	return nil
+}
+
+// complete streams the assistant reply to out and returns the full reply.
+func (c *chatClient) complete(ctx context.Context, messages []message, out io.Writer) (string, error) {
+	payload, err := json.Marshal(completionRequest{
+		Model:     c.model,
+		Messages:  messages,
+		MaxTokens: c.maxTokens,
+		Stream:    true,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/v1/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("chat completion failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("chat completion failed, endpoint returned %s: %s", resp.Status, string(body))
+	}
+
+	var reply strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			break
+		}
+		var chunk completionChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return reply.String(), fmt.Errorf("failed to decode completion chunk: %w", err)
+		}
+		for _, choice := range chunk.Choices {
+			reply.WriteString(choice.Delta.Content)
+			_, _ = io.WriteString(out, choice.Delta.Content)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return reply.String(), fmt.Errorf("failed to read completion stream: %w", err)
+	}
+	_, _ = fmt.Fprintln(out)
+	return reply.String(), nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/yaml"
+)
+
+// lastAppliedAnnotation is the kubectl apply bookkeeping, it belongs to the source object.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+type CloneOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	name      string
+	namespace string
+	overrides []string
+	dryRun    bool
+}
+
+var cloneOpts CloneOptions
+
+// override sets the spec field at path to value.
+type override struct {
+	path  []string
+	value interface{}
+}
+
+func NewCloneCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	cloneCmd := &cobra.Command{
+		Use:   "clone <rbgName> [--name <newName>] [--to <namespace>] [--set <path>=<value>]...",
+		Short: "Create a copy of a rbg under another name or namespace",
+		Long: `Create a copy of a rbg under another name or namespace, optionally overriding fields.
+
+The spec, labels and annotations of the rbg are copied; its status and the kubectl
+bookkeeping annotations are not. --set paths are relative to the spec and select the
+elements of named lists, such as roles and containers, by name. Values are parsed as YAML,
+so numbers and booleans keep their type.
+
+ConfigMaps, Secrets and volumes referenced by the roles are not copied; create them in
+the target namespace first.`,
+		Example: `  kubectl rbg clone my-rbg --to staging --set roles.decode.replicas=1
+  kubectl rbg clone my-rbg --name my-rbg-canary --set roles.decode.standalonePattern.template.spec.containers.engine.image=vllm:v0.11
+  kubectl rbg clone my-rbg --to staging --dry-run`,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			overrides, err := validateClone(args)
+			if err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(cloneOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runClone(context.Background(), rbgClient, args[0], util.GetNamespace(cloneOpts.cf), overrides, os.Stdout)
+		},
+	}
+	cloneOpts.cf = cf
+	cloneCmd.Flags().StringVar(&cloneOpts.name, "name", "", "Name of the copy, defaults to the name of the rbg")
+	cloneCmd.Flags().StringVar(&cloneOpts.namespace, "to", "", "Namespace of the copy, defaults to the namespace of the rbg")
+	cloneCmd.Flags().StringArrayVar(&cloneOpts.overrides, "set", nil,
+		"Override a spec field of the copy as <path>=<value>, e.g. roles.decode.replicas=1; may be repeated")
+	cloneCmd.Flags().BoolVar(&cloneOpts.dryRun, "dry-run", false, "Only print the manifest of the copy")
+
+	return cloneCmd
+}
+
+func validateClone(args []string) ([]override, error) {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return nil, fmt.Errorf("rbg name is required")
+	}
+	overrides := make([]override, 0, len(cloneOpts.overrides))
+	for _, value := range cloneOpts.overrides {
+		o, err := parseOverride(value)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, o)
+	}
+	return overrides, nil
+}
+
+func parseOverride(value string) (override, error) {
+	path, raw, ok := strings.Cut(value, "=")
+	if !ok || path == "" {
+		return override{}, fmt.Errorf("invalid override %q, expected <path>=<value>", value)
+	}
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return override{}, fmt.Errorf("invalid override path %q", path)
+		}
+	}
+	var parsed interface{}
+	if err := yaml.Unmarshal([]byte(raw), &parsed); err != nil {
+		return override{}, fmt.Errorf("invalid value of override %s: %w", path, err)
+	}
+	return override{path: segments, value: parsed}, nil
+}
+
+func runClone(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	name, namespace string,
+	overrides []override,
+	out io.Writer,
+) error {
+	source, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	clone, err := buildClone(source, overrides)
+	if err != nil {
+		return err
+	}
+	if clone.Name == source.Name && clone.Namespace == source.Namespace {
+		return fmt.Errorf("the copy of rbg %s needs another name or namespace, use --name or --to", name)
+	}
+
+	if cloneOpts.dryRun {
+		clone.TypeMeta = metav1.TypeMeta{APIVersion: workloadsv1alpha2.GroupVersion.String(), Kind: "RoleBasedGroup"}
+		data, err := yaml.Marshal(clone)
+		if err != nil {
+			return fmt.Errorf("failed to marshal RoleBasedGroup: %w", err)
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(clone.Namespace).Create(ctx, clone, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create RoleBasedGroup %s/%s: %w", clone.Namespace, clone.Name, err)
+	}
+	_, _ = fmt.Fprintf(out, "rbg %s/%s cloned from %s/%s\n", clone.Namespace, clone.Name, namespace, name)
+	return nil
+}
+
+// buildClone copies the spec and metadata of the source rbg under the new name and namespace,
+// then applies the overrides to the spec.
+func buildClone(source *workloadsv1alpha2.RoleBasedGroup, overrides []override) (*workloadsv1alpha2.RoleBasedGroup, error) {
+	clone := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        source.Name,
+			Namespace:   source.Namespace,
+			Labels:      source.Labels,
+			Annotations: map[string]string{},
+		},
+	}
+	if cloneOpts.name != "" {
+		clone.Name = cloneOpts.name
+	}
+	if cloneOpts.namespace != "" {
+		clone.Namespace = cloneOpts.namespace
+	}
+	for key, value := range source.Annotations {
+		if key != lastAppliedAnnotation && key != constants.ChangeCauseAnnotationKey {
+			clone.Annotations[key] = value
+		}
+	}
+
+	data, err := json.Marshal(source.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal spec of rbg %s: %w", source.Name, err)
+	}
+	spec := map[string]interface{}{}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal spec of rbg %s: %w", source.Name, err)
+	}
+	for _, o := range overrides {
+		if err := setField(spec, o.path, o.value); err != nil {
+			return nil, fmt.Errorf("failed to set %s: %w", strings.Join(o.path, "."), err)
+		}
+	}
+	if data, err = json.Marshal(spec); err != nil {
+		return nil, fmt.Errorf("failed to marshal spec of the copy: %w", err)
+	}
+	if err := json.Unmarshal(data, &clone.Spec); err != nil {
+		return nil, fmt.Errorf("invalid override: %w", err)
+	}
+	return clone, nil
+}
+
+// setField sets the field at path in obj, creating the missing maps. A segment following
+// a list selects the element with that name, or with that index when it is a number.
+func setField(obj map[string]interface{}, path []string, value interface{}) error {
+	key := path[0]
+	if len(path) == 1 {
+		obj[key] = value
+		return nil
+	}
+	switch child := obj[key].(type) {
+	case nil:
+		next := map[string]interface{}{}
+		obj[key] = next
+		return setField(next, path[1:], value)
+	case map[string]interface{}:
+		return setField(child, path[1:], value)
+	case []interface{}:
+		element, err := listElement(child, key, path[1])
+		if err != nil {
+			return err
+		}
+		if len(path) == 2 {
+			return fmt.Errorf("cannot replace element %s of %s, set one of its fields", path[1], key)
+		}
+		return setField(element, path[2:], value)
+	default:
+		return fmt.Errorf("%s is not an object", key)
+	}
+}
+
+func listElement(list []interface{}, key, selector string) (map[string]interface{}, error) {
+	for _, item := range list {
+		if element, ok := item.(map[string]interface{}); ok && element["name"] == selector {
+			return element, nil
+		}
+	}
+	if index, err := strconv.Atoi(selector); err == nil && index >= 0 && index < len(list) {
+		if element, ok := list[index].(map[string]interface{}); ok {
+			return element, nil
+		}
+	}
+	return nil, fmt.Errorf("no element %s in %s", selector, key)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clone
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+func TestParseOverride(t *testing.T) {
+	o, err := parseOverride("roles.decode.replicas=1")
+	require.NoError(t, err)
+	assert.Equal(t, override{path: []string{"roles", "decode", "replicas"}, value: float64(1)}, o)
+
+	o, err = parseOverride("roles.decode.labels.env=staging")
+	require.NoError(t, err)
+	assert.Equal(t, "staging", o.value)
+
+	_, err = parseOverride("roles.decode.replicas")
+	assert.EqualError(t, err, `invalid override "roles.decode.replicas", expected <path>=<value>`)
+	_, err = parseOverride("roles..replicas=1")
+	assert.EqualError(t, err, `invalid override path "roles..replicas"`)
+}
+
+func TestBuildClone(t *testing.T) {
+	origOpts := cloneOpts
+	defer func() { cloneOpts = origOpts }()
+
+	template := &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+		{Name: "engine", Image: "vllm:v0.10"},
+		{Name: "sidecar", Image: "proxy:v1"},
+	}}}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "prod").WithResourceVersion("42").WithUID("1234").
+		WithLabels(map[string]string{"app": "llm"}).
+		WithAnnotations(map[string]string{
+			"team":                             "serving",
+			lastAppliedAnnotation:              "{}",
+			constants.ChangeCauseAnnotationKey: "kubectl rbg scale llm",
+		}).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(template.DeepCopy()).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(4).WithTemplate(template.DeepCopy()).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{ObservedGeneration: 3}).Obj()
+
+	cloneOpts = CloneOptions{namespace: "staging"}
+	overrides := []override{}
+	for _, value := range []string{
+		"roles.decode.replicas=1",
+		"roles.decode.standalonePattern.template.spec.containers.engine.image=vllm:v0.11",
+		"roles.1.labels.env=staging",
+	} {
+		o, err := parseOverride(value)
+		require.NoError(t, err)
+		overrides = append(overrides, o)
+	}
+	clone, err := buildClone(rbg.DeepCopy(), overrides)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.ObjectMeta{
+		Name:        "llm",
+		Namespace:   "staging",
+		Labels:      map[string]string{"app": "llm"},
+		Annotations: map[string]string{"team": "serving"},
+	}, clone.ObjectMeta)
+	assert.Equal(t, int32(2), *clone.Spec.Roles[0].Replicas)
+	assert.Equal(t, int32(1), *clone.Spec.Roles[1].Replicas)
+	assert.Equal(t, map[string]string{"env": "staging"}, clone.Spec.Roles[1].Labels)
+	containers := clone.Spec.Roles[1].StandalonePattern.Template.Spec.Containers
+	assert.Equal(t, "vllm:v0.11", containers[0].Image)
+	assert.Equal(t, "proxy:v1", containers[1].Image)
+	assert.Equal(t, "vllm:v0.10", clone.Spec.Roles[0].StandalonePattern.Template.Spec.Containers[0].Image)
+	assert.Empty(t, clone.Status)
+
+	cases := map[string]string{
+		"roles.router.replicas=1":       "failed to set roles.router.replicas: no element router in roles",
+		"roles.decode=1":                "failed to set roles.decode: cannot replace element decode of roles, set one of its fields",
+		"roles.decode.replicas.value=1": "failed to set roles.decode.replicas.value: replicas is not an object",
+	}
+	for value, want := range cases {
+		o, err := parseOverride(value)
+		require.NoError(t, err)
+		_, err = buildClone(rbg.DeepCopy(), []override{o})
+		assert.EqualError(t, err, want, value)
+	}
+	o, err := parseOverride("roles.decode.replicas=many")
+	require.NoError(t, err)
+	_, err = buildClone(rbg.DeepCopy(), []override{o})
+	assert.ErrorContains(t, err, "invalid override: json: cannot unmarshal string")
+}
+
+func TestRunClone(t *testing.T) {
+	origOpts := cloneOpts
+	defer func() { cloneOpts = origOpts }()
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "prod").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(4).Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	cloneOpts = CloneOptions{}
+	var out bytes.Buffer
+	err := runClone(context.TODO(), rbgClient, "llm", "prod", nil, &out)
+	assert.EqualError(t, err, "the copy of rbg llm needs another name or namespace, use --name or --to")
+
+	cloneOpts = CloneOptions{name: "llm-canary", dryRun: true}
+	require.NoError(t, runClone(context.TODO(), rbgClient, "llm", "prod", nil, &out))
+	manifest := &workloadsv1alpha2.RoleBasedGroup{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), manifest))
+	assert.Equal(t, "RoleBasedGroup", manifest.Kind)
+	assert.Equal(t, "llm-canary", manifest.Name)
+	_, err = rbgClient.WorkloadsV1alpha2().RoleBasedGroups("prod").Get(context.TODO(), "llm-canary", metav1.GetOptions{})
+	assert.Error(t, err)
+
+	cloneOpts = CloneOptions{namespace: "staging"}
+	out.Reset()
+	require.NoError(t, runClone(context.TODO(), rbgClient, "llm", "prod", nil, &out))
+	assert.Equal(t, "rbg staging/llm cloned from prod/llm\n", out.String())
+	clone, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups("staging").Get(context.TODO(), "llm", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, clone.Spec.Roles, 2)
+
+	err = runClone(context.TODO(), rbgClient, "llm", "prod", nil, &out)
+	assert.ErrorContains(t, err, "failed to create RoleBasedGroup staging/llm")
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+// Role statuses of the comparison.
+const (
+	statusIdentical = "identical"
+	statusDifferent = "different"
+	statusLeftOnly  = "left-only"
+	statusRightOnly = "right-only"
+)
+
+const none = "<none>"
+
+type CompareOptions struct {
+	cf       *genericclioptions.ConfigFlags
+	revision int64
+	output   util.OutputOptions
+}
+
+var compareOpts CompareOptions
+
+func NewCompareCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	compareCmd := &cobra.Command{
+		Use:   "compare <rbg1> [<rbg2>] [--revision <revision>]",
+		Short: "Compare two rbgs, or a rbg with one of its revisions, role by role",
+		Long: `Compare two rbgs, or a rbg with one of its revisions, role by role.
+
+Roles are matched by name. For every role the replicas, pattern, and per container the
+image, command, arguments, environment and resources are compared; only the differences
+are printed. A rbg in another namespace is given as <namespace>/<name>.
+
+With --revision, the rbg is compared with the given revision of itself. Revisions do not
+record replicas, so only the templates of the roles differ in that mode.`,
+		Example: `  kubectl rbg compare prod/llm staging/llm
+  kubectl rbg compare llm llm-canary -o yaml
+  kubectl rbg compare llm --revision 3`,
+		Args:               cobra.RangeArgs(1, 2),
+		ValidArgsFunction:  util.CompleteRBGNames(cf, 2),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateCompare(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(compareOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(compareOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runCompare(context.Background(), rbgClient, k8sClient, args, util.GetNamespace(compareOpts.cf), os.Stdout)
+		},
+	}
+	compareOpts.cf = cf
+	compareCmd.Flags().Int64Var(&compareOpts.revision, "revision", 0, "Compare the rbg with this revision of itself")
+	compareOpts.output.AddOutputFlags(compareCmd, util.OutputJSON, util.OutputYAML)
+
+	return compareCmd
+}
+
+func validateCompare(args []string) error {
+	if err := compareOpts.output.Validate(); err != nil {
+		return err
+	}
+	for _, arg := range args {
+		if arg == "" || strings.HasPrefix(arg, "/") || strings.HasSuffix(arg, "/") {
+			return fmt.Errorf("invalid rbg %q, expected <name> or <namespace>/<name>", arg)
+		}
+	}
+	if compareOpts.revision < 0 {
+		return fmt.Errorf("--revision cannot be negative")
+	}
+	if compareOpts.revision > 0 && len(args) != 1 {
+		return fmt.Errorf("--revision compares a single rbg with its revision")
+	}
+	if compareOpts.revision == 0 && len(args) != 2 {
+		return fmt.Errorf("two rbgs are required, or one rbg and --revision")
+	}
+	return nil
+}
+
+// comparison is the result of the comparison, also the -o json|yaml output.
+type comparison struct {
+	Left  string     `json:"left"`
+	Right string     `json:"right"`
+	Roles []roleDiff `json:"roles"`
+}
+
+type roleDiff struct {
+	Role    string   `json:"role"`
+	Status  string   `json:"status"`
+	Changes []change `json:"changes,omitempty"`
+}
+
+// change is a field whose value differs, none when it is unset on one side.
+type change struct {
+	Field string `json:"field"`
+	Left  string `json:"left"`
+	Right string `json:"right"`
+}
+
+func runCompare(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	args []string,
+	namespace string,
+	out io.Writer,
+) error {
+	left, leftName, err := getRBG(ctx, rbgClient, args[0], namespace)
+	if err != nil {
+		return err
+	}
+	var right *workloadsv1alpha2.RoleBasedGroup
+	rightName := ""
+	if compareOpts.revision > 0 {
+		// The revision is the baseline, the live rbg what changed since.
+		live := left
+		rightName = leftName
+		leftName = fmt.Sprintf("%s@revision %d", leftName, compareOpts.revision)
+		if left, err = revisionRBG(ctx, k8sClient, live, compareOpts.revision); err != nil {
+			return err
+		}
+		right = live
+	} else {
+		if right, rightName, err = getRBG(ctx, rbgClient, args[1], namespace); err != nil {
+			return err
+		}
+		if leftName == rightName {
+			return fmt.Errorf("cannot compare rbg %s with itself", leftName)
+		}
+	}
+
+	result := compareRBGs(left, right)
+	result.Left, result.Right = leftName, rightName
+	if compareOpts.output.IsStructured() {
+		return compareOpts.output.PrintObject(out, result)
+	}
+	printComparison(out, result)
+	return nil
+}
+
+// getRBG gets the rbg given as <name> or <namespace>/<name> and returns it with its qualified name.
+func getRBG(
+	ctx context.Context, rbgClient versioned.Interface, ref, namespace string,
+) (*workloadsv1alpha2.RoleBasedGroup, string, error) {
+	name := ref
+	if ns, n, ok := strings.Cut(ref, "/"); ok {
+		namespace, name = ns, n
+	}
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get RoleBasedGroup %s/%s: %w", namespace, name, err)
+	}
+	return rbg, namespace + "/" + name, nil
+}
+
+func revisionRBG(
+	ctx context.Context, k8sClient kubernetes.Interface, rbg *workloadsv1alpha2.RoleBasedGroup, revision int64,
+) (*workloadsv1alpha2.RoleBasedGroup, error) {
+	revisions, err := util.ListOwnedRevisions(ctx, k8sClient, rbg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	for _, rev := range revisions {
+		if rev.Revision == revision {
+			restored, err := utils.ApplyRevision(rbg, rev)
+			if err != nil {
+				return nil, fmt.Errorf("failed to apply revision %d: %w", revision, err)
+			}
+			return restored, nil
+		}
+	}
+	return nil, fmt.Errorf("revision %d of rbg %s not found", revision, rbg.Name)
+}
+
+// compareRBGs compares the roles of both rbgs, in the order of the left rbg followed by
+// the roles only the right one has.
+func compareRBGs(left, right *workloadsv1alpha2.RoleBasedGroup) *comparison {
+	result := &comparison{Roles: []roleDiff{}}
+	for i := range left.Spec.Roles {
+		role := &left.Spec.Roles[i]
+		other, err := right.GetRole(role.Name)
+		if err != nil {
+			result.Roles = append(result.Roles, roleDiff{Role: role.Name, Status: statusLeftOnly})
+			continue
+		}
+		diff := roleDiff{Role: role.Name, Status: statusIdentical}
+		diff.Changes = diffFields(roleFields(left, role), roleFields(right, other))
+		if len(diff.Changes) > 0 {
+			diff.Status = statusDifferent
+		}
+		result.Roles = append(result.Roles, diff)
+	}
+	for i := range right.Spec.Roles {
+		if _, err := left.GetRole(right.Spec.Roles[i].Name); err != nil {
+			result.Roles = append(result.Roles, roleDiff{Role: right.Spec.Roles[i].Name, Status: statusRightOnly})
+		}
+	}
+	return result
+}
+
+func diffFields(left, right []field) []change {
+	rightValues := map[string]string{}
+	for _, f := range right {
+		rightValues[f.name] = f.value
+	}
+	var changes []change
+	seen := map[string]bool{}
+	for _, f := range left {
+		seen[f.name] = true
+		value, ok := rightValues[f.name]
+		if !ok {
+			value = none
+		}
+		if value != f.value {
+			changes = append(changes, change{Field: f.name, Left: f.value, Right: value})
+		}
+	}
+	for _, f := range right {
+		if !seen[f.name] {
+			changes = append(changes, change{Field: f.name, Left: none, Right: f.value})
+		}
+	}
+	return changes
+}
+
+func printComparison(out io.Writer, result *comparison) {
+	var identical []string
+	different := false
+	for _, diff := range result.Roles {
+		if diff.Status == statusIdentical {
+			identical = append(identical, diff.Role)
+		} else {
+			different = true
+		}
+	}
+	if !different {
+		_, _ = fmt.Fprintf(out, "rbg %s and %s are identical\n", result.Left, result.Right)
+		return
+	}
+
+	w := printers.GetNewTabWriter(out)
+	compareOpts.output.PrintHeader(w, fmt.Sprintf("ROLE\tFIELD\t%s\t%s", result.Left, result.Right))
+	for _, diff := range result.Roles {
+		switch diff.Status {
+		case statusLeftOnly:
+			_, _ = fmt.Fprintf(w, "%s\t<role>\tpresent\t%s\n", diff.Role, none)
+		case statusRightOnly:
+			_, _ = fmt.Fprintf(w, "%s\t<role>\t%s\tpresent\n", diff.Role, none)
+		case statusDifferent:
+			for _, c := range diff.Changes {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", diff.Role, c.Field, c.Left, c.Right)
+			}
+		}
+	}
+	_ = w.Flush()
+	if len(identical) > 0 {
+		_, _ = fmt.Fprintf(out, "\nIdentical roles: %s\n", strings.Join(identical, ", "))
+	}
+}
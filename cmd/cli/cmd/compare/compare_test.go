@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compare
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/pkg/utils"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+func engineTemplate(image, gpus string) *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+		Name:    "engine",
+		Image:   image,
+		Command: []string{"python3", "-m", "sglang.launch_server"},
+		Env:     []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse(gpus)},
+		},
+	}}}}
+}
+
+func TestValidateCompare(t *testing.T) {
+	origOpts := compareOpts
+	defer func() { compareOpts = origOpts }()
+
+	compareOpts = CompareOptions{}
+	assert.NoError(t, validateCompare([]string{"prod/llm", "llm"}))
+	assert.EqualError(t, validateCompare([]string{"llm"}), "two rbgs are required, or one rbg and --revision")
+	assert.EqualError(t, validateCompare([]string{"prod/", "llm"}), `invalid rbg "prod/", expected <name> or <namespace>/<name>`)
+
+	compareOpts = CompareOptions{revision: 2}
+	assert.NoError(t, validateCompare([]string{"llm"}))
+	assert.EqualError(t, validateCompare([]string{"llm", "other"}), "--revision compares a single rbg with its revision")
+}
+
+func TestRunCompare(t *testing.T) {
+	origOpts := compareOpts
+	defer func() { compareOpts = origOpts }()
+
+	prodDecode := engineTemplate("sglang:v0.5.1", "8")
+	prodDecode.Spec.NodeSelector = map[string]string{util.GPUProductLabel: "NVIDIA-H100-80GB-HBM3"}
+	stagingDecode := engineTemplate("sglang:v0.5.2", "4")
+	stagingDecode.Spec.Containers[0].Env = nil
+	rbgClient := fakerbgclient.NewSimpleClientset(
+		wrappersv2.BuildBasicRoleBasedGroup("llm", "prod").WithUID("uid-llm").
+			WithRoles([]workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(engineTemplate("sglang:v0.5.1", "1")).Obj(),
+				wrappersv2.BuildStandaloneRole("decode").WithReplicas(8).WithTemplate(prodDecode).Obj(),
+				wrappersv2.BuildStandaloneRole("router").WithTemplate(engineTemplate("router:v1", "0")).Obj(),
+			}).Obj(),
+		wrappersv2.BuildBasicRoleBasedGroup("llm", "staging").WithUID("uid-llm").
+			WithRoles([]workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(engineTemplate("sglang:v0.5.1", "1")).Obj(),
+				wrappersv2.BuildStandaloneRole("decode").WithTemplate(stagingDecode).Obj(),
+				wrappersv2.BuildStandaloneRole("bench").WithTemplate(engineTemplate("bench:v1", "0")).Obj(),
+			}).Obj(),
+	)
+
+	compareOpts = CompareOptions{}
+	var out bytes.Buffer
+	require.NoError(t, runCompare(context.TODO(), rbgClient, fake.NewSimpleClientset(), []string{"llm", "staging/llm"}, "prod", &out))
+	output := out.String()
+	assert.Regexp(t, `ROLE\s+FIELD\s+prod/llm\s+staging/llm\n`, output)
+	assert.Regexp(t, `decode\s+replicas\s+8\s+1\n`, output)
+	assert.Regexp(t, `decode\s+container engine image\s+sglang:v0.5.1\s+sglang:v0.5.2\n`, output)
+	assert.Regexp(t, `decode\s+container engine env LOG_LEVEL\s+info\s+<none>\n`, output)
+	assert.Regexp(t, `decode\s+container engine limits nvidia.com/gpu\s+8\s+4\n`, output)
+	assert.Regexp(t, `decode\s+nodeSelector nvidia.com/gpu.product\s+NVIDIA-H100-80GB-HBM3\s+<none>\n`, output)
+	assert.Regexp(t, `router\s+<role>\s+present\s+<none>\n`, output)
+	assert.Regexp(t, `bench\s+<role>\s+<none>\s+present\n`, output)
+	assert.NotContains(t, output, "command")
+	assert.Contains(t, output, "Identical roles: prefill")
+
+	compareOpts = CompareOptions{output: util.OutputOptions{Format: util.OutputYAML}}
+	out.Reset()
+	require.NoError(t, runCompare(context.TODO(), rbgClient, fake.NewSimpleClientset(), []string{"prod/llm", "staging/llm"}, "default", &out))
+	result := &comparison{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), result))
+	require.Len(t, result.Roles, 4)
+	assert.Equal(t, roleDiff{Role: "prefill", Status: statusIdentical}, result.Roles[0])
+	assert.Equal(t, statusDifferent, result.Roles[1].Status)
+	assert.Equal(t, change{Field: "replicas", Left: "8", Right: "1"}, result.Roles[1].Changes[0])
+	assert.Equal(t, statusRightOnly, result.Roles[3].Status)
+
+	compareOpts = CompareOptions{}
+	err := runCompare(context.TODO(), rbgClient, fake.NewSimpleClientset(), []string{"llm", "prod/llm"}, "prod", &out)
+	assert.EqualError(t, err, "cannot compare rbg prod/llm with itself")
+	err = runCompare(context.TODO(), rbgClient, fake.NewSimpleClientset(), []string{"llm", "absent"}, "prod", &out)
+	assert.ErrorContains(t, err, "failed to get RoleBasedGroup prod/absent")
+}
+
+func TestRunCompareRevision(t *testing.T) {
+	origOpts := compareOpts
+	defer func() { compareOpts = origOpts }()
+
+	old := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").WithUID("uid-llm").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).WithTemplate(engineTemplate("sglang:v0.5.1", "8")).Obj(),
+		}).Obj()
+	live := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").WithUID("uid-llm").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(4).WithTemplate(engineTemplate("sglang:v0.5.2", "8")).Obj(),
+		}).Obj()
+	first, err := utils.NewRevision(context.TODO(), nil, old, nil)
+	require.NoError(t, err)
+	rbgClient := fakerbgclient.NewSimpleClientset(live)
+	k8sClient := fake.NewSimpleClientset(first)
+
+	compareOpts = CompareOptions{revision: 1}
+	var out bytes.Buffer
+	require.NoError(t, runCompare(context.TODO(), rbgClient, k8sClient, []string{"llm"}, "default", &out))
+	assert.Regexp(t, `ROLE\s+FIELD\s+default/llm@revision 1\s+default/llm\n`, out.String())
+	assert.Regexp(t, `decode\s+container engine image\s+sglang:v0.5.1\s+sglang:v0.5.2\n`, out.String())
+	assert.NotContains(t, out.String(), "replicas")
+
+	compareOpts = CompareOptions{revision: 2}
+	err = runCompare(context.TODO(), rbgClient, k8sClient, []string{"llm"}, "default", &out)
+	assert.EqualError(t, err, "revision 2 of rbg llm not found")
+
+	rbgClient = fakerbgclient.NewSimpleClientset(old)
+	compareOpts = CompareOptions{revision: 1}
+	out.Reset()
+	require.NoError(t, runCompare(context.TODO(), rbgClient, k8sClient, []string{"llm"}, "default", &out))
+	assert.Equal(t, "rbg default/llm@revision 1 and default/llm are identical\n", out.String())
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// field is one compared setting of a role, flattened to a printable value.
+type field struct {
+	name  string
+	value string
+}
+
+// roleFields flattens the settings of a role that explain behavioural differences: the
+// replicas and pattern, and per container the image, command, env and resources.
+func roleFields(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec) []field {
+	var fields []field
+	add := func(name, value string) {
+		if value != "" {
+			fields = append(fields, field{name: name, value: value})
+		}
+	}
+
+	replicas := int32(1)
+	if role.Replicas != nil {
+		replicas = *role.Replicas
+	}
+	add("replicas", strconv.Itoa(int(replicas)))
+	switch {
+	case role.IsLeaderWorkerPattern():
+		add("pattern", "leaderWorker")
+		if size := role.GetLeaderWorkerSize(); size != nil {
+			add("size", strconv.Itoa(int(*size)))
+		}
+		add("leaderTemplatePatch", rawValue(role.GetLeaderTemplatePatch()))
+		add("workerTemplatePatch", rawValue(role.GetWorkerTemplatePatch()))
+	case role.GetCustomComponentsPattern() != nil:
+		add("pattern", "customComponents")
+	default:
+		add("pattern", "standalone")
+	}
+	add("templateRef", role.GetEffectiveTemplateName())
+
+	if role.HasTemplate() {
+		template, err := role.GetResolvedTemplate(rbg)
+		if err != nil {
+			add("template", fmt.Sprintf("<error: %v>", err))
+		} else {
+			fields = append(fields, templateFields("", &template)...)
+		}
+	}
+	if pattern := role.GetCustomComponentsPattern(); pattern != nil {
+		for i := range pattern.Components {
+			component := &pattern.Components[i]
+			prefix := "component " + component.Name + " "
+			if component.Size != nil {
+				add(prefix+"size", strconv.Itoa(int(*component.Size)))
+			}
+			fields = append(fields, templateFields(prefix, &component.Template)...)
+		}
+	}
+	return fields
+}
+
+func templateFields(prefix string, template *corev1.PodTemplateSpec) []field {
+	var fields []field
+	add := func(name, value string) {
+		if value != "" {
+			fields = append(fields, field{name: prefix + name, value: value})
+		}
+	}
+	containers := func(kind string, list []corev1.Container) {
+		for i := range list {
+			c := &list[i]
+			name := kind + " " + c.Name + " "
+			add(name+"image", c.Image)
+			add(name+"command", listValue(c.Command))
+			add(name+"args", listValue(c.Args))
+			for _, env := range c.Env {
+				value := env.Value
+				if env.ValueFrom != nil {
+					value = "<from " + jsonValue(env.ValueFrom) + ">"
+				}
+				add(name+"env "+env.Name, value)
+			}
+			add(name+"envFrom", jsonValue(c.EnvFrom))
+			for _, resourceName := range sortedResources(c.Resources.Requests) {
+				quantity := c.Resources.Requests[resourceName]
+				add(name+"requests "+string(resourceName), quantity.String())
+			}
+			for _, resourceName := range sortedResources(c.Resources.Limits) {
+				quantity := c.Resources.Limits[resourceName]
+				add(name+"limits "+string(resourceName), quantity.String())
+			}
+		}
+	}
+	containers("initContainer", template.Spec.InitContainers)
+	containers("container", template.Spec.Containers)
+
+	keys := make([]string, 0, len(template.Spec.NodeSelector))
+	for key := range template.Spec.NodeSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		add("nodeSelector "+key, template.Spec.NodeSelector[key])
+	}
+	if len(template.Spec.Tolerations) > 0 {
+		add("tolerations", jsonValue(template.Spec.Tolerations))
+	}
+	return fields
+}
+
+func sortedResources(list corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+func listValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return jsonValue(values)
+}
+
+func rawValue(raw *runtime.RawExtension) string {
+	if raw == nil || len(raw.Raw) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw.Raw, &value); err != nil {
+		return string(raw.Raw)
+	}
+	return jsonValue(value)
+}
+
+func jsonValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
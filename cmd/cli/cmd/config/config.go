@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type ConfigSetOptions struct {
+	values []string
+}
+
+var configSetOpts ConfigSetOptions
+
+func NewConfigCmd() *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config SUBCOMMAND",
+		Short: "Manage the defaults of the CLI",
+		Long: fmt.Sprintf(`Manage the defaults of the CLI.
+
+The defaults are stored in ~/.rbgctl/config.yaml, or the file named by $%s, and are
+honored by every command; flags given on the command line take precedence. The keys are:
+
+  %s  namespace used without --namespace, before the kubeconfig namespace
+  %s   registry prefixed to the images given without one, e.g. by set image`,
+			util.ConfigEnv, util.ConfigDefaultNamespace, util.ConfigDefaultRegistry),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+	}
+
+	configCmd.AddCommand(newConfigSetCmd())
+	configCmd.AddCommand(newConfigUnsetCmd())
+	configCmd.AddCommand(newConfigGetCmd())
+	return configCmd
+}
+
+func newConfigSetCmd() *cobra.Command {
+	setCmd := &cobra.Command{
+		Use:   "set [KEY VALUE] [--set KEY=VALUE ...]",
+		Short: "Set defaults of the CLI",
+		Example: `  kubectl rbg config set default-namespace llm
+  kubectl rbg config set default-namespace llm --set default-registry my.registry/`,
+		Args:               cobra.MaximumNArgs(2),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values, err := parseConfigValues(args, configSetOpts.values)
+			if err != nil {
+				return err
+			}
+			return runConfigSet(values, os.Stdout)
+		},
+	}
+	setCmd.Flags().StringArrayVar(&configSetOpts.values, "set", nil, "Additional KEY=VALUE defaults to set")
+
+	return setCmd
+}
+
+func newConfigUnsetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:                "unset KEY [KEY ...]",
+		Short:              "Remove defaults of the CLI",
+		Example:            `  kubectl rbg config unset default-registry`,
+		Args:               cobra.MinimumNArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values := make([][2]string, 0, len(args))
+			for _, key := range args {
+				values = append(values, [2]string{key, ""})
+			}
+			return runConfigSet(values, os.Stdout)
+		},
+	}
+}
+
+func newConfigGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get [KEY]",
+		Short: "Print the defaults of the CLI",
+		Example: `  kubectl rbg config get
+  kubectl rbg config get default-namespace`,
+		Args:               cobra.MaximumNArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigGet(args, os.Stdout)
+		},
+	}
+}
+
+// parseConfigValues returns the KEY VALUE arguments followed by the --set values, in order.
+func parseConfigValues(args, sets []string) ([][2]string, error) {
+	if len(args) == 1 {
+		return nil, fmt.Errorf("missing value for %s", args[0])
+	}
+	var values [][2]string
+	if len(args) == 2 {
+		values = append(values, [2]string{args[0], args[1]})
+	}
+	for _, set := range sets {
+		key, value, ok := strings.Cut(set, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --set %q, must be KEY=VALUE", set)
+		}
+		values = append(values, [2]string{key, value})
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("a KEY VALUE pair or --set KEY=VALUE is required")
+	}
+	return values, nil
+}
+
+func runConfigSet(values [][2]string, out io.Writer) error {
+	config, err := util.LoadConfig()
+	if err != nil {
+		return err
+	}
+	for _, kv := range values {
+		if err := config.Set(kv[0], kv[1]); err != nil {
+			return err
+		}
+	}
+	if err := util.SaveConfig(config); err != nil {
+		return err
+	}
+	for _, kv := range values {
+		if kv[1] == "" {
+			_, _ = fmt.Fprintf(out, "Unset %s\n", kv[0])
+		} else {
+			_, _ = fmt.Fprintf(out, "Set %s to %q\n", kv[0], kv[1])
+		}
+	}
+	return nil
+}
+
+func runConfigGet(args []string, out io.Writer) error {
+	config, err := util.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		value, err := config.Get(args[0])
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out, value)
+		return nil
+	}
+	for _, key := range util.ConfigKeys() {
+		value, _ := config.Get(key)
+		if value == "" {
+			value = "<unset>"
+		}
+		_, _ = fmt.Fprintf(out, "%s: %s\n", key, value)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+func TestParseConfigValues(t *testing.T) {
+	values, err := parseConfigValues([]string{"default-namespace", "llm"}, []string{"default-registry=my.registry/"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][2]string{{"default-namespace", "llm"}, {"default-registry", "my.registry/"}}, values)
+
+	values, err = parseConfigValues(nil, []string{"default-registry="})
+	assert.NoError(t, err)
+	assert.Equal(t, [][2]string{{"default-registry", ""}}, values)
+
+	_, err = parseConfigValues([]string{"default-namespace"}, nil)
+	assert.EqualError(t, err, "missing value for default-namespace")
+	_, err = parseConfigValues(nil, []string{"default-namespace"})
+	assert.EqualError(t, err, `invalid --set "default-namespace", must be KEY=VALUE`)
+	_, err = parseConfigValues(nil, nil)
+	assert.EqualError(t, err, "a KEY VALUE pair or --set KEY=VALUE is required")
+}
+
+func TestRunConfig(t *testing.T) {
+	t.Setenv(util.ConfigEnv, filepath.Join(t.TempDir(), "config.yaml"))
+
+	var out bytes.Buffer
+	require.NoError(t, runConfigGet(nil, &out))
+	assert.Equal(t, "default-namespace: <unset>\ndefault-registry: <unset>\n", out.String())
+
+	out.Reset()
+	require.NoError(t, runConfigSet([][2]string{{"default-namespace", "llm"}, {"default-registry", "my.registry/"}}, &out))
+	assert.Equal(t, "Set default-namespace to \"llm\"\nSet default-registry to \"my.registry/\"\n", out.String())
+
+	out.Reset()
+	require.NoError(t, runConfigGet([]string{"default-namespace"}, &out))
+	assert.Equal(t, "llm\n", out.String())
+
+	out.Reset()
+	require.NoError(t, runConfigSet([][2]string{{"default-registry", ""}}, &out))
+	assert.Equal(t, "Unset default-registry\n", out.String())
+	out.Reset()
+	require.NoError(t, runConfigGet(nil, &out))
+	assert.Equal(t, "default-namespace: llm\ndefault-registry: <unset>\n", out.String())
+
+	// A failed update leaves the file unchanged.
+	assert.Error(t, runConfigSet([][2]string{{"default-namespace", "team-b"}, {"default-cluster", "prod"}}, &out))
+	config, err := util.LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "llm", config.DefaultNamespace)
+
+	assert.Error(t, runConfigGet([]string{"default-cluster"}, &out))
+}
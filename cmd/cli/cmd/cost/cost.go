@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// hoursPerMonth is the average number of hours in a month, as used by cloud billing.
+	hoursPerMonth = 730
+)
+
+type CostOptions struct {
+	cf            *genericclioptions.ConfigFlags
+	allNamespaces bool
+	prices        map[string]string
+	pricingFile   string
+}
+
+var costOpts CostOptions
+
+func NewCostCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	costCmd := &cobra.Command{
+		Use:   "cost",
+		Short: "Report the running GPU cost of rbgs per rbg and role",
+		Long: `Report the running GPU cost of rbgs per rbg and role.
+
+The GPUs requested by the scheduled pods of every rbg are multiplied by a price per
+GPU-hour. Prices are keyed by the GPU product label of the node (nvidia.com/gpu.product)
+or by the extended resource name, the former taking precedence.`,
+		Example: `  kubectl rbg cost --price nvidia.com/gpu=2.5
+  kubectl rbg cost -A --price NVIDIA-H100-80GB-HBM3=4.2,nvidia.com/gpu=2.5
+  kubectl rbg cost -A --pricing-file prices.yaml`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prices, err := loadPrices(costOpts.prices, costOpts.pricingFile)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(costOpts.cf)
+			if err != nil {
+				return err
+			}
+			namespace := util.GetNamespace(costOpts.cf)
+			if costOpts.allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+			return runCost(context.Background(), k8sClient, namespace, prices, os.Stdout)
+		},
+	}
+	costOpts.cf = cf
+	costCmd.Flags().BoolVarP(&costOpts.allNamespaces, "all-namespaces", "A", false,
+		"Report rbgs across all namespaces")
+	costCmd.Flags().StringToStringVar(&costOpts.prices, "price", nil,
+		"Price per GPU-hour keyed by GPU product or resource name, e.g. nvidia.com/gpu=2.5; overrides --pricing-file")
+	costCmd.Flags().StringVar(&costOpts.pricingFile, "pricing-file", "",
+		"YAML file mapping GPU products or resource names to the price per GPU-hour")
+
+	return costCmd
+}
+
+// loadPrices merges the pricing file with the --price flags, the flags taking precedence.
+func loadPrices(flags map[string]string, file string) (map[string]float64, error) {
+	prices := map[string]float64{}
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pricing file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &prices); err != nil {
+			return nil, fmt.Errorf("failed to parse pricing file: %w", err)
+		}
+	}
+	for key, value := range flags {
+		price, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price %q for %s", value, key)
+		}
+		prices[key] = price
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("a pricing table is required, use --price or --pricing-file")
+	}
+	for key, price := range prices {
+		if price < 0 {
+			return nil, fmt.Errorf("price for %s cannot be negative", key)
+		}
+	}
+	return prices, nil
+}
+
+// roleCost is the GPU usage and cost of one role of a rbg.
+type roleCost struct {
+	namespace string
+	rbg       string
+	role      string
+	pods      int
+	gpus      int64
+	// unpriced counts the GPUs no price was found for.
+	unpriced int64
+	hourly   float64
+}
+
+func runCost(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	namespace string,
+	prices map[string]float64,
+	out io.Writer,
+) error {
+	pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: constants.GroupNameLabelKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	products := map[string]string{}
+	for i := range nodes.Items {
+		products[nodes.Items[i].Name] = nodes.Items[i].Labels[util.GPUProductLabel]
+	}
+
+	costs := map[string]*roleCost{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		// Only running pods hold their GPUs: pending pods are not placed yet and
+		// completed pods released them.
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		rbgName := pod.Labels[constants.GroupNameLabelKey]
+		roleName := pod.Labels[constants.RoleNameLabelKey]
+		key := pod.Namespace + "/" + rbgName + "/" + roleName
+		rc, ok := costs[key]
+		if !ok {
+			rc = &roleCost{namespace: pod.Namespace, rbg: rbgName, role: roleName}
+			costs[key] = rc
+		}
+		rc.pods++
+		for resourceName, count := range util.PodGPUs(pod) {
+			rc.gpus += count
+			price, ok := prices[products[pod.Spec.NodeName]]
+			if !ok {
+				price, ok = prices[string(resourceName)]
+			}
+			if !ok {
+				rc.unpriced += count
+				continue
+			}
+			rc.hourly += price * float64(count)
+		}
+	}
+	if len(costs) == 0 {
+		_, _ = fmt.Fprintln(out, "No running rbg pods found")
+		return nil
+	}
+
+	rows := make([]*roleCost, 0, len(costs))
+	for _, rc := range costs {
+		rows = append(rows, rc)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].namespace != rows[j].namespace {
+			return rows[i].namespace < rows[j].namespace
+		}
+		if rows[i].rbg != rows[j].rbg {
+			return rows[i].rbg < rows[j].rbg
+		}
+		return rows[i].role < rows[j].role
+	})
+	printReport(out, rows, namespace == metav1.NamespaceAll)
+	return nil
+}
+
+func printReport(out io.Writer, rows []*roleCost, withNamespace bool) {
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+
+	prefix := func(rc *roleCost) string {
+		if withNamespace {
+			return rc.namespace + "\t"
+		}
+		return ""
+	}
+	header := "RBG\t"
+	if withNamespace {
+		header = "NAMESPACE\t" + header
+	}
+
+	_, _ = fmt.Fprintln(w, header+"ROLE\tPODS\tGPUS\tCOST/HOUR\tCOST/MONTH")
+	var groups []*roleCost
+	byGroup := map[string]*roleCost{}
+	var unpriced int64
+	for _, rc := range rows {
+		_, _ = fmt.Fprintf(w, "%s%s\t%s\t%d\t%d\t%.2f\t%.2f\n", prefix(rc), rc.rbg, rc.role, rc.pods, rc.gpus,
+			rc.hourly, rc.hourly*hoursPerMonth)
+
+		key := rc.namespace + "/" + rc.rbg
+		group, ok := byGroup[key]
+		if !ok {
+			group = &roleCost{namespace: rc.namespace, rbg: rc.rbg}
+			byGroup[key] = group
+			groups = append(groups, group)
+		}
+		group.pods += rc.pods
+		group.gpus += rc.gpus
+		group.hourly += rc.hourly
+		unpriced += rc.unpriced
+	}
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, header+"PODS\tGPUS\tCOST/HOUR\tCOST/MONTH")
+	var total roleCost
+	for _, group := range groups {
+		_, _ = fmt.Fprintf(w, "%s%s\t%d\t%d\t%.2f\t%.2f\n", prefix(group), group.rbg, group.pods, group.gpus,
+			group.hourly, group.hourly*hoursPerMonth)
+		total.gpus += group.gpus
+		total.hourly += group.hourly
+	}
+	_, _ = fmt.Fprintf(w, "\nTotal: %d GPUs, %.2f/hour, %.2f/month\n", total.gpus, total.hourly, total.hourly*hoursPerMonth)
+	if unpriced > 0 {
+		_, _ = fmt.Fprintf(w, "Warning: %d GPUs have no price and are not included in the cost\n", unpriced)
+	}
+}
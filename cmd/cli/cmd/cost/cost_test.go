@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestLoadPrices(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "prices.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("nvidia.com/gpu: 2\nNVIDIA-H100-80GB-HBM3: 4.5\n"), 0o600))
+
+	prices, err := loadPrices(map[string]string{"nvidia.com/gpu": "2.5"}, file)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"nvidia.com/gpu": 2.5, "NVIDIA-H100-80GB-HBM3": 4.5}, prices)
+
+	_, err = loadPrices(nil, "")
+	assert.EqualError(t, err, "a pricing table is required, use --price or --pricing-file")
+	_, err = loadPrices(map[string]string{"nvidia.com/gpu": "cheap"}, "")
+	assert.EqualError(t, err, `invalid price "cheap" for nvidia.com/gpu`)
+	_, err = loadPrices(map[string]string{"nvidia.com/gpu": "-1"}, "")
+	assert.EqualError(t, err, "price for nvidia.com/gpu cannot be negative")
+	_, err = loadPrices(nil, filepath.Join(t.TempDir(), "absent.yaml"))
+	assert.Error(t, err)
+}
+
+func TestRunCost(t *testing.T) {
+	pending := wrappersv2.BuildBasicPod().WithName("llm-decode-2").WithNamespace("default").WithRole("llm", "decode").
+		WithGPUs(1).WithPhase(corev1.PodRunning).Obj()
+	completed := wrappersv2.BuildBasicPod().WithName("llm-decode-3").WithNamespace("default").WithRole("llm", "decode").
+		WithNodeName("node-h100").WithGPUs(1).WithPhase(corev1.PodSucceeded).Obj()
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-h100", Labels: map[string]string{util.GPUProductLabel: "NVIDIA-H100-80GB-HBM3"}}},
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-other"}},
+		wrappersv2.BuildBasicPod().WithName("llm-decode-0").WithNamespace("default").WithRole("llm", "decode").
+			WithNodeName("node-h100").WithGPUs(2).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-1").WithNamespace("default").WithRole("llm", "decode").
+			WithNodeName("node-h100").WithGPUs(2).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-prefill-0").WithNamespace("default").WithRole("llm", "prefill").
+			WithNodeName("node-other").WithGPUs(1).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("chat-worker-0").WithNamespace("team-b").WithRole("chat", "worker").
+			WithNodeName("node-other").WithGPUs(4).WithPhase(corev1.PodRunning).Obj(),
+		pending,
+		completed,
+	)
+	prices := map[string]float64{"NVIDIA-H100-80GB-HBM3": 4, "nvidia.com/gpu": 1}
+
+	var out bytes.Buffer
+	require.NoError(t, runCost(context.TODO(), k8sClient, "default", prices, &out))
+	output := out.String()
+	assert.Regexp(t, `llm\s+decode\s+2\s+4\s+16.00\s+11680.00`, output)
+	assert.Regexp(t, `llm\s+prefill\s+1\s+1\s+1.00\s+730.00`, output)
+	assert.Regexp(t, `llm\s+3\s+5\s+17.00\s+12410.00`, output)
+	assert.NotContains(t, output, "chat")
+	assert.NotContains(t, output, "NAMESPACE")
+
+	out.Reset()
+	require.NoError(t, runCost(context.TODO(), k8sClient, metav1.NamespaceAll, map[string]float64{"NVIDIA-H100-80GB-HBM3": 4}, &out))
+	output = out.String()
+	assert.Regexp(t, `team-b\s+chat\s+worker\s+1\s+4\s+0.00`, output)
+	assert.Contains(t, output, "Total: 9 GPUs, 16.00/hour, 11680.00/month")
+	assert.Contains(t, output, "Warning: 5 GPUs have no price")
+
+	out.Reset()
+	require.NoError(t, runCost(context.TODO(), k8sClient, "empty", prices, &out))
+	assert.Contains(t, out.String(), "No running rbg pods found")
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+// remotePrefix marks a path inside the resolved pod, e.g. ":/tmp/profile.json".
+const remotePrefix = ":"
+
+type CpOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	role      string
+	index     int
+	container string
+}
+
+var cpOpts CpOptions
+
+// execRequest describes a tar command to stream to or from a resolved pod.
+type execRequest struct {
+	pod       *corev1.Pod
+	container string
+	command   []string
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+}
+
+// podExecutor runs the request against the API server; replaced in tests.
+var podExecutor = streamExec
+
+func NewCpCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	cpCmd := &cobra.Command{
+		Use:   "cp <rbgName> --role <roleName> [--index N] <src> <dst>",
+		Short: "Copy files to and from a pod of a rbg role",
+		Long: `Copy files to and from a pod of a rbg role.
+
+The pod is resolved from the role and replica index labels, the same way as for exec.
+Paths prefixed with ':' are inside the pod, all other paths are local; exactly one of
+src and dst must be a pod path. Files are streamed as a tar archive, so the container
+image must provide the tar binary.`,
+		Example: `  # Pull the engine logs of the first prefill replica
+  kubectl rbg cp my-rbg --role prefill --index 0 :/var/log/engine ./engine-logs
+
+  # Push a patched tokenizer into a decode pod
+  kubectl rbg cp my-rbg --role decode --index 1 ./tokenizer.json :/models/tokenizer.json`,
+		Args:               cobra.ExactArgs(3),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateCp(args); err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(cpOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(cpOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runCp(context.Background(), k8sClient, config, args[0], util.GetNamespace(cpOpts.cf),
+				args[1], args[2], os.Stdout)
+		},
+	}
+	cpOpts.cf = cf
+	cpCmd.Flags().StringVar(&cpOpts.role, "role", "", "Name of the role whose pod to copy from or to")
+	cpCmd.Flags().IntVar(&cpOpts.index, "index", 0, "Replica index of the role pod")
+	cpCmd.Flags().StringVarP(&cpOpts.container, "container", "c", "", "Container name, defaults to the default container of the pod")
+
+	return cpCmd
+}
+
+func validateCp(args []string) error {
+	if len(args) != 3 {
+		return fmt.Errorf("rbg name, src and dst are required")
+	}
+	if len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if cpOpts.role == "" {
+		return fmt.Errorf("--role is required")
+	}
+	if cpOpts.index < 0 {
+		return fmt.Errorf("--index cannot be negative")
+	}
+	srcRemote, srcPath := parsePath(args[1])
+	dstRemote, dstPath := parsePath(args[2])
+	if srcRemote == dstRemote {
+		return fmt.Errorf("exactly one of src and dst must be a pod path prefixed with %q", remotePrefix)
+	}
+	if srcPath == "" || dstPath == "" {
+		return fmt.Errorf("src and dst cannot be empty")
+	}
+	return nil
+}
+
+// parsePath reports whether the path refers to the pod and strips the pod prefix.
+func parsePath(p string) (bool, string) {
+	if strings.HasPrefix(p, remotePrefix) {
+		return true, strings.TrimPrefix(p, remotePrefix)
+	}
+	return false, p
+}
+
+func runCp(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	name, namespace, src, dst string,
+	out io.Writer,
+) error {
+	pod, err := util.ResolveRolePod(ctx, k8sClient, namespace, name, cpOpts.role, cpOpts.index)
+	if err != nil {
+		return err
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return fmt.Errorf("cannot copy files with pod %s in phase %s", pod.Name, pod.Status.Phase)
+	}
+	container := cpOpts.container
+	if container == "" {
+		container = util.DefaultContainer(pod)
+	}
+
+	_, srcPath := parsePath(src)
+	dstRemote, dstPath := parsePath(dst)
+	if dstRemote {
+		err = copyToPod(ctx, k8sClient, config, pod, container, srcPath, dstPath, out)
+	} else {
+		err = copyFromPod(ctx, k8sClient, config, pod, container, srcPath, dstPath, out)
+	}
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "Copied %s to %s\n", describePath(src, pod), describePath(dst, pod))
+	return nil
+}
+
+func describePath(p string, pod *corev1.Pod) string {
+	if remote, remotePath := parsePath(p); remote {
+		return pod.Name + ":" + remotePath
+	}
+	return p
+}
+
+// copyToPod streams the local file or directory as a tar archive into the pod. A
+// destination ending with '/' is a directory to copy into, otherwise it names the copy.
+func copyToPod(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	pod *corev1.Pod,
+	container, localPath, remotePath string,
+	out io.Writer,
+) error {
+	if _, err := os.Stat(localPath); err != nil {
+		return fmt.Errorf("failed to read %s: %w", localPath, err)
+	}
+	dir, name := path.Dir(remotePath), path.Base(remotePath)
+	if strings.HasSuffix(remotePath, "/") {
+		dir, name = path.Clean(remotePath), filepath.Base(localPath)
+	}
+
+	reader, writer := io.Pipe()
+	// Closing the reader unblocks the archive writer when the exec stops reading early.
+	defer func() { _ = reader.Close() }()
+	go func() {
+		_ = writer.CloseWithError(writeTar(writer, localPath, name, out))
+	}()
+
+	var stderr bytes.Buffer
+	err := podExecutor(ctx, k8sClient, config, execRequest{
+		pod:       pod,
+		container: container,
+		command:   []string{"tar", "-xmf", "-", "-C", dir},
+		stdin:     reader,
+		stdout:    io.Discard,
+		stderr:    &stderr,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy %s to pod %s: %w%s", localPath, pod.Name, err, remoteOutput(&stderr))
+	}
+	return nil
+}
+
+// copyFromPod archives the pod path with tar and extracts it locally. An existing local
+// directory, or a destination ending with a path separator, receives the copy inside it.
+func copyFromPod(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	pod *corev1.Pod,
+	container, remotePath, localPath string,
+	out io.Writer,
+) error {
+	remotePath = path.Clean(remotePath)
+	if remotePath == "/" || remotePath == "." {
+		return fmt.Errorf("refusing to copy %q, name a file or directory", remotePath)
+	}
+	dir, name := path.Dir(remotePath), path.Base(remotePath)
+
+	target := localPath
+	if info, err := os.Stat(localPath); (err == nil && info.IsDir()) || strings.HasSuffix(localPath, string(filepath.Separator)) {
+		target = filepath.Join(localPath, name)
+	}
+
+	reader, writer := io.Pipe()
+	defer func() { _ = reader.Close() }()
+	var stderr bytes.Buffer
+	execErr := make(chan error, 1)
+	go func() {
+		err := podExecutor(ctx, k8sClient, config, execRequest{
+			pod:       pod,
+			container: container,
+			command:   []string{"tar", "-cf", "-", "-C", dir, name},
+			stdout:    writer,
+			stderr:    &stderr,
+		})
+		_ = writer.CloseWithError(err)
+		execErr <- err
+	}()
+
+	extractErr := extractTar(reader, name, target, out)
+	// Drain what is left so the exec can finish when extraction stopped early.
+	_, _ = io.Copy(io.Discard, reader)
+	if err := <-execErr; err != nil {
+		return fmt.Errorf("failed to copy %s from pod %s: %w%s", remotePath, pod.Name, err, remoteOutput(&stderr))
+	}
+	if extractErr != nil {
+		return fmt.Errorf("failed to copy %s from pod %s: %w", remotePath, pod.Name, extractErr)
+	}
+	return nil
+}
+
+func remoteOutput(stderr *bytes.Buffer) string {
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		return ": " + msg
+	}
+	return ""
+}
+
+func streamExec(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, req execRequest) error {
+	restReq := k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(req.pod.Namespace).
+		Name(req.pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: req.container,
+			Command:   req.command,
+			Stdin:     req.stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", restReq.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create executor: %w", err)
+	}
+	return executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  req.stdin,
+		Stdout: req.stdout,
+		Stderr: req.stderr,
+	})
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+)
+
+func newTestClient() kubernetes.Interface {
+	return fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rbg-prefill-0",
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.GroupNameLabelKey:         "test-rbg",
+				constants.RoleNameLabelKey:          "prefill",
+				constants.RoleInstanceIndexLabelKey: "0",
+			},
+		},
+		Spec:   corev1.PodSpec{Containers: []corev1.Container{{Name: "engine"}, {Name: "sidecar"}}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	})
+}
+
+func TestValidateCp(t *testing.T) {
+	origOpts := cpOpts
+	defer func() { cpOpts = origOpts }()
+
+	cpOpts = CpOptions{role: "prefill"}
+	assert.NoError(t, validateCp([]string{"test-rbg", ":/var/log/engine", "./logs"}))
+	assert.NoError(t, validateCp([]string{"test-rbg", "./tokenizer.json", ":/models/"}))
+	assert.EqualError(t, validateCp([]string{"", "a", ":/b"}), "rbg name is required")
+	assert.EqualError(t, validateCp([]string{"test-rbg", "a", "b"}), `exactly one of src and dst must be a pod path prefixed with ":"`)
+	assert.EqualError(t, validateCp([]string{"test-rbg", ":/a", ":/b"}), `exactly one of src and dst must be a pod path prefixed with ":"`)
+	assert.EqualError(t, validateCp([]string{"test-rbg", ":", "b"}), "src and dst cannot be empty")
+
+	cpOpts = CpOptions{}
+	assert.EqualError(t, validateCp([]string{"test-rbg", "a", ":/b"}), "--role is required")
+
+	cpOpts = CpOptions{role: "prefill", index: -1}
+	assert.EqualError(t, validateCp([]string{"test-rbg", "a", ":/b"}), "--index cannot be negative")
+}
+
+func TestRunCpToPod(t *testing.T) {
+	origOpts := cpOpts
+	origExecutor := podExecutor
+	defer func() {
+		cpOpts = origOpts
+		podExecutor = origExecutor
+	}()
+
+	src := filepath.Join(t.TempDir(), "tokenizer.json")
+	require.NoError(t, os.WriteFile(src, []byte(`{"patched":true}`), 0o600))
+
+	var got execRequest
+	var archive bytes.Buffer
+	podExecutor = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, req execRequest) error {
+		got = req
+		_, err := io.Copy(&archive, req.stdin)
+		return err
+	}
+
+	cpOpts = CpOptions{role: "prefill"}
+	var out bytes.Buffer
+	require.NoError(t, runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", src, ":/models/tok.json", &out))
+	assert.Equal(t, "engine", got.container)
+	assert.Equal(t, []string{"tar", "-xmf", "-", "-C", "/models"}, got.command)
+	assert.Equal(t, fmt.Sprintf("Copied %s to test-rbg-prefill-0:/models/tok.json\n", src), out.String())
+
+	dest := filepath.Join(t.TempDir(), "tok.json")
+	require.NoError(t, extractTar(&archive, "tok.json", dest, nil))
+	data, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, `{"patched":true}`, string(data))
+
+	cpOpts = CpOptions{role: "prefill", container: "sidecar"}
+	require.NoError(t, runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", src, ":/models/", &out))
+	assert.Equal(t, "sidecar", got.container)
+	assert.Equal(t, []string{"tar", "-xmf", "-", "-C", "/models"}, got.command)
+
+	podExecutor = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, req execRequest) error {
+		_, _ = req.stderr.Write([]byte("tar: /models: Cannot open: Read-only file system\n"))
+		return fmt.Errorf("command terminated with exit code 2")
+	}
+	err = runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", src, ":/models/", &out)
+	assert.EqualError(t, err, fmt.Sprintf("failed to copy %s to pod test-rbg-prefill-0: command terminated with exit code 2: "+
+		"tar: /models: Cannot open: Read-only file system", src))
+
+	err = runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", filepath.Join(t.TempDir(), "absent"), ":/models/", &out)
+	assert.ErrorContains(t, err, "failed to read")
+
+	cpOpts = CpOptions{role: "prefill", index: 1}
+	assert.Error(t, runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", src, ":/models/", &out))
+}
+
+func TestRunCpFromPod(t *testing.T) {
+	origOpts := cpOpts
+	origExecutor := podExecutor
+	defer func() {
+		cpOpts = origOpts
+		podExecutor = origExecutor
+	}()
+
+	remote := filepath.Join(t.TempDir(), "engine")
+	require.NoError(t, os.MkdirAll(remote, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(remote, "engine.log"), []byte("ready"), 0o600))
+
+	var got execRequest
+	podExecutor = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, req execRequest) error {
+		got = req
+		return writeTar(req.stdout, remote, "engine", io.Discard)
+	}
+
+	cpOpts = CpOptions{role: "prefill"}
+	dest := t.TempDir()
+	var out bytes.Buffer
+	require.NoError(t, runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", ":/var/log/engine/", dest, &out))
+	assert.Equal(t, []string{"tar", "-cf", "-", "-C", "/var/log", "engine"}, got.command)
+	assert.FileExists(t, filepath.Join(dest, "engine", "engine.log"))
+	assert.Equal(t, fmt.Sprintf("Copied test-rbg-prefill-0:/var/log/engine/ to %s\n", dest), out.String())
+
+	renamed := filepath.Join(t.TempDir(), "logs")
+	require.NoError(t, runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", ":/var/log/engine", renamed, &out))
+	assert.FileExists(t, filepath.Join(renamed, "engine.log"))
+
+	podExecutor = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, req execRequest) error {
+		_, _ = req.stderr.Write([]byte("tar: engine: Cannot stat: No such file or directory\n"))
+		return fmt.Errorf("command terminated with exit code 2")
+	}
+	err := runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", ":/var/log/engine", renamed, &out)
+	assert.EqualError(t, err, "failed to copy /var/log/engine from pod test-rbg-prefill-0: command terminated with exit code 2: "+
+		"tar: engine: Cannot stat: No such file or directory")
+
+	err = runCp(context.TODO(), newTestClient(), &rest.Config{}, "test-rbg", "default", ":/", renamed, &out)
+	assert.EqualError(t, err, `refusing to copy "/", name a file or directory`)
+}
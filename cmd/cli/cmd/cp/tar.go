@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cp
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// writeTar archives the local file or directory at src under the entry name. Only regular
+// files and directories are copied, anything else is skipped with a warning.
+func writeTar(w io.Writer, src, name string, warn io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(src, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		entry := name
+		if rel != "." {
+			entry = path.Join(name, filepath.ToSlash(rel))
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			_, _ = fmt.Fprintf(warn, "Warning: skipping %s, only regular files and directories are copied\n", p)
+			return nil
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = entry
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive %s: %w", src, err)
+	}
+	return tw.Close()
+}
+
+// extractTar writes the archive entries below prefix to dest, the prefix entry itself
+// becoming dest. Entries escaping the prefix are rejected so a pod cannot write outside
+// the destination, and only regular files and directories are extracted.
+func extractTar(r io.Reader, prefix, dest string, warn io.Writer) error {
+	tr := tar.NewReader(r)
+	found := false
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+		name := path.Clean(hdr.Name)
+		if name != prefix && !strings.HasPrefix(name, prefix+"/") {
+			return fmt.Errorf("unexpected archive entry %q outside of %s", hdr.Name, prefix)
+		}
+		found = true
+		target := filepath.Join(dest, filepath.FromSlash(strings.TrimPrefix(name, prefix)))
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", target, err)
+			}
+		case tar.TypeReg:
+			if err := writeFile(tr, target, os.FileMode(hdr.Mode).Perm()); err != nil {
+				return err
+			}
+		default:
+			_, _ = fmt.Fprintf(warn, "Warning: skipping %s, only regular files and directories are copied\n", hdr.Name)
+		}
+	}
+	if !found {
+		return fmt.Errorf("archive does not contain %s", prefix)
+	}
+	return nil
+}
+
+func writeFile(r io.Reader, target string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", target, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cp
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTarRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "profiles", "rank0"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "engine.log"), []byte("ready"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "profiles", "rank0", "trace.json"), []byte("{}"), 0o644))
+	require.NoError(t, os.Symlink("engine.log", filepath.Join(src, "latest.log")))
+
+	var archive, warnings bytes.Buffer
+	require.NoError(t, writeTar(&archive, src, "logs", &warnings))
+	assert.Contains(t, warnings.String(), "skipping "+filepath.Join(src, "latest.log"))
+
+	dest := filepath.Join(t.TempDir(), "copy")
+	require.NoError(t, extractTar(&archive, "logs", dest, &warnings))
+	data, err := os.ReadFile(filepath.Join(dest, "engine.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "ready", string(data))
+	info, err := os.Stat(filepath.Join(dest, "profiles", "rank0", "trace.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o644), info.Mode().Perm())
+	assert.NoFileExists(t, filepath.Join(dest, "latest.log"))
+}
+
+func TestExtractTarSingleFile(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "tokenizer.json")
+	require.NoError(t, os.WriteFile(src, []byte("{}"), 0o600))
+	var archive bytes.Buffer
+	require.NoError(t, writeTar(&archive, src, "tokenizer.json", nil))
+
+	dest := filepath.Join(t.TempDir(), "renamed.json")
+	require.NoError(t, extractTar(&archive, "tokenizer.json", dest, nil))
+	assert.FileExists(t, dest)
+}
+
+func TestExtractTarRejectsEscapes(t *testing.T) {
+	for _, name := range []string{"../evil", "logs/../../evil", "/etc/passwd", "other"} {
+		var archive bytes.Buffer
+		tw := tar.NewWriter(&archive)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: 1}))
+		_, err := tw.Write([]byte("x"))
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+
+		dest := t.TempDir()
+		err = extractTar(&archive, "logs", filepath.Join(dest, "logs"), nil)
+		assert.ErrorContains(t, err, "outside of logs", name)
+		assert.NoFileExists(t, filepath.Join(filepath.Dir(dest), "evil"))
+	}
+
+	var empty bytes.Buffer
+	require.NoError(t, tar.NewWriter(&empty).Close())
+	assert.EqualError(t, extractTar(&empty, "logs", t.TempDir(), nil), "archive does not contain logs")
+}
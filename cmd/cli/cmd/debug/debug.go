@@ -0,0 +1,348 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultWaitTimeout = 2 * time.Minute
+
+	profileGeneral  = "general"
+	profileNetAdmin = "netadmin"
+	profileSysAdmin = "sysadmin"
+)
+
+type DebugOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	role      string
+	index     int
+	image     string
+	container string
+	target    string
+	profile   string
+	stdin     bool
+	tty       bool
+	timeout   time.Duration
+}
+
+var debugOpts DebugOptions
+
+// attachRequest describes the ephemeral container to attach to once it runs.
+type attachRequest struct {
+	pod       *corev1.Pod
+	container string
+	stdin     bool
+	tty       bool
+}
+
+// podAttacher attaches to the debug container; replaced in tests.
+var podAttacher = streamAttach
+
+// debugPollInterval is how often the pod is checked while the debug container starts; overridden in tests.
+var debugPollInterval = time.Second
+
+func NewDebugCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	debugCmd := &cobra.Command{
+		Use:   "debug <rbgName> --role <roleName> [--index N] --image <image> [-- <command> [args...]]",
+		Short: "Attach an ephemeral debug container to a pod of a rbg role",
+		Long: `Attach an ephemeral debug container to a pod of a rbg role.
+
+The container shares the process namespace of the target container, which defaults to
+the default container of the pod, so the engine can be inspected without restarting it.
+The profile sets the capabilities of the debug container:
+
+  general   SYS_PTRACE, to inspect the processes of the target container
+  netadmin  NET_ADMIN and NET_RAW, to diagnose networking and NCCL traffic
+  sysadmin  privileged, to reach the host devices such as RDMA NICs
+
+With -i the command attaches to the container once it runs, otherwise it returns right
+after the container was added. Ephemeral containers cannot be removed from a pod, they
+stay until the pod is recreated.`,
+		Example: `  # Open a shell next to the engine of the second decode replica
+  kubectl rbg debug my-rbg --role decode --index 1 --image nicolaka/netshoot -it
+
+  # Check the RDMA devices of a prefill pod
+  kubectl rbg debug my-rbg --role prefill --image nicolaka/netshoot --profile sysadmin -i -- ibv_devinfo`,
+		Args:               cobra.MinimumNArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateDebug(cmd, args); err != nil {
+				return err
+			}
+			debugOpts.image = util.ResolveImage(debugOpts.image)
+			k8sClient, err := util.GetK8SClientSet(debugOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(debugOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runDebug(context.Background(), k8sClient, config, args[0], util.GetNamespace(debugOpts.cf),
+				args[1:], os.Stdout)
+		},
+	}
+	debugOpts.cf = cf
+	debugCmd.Flags().StringVar(&debugOpts.role, "role", "", "Name of the role whose pod to debug")
+	debugCmd.Flags().IntVar(&debugOpts.index, "index", 0, "Replica index of the role pod")
+	debugCmd.Flags().StringVar(&debugOpts.image, "image", "", "Image of the debug container, prefixed with the default-registry of the CLI config when it has no registry")
+	debugCmd.Flags().StringVarP(&debugOpts.container, "container", "c", "", "Name of the debug container, generated by default")
+	debugCmd.Flags().StringVar(&debugOpts.target, "target", "",
+		"Container whose process namespace to share, defaults to the default container of the pod")
+	debugCmd.Flags().StringVar(&debugOpts.profile, "profile", profileGeneral,
+		"Security profile of the debug container, one of general, netadmin or sysadmin")
+	debugCmd.Flags().BoolVarP(&debugOpts.stdin, "stdin", "i", false, "Keep stdin open and attach to the debug container")
+	debugCmd.Flags().BoolVarP(&debugOpts.tty, "tty", "t", false, "Allocate a TTY for the debug container")
+	debugCmd.Flags().DurationVar(&debugOpts.timeout, "timeout", defaultWaitTimeout,
+		"The length of time to wait for the debug container to start when attaching")
+
+	return debugCmd
+}
+
+func validateDebug(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if cmd != nil && len(args) > 1 && cmd.ArgsLenAtDash() != 1 {
+		return fmt.Errorf("exactly one rbg name must precede -- and the command")
+	}
+	if debugOpts.role == "" {
+		return fmt.Errorf("--role is required")
+	}
+	if debugOpts.index < 0 {
+		return fmt.Errorf("--index cannot be negative")
+	}
+	if debugOpts.image == "" {
+		return fmt.Errorf("--image is required")
+	}
+	if _, err := securityContext(debugOpts.profile); err != nil {
+		return err
+	}
+	if debugOpts.tty && !debugOpts.stdin {
+		return fmt.Errorf("--tty requires --stdin")
+	}
+	if debugOpts.timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	return nil
+}
+
+// securityContext translates a debug profile to the security context of the container.
+func securityContext(profile string) (*corev1.SecurityContext, error) {
+	switch profile {
+	case profileGeneral:
+		return &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"SYS_PTRACE"}},
+		}, nil
+	case profileNetAdmin:
+		return &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN", "NET_RAW"}},
+		}, nil
+	case profileSysAdmin:
+		privileged := true
+		return &corev1.SecurityContext{Privileged: &privileged}, nil
+	}
+	return nil, fmt.Errorf("unknown profile %q, must be one of %s, %s or %s",
+		profile, profileGeneral, profileNetAdmin, profileSysAdmin)
+}
+
+func runDebug(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	name, namespace string,
+	command []string,
+	out io.Writer,
+) error {
+	pod, err := util.ResolveRolePod(ctx, k8sClient, namespace, name, debugOpts.role, debugOpts.index)
+	if err != nil {
+		return err
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return fmt.Errorf("cannot debug pod %s in phase %s", pod.Name, pod.Status.Phase)
+	}
+	container, err := newDebugContainer(pod, command)
+	if err != nil {
+		return err
+	}
+
+	updated := pod.DeepCopy()
+	updated.Spec.EphemeralContainers = append(updated.Spec.EphemeralContainers, *container)
+	if _, err := k8sClient.CoreV1().Pods(namespace).UpdateEphemeralContainers(ctx, pod.Name, updated, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to add ephemeral container to pod %s: %w", pod.Name, err)
+	}
+	_, _ = fmt.Fprintf(out, "Ephemeral container %s added to pod %s, targeting container %s\n",
+		container.Name, pod.Name, container.TargetContainerName)
+
+	if !debugOpts.stdin {
+		_, _ = fmt.Fprintf(out, "Attach with: kubectl attach -it %s -n %s -c %s\n", pod.Name, namespace, container.Name)
+		return nil
+	}
+	if err := waitForContainer(ctx, k8sClient, namespace, pod.Name, container.Name); err != nil {
+		return err
+	}
+	return podAttacher(ctx, k8sClient, config, attachRequest{
+		pod:       pod,
+		container: container.Name,
+		stdin:     debugOpts.stdin,
+		tty:       debugOpts.tty,
+	})
+}
+
+func newDebugContainer(pod *corev1.Pod, command []string) (*corev1.EphemeralContainer, error) {
+	target := debugOpts.target
+	if target == "" {
+		target = util.DefaultContainer(pod)
+	}
+	names := map[string]bool{}
+	for _, c := range pod.Spec.InitContainers {
+		names[c.Name] = true
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		names[c.Name] = true
+	}
+	found := false
+	for _, c := range pod.Spec.Containers {
+		names[c.Name] = true
+		found = found || c.Name == target
+	}
+	if !found {
+		return nil, fmt.Errorf("pod %s has no container %q to target", pod.Name, target)
+	}
+
+	name := debugOpts.container
+	if name == "" {
+		name = "debugger-" + utilrand.String(5)
+	}
+	if names[name] {
+		return nil, fmt.Errorf("pod %s already has a container named %s", pod.Name, name)
+	}
+	securityCtx, err := securityContext(debugOpts.profile)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    debugOpts.image,
+			Command:                  command,
+			Stdin:                    debugOpts.stdin,
+			TTY:                      debugOpts.tty,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+			SecurityContext:          securityCtx,
+		},
+		TargetContainerName: target,
+	}, nil
+}
+
+// waitForContainer polls the pod until the ephemeral container runs, failing fast when it
+// terminated or its image cannot be pulled.
+func waitForContainer(ctx context.Context, k8sClient kubernetes.Interface, namespace, podName, container string) error {
+	err := wait.PollUntilContextTimeout(ctx, debugPollInterval, debugOpts.timeout, true,
+		func(ctx context.Context) (bool, error) {
+			pod, err := k8sClient.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			for _, status := range pod.Status.EphemeralContainerStatuses {
+				if status.Name != container {
+					continue
+				}
+				switch {
+				case status.State.Running != nil:
+					return true, nil
+				case status.State.Terminated != nil:
+					return false, fmt.Errorf("container terminated: %s", status.State.Terminated.Reason)
+				case status.State.Waiting != nil && isImageError(status.State.Waiting.Reason):
+					return false, fmt.Errorf("%s: %s", status.State.Waiting.Reason, status.State.Waiting.Message)
+				}
+			}
+			return false, nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed waiting for debug container %s to start: %w", container, err)
+	}
+	return nil
+}
+
+func isImageError(reason string) bool {
+	switch reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+		return true
+	}
+	return false
+}
+
+func streamAttach(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, req attachRequest) error {
+	restReq := k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(req.pod.Namespace).
+		Name(req.pod.Name).
+		SubResource("attach").
+		VersionedParams(&corev1.PodAttachOptions{
+			Container: req.container,
+			Stdin:     req.stdin,
+			Stdout:    true,
+			Stderr:    !req.tty,
+			TTY:       req.tty,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", restReq.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create executor: %w", err)
+	}
+
+	streamOpts := remotecommand.StreamOptions{
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		Tty:    req.tty,
+	}
+	if req.tty {
+		streamOpts.Stderr = nil
+		if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+			state, err := term.MakeRaw(fd)
+			if err != nil {
+				return fmt.Errorf("failed to set terminal to raw mode: %w", err)
+			}
+			defer func() { _ = term.Restore(fd, state) }()
+		}
+	}
+	return executor.StreamWithContext(ctx, streamOpts)
+}
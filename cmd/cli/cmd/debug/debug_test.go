@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestValidateDebug(t *testing.T) {
+	origOpts := debugOpts
+	defer func() { debugOpts = origOpts }()
+
+	valid := DebugOptions{role: "decode", image: "nicolaka/netshoot", profile: profileGeneral, timeout: time.Minute}
+	debugOpts = valid
+	assert.NoError(t, validateDebug(nil, []string{"test-rbg"}))
+	assert.EqualError(t, validateDebug(nil, []string{""}), "rbg name is required")
+
+	debugOpts = valid
+	debugOpts.role = ""
+	assert.EqualError(t, validateDebug(nil, []string{"test-rbg"}), "--role is required")
+
+	debugOpts = valid
+	debugOpts.index = -1
+	assert.EqualError(t, validateDebug(nil, []string{"test-rbg"}), "--index cannot be negative")
+
+	debugOpts = valid
+	debugOpts.image = ""
+	assert.EqualError(t, validateDebug(nil, []string{"test-rbg"}), "--image is required")
+
+	debugOpts = valid
+	debugOpts.profile = "root"
+	assert.EqualError(t, validateDebug(nil, []string{"test-rbg"}),
+		`unknown profile "root", must be one of general, netadmin or sysadmin`)
+
+	debugOpts = valid
+	debugOpts.tty = true
+	assert.EqualError(t, validateDebug(nil, []string{"test-rbg"}), "--tty requires --stdin")
+}
+
+func TestSecurityContext(t *testing.T) {
+	sc, err := securityContext(profileNetAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.Capability{"NET_ADMIN", "NET_RAW"}, sc.Capabilities.Add)
+
+	sc, err = securityContext(profileSysAdmin)
+	require.NoError(t, err)
+	assert.True(t, *sc.Privileged)
+}
+
+func TestRunDebug(t *testing.T) {
+	origOpts := debugOpts
+	origAttacher := podAttacher
+	origInterval := debugPollInterval
+	defer func() {
+		debugOpts = origOpts
+		podAttacher = origAttacher
+		debugPollInterval = origInterval
+	}()
+	debugPollInterval = time.Millisecond
+
+	pod := wrappersv2.BuildBasicPod().WithName("test-rbg-decode-1").WithNamespace("default").
+		WithLabels(map[string]string{
+			constants.GroupNameLabelKey:         "test-rbg",
+			constants.RoleNameLabelKey:          "decode",
+			constants.RoleInstanceIndexLabelKey: "1",
+		}).
+		WithContainers(corev1.Container{Name: "engine"}, corev1.Container{Name: "sidecar"}).
+		WithPhase(corev1.PodRunning).Obj()
+	client := fake.NewSimpleClientset(pod)
+	debugOpts = DebugOptions{role: "decode", index: 1, image: "nicolaka/netshoot", profile: profileGeneral, timeout: time.Second}
+	var out bytes.Buffer
+	require.NoError(t, runDebug(context.TODO(), client, &rest.Config{}, "test-rbg", "default", nil, &out))
+
+	pod, err := client.CoreV1().Pods("default").Get(context.TODO(), "test-rbg-decode-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, pod.Spec.EphemeralContainers, 1)
+	ec := pod.Spec.EphemeralContainers[0]
+	assert.True(t, strings.HasPrefix(ec.Name, "debugger-"))
+	assert.Equal(t, "nicolaka/netshoot", ec.Image)
+	assert.Equal(t, "engine", ec.TargetContainerName)
+	assert.Equal(t, []corev1.Capability{"SYS_PTRACE"}, ec.SecurityContext.Capabilities.Add)
+	assert.Contains(t, out.String(), "Attach with: kubectl attach -it test-rbg-decode-1 -n default -c "+ec.Name)
+
+	debugOpts.container = ec.Name
+	err = runDebug(context.TODO(), client, &rest.Config{}, "test-rbg", "default", nil, &out)
+	assert.EqualError(t, err, "pod test-rbg-decode-1 already has a container named "+ec.Name)
+
+	debugOpts = DebugOptions{role: "decode", index: 1, image: "busybox", profile: profileGeneral, target: "gpu", timeout: time.Second}
+	err = runDebug(context.TODO(), client, &rest.Config{}, "test-rbg", "default", nil, &out)
+	assert.EqualError(t, err, `pod test-rbg-decode-1 has no container "gpu" to target`)
+}
+
+func TestRunDebugAttach(t *testing.T) {
+	origOpts := debugOpts
+	origAttacher := podAttacher
+	origInterval := debugPollInterval
+	defer func() {
+		debugOpts = origOpts
+		podAttacher = origAttacher
+		debugPollInterval = origInterval
+	}()
+	debugPollInterval = time.Millisecond
+
+	pod := wrappersv2.BuildBasicPod().WithName("test-rbg-decode-1").WithNamespace("default").
+		WithLabels(map[string]string{
+			constants.GroupNameLabelKey:         "test-rbg",
+			constants.RoleNameLabelKey:          "decode",
+			constants.RoleInstanceIndexLabelKey: "1",
+		}).
+		WithContainers(corev1.Container{Name: "engine"}, corev1.Container{Name: "sidecar"}).
+		WithPhase(corev1.PodRunning).Obj()
+
+	// The kubelet starts the ephemeral container; report it with the given state once added.
+	startWith := func(state corev1.ContainerState) *fake.Clientset {
+		client := fake.NewSimpleClientset(pod.DeepCopy())
+		client.PrependReactor("update", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			update := action.(k8stesting.UpdateAction)
+			if update.GetSubresource() != "ephemeralcontainers" {
+				return false, nil, nil
+			}
+			pod := update.GetObject().(*corev1.Pod).DeepCopy()
+			for _, c := range pod.Spec.EphemeralContainers {
+				pod.Status.EphemeralContainerStatuses = append(pod.Status.EphemeralContainerStatuses,
+					corev1.ContainerStatus{Name: c.Name, State: state})
+			}
+			return true, pod, client.Tracker().Update(corev1.SchemeGroupVersion.WithResource("pods"), pod, pod.Namespace)
+		})
+		return client
+	}
+
+	var got attachRequest
+	podAttacher = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, req attachRequest) error {
+		got = req
+		return nil
+	}
+
+	debugOpts = DebugOptions{role: "decode", index: 1, image: "nicolaka/netshoot", container: "netshoot",
+		profile: profileNetAdmin, target: "sidecar", stdin: true, tty: true, timeout: time.Second}
+	client := startWith(corev1.ContainerState{Running: &corev1.ContainerStateRunning{}})
+	var out bytes.Buffer
+	require.NoError(t, runDebug(context.TODO(), client, &rest.Config{}, "test-rbg", "default", []string{"ibv_devinfo"}, &out))
+	assert.Equal(t, "netshoot", got.container)
+	assert.True(t, got.tty)
+	assert.Contains(t, out.String(), "Ephemeral container netshoot added to pod test-rbg-decode-1, targeting container sidecar")
+	debugged, err := client.CoreV1().Pods("default").Get(context.TODO(), "test-rbg-decode-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ibv_devinfo"}, debugged.Spec.EphemeralContainers[0].Command)
+
+	client = startWith(corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "not found"}})
+	err = runDebug(context.TODO(), client, &rest.Config{}, "test-rbg", "default", nil, &out)
+	assert.EqualError(t, err, "failed waiting for debug container netshoot to start: ErrImagePull: not found")
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultWaitTimeout = 5 * time.Minute
+
+	cascadeBackground = "background"
+	cascadeForeground = "foreground"
+	cascadeOrphan     = "orphan"
+)
+
+type DeleteOptions struct {
+	cf           *genericclioptions.ConfigFlags
+	cascade      string
+	keepServices bool
+	wait         bool
+	timeout      time.Duration
+}
+
+var deleteOpts DeleteOptions
+
+// deletePollInterval is how often the cluster is checked while waiting; overridden in tests.
+var deletePollInterval = 2 * time.Second
+
+func NewDeleteCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:                "delete <rbgName>",
+		Short:              "Delete a rbg with explicit control over its child workloads and services",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateDelete(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(deleteOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(deleteOpts.cf)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := util.GetDefaultDynamicClient(deleteOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runDelete(context.Background(), rbgClient, k8sClient, dynamicClient,
+				args[0], util.GetNamespace(deleteOpts.cf), os.Stdout)
+		},
+	}
+	deleteOpts.cf = cf
+	deleteCmd.Flags().StringVar(&deleteOpts.cascade, "cascade", cascadeBackground,
+		"Must be \"background\", \"foreground\", or \"orphan\". Selects the deletion cascading strategy for the role workloads")
+	deleteCmd.Flags().BoolVar(&deleteOpts.keepServices, "keep-services", false,
+		"Keep the headless services of the roles instead of garbage collecting them with the workloads")
+	deleteCmd.Flags().BoolVar(&deleteOpts.wait, "wait", true,
+		"Wait until the rbg and, unless --cascade=orphan, its role workloads are gone")
+	deleteCmd.Flags().DurationVar(&deleteOpts.timeout, "timeout", defaultWaitTimeout, "The length of time to wait when --wait is set")
+
+	return deleteCmd
+}
+
+func validateDelete(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if _, err := propagationPolicy(deleteOpts.cascade); err != nil {
+		return err
+	}
+	if deleteOpts.timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	return nil
+}
+
+func propagationPolicy(cascade string) (metav1.DeletionPropagation, error) {
+	switch cascade {
+	case cascadeBackground:
+		return metav1.DeletePropagationBackground, nil
+	case cascadeForeground:
+		return metav1.DeletePropagationForeground, nil
+	case cascadeOrphan:
+		return metav1.DeletePropagationOrphan, nil
+	default:
+		return "", fmt.Errorf("invalid --cascade %q, must be one of background, foreground or orphan", cascade)
+	}
+}
+
+func runDelete(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	name, namespace string,
+	out io.Writer,
+) error {
+	policy, err := propagationPolicy(deleteOpts.cascade)
+	if err != nil {
+		return err
+	}
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+
+	// Orphaned workloads keep their services alive, so there is nothing to release then.
+	if deleteOpts.keepServices && policy != metav1.DeletePropagationOrphan {
+		if err := releaseServices(ctx, k8sClient, rbg, out); err != nil {
+			return err
+		}
+	}
+
+	if err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &policy,
+		Preconditions:     &metav1.Preconditions{UID: &rbg.UID},
+	}); err != nil {
+		return fmt.Errorf("failed to delete RoleBasedGroup: %w", err)
+	}
+	_, _ = fmt.Fprintf(out, "rbg %s deleted (cascade=%s)\n", name, deleteOpts.cascade)
+
+	if !deleteOpts.wait {
+		return nil
+	}
+	return waitForCleanup(ctx, rbgClient, dynamicClient, rbg, policy, out)
+}
+
+// releaseServices drops the owner references from the role workloads to the headless services
+// of the rbg, so the garbage collector leaves them in place once the workloads are gone.
+func releaseServices(ctx context.Context, k8sClient kubernetes.Interface, rbg *workloadsv1alpha2.RoleBasedGroup, out io.Writer) error {
+	workloads := make(map[string]bool, len(rbg.Spec.Roles))
+	for i := range rbg.Spec.Roles {
+		workloads[rbg.GetWorkloadName(&rbg.Spec.Roles[i])] = true
+	}
+
+	svcs, err := k8sClient.CoreV1().Services(rbg.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", constants.GroupNameLabelKey, rbg.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list services: %w", err)
+	}
+	for i := range svcs.Items {
+		svc := &svcs.Items[i]
+		refs := make([]metav1.OwnerReference, 0, len(svc.OwnerReferences))
+		for _, ref := range svc.OwnerReferences {
+			if !workloads[ref.Name] && ref.UID != rbg.UID {
+				refs = append(refs, ref)
+			}
+		}
+		if len(refs) == len(svc.OwnerReferences) {
+			continue
+		}
+		svc.OwnerReferences = refs
+		if _, err := k8sClient.CoreV1().Services(rbg.Namespace).Update(ctx, svc, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to release service %s: %w", svc.Name, err)
+		}
+		_, _ = fmt.Fprintf(out, "service %s kept\n", svc.Name)
+	}
+	return nil
+}
+
+func waitForCleanup(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	dynamicClient dynamic.Interface,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	policy metav1.DeletionPropagation,
+	out io.Writer,
+) error {
+	err := wait.PollUntilContextTimeout(ctx, deletePollInterval, deleteOpts.timeout, true,
+		func(ctx context.Context) (bool, error) {
+			_, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(rbg.Namespace).Get(ctx, rbg.Name, metav1.GetOptions{})
+			if err == nil {
+				return false, nil
+			}
+			if !apierrors.IsNotFound(err) {
+				return false, err
+			}
+			if policy == metav1.DeletePropagationOrphan {
+				return true, nil
+			}
+			for i := range rbg.Spec.Roles {
+				role := &rbg.Spec.Roles[i]
+				_, err := dynamicClient.Resource(util.WorkloadGVR(role)).Namespace(rbg.Namespace).
+					Get(ctx, rbg.GetWorkloadName(role), metav1.GetOptions{})
+				if err == nil {
+					return false, nil
+				}
+				if !apierrors.IsNotFound(err) {
+					return false, err
+				}
+			}
+			return true, nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed waiting for rbg %s to be cleaned up: %w", rbg.Name, err)
+	}
+	if policy == metav1.DeletePropagationOrphan {
+		_, _ = fmt.Fprintf(out, "rbg %s is gone, role workloads were orphaned\n", rbg.Name)
+	} else {
+		_, _ = fmt.Fprintf(out, "rbg %s and its role workloads are gone\n", rbg.Name)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newTestService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s-test-rbg-prefill",
+			Namespace: "default",
+			Labels:    map[string]string{constants.GroupNameLabelKey: "test-rbg"},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "test-rbg-prefill", UID: "sts-uid"},
+				{APIVersion: "v1", Kind: "ConfigMap", Name: "unrelated", UID: "cm-uid"},
+			},
+		},
+	}
+}
+
+func TestValidateDelete(t *testing.T) {
+	origOpts := deleteOpts
+	defer func() { deleteOpts = origOpts }()
+
+	deleteOpts = DeleteOptions{cascade: cascadeForeground, timeout: time.Second}
+	assert.NoError(t, validateDelete([]string{"test-rbg"}))
+	assert.EqualError(t, validateDelete([]string{""}), "rbg name is required")
+
+	deleteOpts.cascade = "true"
+	assert.ErrorContains(t, validateDelete([]string{"test-rbg"}), "invalid --cascade")
+
+	deleteOpts = DeleteOptions{cascade: cascadeOrphan}
+	assert.EqualError(t, validateDelete([]string{"test-rbg"}), "--timeout must be positive")
+}
+
+func TestRunDelete(t *testing.T) {
+	origOpts := deleteOpts
+	origInterval := deletePollInterval
+	defer func() {
+		deleteOpts = origOpts
+		deletePollInterval = origInterval
+	}()
+	deletePollInterval = 10 * time.Millisecond
+	ctx := context.TODO()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithUID("rbg-uid").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithWorkload("apps/v1", "StatefulSet").Obj(),
+		}).Obj()
+
+	t.Run("foreground keeps services", func(t *testing.T) {
+		rbgClient := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		k8sClient := fake.NewSimpleClientset(newTestService())
+		dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+		var policy metav1.DeletionPropagation
+		rbgClient.PrependReactor("delete", "rolebasedgroups", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			policy = *action.(k8stesting.DeleteAction).GetDeleteOptions().PropagationPolicy
+			return false, nil, nil
+		})
+
+		deleteOpts = DeleteOptions{cascade: cascadeForeground, keepServices: true, wait: true, timeout: time.Second}
+		var out bytes.Buffer
+		assert.NoError(t, runDelete(ctx, rbgClient, k8sClient, dynamicClient, "test-rbg", "default", &out))
+		assert.Equal(t, metav1.DeletePropagationForeground, policy)
+		assert.Contains(t, out.String(), "service s-test-rbg-prefill kept")
+		assert.Contains(t, out.String(), "its role workloads are gone")
+
+		svc, err := k8sClient.CoreV1().Services("default").Get(ctx, "s-test-rbg-prefill", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Len(t, svc.OwnerReferences, 1)
+		assert.Equal(t, "unrelated", svc.OwnerReferences[0].Name)
+	})
+
+	t.Run("wait times out on remaining workloads", func(t *testing.T) {
+		rbgClient := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		k8sClient := fake.NewSimpleClientset(newTestService())
+		sts := &unstructured.Unstructured{}
+		sts.SetAPIVersion("apps/v1")
+		sts.SetKind("StatefulSet")
+		sts.SetNamespace("default")
+		sts.SetName("test-rbg-prefill")
+		dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), sts)
+
+		deleteOpts = DeleteOptions{cascade: cascadeBackground, wait: true, timeout: 50 * time.Millisecond}
+		var out bytes.Buffer
+		err := runDelete(ctx, rbgClient, k8sClient, dynamicClient, "test-rbg", "default", &out)
+		assert.ErrorContains(t, err, "failed waiting for rbg test-rbg to be cleaned up")
+
+		svc, err := k8sClient.CoreV1().Services("default").Get(ctx, "s-test-rbg-prefill", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Len(t, svc.OwnerReferences, 2)
+	})
+
+	t.Run("orphan does not wait for workloads", func(t *testing.T) {
+		rbgClient := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		sts := &unstructured.Unstructured{}
+		sts.SetAPIVersion("apps/v1")
+		sts.SetKind("StatefulSet")
+		sts.SetNamespace("default")
+		sts.SetName("test-rbg-prefill")
+		dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), sts)
+
+		deleteOpts = DeleteOptions{cascade: cascadeOrphan, keepServices: true, wait: true, timeout: time.Second}
+		var out bytes.Buffer
+		assert.NoError(t, runDelete(ctx, rbgClient, fake.NewSimpleClientset(), dynamicClient, "test-rbg", "default", &out))
+		assert.Contains(t, out.String(), "role workloads were orphaned")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		deleteOpts = DeleteOptions{cascade: cascadeBackground}
+		err := runDelete(ctx, fakerbgclient.NewSimpleClientset(), fake.NewSimpleClientset(),
+			fakedynamic.NewSimpleDynamicClient(runtime.NewScheme()), "test-rbg", "default", &bytes.Buffer{})
+		assert.ErrorContains(t, err, "failed to get RoleBasedGroup")
+	})
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	// maxEvents caps the number of events printed at the end of the report.
+	maxEvents = 10
+)
+
+type DescribeOptions struct {
+	cf     *genericclioptions.ConfigFlags
+	output util.OutputOptions
+}
+
+var describeOpts DescribeOptions
+
+func NewDescribeCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	describeCmd := &cobra.Command{
+		Use:                "describe <rbgName>",
+		Short:              "Show details of a rbg, its workloads, pods and events",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := describeOpts.output.Validate(); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(describeOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(describeOpts.cf)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := util.GetDefaultDynamicClient(describeOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runDescribe(context.Background(), rbgClient, k8sClient, dynamicClient,
+				args[0], util.GetNamespace(describeOpts.cf), os.Stdout)
+		},
+	}
+	describeOpts.cf = cf
+	describeOpts.output.AddOutputFlags(describeCmd, util.OutputJSON, util.OutputYAML, util.OutputWide, util.OutputName)
+
+	return describeCmd
+}
+
+func runDescribe(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	if describeOpts.output.Format == util.OutputName {
+		_, _ = fmt.Fprintf(out, "rolebasedgroup.%s/%s\n", workloadsv1alpha2.GroupVersion.Group, rbg.Name)
+		return nil
+	}
+
+	pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", constants.GroupNameLabelKey, rbg.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	events, err := k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.name=%s", rbg.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list events: %w", err)
+	}
+
+	workloads, err := getWorkloads(ctx, rbg, dynamicClient)
+	if err != nil {
+		return err
+	}
+	if describeOpts.output.IsStructured() {
+		return printObjects(out, rbg, workloads, pods.Items, filterEvents(events.Items, rbg))
+	}
+
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+
+	printOverview(w, rbg)
+	printRoles(w, rbg)
+	printWorkloads(w, workloads)
+	printPods(w, pods.Items)
+	printConditions(w, rbg.Status.Conditions)
+	printEvents(w, filterEvents(events.Items, rbg))
+	return nil
+}
+
+// printObjects prints the rbg with its workloads, pods and events as a List, the way
+// kubectl prints several objects.
+func printObjects(
+	out io.Writer,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	workloads []roleWorkload,
+	pods []corev1.Pod,
+	events []corev1.Event,
+) error {
+	// Typed clients drop the TypeMeta, restore it so every item is self-describing.
+	rbg.SetGroupVersionKind(workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroup"))
+	items := []interface{}{rbg}
+	for _, workload := range workloads {
+		if workload.obj != nil {
+			items = append(items, workload.obj)
+		}
+	}
+	for i := range pods {
+		pods[i].SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+		items = append(items, &pods[i])
+	}
+	for i := range events {
+		events[i].SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Event"))
+		items = append(items, &events[i])
+	}
+	return describeOpts.output.PrintObject(out, map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	})
+}
+
+func printOverview(w io.Writer, rbg *workloadsv1alpha2.RoleBasedGroup) {
+	_, _ = fmt.Fprintf(w, "Name:\t%s\n", rbg.Name)
+	_, _ = fmt.Fprintf(w, "Namespace:\t%s\n", rbg.Namespace)
+	_, _ = fmt.Fprintf(w, "Age:\t%s\n", age(rbg.CreationTimestamp))
+	_, _ = fmt.Fprintf(w, "Observed Generation:\t%d/%d\n", rbg.Status.ObservedGeneration, rbg.Generation)
+}
+
+func printRoles(w io.Writer, rbg *workloadsv1alpha2.RoleBasedGroup) {
+	_, _ = fmt.Fprintln(w, "\nRoles:")
+	describeOpts.output.PrintHeader(w, "  NAME\tWORKLOAD\tREPLICAS\tREADY\tUPDATED\tIMAGES\tDEPENDENCIES")
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		var desired int32 = 1
+		if role.Replicas != nil {
+			desired = *role.Replicas
+		}
+		status, _ := rbg.GetRoleStatus(role.Name)
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%d\t%d\t%d\t%s\t%s\n",
+			role.Name,
+			role.GetWorkloadSpec().Kind,
+			desired,
+			status.ReadyReplicas,
+			status.UpdatedReplicas,
+			orNone(roleImages(rbg, role)),
+			orNone(strings.Join(role.Dependencies, ",")),
+		)
+	}
+}
+
+// roleWorkload is the workload of a role, obj is nil when the workload does not exist.
+type roleWorkload struct {
+	kind string
+	name string
+	obj  *unstructured.Unstructured
+}
+
+func getWorkloads(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, dynamicClient dynamic.Interface) ([]roleWorkload, error) {
+	workloads := make([]roleWorkload, 0, len(rbg.Spec.Roles))
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		workload := roleWorkload{kind: role.GetWorkloadSpec().Kind, name: rbg.GetWorkloadName(role)}
+		obj, err := dynamicClient.Resource(util.WorkloadGVR(role)).Namespace(rbg.Namespace).Get(ctx, workload.name, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get %s %s: %w", workload.kind, workload.name, err)
+		}
+		if err == nil {
+			workload.obj = obj
+		}
+		workloads = append(workloads, workload)
+	}
+	return workloads, nil
+}
+
+func printWorkloads(w io.Writer, workloads []roleWorkload) {
+	_, _ = fmt.Fprintln(w, "\nWorkloads:")
+	describeOpts.output.PrintHeader(w, "  KIND\tNAME\tREPLICAS\tREADY")
+	for _, workload := range workloads {
+		if workload.obj == nil {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t<missing>\t<missing>\n", workload.kind, workload.name)
+			continue
+		}
+		replicas, _, _ := unstructured.NestedInt64(workload.obj.Object, "status", "replicas")
+		ready, _, _ := unstructured.NestedInt64(workload.obj.Object, "status", "readyReplicas")
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%d\t%d\n", workload.obj.GetKind(), workload.obj.GetName(), replicas, ready)
+	}
+}
+
+func printPods(w io.Writer, pods []corev1.Pod) {
+	_, _ = fmt.Fprintln(w, "\nPods:")
+	if len(pods) == 0 {
+		_, _ = fmt.Fprintln(w, "  <none>")
+		return
+	}
+	sort.SliceStable(pods, func(i, j int) bool {
+		ri, rj := pods[i].Labels[constants.RoleNameLabelKey], pods[j].Labels[constants.RoleNameLabelKey]
+		if ri != rj {
+			return ri < rj
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	wide := describeOpts.output.Format == util.OutputWide
+	header := "  ROLE\tNAME\tPHASE\tREADY\tRESTARTS\tNODE\tGPU\tAGE"
+	if wide {
+		header += "\tIP"
+	}
+	describeOpts.output.PrintHeader(w, header)
+	for i := range pods {
+		pod := &pods[i]
+		readyContainers, restarts := 0, int32(0)
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Ready {
+				readyContainers++
+			}
+			restarts += cs.RestartCount
+		}
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%d/%d\t%d\t%s\t%s\t%s",
+			orNone(pod.Labels[constants.RoleNameLabelKey]),
+			pod.Name,
+			pod.Status.Phase,
+			readyContainers, len(pod.Spec.Containers),
+			restarts,
+			orNone(pod.Spec.NodeName),
+			orNone(gpuSummary(pod)),
+			age(pod.CreationTimestamp),
+		)
+		if wide {
+			_, _ = fmt.Fprintf(w, "\t%s", orNone(pod.Status.PodIP))
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+}
+
+func printConditions(w io.Writer, conditions []metav1.Condition) {
+	_, _ = fmt.Fprintln(w, "\nConditions:")
+	if len(conditions) == 0 {
+		_, _ = fmt.Fprintln(w, "  <none>")
+		return
+	}
+	describeOpts.output.PrintHeader(w, "  TYPE\tSTATUS\tREASON\tMESSAGE")
+	for _, cond := range conditions {
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", cond.Type, cond.Status, orNone(cond.Reason), cond.Message)
+	}
+}
+
+func printEvents(w io.Writer, events []corev1.Event) {
+	_, _ = fmt.Fprintln(w, "\nEvents:")
+	if len(events) == 0 {
+		_, _ = fmt.Fprintln(w, "  <none>")
+		return
+	}
+	describeOpts.output.PrintHeader(w, "  TYPE\tREASON\tAGE\tMESSAGE")
+	for _, event := range events {
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", event.Type, event.Reason, age(util.EventTime(event)), strings.TrimSpace(event.Message))
+	}
+}
+
+// filterEvents keeps the most recent events of the given rbg, oldest first.
+func filterEvents(events []corev1.Event, rbg *workloadsv1alpha2.RoleBasedGroup) []corev1.Event {
+	var result []corev1.Event
+	for _, event := range events {
+		if event.InvolvedObject.Kind != "RoleBasedGroup" || event.InvolvedObject.Name != rbg.Name {
+			continue
+		}
+		if event.InvolvedObject.UID != "" && rbg.UID != "" && event.InvolvedObject.UID != rbg.UID {
+			continue
+		}
+		result = append(result, event)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		ti, tj := util.EventTime(result[i]), util.EventTime(result[j])
+		return ti.Before(&tj)
+	})
+	if len(result) > maxEvents {
+		result = result[len(result)-maxEvents:]
+	}
+	return result
+}
+
+// roleImages returns the distinct container images of the role's resolved pod template.
+func roleImages(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec) string {
+	template, err := role.GetResolvedTemplate(rbg)
+	if err != nil {
+		return ""
+	}
+	var images []string
+	seen := map[string]bool{}
+	for _, c := range template.Spec.Containers {
+		if c.Image != "" && !seen[c.Image] {
+			seen[c.Image] = true
+			images = append(images, c.Image)
+		}
+	}
+	return strings.Join(images, ",")
+}
+
+// gpuSummary sums the GPU-like extended resources requested by the pod's containers,
+// e.g. "nvidia.com/gpu=2".
+func gpuSummary(pod *corev1.Pod) string {
+	totals := map[corev1.ResourceName]int64{}
+	for _, c := range pod.Spec.Containers {
+		for name, quantity := range c.Resources.Limits {
+			if strings.Contains(strings.ToLower(string(name)), "gpu") {
+				totals[name] += quantity.Value()
+			}
+		}
+	}
+	names := make([]string, 0, len(totals))
+	for name := range totals {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s=%d", name, totals[corev1.ResourceName(name)]))
+	}
+	return strings.Join(parts, ",")
+}
+
+func age(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(time.Since(t.Time))
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
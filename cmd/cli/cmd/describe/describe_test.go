@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestNewDescribeCmd(t *testing.T) {
+	cf := genericclioptions.NewConfigFlags(true)
+	cmd := NewDescribeCmd(cf)
+
+	assert.Equal(t, "describe <rbgName>", cmd.Use)
+	assert.True(t, cmd.SilenceUsage)
+	assert.Error(t, cmd.Args(cmd, []string{}))
+}
+
+func TestGpuSummary(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+					"nvidia.com/gpu":   resource.MustParse("2"),
+					corev1.ResourceCPU: resource.MustParse("4"),
+				}}},
+				{Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+					"nvidia.com/gpu": resource.MustParse("1"),
+				}}},
+			},
+		},
+	}
+	assert.Equal(t, "nvidia.com/gpu=3", gpuSummary(pod))
+	assert.Equal(t, "", gpuSummary(&corev1.Pod{}))
+}
+
+func TestFilterEvents(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithUID("rbg-uid").Obj()
+	now := time.Now()
+	events := []corev1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "RoleBasedGroup", Name: "test-rbg", UID: "rbg-uid"},
+			Reason:         "Second",
+			LastTimestamp:  metav1.NewTime(now),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "RoleBasedGroup", Name: "test-rbg", UID: "rbg-uid"},
+			Reason:         "First",
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "RoleBasedGroup", Name: "test-rbg", UID: "old-uid"},
+			Reason:         "Stale",
+		},
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-rbg"},
+			Reason:         "Other",
+		},
+	}
+
+	filtered := filterEvents(events, rbg)
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "First", filtered[0].Reason)
+	assert.Equal(t, "Second", filtered[1].Reason)
+}
+
+func TestRunDescribe(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithUID("rbg-uid").
+		WithCreationTimestamp(time.Now().Add(-time.Hour)).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithWorkload("apps/v1", "StatefulSet").
+				WithTemplate(&corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: "vllm:v1"}},
+				}}).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).WithDependencies([]string{"prefill"}).
+				WithWorkload("apps/v1", "Deployment").Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{
+			Conditions: []metav1.Condition{
+				{Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: metav1.ConditionTrue, Reason: "AllRolesReady"},
+			},
+			RoleStatuses: []workloadsv1alpha2.RoleStatus{
+				{Name: "prefill", ReadyReplicas: 1, Replicas: 1, UpdatedReplicas: 1},
+			},
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rbg-prefill-0",
+				Namespace: "default",
+				Labels: map[string]string{
+					constants.GroupNameLabelKey: "test-rbg",
+					constants.RoleNameLabelKey:  "prefill",
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   "node-a",
+				Containers: []corev1.Container{{Name: "main"}},
+			},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{Ready: true}},
+			},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "ev1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "RoleBasedGroup", Name: "test-rbg", UID: "rbg-uid"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Succeed",
+			Message:        "reconciled",
+		},
+	)
+
+	sts := &unstructured.Unstructured{}
+	sts.SetAPIVersion("apps/v1")
+	sts.SetKind("StatefulSet")
+	sts.SetNamespace("default")
+	sts.SetName("test-rbg-prefill")
+	_ = unstructured.SetNestedField(sts.Object, int64(1), "status", "replicas")
+	_ = unstructured.SetNestedField(sts.Object, int64(1), "status", "readyReplicas")
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), sts)
+
+	var out bytes.Buffer
+	err := runDescribe(context.TODO(), rbgClient, k8sClient, dynamicClient, "test-rbg", "default", &out)
+	assert.NoError(t, err)
+
+	output := out.String()
+	assert.Contains(t, output, "test-rbg")
+	assert.Contains(t, output, "vllm:v1")
+	assert.Contains(t, output, "StatefulSet")
+	assert.Contains(t, output, "test-rbg-decode")
+	assert.Contains(t, output, "<missing>")
+	assert.Contains(t, output, "node-a")
+	assert.Contains(t, output, "AllRolesReady")
+	assert.Contains(t, output, "reconciled")
+
+	err = runDescribe(context.TODO(), rbgClient, k8sClient, dynamicClient, "absent", "default", &out)
+	assert.Error(t, err)
+
+	origOpts := describeOpts
+	defer func() { describeOpts = origOpts }()
+
+	describeOpts = DescribeOptions{output: util.OutputOptions{Format: util.OutputJSON}}
+	out.Reset()
+	assert.NoError(t, runDescribe(context.TODO(), rbgClient, k8sClient, dynamicClient, "test-rbg", "default", &out))
+	list := &unstructured.UnstructuredList{}
+	assert.NoError(t, list.UnmarshalJSON(out.Bytes()))
+	var kinds []string
+	for _, item := range list.Items {
+		kinds = append(kinds, item.GetKind())
+	}
+	assert.Equal(t, []string{"RoleBasedGroup", "StatefulSet", "Pod", "Event"}, kinds)
+
+	describeOpts = DescribeOptions{output: util.OutputOptions{Format: util.OutputName}}
+	out.Reset()
+	assert.NoError(t, runDescribe(context.TODO(), rbgClient, k8sClient, dynamicClient, "test-rbg", "default", &out))
+	assert.Equal(t, "rolebasedgroup.workloads.x-k8s.io/test-rbg\n", out.String())
+
+	describeOpts = DescribeOptions{output: util.OutputOptions{Format: util.OutputWide, NoHeaders: true}}
+	out.Reset()
+	assert.NoError(t, runDescribe(context.TODO(), rbgClient, k8sClient, dynamicClient, "test-rbg", "default", &out))
+	assert.NotContains(t, out.String(), "RESTARTS")
+	assert.Regexp(t, `test-rbg-prefill-0\s+Running.*<none>\n`, out.String())
+}
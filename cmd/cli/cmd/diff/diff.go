@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	fieldManager = "kubectl-rbg"
+
+	colorRed   = "\033[31m"
+	colorGreen = "\033[32m"
+	colorCyan  = "\033[36m"
+	colorReset = "\033[0m"
+)
+
+type DiffOptions struct {
+	cf       *genericclioptions.ConfigFlags
+	filename string
+	color    string
+}
+
+var diffOpts DiffOptions
+
+func NewDiffCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:                "diff -f <file>",
+		Short:              "Diff a RoleBasedGroup manifest against the live cluster state",
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateDiff(); err != nil {
+				return err
+			}
+			dynamicClient, err := util.GetDefaultDynamicClient(diffOpts.cf)
+			if err != nil {
+				return err
+			}
+			in, err := util.OpenManifest(diffOpts.filename)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = in.Close() }()
+			return runDiff(context.Background(), dynamicClient, in, util.GetNamespace(diffOpts.cf), os.Stdout, useColor(diffOpts.color))
+		},
+	}
+	diffOpts.cf = cf
+	diffCmd.Flags().StringVarP(&diffOpts.filename, "filename", "f", "", "Manifest containing the RoleBasedGroup(s) to diff, use - for stdin")
+	diffCmd.Flags().StringVar(&diffOpts.color, "color", "auto", "Colorize the output: auto, always or never")
+
+	return diffCmd
+}
+
+func validateDiff() error {
+	if diffOpts.filename == "" {
+		return fmt.Errorf("-f/--filename is required")
+	}
+	switch diffOpts.color {
+	case "auto", "always", "never":
+	default:
+		return fmt.Errorf("--color must be one of auto, always, never")
+	}
+	return nil
+}
+
+func useColor(mode string) bool {
+	switch mode {
+	case "always":
+		return true
+	case "never":
+		return false
+	default:
+		return term.IsTerminal(int(os.Stdout.Fd()))
+	}
+}
+
+func runDiff(ctx context.Context, dynamicClient dynamic.Interface, in io.Reader, namespace string, out io.Writer, color bool) error {
+	objects, err := decodeRoleBasedGroups(in, namespace)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return fmt.Errorf("no RoleBasedGroup found in manifest")
+	}
+
+	for _, desired := range objects {
+		gvr := desired.GroupVersionKind().GroupVersion().WithResource("rolebasedgroups")
+		client := dynamicClient.Resource(gvr).Namespace(desired.GetNamespace())
+
+		live, err := client.Get(ctx, desired.GetName(), metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get RoleBasedGroup %s: %w", desired.GetName(), err)
+			}
+			live = nil
+		}
+
+		// Let the API server merge the manifest into the live object and run defaulting
+		// and admission, without persisting anything.
+		merged, err := client.Apply(ctx, desired.GetName(), desired, metav1.ApplyOptions{
+			FieldManager: fieldManager,
+			Force:        true,
+			DryRun:       []string{metav1.DryRunAll},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to dry-run RoleBasedGroup %s: %w", desired.GetName(), err)
+		}
+
+		if err := printDiff(out, live, merged, color); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeRoleBasedGroups reads all RoleBasedGroup documents from a YAML or JSON stream.
+// Documents of other kinds are ignored.
+func decodeRoleBasedGroups(in io.Reader, namespace string) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(in), 4096)
+	var objects []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		if gvk.Group != workloadsv1alpha2.GroupVersion.Group || gvk.Kind != "RoleBasedGroup" {
+			continue
+		}
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("RoleBasedGroup in manifest has no name")
+		}
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+func printDiff(out io.Writer, live, merged *unstructured.Unstructured, color bool) error {
+	from, err := toYAML(live)
+	if err != nil {
+		return err
+	}
+	to, err := toYAML(merged)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s/%s", merged.GetNamespace(), merged.GetName())
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: "live/" + name,
+		ToFile:   "merged/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compute diff: %w", err)
+	}
+	if text == "" {
+		_, _ = fmt.Fprintf(out, "RoleBasedGroup %s is up to date\n", name)
+		return nil
+	}
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		_, _ = fmt.Fprint(out, colorize(line, color))
+	}
+
+	if changes := workloadChanges(live, merged); len(changes) > 0 {
+		_, _ = fmt.Fprintf(out, "\nWorkloads affected in %s:\n", name)
+		for _, change := range changes {
+			_, _ = fmt.Fprintf(out, "  %s\n", change)
+		}
+	}
+	return nil
+}
+
+func colorize(line string, color bool) string {
+	if !color || line == "" {
+		return line
+	}
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"), strings.HasPrefix(line, "@@"):
+		return colorCyan + strings.TrimSuffix(line, "\n") + colorReset + "\n"
+	case strings.HasPrefix(line, "+"):
+		return colorGreen + strings.TrimSuffix(line, "\n") + colorReset + "\n"
+	case strings.HasPrefix(line, "-"):
+		return colorRed + strings.TrimSuffix(line, "\n") + colorReset + "\n"
+	}
+	return line
+}
+
+// toYAML renders the object without the server-managed fields, which would otherwise
+// show up as noise in every diff.
+func toYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	clean := obj.DeepCopy()
+	unstructured.RemoveNestedField(clean.Object, "status")
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp"} {
+		unstructured.RemoveNestedField(clean.Object, "metadata", field)
+	}
+	data, err := yaml.Marshal(clean.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", obj.GetName(), err)
+	}
+	return string(data), nil
+}
+
+// workloadChanges lists the child workloads that would be created, updated or deleted
+// when the merged spec is applied, based on which roles differ.
+func workloadChanges(live, merged *unstructured.Unstructured) []string {
+	liveRoles := rolesByName(live)
+	mergedRoles := rolesByName(merged)
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	rbg.Name = merged.GetName()
+
+	var changes []string
+	for name, role := range mergedRoles {
+		old, found := liveRoles[name]
+		switch {
+		case !found:
+			changes = append(changes, describeWorkload("create", rbg, name, role))
+		case !reflect.DeepEqual(old, role):
+			changes = append(changes, describeWorkload("update", rbg, name, role))
+		}
+	}
+	for name, role := range liveRoles {
+		if _, found := mergedRoles[name]; !found {
+			changes = append(changes, describeWorkload("delete", rbg, name, role))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+func describeWorkload(action string, rbg *workloadsv1alpha2.RoleBasedGroup, name string, role map[string]interface{}) string {
+	annotations, _, _ := unstructured.NestedStringMap(role, "annotations")
+	spec := &workloadsv1alpha2.RoleSpec{Name: name, Annotations: annotations}
+	return fmt.Sprintf("%s %s %s", action, spec.GetWorkloadSpec().Kind, rbg.GetWorkloadName(spec))
+}
+
+func rolesByName(obj *unstructured.Unstructured) map[string]map[string]interface{} {
+	result := map[string]map[string]interface{}{}
+	if obj == nil {
+		return result
+	}
+	roles, _, _ := unstructured.NestedSlice(obj.Object, "spec", "roles")
+	for _, r := range roles {
+		role, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := role["name"].(string); ok && name != "" {
+			result[name] = role
+		}
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+)
+
+const manifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ignored
+---
+apiVersion: workloads.x-k8s.io/v1alpha2
+kind: RoleBasedGroup
+metadata:
+  name: test-rbg
+spec:
+  roles:
+  - name: prefill
+    replicas: 2
+  - name: decode
+    replicas: 1
+    annotations:
+      rbg.workloads.x-k8s.io/role-workload-type: apps/v1/StatefulSet
+`
+
+const liveObject = `apiVersion: workloads.x-k8s.io/v1alpha2
+kind: RoleBasedGroup
+metadata:
+  name: test-rbg
+  namespace: default
+  resourceVersion: "7"
+  generation: 3
+spec:
+  roles:
+  - name: prefill
+    replicas: 1
+  - name: router
+    replicas: 1
+status:
+  observedGeneration: 3
+`
+
+func mustUnstructured(t *testing.T, data string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	assert.NoError(t, yaml.Unmarshal([]byte(data), &obj.Object))
+	return obj
+}
+
+func TestValidateDiff(t *testing.T) {
+	origOpts := diffOpts
+	defer func() { diffOpts = origOpts }()
+
+	diffOpts = DiffOptions{color: "auto"}
+	assert.Error(t, validateDiff())
+
+	diffOpts = DiffOptions{filename: "rbg.yaml", color: "sometimes"}
+	assert.Error(t, validateDiff())
+
+	diffOpts = DiffOptions{filename: "rbg.yaml", color: "never"}
+	assert.NoError(t, validateDiff())
+}
+
+func TestDecodeRoleBasedGroups(t *testing.T) {
+	objects, err := decodeRoleBasedGroups(strings.NewReader(manifest), "default")
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+	assert.Equal(t, "test-rbg", objects[0].GetName())
+	assert.Equal(t, "default", objects[0].GetNamespace())
+
+	_, err = decodeRoleBasedGroups(strings.NewReader("apiVersion: workloads.x-k8s.io/v1alpha2\nkind: RoleBasedGroup\n"), "default")
+	assert.Error(t, err)
+}
+
+func TestWorkloadChanges(t *testing.T) {
+	live := mustUnstructured(t, liveObject)
+	objects, err := decodeRoleBasedGroups(strings.NewReader(manifest), "default")
+	assert.NoError(t, err)
+
+	changes := workloadChanges(live, objects[0])
+	assert.Equal(t, []string{
+		"create StatefulSet test-rbg-decode",
+		"delete RoleInstanceSet test-rbg-router",
+		"update RoleInstanceSet test-rbg-prefill",
+	}, changes)
+
+	assert.Empty(t, workloadChanges(live, live))
+}
+
+func TestColorize(t *testing.T) {
+	assert.Equal(t, "+a\n", colorize("+a\n", false))
+	assert.Equal(t, colorGreen+"+a"+colorReset+"\n", colorize("+a\n", true))
+	assert.Equal(t, colorRed+"-a"+colorReset+"\n", colorize("-a\n", true))
+	assert.Equal(t, colorCyan+"@@ -1 +1 @@"+colorReset+"\n", colorize("@@ -1 +1 @@\n", true))
+	assert.Equal(t, " a\n", colorize(" a\n", true))
+}
+
+func TestRunDiff(t *testing.T) {
+	live := mustUnstructured(t, liveObject)
+	client := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), live)
+	// The fake client does not implement server-side apply, so return the manifest as the merged result.
+	client.PrependReactor("patch", "rolebasedgroups", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchActionImpl)
+		assert.Equal(t, types.ApplyPatchType, patch.GetPatchType())
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+		return true, obj, nil
+	})
+
+	var out bytes.Buffer
+	err := runDiff(context.TODO(), client, strings.NewReader(manifest), "default", &out, false)
+	assert.NoError(t, err)
+
+	output := out.String()
+	assert.Contains(t, output, "--- live/default/test-rbg")
+	assert.Contains(t, output, "+++ merged/default/test-rbg")
+	assert.Contains(t, output, "-    replicas: 1")
+	assert.Contains(t, output, "+    replicas: 2")
+	assert.NotContains(t, output, "resourceVersion")
+	assert.NotContains(t, output, "observedGeneration")
+	assert.Contains(t, output, "update RoleInstanceSet test-rbg-prefill")
+
+	out.Reset()
+	err = runDiff(context.TODO(), client, strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n"), "default", &out, false)
+	assert.EqualError(t, err, "no RoleBasedGroup found in manifest")
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	// controllerSelector matches the controller Deployment installed by the helm chart.
+	controllerSelector = "control-plane=rbgs-controller"
+
+	defaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// requiredRBGResources must be served for the controller and the CLI to work.
+var requiredRBGResources = []string{
+	"rolebasedgroups",
+	"rolebasedgroupsets",
+	"rolebasedgroupscalingadapters",
+	"roleinstancesets",
+	"roleinstances",
+	"coordinatedpolicies",
+}
+
+// gpuResourceNames are the extended resources advertised by common GPU device plugins.
+var gpuResourceNames = []string{"nvidia.com/gpu", "amd.com/gpu"}
+
+type checkStatus string
+
+const (
+	statusOK   checkStatus = "OK"
+	statusWarn checkStatus = "WARN"
+	statusFail checkStatus = "FAIL"
+)
+
+// checkResult is the outcome of a single preflight check with a hint on how to fix it.
+type checkResult struct {
+	name    string
+	status  checkStatus
+	message string
+	fix     string
+}
+
+type check struct {
+	name string
+	run  func(ctx context.Context, k8sClient kubernetes.Interface) checkResult
+}
+
+var checks = []check{
+	{"RBG CRDs", checkRBGCRDs},
+	{"RBG controller", checkController},
+	{"LeaderWorkerSet CRD", checkLWSCRD},
+	{"GPU devices", checkGPUs},
+	{"Gang scheduler", checkGangScheduler},
+	{"Default StorageClass", checkDefaultStorageClass},
+}
+
+func NewDoctorCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:                "doctor",
+		Short:              "Check that the target cluster is ready to run RoleBasedGroups",
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k8sClient, err := util.GetK8SClientSet(cf)
+			if err != nil {
+				return err
+			}
+			return runDoctor(context.Background(), k8sClient, os.Stdout)
+		},
+	}
+}
+
+func runDoctor(ctx context.Context, k8sClient kubernetes.Interface, out io.Writer) error {
+	results := make([]checkResult, 0, len(checks))
+	for _, c := range checks {
+		result := c.run(ctx, k8sClient)
+		result.name = c.name
+		results = append(results, result)
+	}
+
+	w := printers.GetNewTabWriter(out)
+	_, _ = fmt.Fprintln(w, "CHECK\tSTATUS\tMESSAGE")
+	failed := 0
+	for _, r := range results {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", r.name, r.status, r.message)
+		if r.status == statusFail {
+			failed++
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	var fixes []string
+	for _, r := range results {
+		if r.status != statusOK && r.fix != "" {
+			fixes = append(fixes, fmt.Sprintf("  - %s: %s", r.name, r.fix))
+		}
+	}
+	if len(fixes) > 0 {
+		_, _ = fmt.Fprintf(out, "\nSuggested fixes:\n%s\n", strings.Join(fixes, "\n"))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(results))
+	}
+	return nil
+}
+
+// servedResources returns the resources served for a group version, or nil if it is not served.
+func servedResources(k8sClient kubernetes.Interface, groupVersion string) (map[string]bool, error) {
+	list, err := k8sClient.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	served := make(map[string]bool, len(list.APIResources))
+	for _, r := range list.APIResources {
+		served[r.Name] = true
+	}
+	return served, nil
+}
+
+func checkRBGCRDs(_ context.Context, k8sClient kubernetes.Interface) checkResult {
+	gv := workloadsv1alpha2.GroupVersion.String()
+	served, err := servedResources(k8sClient, gv)
+	if err != nil {
+		return checkResult{status: statusFail, message: fmt.Sprintf("failed to discover %s: %v", gv, err)}
+	}
+	var missing []string
+	for _, r := range requiredRBGResources {
+		if !served[r] {
+			missing = append(missing, r)
+		}
+	}
+	if len(missing) > 0 {
+		return checkResult{
+			status:  statusFail,
+			message: fmt.Sprintf("%s does not serve %s", gv, strings.Join(missing, ", ")),
+			fix:     "install or upgrade the rbgs helm chart, which ships the CRDs: helm upgrade --install rbgs deploy/helm/rbgs",
+		}
+	}
+	return checkResult{status: statusOK, message: fmt.Sprintf("%s serves all %d resources", gv, len(requiredRBGResources))}
+}
+
+func checkController(ctx context.Context, k8sClient kubernetes.Interface) checkResult {
+	deploys, err := k8sClient.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: controllerSelector})
+	if err != nil {
+		return checkResult{status: statusFail, message: fmt.Sprintf("failed to list controller deployments: %v", err)}
+	}
+	if len(deploys.Items) == 0 {
+		return checkResult{
+			status:  statusFail,
+			message: fmt.Sprintf("no deployment labeled %s found", controllerSelector),
+			fix:     "install the controller with the rbgs helm chart",
+		}
+	}
+	d := deploys.Items[0]
+	desired := int32(1)
+	if d.Spec.Replicas != nil {
+		desired = *d.Spec.Replicas
+	}
+	message := fmt.Sprintf("%s/%s has %d/%d available replicas", d.Namespace, d.Name, d.Status.AvailableReplicas, desired)
+	fix := fmt.Sprintf("inspect the controller with: kubectl -n %s describe deployment %s && kubectl -n %s logs deployment/%s",
+		d.Namespace, d.Name, d.Namespace, d.Name)
+	switch {
+	case d.Status.AvailableReplicas == 0:
+		return checkResult{status: statusFail, message: message, fix: fix}
+	case d.Status.AvailableReplicas < desired:
+		return checkResult{status: statusWarn, message: message, fix: fix}
+	}
+	return checkResult{status: statusOK, message: message}
+}
+
+func checkLWSCRD(_ context.Context, k8sClient kubernetes.Interface) checkResult {
+	served, err := servedResources(k8sClient, "leaderworkerset.x-k8s.io/v1")
+	if err != nil {
+		return checkResult{status: statusFail, message: fmt.Sprintf("failed to discover leaderworkerset.x-k8s.io/v1: %v", err)}
+	}
+	if !served["leaderworkersets"] {
+		return checkResult{
+			status:  statusWarn,
+			message: "leaderworkersets.leaderworkerset.x-k8s.io is not installed, roles cannot use the LeaderWorkerSet workload",
+			fix:     "install LeaderWorkerSet from https://github.com/kubernetes-sigs/lws if roles need it",
+		}
+	}
+	return checkResult{status: statusOK, message: "leaderworkersets.leaderworkerset.x-k8s.io is installed"}
+}
+
+func checkGPUs(ctx context.Context, k8sClient kubernetes.Interface) checkResult {
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return checkResult{status: statusFail, message: fmt.Sprintf("failed to list nodes: %v", err)}
+	}
+	totals := map[string]int64{}
+	gpuNodes := 0
+	for _, node := range nodes.Items {
+		found := false
+		for _, name := range gpuResourceNames {
+			if q, ok := node.Status.Allocatable[corev1.ResourceName(name)]; ok && !q.IsZero() {
+				totals[name] += q.Value()
+				found = true
+			}
+		}
+		if found {
+			gpuNodes++
+		}
+	}
+	if gpuNodes == 0 {
+		return checkResult{
+			status:  statusWarn,
+			message: fmt.Sprintf("none of %d nodes advertises %s", len(nodes.Items), strings.Join(gpuResourceNames, " or ")),
+			fix:     "install the GPU driver and device plugin on GPU nodes, e.g. the NVIDIA GPU Operator",
+		}
+	}
+	parts := make([]string, 0, len(totals))
+	for name, total := range totals {
+		parts = append(parts, fmt.Sprintf("%d %s", total, name))
+	}
+	sort.Strings(parts)
+	return checkResult{status: statusOK, message: fmt.Sprintf("%s allocatable on %d nodes", strings.Join(parts, ", "), gpuNodes)}
+}
+
+func checkGangScheduler(_ context.Context, k8sClient kubernetes.Interface) checkResult {
+	for _, gv := range []string{"scheduling.x-k8s.io/v1alpha1", "scheduling.volcano.sh/v1beta1"} {
+		served, err := servedResources(k8sClient, gv)
+		if err != nil {
+			return checkResult{status: statusFail, message: fmt.Sprintf("failed to discover %s: %v", gv, err)}
+		}
+		if served["podgroups"] {
+			return checkResult{status: statusOK, message: fmt.Sprintf("podgroups.%s is installed", strings.Split(gv, "/")[0])}
+		}
+	}
+	return checkResult{
+		status:  statusWarn,
+		message: "no PodGroup CRD found, gang scheduling is unavailable",
+		fix:     "install scheduler-plugins (coscheduling) or Volcano and start the controller with the matching --scheduler-name",
+	}
+}
+
+func checkDefaultStorageClass(ctx context.Context, k8sClient kubernetes.Interface) checkResult {
+	classes, err := k8sClient.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return checkResult{status: statusFail, message: fmt.Sprintf("failed to list storage classes: %v", err)}
+	}
+	for _, sc := range classes.Items {
+		if sc.Annotations[defaultStorageClassAnnotation] == "true" || sc.Annotations[betaDefaultStorageClassAnnotation] == "true" {
+			return checkResult{status: statusOK, message: fmt.Sprintf("%s is the default StorageClass", sc.Name)}
+		}
+	}
+	return checkResult{
+		status:  statusWarn,
+		message: fmt.Sprintf("none of %d storage classes is marked as default", len(classes.Items)),
+		fix: fmt.Sprintf("mark one as default: kubectl annotate storageclass <name> %s=true, "+
+			"or set storageClassName on every volume claim", defaultStorageClassAnnotation),
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func apiResources(groupVersion string, names ...string) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, name := range names {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: name})
+	}
+	return list
+}
+
+func TestRunDoctorHealthyCluster(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rbgs-controller-manager",
+				Namespace: "rbgs-system",
+				Labels:    map[string]string{"control-plane": "rbgs-controller"},
+			},
+			Spec:   appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+			Status: appsv1.DeploymentStatus{AvailableReplicas: 1},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-node"},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("8"),
+			}},
+		},
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{
+			Name:        "standard",
+			Annotations: map[string]string{defaultStorageClassAnnotation: "true"},
+		}},
+	)
+	client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		apiResources("workloads.x-k8s.io/v1alpha2", requiredRBGResources...),
+		apiResources("leaderworkerset.x-k8s.io/v1", "leaderworkersets"),
+		apiResources("scheduling.volcano.sh/v1beta1", "podgroups", "queues"),
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, runDoctor(context.TODO(), client, &out))
+	assert.Contains(t, out.String(), "rbgs-system/rbgs-controller-manager has 1/1 available replicas")
+	assert.Contains(t, out.String(), "8 nvidia.com/gpu allocatable on 1 nodes")
+	assert.Contains(t, out.String(), "podgroups.scheduling.volcano.sh is installed")
+	assert.Contains(t, out.String(), "standard is the default StorageClass")
+	assert.NotContains(t, out.String(), "Suggested fixes")
+}
+
+func TestRunDoctorEmptyCluster(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		apiResources("workloads.x-k8s.io/v1alpha2", "rolebasedgroups"),
+	}
+
+	var out bytes.Buffer
+	err := runDoctor(context.TODO(), client, &out)
+	assert.EqualError(t, err, "2 of 6 checks failed")
+	assert.Regexp(t, `RBG CRDs\s+FAIL\s+workloads.x-k8s.io/v1alpha2 does not serve rolebasedgroupsets`, out.String())
+	assert.Regexp(t, `RBG controller\s+FAIL`, out.String())
+	assert.Regexp(t, `LeaderWorkerSet CRD\s+WARN`, out.String())
+	assert.Regexp(t, `GPU devices\s+WARN`, out.String())
+	assert.Regexp(t, `Gang scheduler\s+WARN`, out.String())
+	assert.Regexp(t, `Default StorageClass\s+WARN`, out.String())
+	assert.Contains(t, out.String(), "Suggested fixes")
+	assert.Contains(t, out.String(), "kubectl annotate storageclass")
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drainrole
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultRouterRole  = "router"
+	defaultPort        = "8000"
+	defaultWaitTimeout = 10 * time.Minute
+)
+
+type DrainRoleOptions struct {
+	cf         *genericclioptions.ConfigFlags
+	role       string
+	routerRole string
+	routerPort string
+	port       string
+	replicas   int32
+	timeout    time.Duration
+}
+
+var drainRoleOpts DrainRoleOptions
+
+// podForwarder exposes a port of a pod locally and returns its base URL; replaced in tests.
+var podForwarder = util.ForwardLocalEndpoint
+
+// drainPollInterval is how often the in-flight requests are checked; overridden in tests.
+var drainPollInterval = 2 * time.Second
+
+func NewDrainRoleCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	drainRoleCmd := &cobra.Command{
+		Use:   "drain-role <rbgName> --role <roleName>",
+		Short: "Gracefully drain a role of a rbg and scale it down",
+		Long: `Gracefully drain a role of a rbg and scale it down.
+
+The role pods are first removed from the SGLang router of the rbg through its
+remove_worker API, so that no new request reaches them. The command then waits until the
+engines report no running or queued requests in their Prometheus metrics, SGLang and
+vLLM metric names are understood, and finally scales the role to --replicas.
+
+Skip the router step with --router-role="" when the role does not sit behind a router,
+for instance when the traffic is already moved away. The drain fails, without scaling,
+when the requests do not complete within the timeout.`,
+		Example: `  # Drain the decode role before node maintenance
+  kubectl rbg drain-role my-rbg --role decode
+
+  # Keep one replica and wait up to half an hour for long generations
+  kubectl rbg drain-role my-rbg --role decode --replicas 1 --timeout 30m`,
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateDrainRole(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(drainRoleOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(drainRoleOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(drainRoleOpts.cf)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			return runDrainRole(ctx, rbgClient, k8sClient, config, args[0], util.GetNamespace(drainRoleOpts.cf), os.Stdout)
+		},
+	}
+	drainRoleOpts.cf = cf
+	drainRoleCmd.Flags().StringVar(&drainRoleOpts.role, "role", "", "Name of the role to drain")
+	drainRoleCmd.Flags().StringVar(&drainRoleOpts.routerRole, "router-role", defaultRouterRole,
+		"Role running the SGLang router the workers are removed from, empty to skip")
+	drainRoleCmd.Flags().StringVar(&drainRoleOpts.routerPort, "router-port", defaultPort, "Port number or name of the router API")
+	drainRoleCmd.Flags().StringVar(&drainRoleOpts.port, "port", defaultPort,
+		"Port number or name serving the engine metrics in the role pods")
+	drainRoleCmd.Flags().Int32Var(&drainRoleOpts.replicas, "replicas", 0, "Replicas of the role once drained")
+	drainRoleCmd.Flags().DurationVar(&drainRoleOpts.timeout, "timeout", defaultWaitTimeout,
+		"The length of time to wait for the in-flight requests to complete")
+
+	return drainRoleCmd
+}
+
+func validateDrainRole(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if drainRoleOpts.role == "" {
+		return fmt.Errorf("--role is required")
+	}
+	if drainRoleOpts.role == drainRoleOpts.routerRole {
+		return fmt.Errorf("cannot drain the router role %s through itself, use --router-role=\"\"", drainRoleOpts.role)
+	}
+	if drainRoleOpts.replicas < 0 {
+		return fmt.Errorf("--replicas cannot be negative")
+	}
+	if drainRoleOpts.timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	return nil
+}
+
+func runDrainRole(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	if _, err := rbg.GetRole(drainRoleOpts.role); err != nil {
+		return err
+	}
+	pods, err := util.ListRolePods(ctx, k8sClient, namespace, name, drainRoleOpts.role)
+	if err != nil {
+		return err
+	}
+	var running []corev1.Pod
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodRunning && pods[i].DeletionTimestamp == nil {
+			running = append(running, pods[i])
+		}
+	}
+
+	// Port-forwards live until the drain is over.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	if drainRoleOpts.routerRole != "" {
+		if err := cordon(ctx, k8sClient, config, client, name, namespace, running, out); err != nil {
+			return err
+		}
+	}
+	if len(running) > 0 {
+		if err := waitForDrain(ctx, k8sClient, config, client, running, out); err != nil {
+			return err
+		}
+	}
+
+	if err := util.ScaleRole(ctx, rbgClient, name, namespace, drainRoleOpts.role, drainRoleOpts.replicas); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "rbg %s role %s drained and scaled to %d\n", name, drainRoleOpts.role, drainRoleOpts.replicas)
+	return nil
+}
+
+// cordon removes the workers backed by the role pods from the router.
+func cordon(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	client *http.Client,
+	name, namespace string,
+	pods []corev1.Pod,
+	out io.Writer,
+) error {
+	router, err := util.ReadyRolePod(ctx, k8sClient, namespace, name, drainRoleOpts.routerRole)
+	if err != nil {
+		return fmt.Errorf("failed to find the router: %w", err)
+	}
+	baseURL, err := podForwarder(ctx, k8sClient, config, router, drainRoleOpts.routerPort)
+	if err != nil {
+		return err
+	}
+	workers, err := listWorkers(ctx, client, baseURL)
+	if err != nil {
+		return err
+	}
+
+	removed := 0
+	for _, worker := range workers {
+		pod := workerPod(worker, pods)
+		if pod == nil {
+			continue
+		}
+		if err := removeWorker(ctx, client, baseURL, worker); err != nil {
+			return err
+		}
+		removed++
+		_, _ = fmt.Fprintf(out, "Removed worker %s of pod %s from router %s\n", worker, pod.Name, router.Name)
+	}
+	if removed == 0 {
+		_, _ = fmt.Fprintf(out, "Warning: no worker of router %s matches the pods of role %s\n", router.Name, drainRoleOpts.role)
+	}
+	return nil
+}
+
+// waitForDrain polls the engine metrics of the pods until none has requests in flight.
+func waitForDrain(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	client *http.Client,
+	pods []corev1.Pod,
+	out io.Writer,
+) error {
+	endpoints := map[string]string{}
+	for i := range pods {
+		baseURL, err := podForwarder(ctx, k8sClient, config, &pods[i], drainRoleOpts.port)
+		if err != nil {
+			return err
+		}
+		endpoints[pods[i].Name] = baseURL
+	}
+
+	last := int64(-1)
+	var lastErr error
+	err := wait.PollUntilContextTimeout(ctx, drainPollInterval, drainRoleOpts.timeout, true,
+		func(ctx context.Context) (bool, error) {
+			var total int64
+			for i := range pods {
+				inflight, err := fetchInflight(ctx, client, endpoints[pods[i].Name])
+				if err != nil {
+					// The engine may be busy, retry until the timeout.
+					lastErr = fmt.Errorf("pod %s: %w", pods[i].Name, err)
+					return false, nil
+				}
+				total += inflight
+			}
+			lastErr = nil
+			if total != last {
+				_, _ = fmt.Fprintf(out, "%d requests in flight on role %s\n", total, drainRoleOpts.role)
+				last = total
+			}
+			return total == 0, nil
+		})
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("failed waiting for role %s to drain, the role was not scaled: %w", drainRoleOpts.role, lastErr)
+		}
+		return fmt.Errorf("failed waiting for role %s to drain, the role was not scaled: %w", drainRoleOpts.role, err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drainrole
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestValidateDrainRole(t *testing.T) {
+	origOpts := drainRoleOpts
+	defer func() { drainRoleOpts = origOpts }()
+
+	drainRoleOpts = DrainRoleOptions{role: "decode", routerRole: "router", timeout: time.Minute}
+	assert.NoError(t, validateDrainRole([]string{"llm"}))
+	assert.EqualError(t, validateDrainRole([]string{""}), "rbg name is required")
+
+	drainRoleOpts = DrainRoleOptions{routerRole: "router", timeout: time.Minute}
+	assert.EqualError(t, validateDrainRole([]string{"llm"}), "--role is required")
+
+	drainRoleOpts = DrainRoleOptions{role: "router", routerRole: "router", timeout: time.Minute}
+	assert.EqualError(t, validateDrainRole([]string{"llm"}), `cannot drain the router role router through itself, use --router-role=""`)
+
+	drainRoleOpts = DrainRoleOptions{role: "decode", replicas: -1, timeout: time.Minute}
+	assert.EqualError(t, validateDrainRole([]string{"llm"}), "--replicas cannot be negative")
+}
+
+func TestRunDrainRole(t *testing.T) {
+	origOpts := drainRoleOpts
+	origForwarder := podForwarder
+	origInterval := drainPollInterval
+	defer func() {
+		drainRoleOpts = origOpts
+		podForwarder = origForwarder
+		drainPollInterval = origInterval
+	}()
+	drainPollInterval = 10 * time.Millisecond
+
+	var removed []string
+	router := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/list_workers" {
+			_, _ = w.Write([]byte(`{"urls":["http://10.0.0.1:8000","http://10.0.0.2:8000","http://10.0.0.9:8000"]}`))
+			return
+		}
+		removed = append(removed, r.URL.Query().Get("url"))
+	}))
+	defer router.Close()
+	// The engine finishes one request per scrape.
+	var inflight atomic.Int64
+	engine := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := inflight.Load()
+		if value > 0 {
+			inflight.Add(-1)
+		}
+		_, _ = fmt.Fprintf(w, "sglang:num_running_reqs{tp_rank=\"0\"} %d\nsglang:num_queue_reqs{tp_rank=\"0\"} 0\n", value)
+	}))
+	defer engine.Close()
+
+	var forwarded []string
+	podForwarder = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, pod *corev1.Pod, port string) (string, error) {
+		forwarded = append(forwarded, pod.Name+":"+port)
+		if pod.Labels[constants.RoleNameLabelKey] == "router" {
+			return router.URL, nil
+		}
+		return engine.URL, nil
+	}
+
+	k8sClient := fake.NewSimpleClientset(
+		wrappersv2.BuildBasicPod().WithName("llm-router-0").WithNamespace("default").WithRole("llm", "router").
+			WithReadyCondition(true).WithPodIP("10.0.0.9").Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-0").WithNamespace("default").WithRole("llm", "decode").
+			WithReadyCondition(true).WithPodIP("10.0.0.1").Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-1").WithNamespace("default").WithRole("llm", "decode").
+			WithReadyCondition(true).WithPodIP("10.0.0.2").Obj(),
+	)
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+	drainRoleOpts = DrainRoleOptions{role: "decode", routerRole: "router", routerPort: "8000", port: "http", timeout: time.Second}
+	inflight.Store(3)
+
+	var out bytes.Buffer
+	require.NoError(t, runDrainRole(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out))
+	assert.Equal(t, []string{"http://10.0.0.1:8000", "http://10.0.0.2:8000"}, removed)
+	assert.Equal(t, []string{"llm-router-0:8000", "llm-decode-0:http", "llm-decode-1:http"}, forwarded)
+	assert.Contains(t, out.String(), "Removed worker http://10.0.0.1:8000 of pod llm-decode-0 from router llm-router-0")
+	assert.Contains(t, out.String(), "0 requests in flight on role decode")
+	assert.Contains(t, out.String(), "rbg llm role decode drained and scaled to 0")
+
+	drained, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "llm", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), *drained.Spec.Roles[1].Replicas)
+
+	// Requests that never complete fail the drain and leave the role alone.
+	rbgClient = fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+	drainRoleOpts = DrainRoleOptions{role: "decode", port: "http", replicas: 1, timeout: 50 * time.Millisecond}
+	inflight.Store(1000)
+	out.Reset()
+	err = runDrainRole(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out)
+	assert.ErrorContains(t, err, "failed waiting for role decode to drain, the role was not scaled")
+	assert.NotContains(t, out.String(), "Removed worker")
+	drained, err = rbgClient.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "llm", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), *drained.Spec.Roles[1].Replicas)
+
+	drainRoleOpts = DrainRoleOptions{role: "prefill", timeout: time.Second}
+	err = runDrainRole(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out)
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drainrole
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// inflightMetrics are the gauges of queued and running requests exposed on /metrics by the
+// supported engines, summed to get the requests a pod still has to serve.
+var inflightMetrics = map[string]bool{
+	"sglang:num_running_reqs":   true,
+	"sglang:num_queue_reqs":     true,
+	"vllm:num_requests_running": true,
+	"vllm:num_requests_waiting": true,
+}
+
+// fetchInflight scrapes the Prometheus metrics of an engine and returns its in-flight requests.
+func fetchInflight(ctx context.Context, client *http.Client, baseURL string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/metrics", nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to scrape metrics: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to scrape metrics: %s", resp.Status)
+	}
+	return parseInflight(resp.Body)
+}
+
+func parseInflight(r io.Reader) (int64, error) {
+	var total float64
+	found := false
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name := line
+		if i := strings.IndexAny(line, "{ "); i >= 0 {
+			name = line[:i]
+		}
+		if !inflightMetrics[name] {
+			continue
+		}
+		rest := line[len(name):]
+		if strings.HasPrefix(rest, "{") {
+			rest = rest[strings.LastIndex(rest, "}")+1:]
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value of metric %s: %w", name, err)
+		}
+		total += value
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read metrics: %w", err)
+	}
+	if !found {
+		return 0, fmt.Errorf("no in-flight request metrics found, is the engine started with metrics enabled?")
+	}
+	return int64(total), nil
+}
+
+// listWorkers returns the worker URLs registered in the SGLang router.
+func listWorkers(ctx context.Context, client *http.Client, baseURL string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/list_workers", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list router workers: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list router workers: %s", resp.Status)
+	}
+	var workers struct {
+		URLs []string `json:"urls"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&workers); err != nil {
+		return nil, fmt.Errorf("failed to decode router workers: %w", err)
+	}
+	return workers.URLs, nil
+}
+
+// removeWorker deregisters a worker from the SGLang router, which stops sending it new requests.
+func removeWorker(ctx context.Context, client *http.Client, baseURL, workerURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		baseURL+"/remove_worker?url="+url.QueryEscape(workerURL), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to remove worker %s: %w", workerURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to remove worker %s: %s %s", workerURL, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// workerPod returns the pod a router worker URL points to. Workers are registered either by
+// pod IP or by the DNS name of the pod, <hostname>.<subdomain>..., or <pod-name>.<...>.
+func workerPod(workerURL string, pods []corev1.Pod) *corev1.Pod {
+	u, err := url.Parse(workerURL)
+	if err != nil {
+		return nil
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		for i := range pods {
+			if pods[i].Status.PodIP == host {
+				return &pods[i]
+			}
+		}
+		return nil
+	}
+	label, _, _ := strings.Cut(host, ".")
+	for i := range pods {
+		if label == pods[i].Name || (pods[i].Spec.Hostname != "" && label == pods[i].Spec.Hostname) {
+			return &pods[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drainrole
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseInflight(t *testing.T) {
+	sglang := `# HELP sglang:num_running_reqs The number of running requests.
+# TYPE sglang:num_running_reqs gauge
+sglang:num_running_reqs{model_name="qwen",tp_rank="0"} 3.0
+sglang:num_queue_reqs{model_name="qwen",tp_rank="0"} 2.0
+sglang:num_used_tokens{model_name="qwen",tp_rank="0"} 4096.0
+`
+	inflight, err := parseInflight(strings.NewReader(sglang))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), inflight)
+
+	vllm := "vllm:num_requests_running 1\nvllm:num_requests_waiting 0 1700000000\n"
+	inflight, err = parseInflight(strings.NewReader(vllm))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), inflight)
+
+	_, err = parseInflight(strings.NewReader("process_cpu_seconds_total 12\n"))
+	assert.ErrorContains(t, err, "no in-flight request metrics found")
+	_, err = parseInflight(strings.NewReader("vllm:num_requests_running many\n"))
+	assert.ErrorContains(t, err, "invalid value of metric vllm:num_requests_running")
+}
+
+func TestWorkerPod(t *testing.T) {
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "llm-decode-0"},
+			Status:     corev1.PodStatus{PodIP: "10.0.0.5"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "llm-decode-7d9f-abcde"},
+			Spec:       corev1.PodSpec{Hostname: "llm-decode-1"},
+			Status:     corev1.PodStatus{PodIP: "10.0.0.6"},
+		},
+	}
+	cases := map[string]string{
+		"http://10.0.0.5:8000":                    "llm-decode-0",
+		"http://llm-decode-0.s-llm-decode:8000":   "llm-decode-0",
+		"http://llm-decode-1.s-llm-decode:8000":   "llm-decode-7d9f-abcde",
+		"http://10.0.0.7:8000":                    "",
+		"http://llm-prefill-0.s-llm-prefill:8000": "",
+	}
+	for workerURL, want := range cases {
+		pod := workerPod(workerURL, pods)
+		if want == "" {
+			assert.Nil(t, pod, workerURL)
+			continue
+		}
+		require.NotNil(t, pod, workerURL)
+		assert.Equal(t, want, pod.Name, workerURL)
+	}
+}
+
+func TestRouterAPI(t *testing.T) {
+	var removed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/list_workers":
+			_, _ = w.Write([]byte(`{"urls":["http://10.0.0.5:8000","http://10.0.0.9:8000"]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/remove_worker":
+			if r.URL.Query().Get("url") == "http://unknown:8000" {
+				http.Error(w, "worker not found", http.StatusNotFound)
+				return
+			}
+			removed = append(removed, r.URL.Query().Get("url"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	workers, err := listWorkers(context.TODO(), server.Client(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://10.0.0.5:8000", "http://10.0.0.9:8000"}, workers)
+
+	require.NoError(t, removeWorker(context.TODO(), server.Client(), server.URL, "http://10.0.0.5:8000"))
+	assert.Equal(t, []string{"http://10.0.0.5:8000"}, removed)
+	err = removeWorker(context.TODO(), server.Client(), server.URL, "http://unknown:8000")
+	assert.EqualError(t, err, "failed to remove worker http://unknown:8000: 404 Not Found worker not found")
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type EventsOptions struct {
+	cf     *genericclioptions.ConfigFlags
+	role   string
+	watch  bool
+	output util.OutputOptions
+}
+
+var eventsOpts EventsOptions
+
+func NewEventsCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	eventsCmd := &cobra.Command{
+		Use:   "events <rbgName>",
+		Short: "List events of a rbg, its role workloads and pods, tagged by role",
+		Example: `  kubectl rbg events my-rbg
+  kubectl rbg events my-rbg --role decode -w`,
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := eventsOpts.output.Validate(); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(eventsOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(eventsOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runEvents(context.Background(), rbgClient, k8sClient, args[0], util.GetNamespace(eventsOpts.cf), os.Stdout)
+		},
+	}
+	eventsOpts.cf = cf
+	eventsCmd.Flags().StringVar(&eventsOpts.role, "role", "", "Only show events of the given role")
+	eventsCmd.Flags().BoolVarP(&eventsOpts.watch, "watch", "w", false, "After listing the events, watch for new ones")
+	eventsOpts.output.AddOutputFlags(eventsCmd, util.OutputJSON, util.OutputYAML, util.OutputWide, util.OutputName)
+
+	return eventsCmd
+}
+
+// taggedEvent is an event of the rbg together with the role it belongs to.
+type taggedEvent struct {
+	role  string
+	event *corev1.Event
+}
+
+func runEvents(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	if eventsOpts.role != "" {
+		if _, err := rbg.GetRole(eventsOpts.role); err != nil {
+			return err
+		}
+	}
+	pods, err := util.ListRolePods(ctx, k8sClient, namespace, name, "")
+	if err != nil {
+		return err
+	}
+	index := util.NewEventRoleIndex(rbg, pods)
+
+	// Events cannot be selected by owner, so list the namespace and match locally.
+	list, err := k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list events: %w", err)
+	}
+	var events []taggedEvent
+	for i := range list.Items {
+		if role, ok := matchEvent(index, &list.Items[i]); ok {
+			events = append(events, taggedEvent{role: role, event: &list.Items[i]})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return util.EventTime(*events[i].event).Time.Before(util.EventTime(*events[j].event).Time)
+	})
+
+	if eventsOpts.output.IsStructured() && !eventsOpts.watch {
+		items := make([]corev1.Event, 0, len(events))
+		for _, e := range events {
+			e.event.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Event"))
+			items = append(items, *e.event)
+		}
+		return eventsOpts.output.PrintObject(out, &corev1.EventList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "List"},
+			Items:    items,
+		})
+	}
+	if len(events) == 0 && !eventsOpts.watch && eventsOpts.output.Format != util.OutputName {
+		_, _ = fmt.Fprintf(out, "No events found for rbg %s\n", name)
+		return nil
+	}
+	w := printers.GetNewTabWriter(out)
+	if isTable() {
+		header := "LAST SEEN\tROLE\tTYPE\tREASON\tOBJECT\tMESSAGE"
+		if eventsOpts.output.Format == util.OutputWide {
+			header += "\tCOUNT\tSOURCE"
+		}
+		eventsOpts.output.PrintHeader(w, header)
+	}
+	for _, e := range events {
+		printEvent(w, e.role, e.event)
+	}
+	_ = w.Flush()
+
+	if !eventsOpts.watch {
+		return nil
+	}
+	watcher, err := k8sClient.CoreV1().Events(namespace).Watch(ctx, metav1.ListOptions{ResourceVersion: list.ResourceVersion})
+	if err != nil {
+		return fmt.Errorf("failed to watch events: %w", err)
+	}
+	defer watcher.Stop()
+	return streamEvents(ctx, watcher.ResultChan(), index, w)
+}
+
+// flushWriter is the tab writer the events are printed with.
+type flushWriter interface {
+	io.Writer
+	Flush() error
+}
+
+// streamEvents prints matching events as they arrive until the watch ends.
+func streamEvents(ctx context.Context, ch <-chan watch.Event, index *util.EventRoleIndex, w flushWriter) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if e.Type != watch.Added && e.Type != watch.Modified {
+				continue
+			}
+			event, ok := e.Object.(*corev1.Event)
+			if !ok {
+				continue
+			}
+			if role, ok := matchEvent(index, event); ok {
+				printEvent(w, role, event)
+				_ = w.Flush()
+			}
+		}
+	}
+}
+
+// matchEvent returns the role of an event of the rbg, honoring --role.
+func matchEvent(index *util.EventRoleIndex, event *corev1.Event) (string, bool) {
+	role, ok := index.RoleOf(event)
+	if !ok {
+		return "", false
+	}
+	if eventsOpts.role != "" && role != eventsOpts.role {
+		return "", false
+	}
+	return role, true
+}
+
+func isTable() bool {
+	return !eventsOpts.output.IsStructured() && eventsOpts.output.Format != util.OutputName
+}
+
+// printEvent prints a single event in the output format. In watch mode structured output is
+// a stream of objects, YAML documents being separated by "---".
+func printEvent(w io.Writer, role string, event *corev1.Event) {
+	switch eventsOpts.output.Format {
+	case util.OutputName:
+		_, _ = fmt.Fprintf(w, "event/%s\n", event.Name)
+		return
+	case util.OutputJSON, util.OutputYAML:
+		event.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Event"))
+		if eventsOpts.output.Format == util.OutputYAML {
+			_, _ = fmt.Fprintln(w, "---")
+		}
+		_ = eventsOpts.output.PrintObject(w, event)
+		return
+	}
+
+	obj := event.InvolvedObject
+	if role == "" {
+		// Events of the rbg itself and of objects shared by all roles.
+		role = "-"
+	}
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s/%s\t%s", age(util.EventTime(*event)), role,
+		event.Type, event.Reason, strings.ToLower(obj.Kind), obj.Name, strings.TrimSpace(event.Message))
+	if eventsOpts.output.Format == util.OutputWide {
+		_, _ = fmt.Fprintf(w, "\t%d\t%s", event.Count, orNone(eventSource(event)))
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+// eventSource returns the component that reported the event, for both the core and the
+// events.k8s.io flavours of events.
+func eventSource(event *corev1.Event) string {
+	if event.Source.Component != "" {
+		return event.Source.Component
+	}
+	return event.ReportingController
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
+
+func age(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(time.Since(t.Time))
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+func newTestEvent(name, kind, object, reason string, seen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Message:        reason + " message",
+		LastTimestamp:  metav1.NewTime(seen),
+	}
+}
+
+func TestRunEvents(t *testing.T) {
+	origOpts := eventsOpts
+	defer func() { eventsOpts = origOpts }()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "StatefulSet").Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode-large").WithWorkload("leaderworkerset.x-k8s.io/v1", "LeaderWorkerSet").Obj(),
+		}).Obj()
+
+	now := time.Now()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	k8sClient := fake.NewSimpleClientset(
+		newTestEvent("e1", "Pod", "test-rbg-decode-0", "BackOff", now),
+		newTestEvent("e2", "RoleBasedGroup", "test-rbg", "Succeed", now.Add(-time.Hour)),
+		newTestEvent("e3", "Pod", "test-rbg-decode-large-0", "FailedScheduling", now.Add(-time.Minute)),
+		newTestEvent("e4", "Pod", "unrelated-0", "Unrelated", now),
+	)
+
+	eventsOpts = EventsOptions{}
+	var out bytes.Buffer
+	require.NoError(t, runEvents(context.TODO(), rbgClient, k8sClient, "test-rbg", "default", &out))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[0], "LAST SEEN")
+	assert.Contains(t, lines[1], "Succeed")
+	assert.Contains(t, lines[2], "decode-large")
+	assert.Contains(t, lines[2], "pod/test-rbg-decode-large-0")
+	assert.Contains(t, lines[3], "BackOff")
+	assert.NotContains(t, out.String(), "Unrelated")
+
+	eventsOpts = EventsOptions{role: "decode"}
+	out.Reset()
+	require.NoError(t, runEvents(context.TODO(), rbgClient, k8sClient, "test-rbg", "default", &out))
+	assert.Contains(t, out.String(), "BackOff")
+	assert.NotContains(t, out.String(), "FailedScheduling")
+	assert.NotContains(t, out.String(), "Succeed")
+
+	eventsOpts = EventsOptions{role: "prefill"}
+	assert.Error(t, runEvents(context.TODO(), rbgClient, k8sClient, "test-rbg", "default", &out))
+
+	eventsOpts = EventsOptions{}
+	assert.Error(t, runEvents(context.TODO(), rbgClient, k8sClient, "absent", "default", &out))
+}
+
+func TestStreamEvents(t *testing.T) {
+	origOpts := eventsOpts
+	defer func() { eventsOpts = origOpts }()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "StatefulSet").Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode-large").WithWorkload("leaderworkerset.x-k8s.io/v1", "LeaderWorkerSet").Obj(),
+		}).Obj()
+	eventsOpts = EventsOptions{}
+
+	watcher := watch.NewFake()
+	go func() {
+		watcher.Add(newTestEvent("e1", "Pod", "test-rbg-decode-1", "Created", time.Now()))
+		watcher.Add(newTestEvent("e2", "Pod", "unrelated-0", "Unrelated", time.Now()))
+		watcher.Delete(newTestEvent("e3", "Pod", "test-rbg-decode-1", "Deleted", time.Now()))
+		watcher.Stop()
+	}()
+
+	var out bytes.Buffer
+	w := printers.GetNewTabWriter(&out)
+	require.NoError(t, streamEvents(context.TODO(), watcher.ResultChan(), util.NewEventRoleIndex(rbg, nil), w))
+	assert.Contains(t, out.String(), "Created")
+	assert.NotContains(t, out.String(), "Unrelated")
+	assert.NotContains(t, out.String(), "Deleted")
+}
+
+func TestRunEventsOutput(t *testing.T) {
+	origOpts := eventsOpts
+	defer func() { eventsOpts = origOpts }()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "StatefulSet").Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode-large").WithWorkload("leaderworkerset.x-k8s.io/v1", "LeaderWorkerSet").Obj(),
+		}).Obj()
+
+	now := time.Now()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	backOff := newTestEvent("e1", "Pod", "test-rbg-decode-0", "BackOff", now)
+	backOff.Count = 3
+	backOff.Source.Component = "kubelet"
+	k8sClient := fake.NewSimpleClientset(
+		backOff,
+		newTestEvent("e2", "RoleBasedGroup", "test-rbg", "Succeed", now.Add(-time.Hour)),
+	)
+	run := func(output util.OutputOptions) string {
+		eventsOpts = EventsOptions{output: output}
+		var out bytes.Buffer
+		require.NoError(t, runEvents(context.TODO(), rbgClient, k8sClient, "test-rbg", "default", &out))
+		return out.String()
+	}
+
+	list := &corev1.EventList{}
+	require.NoError(t, json.Unmarshal([]byte(run(util.OutputOptions{Format: util.OutputJSON})), list))
+	assert.Equal(t, "List", list.Kind)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "Event", list.Items[0].Kind)
+	assert.Equal(t, "Succeed", list.Items[0].Reason)
+
+	assert.Equal(t, "event/e2\nevent/e1\n", run(util.OutputOptions{Format: util.OutputName}))
+
+	output := run(util.OutputOptions{Format: util.OutputWide, NoHeaders: true})
+	assert.NotContains(t, output, "LAST SEEN")
+	assert.Regexp(t, `BackOff message\s+3\s+kubelet`, output)
+	assert.Regexp(t, `Succeed message\s+0\s+<none>`, output)
+}
+
+func TestStreamEventsYAML(t *testing.T) {
+	origOpts := eventsOpts
+	defer func() { eventsOpts = origOpts }()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "StatefulSet").Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode-large").WithWorkload("leaderworkerset.x-k8s.io/v1", "LeaderWorkerSet").Obj(),
+		}).Obj()
+	eventsOpts = EventsOptions{output: util.OutputOptions{Format: util.OutputYAML}}
+
+	watcher := watch.NewFake()
+	go func() {
+		watcher.Add(newTestEvent("e1", "Pod", "test-rbg-decode-1", "Created", time.Now()))
+		watcher.Add(newTestEvent("e2", "Pod", "test-rbg-decode-1", "Started", time.Now()))
+		watcher.Stop()
+	}()
+
+	var out bytes.Buffer
+	w := printers.GetNewTabWriter(&out)
+	require.NoError(t, streamEvents(context.TODO(), watcher.ResultChan(), util.NewEventRoleIndex(rbg, nil), w))
+	docs := strings.Split(strings.TrimPrefix(out.String(), "---\n"), "---\n")
+	require.Len(t, docs, 2)
+	event := &corev1.Event{}
+	require.NoError(t, yaml.Unmarshal([]byte(docs[1]), event))
+	assert.Equal(t, "Started", event.Reason)
+	assert.Equal(t, "Event", event.Kind)
+}
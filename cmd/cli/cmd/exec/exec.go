@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type ExecOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	role      string
+	index     int
+	container string
+	stdin     bool
+	tty       bool
+}
+
+var execOpts ExecOptions
+
+// execRequest describes a command to run in a resolved pod.
+type execRequest struct {
+	pod       *corev1.Pod
+	container string
+	command   []string
+	stdin     bool
+	tty       bool
+}
+
+// podExecutor runs the request against the API server; replaced in tests.
+var podExecutor = streamExec
+
+func NewExecCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	execCmd := &cobra.Command{
+		Use:                "exec <rbgName> --role <roleName> [--index N] -- <command> [args...]",
+		Short:              "Execute a command in a pod of a rbg role",
+		Args:               cobra.MinimumNArgs(2),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateExec(cmd, args); err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(execOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(execOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runExec(context.Background(), k8sClient, config, args[0], util.GetNamespace(execOpts.cf), args[1:])
+		},
+	}
+	execOpts.cf = cf
+	execCmd.Flags().StringVar(&execOpts.role, "role", "", "Name of the role whose pod to exec into")
+	execCmd.Flags().IntVar(&execOpts.index, "index", 0, "Replica index of the role pod")
+	execCmd.Flags().StringVarP(&execOpts.container, "container", "c", "", "Container name, defaults to the first container of the pod")
+	execCmd.Flags().BoolVarP(&execOpts.stdin, "stdin", "i", false, "Pass stdin to the container")
+	execCmd.Flags().BoolVarP(&execOpts.tty, "tty", "t", false, "Stdin is a TTY")
+
+	return execCmd
+}
+
+func validateExec(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if cmd != nil && cmd.ArgsLenAtDash() != 1 {
+		return fmt.Errorf("exactly one rbg name must precede -- and the command")
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("command is required after --")
+	}
+	if execOpts.role == "" {
+		return fmt.Errorf("--role is required")
+	}
+	if execOpts.index < 0 {
+		return fmt.Errorf("--index cannot be negative")
+	}
+	return nil
+}
+
+func runExec(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, name, namespace string, command []string) error {
+	pod, err := util.ResolveRolePod(ctx, k8sClient, namespace, name, execOpts.role, execOpts.index)
+	if err != nil {
+		return err
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return fmt.Errorf("cannot exec into pod %s in phase %s", pod.Name, pod.Status.Phase)
+	}
+
+	container := execOpts.container
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+	return podExecutor(ctx, k8sClient, config, execRequest{
+		pod:       pod,
+		container: container,
+		command:   command,
+		stdin:     execOpts.stdin,
+		tty:       execOpts.tty,
+	})
+}
+
+func streamExec(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, req execRequest) error {
+	restReq := k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(req.pod.Namespace).
+		Name(req.pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: req.container,
+			Command:   req.command,
+			Stdin:     req.stdin,
+			Stdout:    true,
+			Stderr:    !req.tty,
+			TTY:       req.tty,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", restReq.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create executor: %w", err)
+	}
+
+	var stdin io.Reader
+	if req.stdin {
+		stdin = os.Stdin
+	}
+	streamOpts := remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		Tty:    req.tty,
+	}
+	if req.tty {
+		streamOpts.Stderr = nil
+		if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+			state, err := term.MakeRaw(fd)
+			if err != nil {
+				return fmt.Errorf("failed to set terminal to raw mode: %w", err)
+			}
+			defer func() { _ = term.Restore(fd, state) }()
+		}
+	}
+	return executor.StreamWithContext(ctx, streamOpts)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+)
+
+func TestValidateExec(t *testing.T) {
+	origOpts := execOpts
+	defer func() { execOpts = origOpts }()
+
+	execOpts = ExecOptions{role: "decode"}
+	assert.NoError(t, validateExec(nil, []string{"test-rbg", "bash"}))
+	assert.EqualError(t, validateExec(nil, []string{"test-rbg"}), "command is required after --")
+	assert.EqualError(t, validateExec(nil, []string{""}), "rbg name is required")
+
+	execOpts = ExecOptions{}
+	assert.EqualError(t, validateExec(nil, []string{"test-rbg", "bash"}), "--role is required")
+
+	execOpts = ExecOptions{role: "decode", index: -1}
+	assert.EqualError(t, validateExec(nil, []string{"test-rbg", "bash"}), "--index cannot be negative")
+}
+
+func TestRunExec(t *testing.T) {
+	origOpts := execOpts
+	origExecutor := podExecutor
+	defer func() {
+		execOpts = origOpts
+		podExecutor = origExecutor
+	}()
+
+	client := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rbg-decode-2",
+				Namespace: "default",
+				Labels: map[string]string{
+					constants.GroupNameLabelKey:         "test-rbg",
+					constants.RoleNameLabelKey:          "decode",
+					constants.RoleInstanceIndexLabelKey: "2",
+				},
+			},
+			Spec:   corev1.PodSpec{Containers: []corev1.Container{{Name: "engine"}, {Name: "sidecar"}}},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	)
+
+	var got execRequest
+	podExecutor = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, req execRequest) error {
+		got = req
+		return nil
+	}
+
+	execOpts = ExecOptions{role: "decode", index: 2, stdin: true, tty: true}
+	assert.NoError(t, runExec(context.TODO(), client, &rest.Config{}, "test-rbg", "default", []string{"bash"}))
+	assert.Equal(t, "test-rbg-decode-2", got.pod.Name)
+	assert.Equal(t, "engine", got.container)
+	assert.Equal(t, []string{"bash"}, got.command)
+	assert.True(t, got.stdin)
+	assert.True(t, got.tty)
+
+	execOpts = ExecOptions{role: "decode", index: 2, container: "sidecar"}
+	assert.NoError(t, runExec(context.TODO(), client, &rest.Config{}, "test-rbg", "default", []string{"ls", "/"}))
+	assert.Equal(t, "sidecar", got.container)
+
+	execOpts = ExecOptions{role: "decode", index: 0}
+	assert.Error(t, runExec(context.TODO(), client, &rest.Config{}, "test-rbg", "default", []string{"bash"}))
+}
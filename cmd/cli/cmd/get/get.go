@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type GetOptions struct {
+	cf            *genericclioptions.ConfigFlags
+	allNamespaces bool
+	output        util.OutputOptions
+}
+
+var getOpts GetOptions
+
+func NewGetCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:                "get",
+		Short:              "List RoleBasedGroups with per-role readiness",
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := getOpts.output.Validate(); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(getOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(getOpts.cf)
+			if err != nil {
+				return err
+			}
+			namespace := util.GetNamespace(getOpts.cf)
+			if getOpts.allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+			return runGet(context.Background(), rbgClient, k8sClient, namespace, os.Stdout)
+		},
+	}
+	getOpts.cf = cf
+	getCmd.Flags().BoolVarP(&getOpts.allNamespaces, "all-namespaces", "A", false,
+		"List RoleBasedGroups across all namespaces")
+	getOpts.output.AddOutputFlags(getCmd, util.OutputJSON, util.OutputYAML, util.OutputWide, util.OutputName)
+
+	return getCmd
+}
+
+// rbgRow is a single line of the get output.
+type rbgRow struct {
+	namespace string
+	name      string
+	roles     string
+	ready     string
+	revision  string
+	age       string
+	// generation and paused are only printed with -o wide.
+	generation string
+	paused     string
+}
+
+func runGet(ctx context.Context, rbgClient versioned.Interface, k8sClient kubernetes.Interface, namespace string, out io.Writer) error {
+	rbgList, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list RoleBasedGroups: %w", err)
+	}
+	items := rbgList.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	switch {
+	case getOpts.output.IsStructured():
+		// Typed clients drop the TypeMeta of list items, restore it so the output can be applied.
+		rbgList.SetGroupVersionKind(workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroupList"))
+		for i := range items {
+			items[i].SetGroupVersionKind(workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroup"))
+		}
+		return getOpts.output.PrintObject(out, rbgList)
+	case getOpts.output.Format == util.OutputName:
+		for i := range items {
+			_, _ = fmt.Fprintf(out, "rolebasedgroup.%s/%s\n", workloadsv1alpha2.GroupVersion.Group, items[i].Name)
+		}
+		return nil
+	}
+
+	if len(items) == 0 {
+		if namespace == metav1.NamespaceAll {
+			_, _ = fmt.Fprintln(out, "No RoleBasedGroups found.")
+		} else {
+			_, _ = fmt.Fprintf(out, "No RoleBasedGroups found in %s namespace.\n", namespace)
+		}
+		return nil
+	}
+
+	rows := make([]rbgRow, 0, len(items))
+	for i := range items {
+		revisions, err := util.ListOwnedRevisions(ctx, k8sClient, &items[i])
+		if err != nil {
+			return fmt.Errorf("failed to list revisions of %s/%s: %w", items[i].Namespace, items[i].Name, err)
+		}
+		revision := "<none>"
+		if current := util.CurrentRevision(revisions); current != nil {
+			revision = fmt.Sprintf("%d", current.Revision)
+		}
+		rows = append(rows, buildRow(&items[i], revision, time.Now()))
+	}
+
+	printRows(out, rows, namespace == metav1.NamespaceAll)
+	return nil
+}
+
+func buildRow(rbg *workloadsv1alpha2.RoleBasedGroup, revision string, now time.Time) rbgRow {
+	return rbgRow{
+		namespace: rbg.Namespace,
+		name:      rbg.Name,
+		roles:     formatRoles(rbg),
+		ready:     readyCondition(rbg),
+		revision:  revision,
+		age:       formatAge(rbg.CreationTimestamp, now),
+
+		generation: fmt.Sprintf("%d/%d", rbg.Status.ObservedGeneration, rbg.Generation),
+		paused:     fmt.Sprintf("%t", rbg.IsPaused()),
+	}
+}
+
+// formatRoles renders the ready/desired replicas of each role in spec order,
+// e.g. "router:1/1,prefill:2/4".
+func formatRoles(rbg *workloadsv1alpha2.RoleBasedGroup) string {
+	if len(rbg.Spec.Roles) == 0 {
+		return "<none>"
+	}
+	parts := make([]string, 0, len(rbg.Spec.Roles))
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		var desired int32 = 1
+		if role.Replicas != nil {
+			desired = *role.Replicas
+		}
+		var ready int32
+		if status, found := rbg.GetRoleStatus(role.Name); found {
+			ready = status.ReadyReplicas
+		}
+		parts = append(parts, fmt.Sprintf("%s:%d/%d", role.Name, ready, desired))
+	}
+	return strings.Join(parts, ",")
+}
+
+func readyCondition(rbg *workloadsv1alpha2.RoleBasedGroup) string {
+	cond := meta.FindStatusCondition(rbg.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupReady))
+	if cond == nil {
+		return string(metav1.ConditionUnknown)
+	}
+	return string(cond.Status)
+}
+
+func formatAge(created metav1.Time, now time.Time) string {
+	if created.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(now.Sub(created.Time))
+}
+
+func printRows(out io.Writer, rows []rbgRow, withNamespace bool) {
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+
+	wide := getOpts.output.Format == util.OutputWide
+	header := "NAME\tROLES\tREADY\tREVISION\tAGE"
+	if withNamespace {
+		header = "NAMESPACE\t" + header
+	}
+	if wide {
+		header += "\tGENERATION\tPAUSED"
+	}
+	getOpts.output.PrintHeader(w, header)
+	for _, row := range rows {
+		if withNamespace {
+			_, _ = fmt.Fprintf(w, "%s\t", row.namespace)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s", row.name, row.roles, row.ready, row.revision, row.age)
+		if wide {
+			_, _ = fmt.Fprintf(w, "\t%s\t%s", row.generation, row.paused)
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+func newTestRevision(rbg *workloadsv1alpha2.RoleBasedGroup, name string, revision int64) *appsv1.ControllerRevision {
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: rbg.Namespace,
+			Labels:    map[string]string{constants.GroupNameLabelKey: rbg.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				{Name: rbg.Name, UID: rbg.UID, Controller: ptr.To(true)},
+			},
+		},
+		Revision: revision,
+	}
+}
+
+func TestNewGetCmd(t *testing.T) {
+	cf := genericclioptions.NewConfigFlags(true)
+	cmd := NewGetCmd(cf)
+
+	assert.Equal(t, "get", cmd.Use)
+	assert.True(t, cmd.SilenceUsage)
+	assert.NotNil(t, cmd.Flags().Lookup("all-namespaces"))
+	assert.Equal(t, "A", cmd.Flags().Lookup("all-namespaces").Shorthand)
+}
+
+func TestFormatRoles(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").WithReplicas(1).Obj(),
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{RoleStatuses: []workloadsv1alpha2.RoleStatus{
+			{Name: "router", ReadyReplicas: 1, Replicas: 1},
+			{Name: "prefill", ReadyReplicas: 1, Replicas: 2},
+		}}).Obj()
+	assert.Equal(t, "router:1/1,prefill:1/2", formatRoles(rbg))
+
+	// Roles without status report zero ready replicas, nil replicas default to 1.
+	rbg.Spec.Roles = append(rbg.Spec.Roles, workloadsv1alpha2.RoleSpec{Name: "decode"})
+	assert.Equal(t, "router:1/1,prefill:1/2,decode:0/1", formatRoles(rbg))
+
+	rbg.Spec.Roles = nil
+	assert.Equal(t, "<none>", formatRoles(rbg))
+}
+
+func TestReadyCondition(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test", "default").
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{Conditions: []metav1.Condition{
+			{Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: metav1.ConditionFalse},
+		}}).Obj()
+	assert.Equal(t, "False", readyCondition(rbg))
+
+	rbg.Status.Conditions = nil
+	assert.Equal(t, "Unknown", readyCondition(rbg))
+}
+
+func TestRunGet(t *testing.T) {
+	roles := []workloadsv1alpha2.RoleSpec{
+		wrappersv2.BuildStandaloneRole("router").WithReplicas(1).Obj(),
+		wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj(),
+	}
+	status := workloadsv1alpha2.RoleBasedGroupStatus{
+		Conditions: []metav1.Condition{
+			{Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: metav1.ConditionFalse},
+		},
+		RoleStatuses: []workloadsv1alpha2.RoleStatus{
+			{Name: "router", ReadyReplicas: 1, Replicas: 1},
+			{Name: "prefill", ReadyReplicas: 1, Replicas: 2},
+		},
+	}
+	rbgA := wrappersv2.BuildBasicRoleBasedGroup("rbg-a", "default").WithUID("rbg-a-uid").
+		WithCreationTimestamp(time.Now().Add(-5 * time.Hour)).WithRoles(roles).WithStatus(status).Obj()
+	rbgB := wrappersv2.BuildBasicRoleBasedGroup("rbg-b", "other").WithUID("rbg-b-uid").
+		WithCreationTimestamp(time.Now().Add(-5 * time.Hour)).WithRoles(roles).WithStatus(status).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbgA, rbgB)
+	k8sClient := fake.NewSimpleClientset(
+		newTestRevision(rbgA, "rbg-a-1", 1),
+		newTestRevision(rbgA, "rbg-a-2", 2),
+	)
+
+	t.Run("single namespace", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runGet(context.TODO(), rbgClient, k8sClient, "default", &out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "NAME")
+		assert.NotContains(t, out.String(), "NAMESPACE")
+		assert.Contains(t, out.String(), "rbg-a")
+		assert.Contains(t, out.String(), "router:1/1,prefill:1/2")
+		assert.Contains(t, out.String(), "False   2")
+		assert.Contains(t, out.String(), "5h")
+		assert.NotContains(t, out.String(), "rbg-b")
+	})
+
+	t.Run("all namespaces", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runGet(context.TODO(), rbgClient, k8sClient, metav1.NamespaceAll, &out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "NAMESPACE")
+		assert.Contains(t, out.String(), "rbg-a")
+		assert.Contains(t, out.String(), "rbg-b")
+		assert.Contains(t, out.String(), "<none>")
+	})
+
+	t.Run("empty namespace", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runGet(context.TODO(), rbgClient, k8sClient, "empty", &out)
+		assert.NoError(t, err)
+		assert.Equal(t, "No RoleBasedGroups found in empty namespace.\n", out.String())
+	})
+}
+
+func TestBuildRowRevision(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test", "default").
+		WithCreationTimestamp(time.Now().Add(-5 * time.Hour)).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{Conditions: []metav1.Condition{
+			{Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: metav1.ConditionFalse},
+		}}).Obj()
+	row := buildRow(rbg, "3", rbg.CreationTimestamp.Add(90*time.Second))
+	assert.Equal(t, "3", row.revision)
+	assert.Equal(t, "90s", row.age)
+	assert.Equal(t, "False", row.ready)
+}
+
+func TestRunGetOutput(t *testing.T) {
+	origOpts := getOpts
+	defer func() { getOpts = origOpts }()
+
+	rbgClient := fakerbgclient.NewSimpleClientset(wrappersv2.BuildBasicRoleBasedGroup("rbg-a", "default").
+		WithCreationTimestamp(time.Now().Add(-5 * time.Hour)).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").WithReplicas(1).Obj(),
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{
+			Conditions: []metav1.Condition{
+				{Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: metav1.ConditionFalse},
+			},
+			RoleStatuses: []workloadsv1alpha2.RoleStatus{
+				{Name: "router", ReadyReplicas: 1, Replicas: 1},
+				{Name: "prefill", ReadyReplicas: 1, Replicas: 2},
+			},
+		}).Obj())
+	k8sClient := fake.NewSimpleClientset()
+	run := func(output util.OutputOptions) string {
+		getOpts = GetOptions{output: output}
+		var out bytes.Buffer
+		assert.NoError(t, runGet(context.TODO(), rbgClient, k8sClient, "default", &out))
+		return out.String()
+	}
+
+	list := &workloadsv1alpha2.RoleBasedGroupList{}
+	assert.NoError(t, json.Unmarshal([]byte(run(util.OutputOptions{Format: util.OutputJSON})), list))
+	assert.Equal(t, "RoleBasedGroupList", list.Kind)
+	assert.Len(t, list.Items, 1)
+	assert.Equal(t, "RoleBasedGroup", list.Items[0].Kind)
+
+	assert.NoError(t, yaml.Unmarshal([]byte(run(util.OutputOptions{Format: util.OutputYAML})), list))
+	assert.Equal(t, "rbg-a", list.Items[0].Name)
+
+	assert.Equal(t, "rolebasedgroup.workloads.x-k8s.io/rbg-a\n", run(util.OutputOptions{Format: util.OutputName}))
+
+	output := run(util.OutputOptions{Format: util.OutputWide, NoHeaders: true})
+	assert.NotContains(t, output, "NAME")
+	assert.Regexp(t, `rbg-a\s+router:1/1,prefill:1/2\s+False\s+<none>\s+5h\s+0/0\s+false`, output)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpu
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type GPUOptions struct {
+	cf       *genericclioptions.ConfigFlags
+	selector string
+	output   util.OutputOptions
+}
+
+var gpuOpts GPUOptions
+
+func NewGPUCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	gpuCmd := &cobra.Command{
+		Use:   "gpu",
+		Short: "Report the GPU inventory of the cluster and the rbg roles occupying it",
+		Long: `Report the GPU inventory of the cluster and the rbg roles occupying it.
+
+Nodes with allocatable GPUs are grouped by GPU product, taken from the node label set by
+the NVIDIA GPU feature discovery (nvidia.com/gpu.product) or the extended resource name
+otherwise, and by the number of GPUs per node. Allocated GPUs are the ones requested by
+the scheduled pods of every namespace. Free GPUs are only counted on ready, schedulable
+nodes, so the summary tells how many GPUs of which shape a new or scaled role can get.`,
+		Example: `  kubectl rbg gpu
+  kubectl rbg gpu -l node.kubernetes.io/instance-type=p5.48xlarge
+  kubectl rbg gpu -o yaml`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := gpuOpts.output.Validate(); err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(gpuOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runGPU(context.Background(), k8sClient, os.Stdout)
+		},
+	}
+	gpuOpts.cf = cf
+	gpuCmd.Flags().StringVarP(&gpuOpts.selector, "selector", "l", "",
+		"Label selector restricting the nodes reported, e.g. pool=inference")
+	gpuOpts.output.AddOutputFlags(gpuCmd, util.OutputJSON, util.OutputYAML)
+
+	return gpuCmd
+}
+
+// inventoryReport is the GPU inventory of the cluster, also the -o json|yaml output.
+type inventoryReport struct {
+	Groups []productGroup  `json:"groups"`
+	Nodes  []nodeInventory `json:"nodes"`
+}
+
+// productGroup aggregates the nodes sharing a GPU product and a number of GPUs per node.
+type productGroup struct {
+	Product     string `json:"product"`
+	GPUsPerNode int64  `json:"gpusPerNode"`
+	Nodes       int    `json:"nodes"`
+	Allocatable int64  `json:"allocatable"`
+	Allocated   int64  `json:"allocated"`
+	// Free only counts the GPUs of ready, schedulable nodes.
+	Free int64 `json:"free"`
+}
+
+type nodeInventory struct {
+	Name        string           `json:"name"`
+	Product     string           `json:"product"`
+	Schedulable bool             `json:"schedulable"`
+	Status      string           `json:"status"`
+	Allocatable int64            `json:"allocatable"`
+	Allocated   int64            `json:"allocated"`
+	Roles       []roleAllocation `json:"roles"`
+}
+
+// roleAllocation is the GPUs of a node held by the pods of a rbg role. Pods not owned by
+// a rbg are accounted together with an empty rbg name.
+type roleAllocation struct {
+	Namespace string `json:"namespace,omitempty"`
+	RBG       string `json:"rbg,omitempty"`
+	Role      string `json:"role,omitempty"`
+	GPUs      int64  `json:"gpus"`
+}
+
+func (n *nodeInventory) free() int64 {
+	if n.Allocated >= n.Allocatable {
+		return 0
+	}
+	return n.Allocatable - n.Allocated
+}
+
+func runGPU(ctx context.Context, k8sClient kubernetes.Interface, out io.Writer) error {
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: gpuOpts.selector})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	pods, err := k8sClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	report := buildInventory(nodes.Items, pods.Items)
+	if gpuOpts.output.IsStructured() {
+		return gpuOpts.output.PrintObject(out, report)
+	}
+	if len(report.Nodes) == 0 {
+		_, _ = fmt.Fprintln(out, "No GPU nodes found")
+		return nil
+	}
+	printInventory(out, report)
+	return nil
+}
+
+func buildInventory(nodes []corev1.Node, pods []corev1.Pod) *inventoryReport {
+	report := &inventoryReport{Groups: []productGroup{}, Nodes: []nodeInventory{}}
+	byNode := map[string]*nodeInventory{}
+	for i := range nodes {
+		node := &nodes[i]
+		gpus := util.NodeGPUs(node)
+		if len(gpus) == 0 {
+			continue
+		}
+		inv := nodeInventory{Name: node.Name, Product: node.Labels[util.GPUProductLabel], Roles: []roleAllocation{}}
+		names := make([]string, 0, len(gpus))
+		for name, count := range gpus {
+			inv.Allocatable += count
+			names = append(names, string(name))
+		}
+		if inv.Product == "" {
+			sort.Strings(names)
+			inv.Product = strings.Join(names, ",")
+		}
+		inv.Schedulable, inv.Status = nodeStatus(node)
+		report.Nodes = append(report.Nodes, inv)
+	}
+	sort.Slice(report.Nodes, func(i, j int) bool { return report.Nodes[i].Name < report.Nodes[j].Name })
+	for i := range report.Nodes {
+		byNode[report.Nodes[i].Name] = &report.Nodes[i]
+	}
+
+	for i := range pods {
+		pod := &pods[i]
+		// Unbound pods hold no GPU yet and completed pods released theirs.
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		inv, ok := byNode[pod.Spec.NodeName]
+		if !ok {
+			continue
+		}
+		var count int64
+		for _, gpus := range util.PodGPUs(pod) {
+			count += gpus
+		}
+		if count == 0 {
+			continue
+		}
+		inv.Allocated += count
+		allocation := roleAllocation{}
+		if rbgName := pod.Labels[constants.GroupNameLabelKey]; rbgName != "" {
+			allocation = roleAllocation{Namespace: pod.Namespace, RBG: rbgName, Role: pod.Labels[constants.RoleNameLabelKey]}
+		}
+		addAllocation(inv, allocation, count)
+	}
+
+	byGroup := map[string]int{}
+	for i := range report.Nodes {
+		inv := &report.Nodes[i]
+		sort.Slice(inv.Roles, func(a, b int) bool { return allocationLess(&inv.Roles[a], &inv.Roles[b]) })
+		key := fmt.Sprintf("%s/%d", inv.Product, inv.Allocatable)
+		index, ok := byGroup[key]
+		if !ok {
+			index = len(report.Groups)
+			byGroup[key] = index
+			report.Groups = append(report.Groups, productGroup{Product: inv.Product, GPUsPerNode: inv.Allocatable})
+		}
+		group := &report.Groups[index]
+		group.Nodes++
+		group.Allocatable += inv.Allocatable
+		group.Allocated += inv.Allocated
+		if inv.Schedulable {
+			group.Free += inv.free()
+		}
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if report.Groups[i].Product != report.Groups[j].Product {
+			return report.Groups[i].Product < report.Groups[j].Product
+		}
+		return report.Groups[i].GPUsPerNode > report.Groups[j].GPUsPerNode
+	})
+	return report
+}
+
+func addAllocation(inv *nodeInventory, allocation roleAllocation, gpus int64) {
+	for i := range inv.Roles {
+		role := &inv.Roles[i]
+		if role.Namespace == allocation.Namespace && role.RBG == allocation.RBG && role.Role == allocation.Role {
+			role.GPUs += gpus
+			return
+		}
+	}
+	allocation.GPUs = gpus
+	inv.Roles = append(inv.Roles, allocation)
+}
+
+// allocationLess sorts the rbg roles by namespace, rbg and role, and the other pods last.
+func allocationLess(a, b *roleAllocation) bool {
+	if (a.RBG == "") != (b.RBG == "") {
+		return b.RBG == ""
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	if a.RBG != b.RBG {
+		return a.RBG < b.RBG
+	}
+	return a.Role < b.Role
+}
+
+// nodeStatus mirrors the STATUS column of kubectl get nodes.
+func nodeStatus(node *corev1.Node) (bool, string) {
+	ready := false
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			ready = cond.Status == corev1.ConditionTrue
+			break
+		}
+	}
+	status := "NotReady"
+	if ready {
+		status = "Ready"
+	}
+	if node.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+	return ready && !node.Spec.Unschedulable, status
+}
+
+func printInventory(out io.Writer, report *inventoryReport) {
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+
+	gpuOpts.output.PrintHeader(w, "PRODUCT\tGPUS/NODE\tNODES\tALLOCATABLE\tALLOCATED\tFREE")
+	var total productGroup
+	for _, group := range report.Groups {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n", group.Product, group.GPUsPerNode, group.Nodes,
+			group.Allocatable, group.Allocated, group.Free)
+		total.Allocatable += group.Allocatable
+		total.Allocated += group.Allocated
+		total.Free += group.Free
+	}
+
+	_, _ = fmt.Fprintln(w)
+	gpuOpts.output.PrintHeader(w, "NODE\tPRODUCT\tSTATUS\tALLOCATABLE\tALLOCATED\tFREE\tROLES")
+	for i := range report.Nodes {
+		inv := &report.Nodes[i]
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%s\n", inv.Name, inv.Product, inv.Status, inv.Allocatable,
+			inv.Allocated, inv.free(), formatRoles(inv.Roles))
+	}
+	_, _ = fmt.Fprintf(w, "\nTotal: %d GPUs allocatable, %d allocated, %d free on schedulable nodes\n",
+		total.Allocatable, total.Allocated, total.Free)
+}
+
+func formatRoles(roles []roleAllocation) string {
+	if len(roles) == 0 {
+		return "<none>"
+	}
+	parts := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role.RBG == "" {
+			parts = append(parts, fmt.Sprintf("<other>=%d", role.GPUs))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s/%s/%s=%d", role.Namespace, role.RBG, role.Role, role.GPUs))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpu
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+func newTestNode(name, product string, gpus int64, ready bool) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": "inference"}},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("96"),
+				"nvidia.com/gpu":   *resource.NewQuantity(gpus, resource.DecimalSI),
+			},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}},
+		},
+	}
+	if product != "" {
+		node.Labels[util.GPUProductLabel] = product
+	}
+	if ready {
+		node.Status.Conditions[0].Status = corev1.ConditionTrue
+	}
+	return node
+}
+
+func newTestClient() *fake.Clientset {
+	cordoned := newTestNode("node-h100-c", "NVIDIA-H100-80GB-HBM3", 8, true)
+	cordoned.Spec.Unschedulable = true
+	return fake.NewSimpleClientset(
+		newTestNode("node-h100-a", "NVIDIA-H100-80GB-HBM3", 8, true),
+		newTestNode("node-h100-b", "NVIDIA-H100-80GB-HBM3", 8, true),
+		cordoned,
+		newTestNode("node-l20", "NVIDIA-L20", 4, false),
+		newTestNode("node-unlabelled", "", 2, true),
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-cpu"}},
+		wrappersv2.BuildBasicPod().WithName("llm-decode-0").WithNamespace("default").WithRole("llm", "decode").
+			WithNodeName("node-h100-a").WithGPUs(4).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-1").WithNamespace("default").WithRole("llm", "decode").
+			WithNodeName("node-h100-a").WithGPUs(2).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-prefill-0").WithNamespace("default").WithRole("llm", "prefill").
+			WithNodeName("node-h100-b").WithGPUs(2).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("chat-worker-0").WithNamespace("team-b").WithRole("chat", "worker").
+			WithNodeName("node-h100-c").WithGPUs(8).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("gpu-burn").WithNamespace("kube-system").
+			WithNodeName("node-h100-a").WithGPUs(1).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-2").WithNamespace("default").WithRole("llm", "decode").
+			WithGPUs(4).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-9").WithNamespace("default").WithRole("llm", "decode").
+			WithNodeName("node-h100-a").WithGPUs(8).WithPhase(corev1.PodSucceeded).Obj(),
+	)
+}
+
+func TestRunGPU(t *testing.T) {
+	origOpts := gpuOpts
+	defer func() { gpuOpts = origOpts }()
+
+	gpuOpts = GPUOptions{}
+	var out bytes.Buffer
+	require.NoError(t, runGPU(context.TODO(), newTestClient(), &out))
+	output := out.String()
+	assert.Regexp(t, `NVIDIA-H100-80GB-HBM3\s+8\s+3\s+24\s+17\s+7\n`, output)
+	assert.Regexp(t, `NVIDIA-L20\s+4\s+1\s+4\s+0\s+0\n`, output)
+	assert.Regexp(t, `nvidia.com/gpu\s+2\s+1\s+2\s+0\s+2\n`, output)
+	assert.Regexp(t, `node-h100-a\s+NVIDIA-H100-80GB-HBM3\s+Ready\s+8\s+7\s+1\s+default/llm/decode=6,<other>=1\n`, output)
+	assert.Regexp(t, `node-h100-c\s+NVIDIA-H100-80GB-HBM3\s+Ready,SchedulingDisabled\s+8\s+8\s+0\s+team-b/chat/worker=8\n`, output)
+	assert.Regexp(t, `node-l20\s+NVIDIA-L20\s+NotReady\s+4\s+0\s+4\s+<none>\n`, output)
+	assert.Contains(t, output, "Total: 30 GPUs allocatable, 17 allocated, 9 free on schedulable nodes")
+	assert.NotContains(t, output, "node-cpu")
+
+	gpuOpts = GPUOptions{selector: "pool=training"}
+	out.Reset()
+	require.NoError(t, runGPU(context.TODO(), newTestClient(), &out))
+	assert.Equal(t, "No GPU nodes found\n", out.String())
+}
+
+func TestRunGPUStructured(t *testing.T) {
+	origOpts := gpuOpts
+	defer func() { gpuOpts = origOpts }()
+
+	gpuOpts = GPUOptions{selector: util.GPUProductLabel + "=NVIDIA-H100-80GB-HBM3", output: util.OutputOptions{Format: util.OutputYAML}}
+	var out bytes.Buffer
+	require.NoError(t, runGPU(context.TODO(), newTestClient(), &out))
+
+	report := &inventoryReport{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), report))
+	assert.Equal(t, []productGroup{
+		{Product: "NVIDIA-H100-80GB-HBM3", GPUsPerNode: 8, Nodes: 3, Allocatable: 24, Allocated: 17, Free: 7},
+	}, report.Groups)
+	require.Len(t, report.Nodes, 3)
+	assert.Equal(t, []roleAllocation{{Namespace: "default", RBG: "llm", Role: "prefill", GPUs: 2}}, report.Nodes[1].Roles)
+	assert.False(t, report.Nodes[2].Schedulable)
+}
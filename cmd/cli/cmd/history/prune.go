@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultKeep = 3
+	// minKeep covers the current revision and the previous one, which rollout undo restores.
+	minKeep = 2
+)
+
+type PruneOptions struct {
+	cf     *genericclioptions.ConfigFlags
+	keep   int
+	dryRun bool
+}
+
+var pruneOpts PruneOptions
+
+func NewPruneCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	pruneCmd := &cobra.Command{
+		Use:   "prune <rbgName> [--keep N]",
+		Short: "Delete the old revisions of a rbg",
+		Long: `Delete the old revisions of a rbg, keeping the --keep most recent ones.
+
+The controller already garbage collects revisions past its history limit; this removes
+them right away. The current revision and the previous one, which rollout undo goes back
+to, are always kept.`,
+		Example: `  kubectl rbg history prune my-rbg --keep 3
+  kubectl rbg history prune my-rbg --keep 2 --dry-run`,
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validatePrune(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(pruneOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(pruneOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runPrune(context.Background(), rbgClient, k8sClient, args[0], util.GetNamespace(pruneOpts.cf), os.Stdout)
+		},
+	}
+	pruneOpts.cf = cf
+	pruneCmd.Flags().IntVar(&pruneOpts.keep, "keep", defaultKeep, "Number of most recent revisions to keep, at least 2")
+	pruneCmd.Flags().BoolVar(&pruneOpts.dryRun, "dry-run", false, "Only print the revisions that would be deleted")
+
+	return pruneCmd
+}
+
+func validatePrune(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if pruneOpts.keep < minKeep {
+		return fmt.Errorf("--keep must be at least %d, the current and previous revisions are always kept", minKeep)
+	}
+	return nil
+}
+
+func runPrune(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	revisions, err := util.ListOwnedRevisions(ctx, k8sClient, rbg)
+	if err != nil {
+		return fmt.Errorf("failed to list revisions: %w", err)
+	}
+	// Newest first, the order the revisions are kept in.
+	sort.SliceStable(revisions, func(i, j int) bool {
+		if revisions[i].Revision == revisions[j].Revision {
+			return revisions[j].CreationTimestamp.Before(&revisions[i].CreationTimestamp)
+		}
+		return revisions[i].Revision > revisions[j].Revision
+	})
+	if len(revisions) <= pruneOpts.keep {
+		_, _ = fmt.Fprintf(out, "Nothing to prune, rbg %s has %d revisions\n", name, len(revisions))
+		return nil
+	}
+
+	kept, stale := revisions[:pruneOpts.keep], revisions[pruneOpts.keep:]
+	verb := "Deleted"
+	if pruneOpts.dryRun {
+		verb = "Would delete"
+	}
+	for i := len(stale) - 1; i >= 0; i-- {
+		rev := stale[i]
+		if !pruneOpts.dryRun {
+			// The UID precondition keeps a revision recreated under the same name since listing.
+			err := k8sClient.AppsV1().ControllerRevisions(namespace).Delete(ctx, rev.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{UID: &rev.UID},
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete revision %d: %w", rev.Revision, err)
+			}
+		}
+		_, _ = fmt.Fprintf(out, "%s revision %d (%s)\n", verb, rev.Revision, rev.Name)
+	}
+	_, _ = fmt.Fprintf(out, "Kept revisions %s\n", formatRevisions(kept))
+	return nil
+}
+
+// formatRevisions lists the revision numbers in ascending order.
+func formatRevisions(revisions []*appsv1.ControllerRevision) string {
+	numbers := make([]string, 0, len(revisions))
+	for i := len(revisions) - 1; i >= 0; i-- {
+		numbers = append(numbers, strconv.FormatInt(revisions[i].Revision, 10))
+	}
+	return strings.Join(numbers, ", ")
+}
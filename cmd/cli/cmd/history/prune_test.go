@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package history
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+)
+
+func newTestRevision(rbg *workloadsv1alpha2.RoleBasedGroup, revision int64, owner types.UID) *appsv1.ControllerRevision {
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", rbg.Name, revision),
+			Namespace: rbg.Namespace,
+			UID:       types.UID(fmt.Sprintf("rev-%d", revision)),
+			Labels:    map[string]string{constants.GroupNameLabelKey: rbg.Name},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: workloadsv1alpha2.GroupVersion.String(),
+				Kind:       "RoleBasedGroup",
+				Name:       rbg.Name,
+				UID:        owner,
+				Controller: ptr.To(true),
+			}},
+		},
+		Revision: revision,
+	}
+}
+
+func TestValidatePrune(t *testing.T) {
+	origOpts := pruneOpts
+	defer func() { pruneOpts = origOpts }()
+
+	pruneOpts = PruneOptions{keep: 3}
+	assert.NoError(t, validatePrune([]string{"llm"}))
+	assert.EqualError(t, validatePrune([]string{""}), "rbg name is required")
+
+	pruneOpts = PruneOptions{keep: 1}
+	assert.EqualError(t, validatePrune([]string{"llm"}), "--keep must be at least 2, the current and previous revisions are always kept")
+}
+
+func TestRunPrune(t *testing.T) {
+	origOpts := pruneOpts
+	defer func() { pruneOpts = origOpts }()
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default", UID: "rbg-uid"}}
+	newClients := func() (*fakerbgclient.Clientset, *fake.Clientset) {
+		objects := []runtime.Object{
+			// Revision of another rbg of the same name, which is never touched.
+			newTestRevision(rbg, 0, "other-uid"),
+		}
+		for _, revision := range []int64{3, 1, 5, 2, 4} {
+			objects = append(objects, newTestRevision(rbg, revision, rbg.UID))
+		}
+		return fakerbgclient.NewSimpleClientset(rbg), fake.NewSimpleClientset(objects...)
+	}
+	remaining := func(k8sClient *fake.Clientset) []int64 {
+		list, err := k8sClient.AppsV1().ControllerRevisions("default").List(context.TODO(), metav1.ListOptions{})
+		require.NoError(t, err)
+		var revisions []int64
+		for _, rev := range list.Items {
+			revisions = append(revisions, rev.Revision)
+		}
+		return revisions
+	}
+
+	rbgClient, k8sClient := newClients()
+	pruneOpts = PruneOptions{keep: 3, dryRun: true}
+	var out bytes.Buffer
+	require.NoError(t, runPrune(context.TODO(), rbgClient, k8sClient, "llm", "default", &out))
+	assert.Equal(t, "Would delete revision 1 (llm-1)\nWould delete revision 2 (llm-2)\nKept revisions 3, 4, 5\n", out.String())
+	assert.ElementsMatch(t, []int64{0, 1, 2, 3, 4, 5}, remaining(k8sClient))
+
+	pruneOpts = PruneOptions{keep: 2}
+	out.Reset()
+	require.NoError(t, runPrune(context.TODO(), rbgClient, k8sClient, "llm", "default", &out))
+	assert.Equal(t, "Deleted revision 1 (llm-1)\nDeleted revision 2 (llm-2)\nDeleted revision 3 (llm-3)\nKept revisions 4, 5\n", out.String())
+	assert.ElementsMatch(t, []int64{0, 4, 5}, remaining(k8sClient))
+
+	out.Reset()
+	require.NoError(t, runPrune(context.TODO(), rbgClient, k8sClient, "llm", "default", &out))
+	assert.Equal(t, "Nothing to prune, rbg llm has 2 revisions\n", out.String())
+
+	err := runPrune(context.TODO(), rbgClient, k8sClient, "absent", "default", &out)
+	assert.ErrorContains(t, err, "failed to get RoleBasedGroup")
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/deploy/kubectl"
+)
+
+const (
+	fieldManager = "kubectl-rbg"
+	crdKind      = "CustomResourceDefinition"
+)
+
+type InstallOptions struct {
+	cf         *genericclioptions.ConfigFlags
+	upgrade    bool
+	uninstall  bool
+	deleteCRDs bool
+	image      string
+	dryRun     bool
+	wait       bool
+	timeout    time.Duration
+}
+
+var installOpts InstallOptions
+
+// installPollInterval is how often the controller Deployment is checked while waiting; shortened in tests.
+var installPollInterval = 2 * time.Second
+
+func NewInstallCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	installCmd := &cobra.Command{
+		Use:   "install [--upgrade | --uninstall]",
+		Short: "Install, upgrade or uninstall the RBG controller in the cluster",
+		Long: `Install, upgrade or uninstall the RBG controller in the cluster.
+
+The manifests of the release the CLI was built with are embedded in it: the
+CustomResourceDefinitions, the RBAC rules, the webhook Service and the controller
+Deployment. They are applied with server-side apply, so an upgrade only changes the
+fields that differ from the new release.
+
+An existing installation is only changed with --upgrade. --uninstall removes the
+controller but keeps the CustomResourceDefinitions, and with them every rbg, unless
+--delete-crds is given.`,
+		Example: `  kubectl rbg install
+  kubectl rbg install --upgrade --image my.registry/rbgs-controller:v0.7.0
+  kubectl rbg install --uninstall --delete-crds`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateInstall(); err != nil {
+				return err
+			}
+			dynamicClient, err := util.GetDefaultDynamicClient(installOpts.cf)
+			if err != nil {
+				return err
+			}
+			mapper, err := installOpts.cf.ToRESTMapper()
+			if err != nil {
+				return err
+			}
+			if installOpts.uninstall {
+				return runUninstall(context.Background(), dynamicClient, mapper, kubectl.Manifests, os.Stdout)
+			}
+			return runInstall(context.Background(), dynamicClient, mapper, kubectl.Manifests, os.Stdout)
+		},
+	}
+	installOpts.cf = cf
+	installCmd.Flags().BoolVar(&installOpts.upgrade, "upgrade", false, "Upgrade an existing installation to the embedded release")
+	installCmd.Flags().BoolVar(&installOpts.uninstall, "uninstall", false, "Remove the controller from the cluster")
+	installCmd.Flags().BoolVar(&installOpts.deleteCRDs, "delete-crds", false,
+		"With --uninstall, also delete the CustomResourceDefinitions and thereby every rbg")
+	installCmd.Flags().StringVar(&installOpts.image, "image", "",
+		"Controller image to deploy instead of the one of the embedded release")
+	installCmd.Flags().BoolVar(&installOpts.dryRun, "dry-run", false,
+		"Submit the changes to the API server as a dry run without persisting them")
+	installCmd.Flags().BoolVar(&installOpts.wait, "wait", true, "Wait for the controller to become available")
+	installCmd.Flags().DurationVar(&installOpts.timeout, "timeout", 5*time.Minute,
+		"The length of time to wait for the controller to become available")
+
+	return installCmd
+}
+
+func validateInstall() error {
+	if installOpts.upgrade && installOpts.uninstall {
+		return fmt.Errorf("--upgrade and --uninstall are mutually exclusive")
+	}
+	if installOpts.deleteCRDs && !installOpts.uninstall {
+		return fmt.Errorf("--delete-crds requires --uninstall")
+	}
+	if installOpts.image != "" && installOpts.uninstall {
+		return fmt.Errorf("--image cannot be used with --uninstall")
+	}
+	if installOpts.timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	return nil
+}
+
+func runInstall(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+	mapper meta.RESTMapper,
+	manifest []byte,
+	out io.Writer,
+) error {
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+	controller, err := findController(objects)
+	if err != nil {
+		return err
+	}
+	if installOpts.image != "" {
+		if err := setControllerImage(controller, util.ResolveImage(installOpts.image)); err != nil {
+			return err
+		}
+	}
+	image := controllerImage(controller)
+
+	deployments := dynamicClient.Resource(appsv1.SchemeGroupVersion.WithResource("deployments")).Namespace(controller.GetNamespace())
+	existing, err := deployments.Get(ctx, controller.GetName(), metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get the controller Deployment: %w", err)
+	}
+	installed := err == nil
+	if installed && !installOpts.upgrade {
+		return fmt.Errorf("RBG is already installed in namespace %s with image %s, use --upgrade to update it",
+			controller.GetNamespace(), controllerImage(existing))
+	}
+
+	options := metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
+	if installOpts.dryRun {
+		options.DryRun = []string{metav1.DryRunAll}
+	}
+	for _, obj := range objects {
+		client, err := resourceClient(dynamicClient, mapper, obj)
+		if err != nil {
+			return err
+		}
+		if _, err := client.Apply(ctx, obj.GetName(), obj, options); err != nil {
+			return fmt.Errorf("failed to apply %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		_, _ = fmt.Fprintf(out, "%s %s applied%s\n", obj.GetKind(), obj.GetName(), dryRunSuffix())
+	}
+
+	action := "installed"
+	if installed {
+		action = "upgraded"
+	}
+	if installOpts.dryRun {
+		_, _ = fmt.Fprintf(out, "\nRBG %s would be %s in namespace %s (dry run)\n", imageVersion(image), action, controller.GetNamespace())
+		return nil
+	}
+	if installOpts.wait {
+		_, _ = fmt.Fprintf(out, "Waiting for Deployment %s to become available...\n", controller.GetName())
+		if err := waitForController(ctx, deployments, controller.GetName()); err != nil {
+			return err
+		}
+	}
+	_, _ = fmt.Fprintf(out, "\nRBG %s %s in namespace %s\n", imageVersion(image), action, controller.GetNamespace())
+	return nil
+}
+
+func runUninstall(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+	mapper meta.RESTMapper,
+	manifest []byte,
+	out io.Writer,
+) error {
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+	propagation := metav1.DeletePropagationBackground
+	options := metav1.DeleteOptions{PropagationPolicy: &propagation}
+	if installOpts.dryRun {
+		options.DryRun = []string{metav1.DryRunAll}
+	}
+	// Delete in reverse order so the controller stops before its RBAC and namespace go away.
+	keptCRDs := false
+	for i := len(objects) - 1; i >= 0; i-- {
+		obj := objects[i]
+		if obj.GetKind() == crdKind && !installOpts.deleteCRDs {
+			keptCRDs = true
+			continue
+		}
+		client, err := resourceClient(dynamicClient, mapper, obj)
+		if err != nil {
+			return err
+		}
+		if err := client.Delete(ctx, obj.GetName(), options); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to delete %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		_, _ = fmt.Fprintf(out, "%s %s deleted%s\n", obj.GetKind(), obj.GetName(), dryRunSuffix())
+	}
+	if keptCRDs {
+		_, _ = fmt.Fprintln(out, "\nThe CustomResourceDefinitions and the rbgs were kept, use --delete-crds to remove them")
+	}
+	return nil
+}
+
+func dryRunSuffix() string {
+	if installOpts.dryRun {
+		return " (dry run)"
+	}
+	return ""
+}
+
+// decodeManifest reads the objects of a multi-document YAML manifest, in order.
+func decodeManifest(manifest []byte) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	var objects []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode the embedded manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+func resourceClient(dynamicClient dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map %s: %w", gvk.Kind, err)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+	}
+	return dynamicClient.Resource(mapping.Resource), nil
+}
+
+// findController returns the controller Deployment of the manifest.
+func findController(objects []*unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	for _, obj := range objects {
+		if obj.GroupVersionKind() == appsv1.SchemeGroupVersion.WithKind("Deployment") {
+			return obj, nil
+		}
+	}
+	return nil, fmt.Errorf("the embedded manifest has no controller Deployment")
+}
+
+func setControllerImage(controller *unstructured.Unstructured, image string) error {
+	containers, _, err := unstructured.NestedSlice(controller.Object, "spec", "template", "spec", "containers")
+	if err != nil || len(containers) == 0 {
+		return fmt.Errorf("the controller Deployment has no container")
+	}
+	container, ok := containers[0].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("the controller Deployment has an invalid container")
+	}
+	container["image"] = image
+	return unstructured.SetNestedSlice(controller.Object, containers, "spec", "template", "spec", "containers")
+}
+
+func controllerImage(controller *unstructured.Unstructured) string {
+	containers, _, _ := unstructured.NestedSlice(controller.Object, "spec", "template", "spec", "containers")
+	if len(containers) == 0 {
+		return ""
+	}
+	container, _ := containers[0].(map[string]interface{})
+	image, _ := container["image"].(string)
+	return image
+}
+
+// imageVersion returns the tag of an image, the image itself when it has none.
+func imageVersion(image string) string {
+	if i := strings.LastIndex(image, ":"); i >= 0 && !strings.Contains(image[i:], "/") {
+		return image[i+1:]
+	}
+	return image
+}
+
+// waitForController waits until every replica of the controller Deployment runs the
+// applied template and is available.
+func waitForController(ctx context.Context, deployments dynamic.ResourceInterface, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, installOpts.timeout)
+	defer cancel()
+	for {
+		obj, err := deployments.Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			deployment := &appsv1.Deployment{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+				return fmt.Errorf("failed to decode Deployment %s: %w", name, err)
+			}
+			if deploymentAvailable(deployment) {
+				return nil
+			}
+		} else if !apierrors.IsNotFound(err) && ctx.Err() == nil {
+			return fmt.Errorf("failed to get Deployment %s: %w", name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for Deployment %s to become available", name)
+		case <-time.After(installPollInterval):
+		}
+	}
+}
+
+func deploymentAvailable(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/deploy/kubectl"
+)
+
+const testManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: rbgs-system
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: rolebasedgroups.workloads.x-k8s.io
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: rbgs-controller-sa
+  namespace: rbgs-system
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: rbgs-controller-manager
+  namespace: rbgs-system
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: rbgs
+        image: rolebasedgroup/rbgs-controller:v0.7.0
+`
+
+var (
+	namespacesGVR  = corev1.SchemeGroupVersion.WithResource("namespaces")
+	crdsGVR        = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	accountsGVR    = corev1.SchemeGroupVersion.WithResource("serviceaccounts")
+	deploymentsGVR = appsv1.SchemeGroupVersion.WithResource("deployments")
+)
+
+func newTestMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: crdKind}, meta.RESTScopeRoot)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ServiceAccount"), meta.RESTScopeNamespace)
+	mapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+	return mapper
+}
+
+// newTestDynamicClient returns a fake client whose server-side applies create or replace the object.
+func newTestDynamicClient(objects ...runtime.Object) *fakedynamic.FakeDynamicClient {
+	client := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		namespacesGVR:  "NamespaceList",
+		crdsGVR:        "CustomResourceDefinitionList",
+		accountsGVR:    "ServiceAccountList",
+		deploymentsGVR: "DeploymentList",
+	}, objects...)
+	client.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+		tracker := client.Tracker()
+		if _, err := tracker.Get(patch.GetResource(), patch.GetNamespace(), patch.GetName()); err != nil {
+			return true, obj, tracker.Create(patch.GetResource(), obj, patch.GetNamespace())
+		}
+		return true, obj, tracker.Update(patch.GetResource(), obj, patch.GetNamespace())
+	})
+	return client
+}
+
+func newTestDeployment(image string, available int32) *unstructured.Unstructured {
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "rbgs-controller-manager", Namespace: "rbgs-system"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "rbgs", Image: image}}}},
+		},
+		Status: appsv1.DeploymentStatus{UpdatedReplicas: available, AvailableReplicas: available},
+	}
+	obj, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestValidateInstall(t *testing.T) {
+	origOpts := installOpts
+	defer func() { installOpts = origOpts }()
+
+	installOpts = InstallOptions{timeout: time.Minute}
+	assert.NoError(t, validateInstall())
+
+	installOpts = InstallOptions{upgrade: true, uninstall: true, timeout: time.Minute}
+	assert.EqualError(t, validateInstall(), "--upgrade and --uninstall are mutually exclusive")
+	installOpts = InstallOptions{deleteCRDs: true, timeout: time.Minute}
+	assert.EqualError(t, validateInstall(), "--delete-crds requires --uninstall")
+	installOpts = InstallOptions{uninstall: true, image: "rbgs:dev", timeout: time.Minute}
+	assert.EqualError(t, validateInstall(), "--image cannot be used with --uninstall")
+	installOpts = InstallOptions{}
+	assert.EqualError(t, validateInstall(), "--timeout must be positive")
+}
+
+func TestRunInstall(t *testing.T) {
+	origOpts := installOpts
+	origInterval := installPollInterval
+	defer func() {
+		installOpts = origOpts
+		installPollInterval = origInterval
+	}()
+	installPollInterval = time.Millisecond
+
+	dynamicClient := newTestDynamicClient()
+	// The controller becomes available once applied.
+	applied := false
+	dynamicClient.PrependReactor("patch", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		applied = true
+		return false, nil, nil
+	})
+	dynamicClient.PrependReactor("get", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if applied {
+			return true, newTestDeployment("my.registry/rbgs-controller:dev", 2), nil
+		}
+		return false, nil, nil
+	})
+	installOpts = InstallOptions{image: "my.registry/rbgs-controller:dev", wait: true, timeout: time.Second}
+
+	var out bytes.Buffer
+	require.NoError(t, runInstall(context.TODO(), dynamicClient, newTestMapper(), []byte(testManifest), &out))
+	output := out.String()
+	assert.Contains(t, output, "Namespace rbgs-system applied\nCustomResourceDefinition rolebasedgroups.workloads.x-k8s.io applied\n")
+	assert.Contains(t, output, "Waiting for Deployment rbgs-controller-manager to become available...")
+	assert.Contains(t, output, "RBG dev installed in namespace rbgs-system")
+
+	var patched []string
+	for _, a := range dynamicClient.Actions() {
+		if patch, ok := a.(k8stesting.PatchAction); ok {
+			patched = append(patched, patch.GetResource().Resource+"/"+patch.GetNamespace()+"/"+patch.GetName())
+			if patch.GetResource() == deploymentsGVR {
+				assert.Contains(t, string(patch.GetPatch()), `"image":"my.registry/rbgs-controller:dev"`)
+			}
+		}
+	}
+	assert.Equal(t, []string{
+		"namespaces//rbgs-system",
+		"customresourcedefinitions//rolebasedgroups.workloads.x-k8s.io",
+		"serviceaccounts/rbgs-system/rbgs-controller-sa",
+		"deployments/rbgs-system/rbgs-controller-manager",
+	}, patched)
+}
+
+func TestRunInstallExisting(t *testing.T) {
+	origOpts := installOpts
+	origInterval := installPollInterval
+	defer func() {
+		installOpts = origOpts
+		installPollInterval = origInterval
+	}()
+	installPollInterval = time.Millisecond
+
+	dynamicClient := newTestDynamicClient(newTestDeployment("rolebasedgroup/rbgs-controller:v0.6.0", 1))
+	installOpts = InstallOptions{wait: true, timeout: time.Second}
+	var out bytes.Buffer
+	err := runInstall(context.TODO(), dynamicClient, newTestMapper(), []byte(testManifest), &out)
+	assert.EqualError(t, err, "RBG is already installed in namespace rbgs-system with image rolebasedgroup/rbgs-controller:v0.6.0, use --upgrade to update it")
+
+	installOpts = InstallOptions{upgrade: true, dryRun: true, wait: true, timeout: time.Second}
+	require.NoError(t, runInstall(context.TODO(), dynamicClient, newTestMapper(), []byte(testManifest), &out))
+	assert.Contains(t, out.String(), "Deployment rbgs-controller-manager applied (dry run)")
+	assert.Contains(t, out.String(), "RBG v0.7.0 would be upgraded in namespace rbgs-system (dry run)")
+	assert.NotContains(t, out.String(), "Waiting")
+
+	// The existing controller never gets all its replicas available.
+	installOpts = InstallOptions{upgrade: true, wait: true, timeout: 20 * time.Millisecond}
+	dynamicClient.PrependReactor("patch", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, newTestDeployment("rolebasedgroup/rbgs-controller:v0.7.0", 1), nil
+	})
+	err = runInstall(context.TODO(), dynamicClient, newTestMapper(), []byte(testManifest), &out)
+	assert.EqualError(t, err, "timed out waiting for Deployment rbgs-controller-manager to become available")
+}
+
+func TestRunUninstall(t *testing.T) {
+	origOpts := installOpts
+	defer func() { installOpts = origOpts }()
+
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1")
+	crd.SetKind(crdKind)
+	crd.SetName("rolebasedgroups.workloads.x-k8s.io")
+	namespace := &unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind("Namespace")
+	namespace.SetName("rbgs-system")
+	newClient := func() *fakedynamic.FakeDynamicClient {
+		return newTestDynamicClient(namespace.DeepCopy(), crd.DeepCopy(), newTestDeployment("rbgs:v0.7.0", 2))
+	}
+
+	dynamicClient := newClient()
+	installOpts = InstallOptions{uninstall: true}
+	var out bytes.Buffer
+	require.NoError(t, runUninstall(context.TODO(), dynamicClient, newTestMapper(), []byte(testManifest), &out))
+	// The service account does not exist and is skipped.
+	assert.Equal(t, "Deployment rbgs-controller-manager deleted\nNamespace rbgs-system deleted\n\n"+
+		"The CustomResourceDefinitions and the rbgs were kept, use --delete-crds to remove them\n", out.String())
+	_, err := dynamicClient.Resource(crdsGVR).Get(context.TODO(), crd.GetName(), metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	dynamicClient = newClient()
+	installOpts = InstallOptions{uninstall: true, deleteCRDs: true}
+	out.Reset()
+	require.NoError(t, runUninstall(context.TODO(), dynamicClient, newTestMapper(), []byte(testManifest), &out))
+	assert.Contains(t, out.String(), "CustomResourceDefinition rolebasedgroups.workloads.x-k8s.io deleted")
+	_, err = dynamicClient.Resource(crdsGVR).Get(context.TODO(), crd.GetName(), metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestEmbeddedManifest(t *testing.T) {
+	objects, err := decodeManifest(kubectl.Manifests)
+	require.NoError(t, err)
+	controller, err := findController(objects)
+	require.NoError(t, err)
+	assert.Equal(t, "rbgs-system", controller.GetNamespace())
+	assert.NotEmpty(t, controllerImage(controller))
+	assert.Equal(t, "Namespace", objects[0].GetKind())
+}
+
+func TestImageVersion(t *testing.T) {
+	assert.Equal(t, "v0.7.0", imageVersion("rolebasedgroup/rbgs-controller:v0.7.0"))
+	assert.Equal(t, "registry:5000/rbgs", imageVersion("registry:5000/rbgs"))
+}
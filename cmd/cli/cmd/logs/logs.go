@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type LogsOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	roles     []string
+	container string
+	follow    bool
+	since     time.Duration
+	tail      int64
+}
+
+var logsOpts LogsOptions
+
+func NewLogsCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	logsCmd := &cobra.Command{
+		Use:                "logs <rbgName>",
+		Short:              "Print the logs of all pods in a rbg, prefixed with role and pod name",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateLogs(args); err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(logsOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runLogs(context.Background(), k8sClient, args[0], util.GetNamespace(logsOpts.cf), os.Stdout)
+		},
+	}
+	logsOpts.cf = cf
+	logsCmd.Flags().StringSliceVar(&logsOpts.roles, "role", nil, "Only print logs of the given roles (repeatable or comma separated)")
+	logsCmd.Flags().StringVarP(&logsOpts.container, "container", "c", "", "Print the logs of this container")
+	logsCmd.Flags().BoolVarP(&logsOpts.follow, "follow", "f", false, "Specify if the logs should be streamed")
+	logsCmd.Flags().DurationVar(&logsOpts.since, "since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h")
+	logsCmd.Flags().Int64Var(&logsOpts.tail, "tail", -1, "Lines of recent log file to display per pod, -1 shows all lines")
+
+	return logsCmd
+}
+
+func validateLogs(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if logsOpts.since < 0 {
+		return fmt.Errorf("--since cannot be negative")
+	}
+	if logsOpts.tail < -1 {
+		return fmt.Errorf("--tail must be greater than or equal to -1")
+	}
+	return nil
+}
+
+func runLogs(ctx context.Context, k8sClient kubernetes.Interface, name, namespace string, out io.Writer) error {
+	selector, err := podSelector(name, logsOpts.roles)
+	if err != nil {
+		return err
+	}
+	pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no pods found for rbg %s", name)
+	}
+	sort.SliceStable(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+
+	writer := &prefixWriter{out: out}
+	var wg sync.WaitGroup
+	errs := make([]error, len(pods.Items))
+	for i := range pods.Items {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = streamPodLogs(ctx, k8sClient, &pods.Items[i], writer)
+		}(i)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// podSelector selects the pods of the rbg, optionally narrowed down to the given roles.
+func podSelector(name string, roles []string) (labels.Selector, error) {
+	groupReq, err := labels.NewRequirement(constants.GroupNameLabelKey, selection.Equals, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.NewSelector().Add(*groupReq)
+	if len(roles) > 0 {
+		roleReq, err := labels.NewRequirement(constants.RoleNameLabelKey, selection.In, roles)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*roleReq)
+	}
+	return selector, nil
+}
+
+func streamPodLogs(ctx context.Context, k8sClient kubernetes.Interface, pod *corev1.Pod, writer *prefixWriter) error {
+	container := logsOpts.container
+	if container == "" {
+		container = util.DefaultContainer(pod)
+	}
+	opts := &corev1.PodLogOptions{
+		Container: container,
+		Follow:    logsOpts.follow,
+	}
+	if logsOpts.since > 0 {
+		opts.SinceSeconds = ptr.To(int64(logsOpts.since.Seconds()))
+	}
+	if logsOpts.tail >= 0 {
+		opts.TailLines = ptr.To(logsOpts.tail)
+	}
+
+	stream, err := k8sClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get logs of pod %s: %w", pod.Name, err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	prefix := fmt.Sprintf("[%s/%s] ", pod.Labels[constants.RoleNameLabelKey], pod.Name)
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		writer.WriteLine(prefix, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("failed to read logs of pod %s: %w", pod.Name, err)
+	}
+	return nil
+}
+
+// prefixWriter serializes lines coming from concurrent pod streams so they don't interleave.
+type prefixWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *prefixWriter) WriteLine(prefix, line string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, _ = fmt.Fprintln(w.out, prefix+strings.TrimRight(line, "\r"))
+}
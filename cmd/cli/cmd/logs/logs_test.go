@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestValidateLogs(t *testing.T) {
+	origOpts := logsOpts
+	defer func() { logsOpts = origOpts }()
+
+	logsOpts = LogsOptions{tail: -1}
+	assert.NoError(t, validateLogs([]string{"test-rbg"}))
+	assert.Error(t, validateLogs([]string{}))
+
+	logsOpts = LogsOptions{tail: -1, since: -time.Second}
+	assert.Error(t, validateLogs([]string{"test-rbg"}))
+
+	logsOpts = LogsOptions{tail: -2}
+	assert.Error(t, validateLogs([]string{"test-rbg"}))
+}
+
+func TestPodSelector(t *testing.T) {
+	selector, err := podSelector("test-rbg", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, constants.GroupNameLabelKey+"=test-rbg", selector.String())
+
+	selector, err = podSelector("test-rbg", []string{"prefill", "decode"})
+	assert.NoError(t, err)
+	assert.Equal(t, constants.GroupNameLabelKey+"=test-rbg,"+constants.RoleNameLabelKey+" in (decode,prefill)", selector.String())
+}
+
+func TestRunLogs(t *testing.T) {
+	origOpts := logsOpts
+	defer func() { logsOpts = origOpts }()
+
+	client := fake.NewSimpleClientset(
+		wrappersv2.BuildBasicPod().WithName("test-rbg-prefill-0").WithNamespace("default").WithRole("test-rbg", "prefill").
+			WithContainers(corev1.Container{Name: "main"}, corev1.Container{Name: "sidecar"}).Obj(),
+		wrappersv2.BuildBasicPod().WithName("test-rbg-decode-0").WithNamespace("default").WithRole("test-rbg", "decode").
+			WithContainers(corev1.Container{Name: "main"}, corev1.Container{Name: "sidecar"}).Obj(),
+	)
+
+	t.Run("all roles", func(t *testing.T) {
+		logsOpts = LogsOptions{tail: -1}
+		var out bytes.Buffer
+		assert.NoError(t, runLogs(context.TODO(), client, "test-rbg", "default", &out))
+		// The fake clientset answers every log request with "fake logs".
+		assert.Contains(t, out.String(), "[prefill/test-rbg-prefill-0] fake logs")
+		assert.Contains(t, out.String(), "[decode/test-rbg-decode-0] fake logs")
+	})
+
+	t.Run("filter by role", func(t *testing.T) {
+		logsOpts = LogsOptions{tail: 10, roles: []string{"decode"}}
+		var out bytes.Buffer
+		assert.NoError(t, runLogs(context.TODO(), client, "test-rbg", "default", &out))
+		assert.NotContains(t, out.String(), "prefill")
+		assert.Contains(t, out.String(), "[decode/test-rbg-decode-0] fake logs")
+	})
+
+	t.Run("no pods", func(t *testing.T) {
+		logsOpts = LogsOptions{tail: -1, roles: []string{"router"}}
+		var out bytes.Buffer
+		assert.EqualError(t, runLogs(context.TODO(), client, "test-rbg", "default", &out), "no pods found for rbg test-rbg")
+	})
+}
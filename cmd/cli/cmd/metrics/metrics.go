@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultPort   = "8000"
+	defaultWindow = 10 * time.Second
+)
+
+type MetricsOptions struct {
+	cf            *genericclioptions.ConfigFlags
+	roles         []string
+	port          string
+	window        time.Duration
+	prometheusURL string
+	output        util.OutputOptions
+}
+
+var metricsOpts MetricsOptions
+
+// podForwarder exposes a port of a pod locally and returns its base URL; replaced in tests.
+var podForwarder = util.ForwardLocalEndpoint
+
+func NewMetricsCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	metricsCmd := &cobra.Command{
+		Use:   "metrics <rbgName>",
+		Short: "Print the live serving metrics of the engines of a rbg, aggregated by role",
+		Long: `Print the live serving metrics of the engines of a rbg, aggregated by role.
+
+The time to first token (TTFT), time per output token (TPOT), running and queued
+requests, prefix cache hit rate and generation throughput are read from the SGLang and
+vLLM Prometheus metrics. Latencies are given as mean and 90th percentile.
+
+By default the metrics endpoint of every running pod is scraped twice through a port
+forward, --window apart, and the latencies cover the requests completed in between; a
+zero window reports them since the engines started. With --prometheus-url, the same
+metrics are queried from a Prometheus server scraping the engines, over the last --window.`,
+		Example: `  kubectl rbg metrics my-rbg
+  kubectl rbg metrics my-rbg --role decode --window 30s
+  kubectl rbg metrics my-rbg --prometheus-url http://prometheus.monitoring:9090 --window 5m -o json`,
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateMetrics(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(metricsOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(metricsOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(metricsOpts.cf)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			return runMetrics(ctx, rbgClient, k8sClient, config, args[0], util.GetNamespace(metricsOpts.cf), os.Stdout)
+		},
+	}
+	metricsOpts.cf = cf
+	metricsCmd.Flags().StringSliceVar(&metricsOpts.roles, "role", nil, "Only report these roles")
+	metricsCmd.Flags().StringVar(&metricsOpts.port, "port", defaultPort,
+		"Port number or name serving the engine metrics in the role pods")
+	metricsCmd.Flags().DurationVar(&metricsOpts.window, "window", defaultWindow,
+		"Time window the latencies and throughput are computed over")
+	metricsCmd.Flags().StringVar(&metricsOpts.prometheusURL, "prometheus-url", "",
+		"Query a Prometheus server scraping the engines instead of the pods, e.g. http://prometheus.monitoring:9090")
+	metricsOpts.output.AddOutputFlags(metricsCmd, util.OutputJSON, util.OutputYAML)
+
+	return metricsCmd
+}
+
+func validateMetrics(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if err := metricsOpts.output.Validate(); err != nil {
+		return err
+	}
+	if metricsOpts.window < 0 {
+		return fmt.Errorf("--window cannot be negative")
+	}
+	if metricsOpts.prometheusURL != "" && metricsOpts.window < time.Second {
+		return fmt.Errorf("--window must be at least 1s with --prometheus-url")
+	}
+	return nil
+}
+
+// metricsReport is the -o json|yaml form of the metrics output.
+type metricsReport struct {
+	Name          string        `json:"name"`
+	Namespace     string        `json:"namespace"`
+	WindowSeconds float64       `json:"windowSeconds"`
+	Roles         []roleMetrics `json:"roles"`
+	Warnings      []string      `json:"warnings,omitempty"`
+}
+
+// roleMetrics are the serving metrics of a role, unset when no engine of the role reports them.
+type roleMetrics struct {
+	Role            string   `json:"role"`
+	Pods            int      `json:"pods"`
+	Running         *float64 `json:"running,omitempty"`
+	Queued          *float64 `json:"queued,omitempty"`
+	TTFT            *latency `json:"ttft,omitempty"`
+	TPOT            *latency `json:"tpot,omitempty"`
+	CacheHitRate    *float64 `json:"cacheHitRate,omitempty"`
+	TokensPerSecond *float64 `json:"tokensPerSecond,omitempty"`
+}
+
+type latency struct {
+	MeanSeconds float64 `json:"meanSeconds"`
+	P90Seconds  float64 `json:"p90Seconds"`
+}
+
+func runMetrics(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	roles, err := selectRoles(rbg)
+	if err != nil {
+		return err
+	}
+	pods, err := util.ListRolePods(ctx, k8sClient, namespace, name, "")
+	if err != nil {
+		return err
+	}
+	byRole := map[string][]*corev1.Pod{}
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			role := pod.Labels[constants.RoleNameLabelKey]
+			byRole[role] = append(byRole[role], pod)
+		}
+	}
+
+	report := &metricsReport{Name: name, Namespace: namespace, WindowSeconds: metricsOpts.window.Seconds()}
+	if metricsOpts.prometheusURL != "" {
+		err = queryPrometheus(ctx, report, rbg, roles, byRole)
+	} else {
+		err = scrapePods(ctx, report, k8sClient, config, roles, byRole)
+	}
+	if err != nil {
+		return err
+	}
+
+	if metricsOpts.output.IsStructured() {
+		return metricsOpts.output.PrintObject(out, report)
+	}
+	printReport(out, report)
+	return nil
+}
+
+// selectRoles returns the --role roles, or every role, in the order of the rbg spec.
+func selectRoles(rbg *workloadsv1alpha2.RoleBasedGroup) ([]*workloadsv1alpha2.RoleSpec, error) {
+	for _, roleName := range metricsOpts.roles {
+		if _, err := rbg.GetRole(roleName); err != nil {
+			return nil, fmt.Errorf("role %q not found in rbg %s", roleName, rbg.Name)
+		}
+	}
+	var roles []*workloadsv1alpha2.RoleSpec
+	for i := range rbg.Spec.Roles {
+		if len(metricsOpts.roles) == 0 || slices.Contains(metricsOpts.roles, rbg.Spec.Roles[i].Name) {
+			roles = append(roles, &rbg.Spec.Roles[i])
+		}
+	}
+	return roles, nil
+}
+
+// scrapePods reads the metrics endpoint of every pod, twice when a window is set.
+func scrapePods(
+	ctx context.Context,
+	report *metricsReport,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	roles []*workloadsv1alpha2.RoleSpec,
+	byRole map[string][]*corev1.Pod,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	type target struct {
+		pod     *corev1.Pod
+		baseURL string
+		first   *snapshot
+	}
+	targets := map[string][]*target{}
+	for _, role := range roles {
+		for _, pod := range byRole[role.Name] {
+			baseURL, err := podForwarder(ctx, k8sClient, config, pod, metricsOpts.port)
+			if err != nil {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("failed to forward pod %s: %v", pod.Name, err))
+				continue
+			}
+			targets[role.Name] = append(targets[role.Name], &target{pod: pod, baseURL: baseURL})
+		}
+	}
+	if metricsOpts.window > 0 {
+		for _, role := range roles {
+			for _, t := range targets[role.Name] {
+				// A failed first scrape leaves the pod reported since its start.
+				t.first, _ = scrape(ctx, client, t.baseURL)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(metricsOpts.window):
+		}
+	}
+
+	for _, role := range roles {
+		var snapshots []*snapshot
+		for _, t := range targets[role.Name] {
+			s, err := scrape(ctx, client, t.baseURL)
+			if err != nil {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("failed to scrape pod %s: %v", t.pod.Name, err))
+				continue
+			}
+			if t.first != nil {
+				s = s.since(t.first)
+			}
+			snapshots = append(snapshots, s)
+		}
+		m := summarize(snapshots, metricsOpts.window.Seconds())
+		m.Role = role.Name
+		m.Pods = len(byRole[role.Name])
+		report.Roles = append(report.Roles, m)
+	}
+	return nil
+}
+
+func scrape(ctx context.Context, client *http.Client, baseURL string) (*snapshot, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metrics endpoint returned %s", resp.Status)
+	}
+	return parseSnapshot(resp.Body)
+}
+
+func printReport(out io.Writer, report *metricsReport) {
+	scope := fmt.Sprintf("over the last %s", time.Duration(report.WindowSeconds*float64(time.Second)))
+	if report.WindowSeconds == 0 {
+		scope = "since the engines started"
+	}
+	_, _ = fmt.Fprintf(out, "Engine metrics of rbg %s/%s %s\n\n", report.Namespace, report.Name, scope)
+
+	w := printers.GetNewTabWriter(out)
+	metricsOpts.output.PrintHeader(w, "ROLE\tPODS\tRUNNING\tQUEUED\tTTFT(MEAN/P90)\tTPOT(MEAN/P90)\tCACHE-HIT\tTOKENS/S")
+	for _, m := range report.Roles {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n", m.Role, m.Pods,
+			formatValue(m.Running, "%.0f"), formatValue(m.Queued, "%.0f"), formatLatency(m.TTFT), formatLatency(m.TPOT),
+			formatPercent(m.CacheHitRate), formatValue(m.TokensPerSecond, "%.1f"))
+	}
+	_ = w.Flush()
+	for _, warning := range report.Warnings {
+		_, _ = fmt.Fprintf(out, "Warning: %s\n", warning)
+	}
+}
+
+func formatValue(value *float64, format string) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf(format, *value)
+}
+
+func formatPercent(value *float64) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", *value*100)
+}
+
+func formatLatency(l *latency) string {
+	if l == nil {
+		return "-"
+	}
+	return formatSeconds(l.MeanSeconds) + "/" + formatSeconds(l.P90Seconds)
+}
+
+func formatSeconds(seconds float64) string {
+	switch {
+	case seconds >= 1:
+		return fmt.Sprintf("%.2fs", seconds)
+	case seconds >= 0.01:
+		return fmt.Sprintf("%.0fms", seconds*1000)
+	default:
+		return fmt.Sprintf("%.1fms", seconds*1000)
+	}
+}
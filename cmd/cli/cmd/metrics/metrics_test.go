@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestValidateMetrics(t *testing.T) {
+	origOpts := metricsOpts
+	defer func() { metricsOpts = origOpts }()
+
+	metricsOpts = MetricsOptions{window: defaultWindow}
+	assert.NoError(t, validateMetrics([]string{"llm"}))
+	assert.EqualError(t, validateMetrics([]string{""}), "rbg name is required")
+
+	metricsOpts = MetricsOptions{window: -time.Second}
+	assert.EqualError(t, validateMetrics([]string{"llm"}), "--window cannot be negative")
+
+	metricsOpts = MetricsOptions{prometheusURL: "http://prometheus:9090"}
+	assert.EqualError(t, validateMetrics([]string{"llm"}), "--window must be at least 1s with --prometheus-url")
+
+	metricsOpts = MetricsOptions{window: defaultWindow, output: util.OutputOptions{Format: "wide"}}
+	assert.Error(t, validateMetrics([]string{"llm"}))
+}
+
+func TestRunMetricsScrape(t *testing.T) {
+	origOpts := metricsOpts
+	origForwarder := podForwarder
+	defer func() {
+		metricsOpts = origOpts
+		podForwarder = origForwarder
+	}()
+
+	// Each scrape of the engine reports 10 more requests and 100 more tokens.
+	var scrapes atomic.Int64
+	engine := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/metrics", r.URL.Path)
+		n := int(scrapes.Add(1))
+		_, _ = w.Write([]byte(engineMetrics(10*n, 100*n)))
+	}))
+	defer engine.Close()
+	var forwarded []string
+	podForwarder = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, pod *corev1.Pod, port string) (string, error) {
+		forwarded = append(forwarded, pod.Name+":"+port)
+		if pod.Name == "llm-prefill-0" {
+			return "", errors.New("connection refused")
+		}
+		return engine.URL, nil
+	}
+
+	k8sClient := fake.NewSimpleClientset(
+		wrappersv2.BuildBasicPod().WithName("llm-prefill-0").WithNamespace("default").WithRole("llm", "prefill").
+			WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-0").WithNamespace("default").WithRole("llm", "decode").
+			WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("llm-decode-1").WithNamespace("default").WithRole("llm", "decode").
+			WithPhase(corev1.PodPending).Obj(),
+	)
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	metricsOpts = MetricsOptions{port: "8000", window: 10 * time.Millisecond}
+
+	var out bytes.Buffer
+	require.NoError(t, runMetrics(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out))
+	assert.Equal(t, []string{"llm-prefill-0:8000", "llm-decode-0:8000"}, forwarded)
+	output := out.String()
+	assert.Contains(t, output, "Engine metrics of rbg default/llm over the last 10ms")
+	assert.Regexp(t, `prefill\s+1\s+-\s+-\s+-\s+-\s+-\s+-`, output)
+	// The 10 requests between the scrapes took 200ms to first token on average.
+	assert.Regexp(t, `decode\s+1\s+6\s+1\s+200ms/420ms\s+-\s+50.0%\s+10000.0`, output)
+	assert.Contains(t, output, "Warning: failed to forward pod llm-prefill-0: connection refused")
+
+	metricsOpts = MetricsOptions{roles: []string{"router"}}
+	err := runMetrics(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out)
+	assert.EqualError(t, err, `role "router" not found in rbg llm`)
+}
+
+func TestRunMetricsPrometheus(t *testing.T) {
+	origOpts := metricsOpts
+	defer func() { metricsOpts = origOpts }()
+
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		query := r.URL.Query().Get("query")
+		queries = append(queries, query)
+		w.Header().Set("Content-Type", "application/json")
+		value := ""
+		switch {
+		case !strings.Contains(query, `pod=~"llm-decode-.*"`) || strings.Contains(query, "vllm:"):
+		case strings.Contains(query, "histogram_quantile") && strings.Contains(query, "time_to_first_token"):
+			value = "0.8"
+		case strings.Contains(query, "time_to_first_token_seconds_sum"):
+			value = "0.25"
+		case strings.Contains(query, "num_running_reqs"):
+			value = "12"
+		case strings.Contains(query, "cache_hit_rate"):
+			value = "NaN"
+		case strings.Contains(query, "generation_tokens_total"):
+			value = "1234.5"
+		}
+		if value == "" {
+			_, _ = w.Write([]byte(`{"status":"success","data":{"result":[]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"success","data":{"result":[{"metric":{},"value":[1,"` + value + `"]}]}}`))
+	}))
+	defer server.Close()
+
+	k8sClient := fake.NewSimpleClientset(
+		wrappersv2.BuildBasicPod().WithName("llm-decode-0").WithNamespace("default").WithRole("llm", "decode").
+			WithPhase(corev1.PodRunning).Obj(),
+	)
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	metricsOpts = MetricsOptions{roles: []string{"decode"}, window: 5 * time.Minute, prometheusURL: server.URL}
+
+	var out bytes.Buffer
+	require.NoError(t, runMetrics(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out))
+	assert.Contains(t, queries, `sum(rate(sglang:generation_tokens_total{namespace="default",pod=~"llm-decode-.*"}[300s]))`)
+	output := out.String()
+	assert.Contains(t, output, "Engine metrics of rbg default/llm over the last 5m0s")
+	assert.Regexp(t, `decode\s+1\s+12\s+-\s+250ms/800ms\s+-\s+-\s+1234.5`, output)
+	assert.NotContains(t, output, "prefill")
+
+	out.Reset()
+	metricsOpts.output = util.OutputOptions{Format: util.OutputJSON}
+	require.NoError(t, runMetrics(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out))
+	assert.Contains(t, out.String(), `"tokensPerSecond": 1234.5`)
+	assert.Contains(t, out.String(), `"windowSeconds": 300`)
+
+	server.Close()
+	err := runMetrics(context.TODO(), rbgClient, k8sClient, &rest.Config{}, "llm", "default", &out)
+	assert.Error(t, err)
+}
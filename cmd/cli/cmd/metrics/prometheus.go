@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+// queryPrometheus computes the role metrics from the engine series in Prometheus. The
+// series of a role are selected by the pod names, which are prefixed by the workload name.
+func queryPrometheus(
+	ctx context.Context,
+	report *metricsReport,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	roles []*workloadsv1alpha2.RoleSpec,
+	byRole map[string][]*corev1.Pod,
+) error {
+	client := &http.Client{Timeout: 10 * time.Second}
+	window := fmt.Sprintf("%ds", int(metricsOpts.window.Seconds()))
+	for _, role := range roles {
+		q := &roleQuerier{
+			ctx:      ctx,
+			client:   client,
+			selector: fmt.Sprintf(`namespace=%q,pod=~%q`, rbg.Namespace, rbg.GetWorkloadName(role)+"-.*"),
+			window:   window,
+		}
+		m := roleMetrics{Role: role.Name, Pods: len(byRole[role.Name])}
+		m.Running = q.first(runningMetrics, "sum(%[1]s{%[2]s})")
+		m.Queued = q.first(queuedMetrics, "sum(%[1]s{%[2]s})")
+		m.TTFT = q.latency(ttftMetrics)
+		m.TPOT = q.latency(tpotMetrics)
+		if m.CacheHitRate = q.first(cacheHitRateMetrics, "avg(%[1]s{%[2]s})"); m.CacheHitRate == nil {
+			for _, names := range cacheCounterMetrics {
+				if m.CacheHitRate = q.value(fmt.Sprintf("sum(rate(%s{%s}[%s])) / sum(rate(%s{%s}[%s]))",
+					names[0], q.selector, window, names[1], q.selector, window)); m.CacheHitRate != nil {
+					break
+				}
+			}
+		}
+		if m.TokensPerSecond = q.first(generationTokenMetrics, "sum(rate(%[1]s{%[2]s}[%[3]s]))"); m.TokensPerSecond == nil {
+			m.TokensPerSecond = q.first(throughputMetrics, "sum(%[1]s{%[2]s})")
+		}
+		if q.err != nil {
+			return q.err
+		}
+		report.Roles = append(report.Roles, m)
+	}
+	return nil
+}
+
+// roleQuerier runs the queries of a role, keeping the first error.
+type roleQuerier struct {
+	ctx      context.Context
+	client   *http.Client
+	selector string
+	window   string
+	err      error
+}
+
+// value returns the single value of the query, nil when there is none or it is not a number.
+func (q *roleQuerier) value(query string) *float64 {
+	if q.err != nil {
+		return nil
+	}
+	samples, err := util.QueryPrometheus(q.ctx, q.client, metricsOpts.prometheusURL, query)
+	if err != nil {
+		q.err = err
+		return nil
+	}
+	if len(samples) == 0 || math.IsNaN(samples[0].Value) || math.IsInf(samples[0].Value, 0) {
+		return nil
+	}
+	return ptr.To(samples[0].Value)
+}
+
+// first returns the result of the query template for the first metric with a value. The
+// template is given the metric name, the pod selector and the window.
+func (q *roleQuerier) first(names []string, template string) *float64 {
+	for _, name := range names {
+		if value := q.value(fmt.Sprintf(template, name, q.selector, q.window)); value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+func (q *roleQuerier) latency(names []string) *latency {
+	for _, name := range names {
+		mean := q.value(fmt.Sprintf("sum(rate(%[1]s_sum{%[2]s}[%[3]s])) / sum(rate(%[1]s_count{%[2]s}[%[3]s]))",
+			name, q.selector, q.window))
+		if mean == nil {
+			continue
+		}
+		l := &latency{MeanSeconds: *mean}
+		query := fmt.Sprintf("histogram_quantile(0.9, sum by (le) (rate(%s_bucket{%s}[%s])))", name, q.selector, q.window)
+		if p90 := q.value(query); p90 != nil {
+			l.P90Seconds = *p90
+		}
+		return l
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/utils/ptr"
+)
+
+// Engine metric names, in order of preference when an engine exports several of them.
+var (
+	ttftMetrics = []string{"sglang:time_to_first_token_seconds", "vllm:time_to_first_token_seconds"}
+	tpotMetrics = []string{
+		"sglang:time_per_output_token_seconds", "sglang:inter_token_latency_seconds",
+		"vllm:time_per_output_token_seconds", "vllm:inter_token_latency_seconds",
+	}
+	runningMetrics      = []string{"sglang:num_running_reqs", "vllm:num_requests_running"}
+	queuedMetrics       = []string{"sglang:num_queue_reqs", "vllm:num_requests_waiting"}
+	cacheHitRateMetrics = []string{"sglang:cache_hit_rate", "vllm:gpu_prefix_cache_hit_rate"}
+	// cacheCounterMetrics are the hits and queries counters of the engines without a hit rate gauge.
+	cacheCounterMetrics = [][2]string{
+		{"vllm:prefix_cache_hits_total", "vllm:prefix_cache_queries_total"},
+		{"vllm:gpu_prefix_cache_hits_total", "vllm:gpu_prefix_cache_queries_total"},
+	}
+	generationTokenMetrics = []string{"sglang:generation_tokens_total", "vllm:generation_tokens_total"}
+	throughputMetrics      = []string{"sglang:gen_throughput"}
+)
+
+// bucket is a cumulative histogram bucket.
+type bucket struct {
+	upperBound float64
+	count      float64
+}
+
+type histogram struct {
+	buckets []bucket
+	sum     float64
+	count   float64
+}
+
+// snapshot is one scrape of the metrics of an engine, the series of a metric summed over
+// their labels, e.g. the model name or the TP rank.
+type snapshot struct {
+	gauges     map[string]float64
+	counters   map[string]float64
+	histograms map[string]*histogram
+}
+
+func newSnapshot() *snapshot {
+	return &snapshot{gauges: map[string]float64{}, counters: map[string]float64{}, histograms: map[string]*histogram{}}
+}
+
+func parseSnapshot(r io.Reader) (*snapshot, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+	s := newSnapshot()
+	for name, family := range families {
+		for _, m := range family.GetMetric() {
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				s.counters[name] += m.GetCounter().GetValue()
+			case dto.MetricType_GAUGE:
+				s.gauges[name] += m.GetGauge().GetValue()
+			case dto.MetricType_UNTYPED:
+				s.gauges[name] += m.GetUntyped().GetValue()
+			case dto.MetricType_HISTOGRAM:
+				h := &histogram{sum: m.GetHistogram().GetSampleSum(), count: float64(m.GetHistogram().GetSampleCount())}
+				for _, b := range m.GetHistogram().GetBucket() {
+					h.buckets = append(h.buckets, bucket{upperBound: b.GetUpperBound(), count: float64(b.GetCumulativeCount())})
+				}
+				s.histograms[name] = addHistograms(s.histograms[name], h)
+			}
+		}
+	}
+	return s, nil
+}
+
+// addHistograms adds the buckets of b to a, matching them by upper bound.
+func addHistograms(a, b *histogram) *histogram {
+	if a == nil {
+		a = &histogram{}
+	}
+	counts := map[float64]float64{}
+	for _, bk := range a.buckets {
+		counts[bk.upperBound] += bk.count
+	}
+	for _, bk := range b.buckets {
+		counts[bk.upperBound] += bk.count
+	}
+	result := &histogram{sum: a.sum + b.sum, count: a.count + b.count}
+	for upperBound, count := range counts {
+		result.buckets = append(result.buckets, bucket{upperBound: upperBound, count: count})
+	}
+	sort.Slice(result.buckets, func(i, j int) bool { return result.buckets[i].upperBound < result.buckets[j].upperBound })
+	return result
+}
+
+// since returns what happened between the previous snapshot and this one: counters and
+// histograms are subtracted while gauges keep their current value. An engine restarted in
+// between is reported since its restart.
+func (s *snapshot) since(previous *snapshot) *snapshot {
+	delta := newSnapshot()
+	for name, value := range s.gauges {
+		delta.gauges[name] = value
+	}
+	for name, value := range s.counters {
+		if before, ok := previous.counters[name]; ok && before <= value {
+			value -= before
+		}
+		delta.counters[name] = value
+	}
+	for name, h := range s.histograms {
+		before, ok := previous.histograms[name]
+		if !ok || before.count > h.count {
+			delta.histograms[name] = h
+			continue
+		}
+		negated := &histogram{sum: -before.sum, count: -before.count}
+		for _, bk := range before.buckets {
+			negated.buckets = append(negated.buckets, bucket{upperBound: bk.upperBound, count: -bk.count})
+		}
+		delta.histograms[name] = addHistograms(h, negated)
+	}
+	return delta
+}
+
+// quantile estimates the q-quantile like histogram_quantile in PromQL, interpolating
+// linearly within the bucket holding the rank.
+func (h *histogram) quantile(q float64) (float64, bool) {
+	if h == nil || h.count <= 0 || len(h.buckets) == 0 {
+		return 0, false
+	}
+	rank := q * h.count
+	lowerBound, lowerCount := 0.0, 0.0
+	for _, bk := range h.buckets {
+		if bk.count >= rank {
+			if math.IsInf(bk.upperBound, 1) {
+				return lowerBound, true
+			}
+			if bk.count == lowerCount {
+				return bk.upperBound, true
+			}
+			return lowerBound + (bk.upperBound-lowerBound)*(rank-lowerCount)/(bk.count-lowerCount), true
+		}
+		lowerBound, lowerCount = bk.upperBound, bk.count
+	}
+	return lowerBound, true
+}
+
+func (h *histogram) mean() (float64, bool) {
+	if h == nil || h.count <= 0 {
+		return 0, false
+	}
+	return h.sum / h.count, true
+}
+
+// firstHistogram returns the first of the named histograms the snapshot has.
+func (s *snapshot) firstHistogram(names []string) *histogram {
+	for _, name := range names {
+		if h, ok := s.histograms[name]; ok {
+			return h
+		}
+	}
+	return nil
+}
+
+// firstGauge returns the first of the named gauges the snapshot has.
+func (s *snapshot) firstGauge(names []string) (float64, bool) {
+	for _, name := range names {
+		if value, ok := s.gauges[name]; ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+func (s *snapshot) firstCounter(names []string) (float64, bool) {
+	for _, name := range names {
+		if value, ok := s.counters[name]; ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// summarize aggregates the snapshots of the pods of a role. window is the time the
+// snapshots cover, zero when they cover the lifetime of the engines.
+func summarize(pods []*snapshot, window float64) roleMetrics {
+	var m roleMetrics
+	var ttft, tpot *histogram
+	var running, queued, hitRate, tokens, throughput, hits, queries sample
+	for _, s := range pods {
+		if h := s.firstHistogram(ttftMetrics); h != nil {
+			ttft = addHistograms(ttft, h)
+		}
+		if h := s.firstHistogram(tpotMetrics); h != nil {
+			tpot = addHistograms(tpot, h)
+		}
+		running.add(s.firstGauge(runningMetrics))
+		queued.add(s.firstGauge(queuedMetrics))
+		hitRate.add(s.firstGauge(cacheHitRateMetrics))
+		for _, names := range cacheCounterMetrics {
+			if h, ok := s.counters[names[0]]; ok {
+				hits.add(h, true)
+				queries.add(s.counters[names[1]], true)
+				break
+			}
+		}
+		tokens.add(s.firstCounter(generationTokenMetrics))
+		throughput.add(s.firstGauge(throughputMetrics))
+	}
+
+	m.TTFT = newLatency(ttft)
+	m.TPOT = newLatency(tpot)
+	m.Running = running.total()
+	m.Queued = queued.total()
+	if hitRate.n > 0 {
+		m.CacheHitRate = ptr.To(hitRate.sum / float64(hitRate.n))
+	} else if queries.sum > 0 {
+		m.CacheHitRate = ptr.To(hits.sum / queries.sum)
+	}
+	if tokens.n > 0 && window > 0 {
+		m.TokensPerSecond = ptr.To(tokens.sum / window)
+	} else {
+		m.TokensPerSecond = throughput.total()
+	}
+	return m
+}
+
+// sample sums a value over the pods reporting it.
+type sample struct {
+	sum float64
+	n   int
+}
+
+func (s *sample) add(value float64, ok bool) {
+	if ok {
+		s.sum += value
+		s.n++
+	}
+}
+
+func (s *sample) total() *float64 {
+	if s.n == 0 {
+		return nil
+	}
+	return ptr.To(s.sum)
+}
+
+func newLatency(h *histogram) *latency {
+	mean, ok := h.mean()
+	if !ok {
+		return nil
+	}
+	l := &latency{MeanSeconds: mean}
+	if p90, ok := h.quantile(0.9); ok {
+		l.P90Seconds = p90
+	}
+	return l
+}
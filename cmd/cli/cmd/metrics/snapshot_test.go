@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// engineMetrics renders SGLang metrics after the given number of requests, half of them
+// under 100ms to first token and the rest under 500ms.
+func engineMetrics(requests, tokens int) string {
+	return fmt.Sprintf(`# TYPE sglang:time_to_first_token_seconds histogram
+sglang:time_to_first_token_seconds_bucket{le="0.1",tp_rank="0"} %[2]d
+sglang:time_to_first_token_seconds_bucket{le="0.5",tp_rank="0"} %[1]d
+sglang:time_to_first_token_seconds_bucket{le="+Inf",tp_rank="0"} %[1]d
+sglang:time_to_first_token_seconds_sum{tp_rank="0"} %[3]g
+sglang:time_to_first_token_seconds_count{tp_rank="0"} %[1]d
+# TYPE sglang:num_running_reqs gauge
+sglang:num_running_reqs{tp_rank="0"} 3
+sglang:num_running_reqs{tp_rank="1"} 3
+# TYPE sglang:num_queue_reqs gauge
+sglang:num_queue_reqs{tp_rank="0"} 1
+# TYPE sglang:cache_hit_rate gauge
+sglang:cache_hit_rate{tp_rank="0"} 0.5
+# TYPE sglang:generation_tokens_total counter
+sglang:generation_tokens_total{tp_rank="0"} %[4]d
+`, requests, requests/2, float64(requests)*0.2, tokens)
+}
+
+func TestParseSnapshot(t *testing.T) {
+	s, err := parseSnapshot(strings.NewReader(engineMetrics(10, 500)))
+	require.NoError(t, err)
+	assert.Equal(t, 6.0, s.gauges["sglang:num_running_reqs"])
+	assert.Equal(t, 500.0, s.counters["sglang:generation_tokens_total"])
+	h := s.histograms["sglang:time_to_first_token_seconds"]
+	require.NotNil(t, h)
+	assert.Equal(t, 10.0, h.count)
+	assert.Equal(t, []bucket{{0.1, 5}, {0.5, 10}, {math.Inf(1), 10}}, h.buckets)
+
+	_, err = parseSnapshot(strings.NewReader("not metrics {"))
+	assert.Error(t, err)
+}
+
+func TestSnapshotSince(t *testing.T) {
+	before, err := parseSnapshot(strings.NewReader(engineMetrics(10, 500)))
+	require.NoError(t, err)
+	after, err := parseSnapshot(strings.NewReader(engineMetrics(30, 800)))
+	require.NoError(t, err)
+
+	delta := after.since(before)
+	assert.Equal(t, 300.0, delta.counters["sglang:generation_tokens_total"])
+	assert.Equal(t, 6.0, delta.gauges["sglang:num_running_reqs"])
+	h := delta.histograms["sglang:time_to_first_token_seconds"]
+	assert.Equal(t, 20.0, h.count)
+	assert.InDelta(t, 4.0, h.sum, 1e-9)
+	assert.Equal(t, []bucket{{0.1, 10}, {0.5, 20}, {math.Inf(1), 20}}, h.buckets)
+
+	// An engine restarted in between is reported since its restart.
+	restarted := before.since(after)
+	assert.Equal(t, 500.0, restarted.counters["sglang:generation_tokens_total"])
+	assert.Equal(t, 10.0, restarted.histograms["sglang:time_to_first_token_seconds"].count)
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	h := &histogram{count: 10, buckets: []bucket{{0.1, 5}, {0.5, 10}, {math.Inf(1), 10}}}
+	q, ok := h.quantile(0.9)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.42, q, 1e-9)
+	q, _ = h.quantile(0.25)
+	assert.InDelta(t, 0.05, q, 1e-9)
+
+	h = &histogram{count: 4, buckets: []bucket{{0.1, 1}, {math.Inf(1), 4}}}
+	q, _ = h.quantile(0.9)
+	assert.Equal(t, 0.1, q)
+
+	_, ok = (&histogram{}).quantile(0.9)
+	assert.False(t, ok)
+}
+
+func TestSummarize(t *testing.T) {
+	first, err := parseSnapshot(strings.NewReader(engineMetrics(10, 500)))
+	require.NoError(t, err)
+	second, err := parseSnapshot(strings.NewReader(engineMetrics(10, 1500)))
+	require.NoError(t, err)
+
+	m := summarize([]*snapshot{first, second}, 10)
+	assert.Equal(t, 12.0, *m.Running)
+	assert.Equal(t, 2.0, *m.Queued)
+	assert.Equal(t, 0.5, *m.CacheHitRate)
+	assert.Equal(t, 200.0, *m.TokensPerSecond)
+	require.NotNil(t, m.TTFT)
+	assert.InDelta(t, 0.2, m.TTFT.MeanSeconds, 1e-9)
+	assert.InDelta(t, 0.42, m.TTFT.P90Seconds, 1e-9)
+	assert.Nil(t, m.TPOT)
+
+	// Without a window the token counters have no rate.
+	assert.Nil(t, summarize([]*snapshot{first}, 0).TokensPerSecond)
+
+	vllm, err := parseSnapshot(strings.NewReader(`# TYPE vllm:prefix_cache_hits_total counter
+vllm:prefix_cache_hits_total 30
+# TYPE vllm:prefix_cache_queries_total counter
+vllm:prefix_cache_queries_total 120
+`))
+	require.NoError(t, err)
+	m = summarize([]*snapshot{vllm}, 0)
+	assert.Equal(t, 0.25, *m.CacheHitRate)
+	assert.Nil(t, m.Running)
+
+	assert.Equal(t, roleMetrics{}, summarize(nil, 10))
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/yaml"
+)
+
+// restartedAtAnnotation is set on pod templates by `kubectl rollout restart`; carrying it
+// over would only trigger a pointless rollout of the new workloads.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+type MigrateOptions struct {
+	cf     *genericclioptions.ConfigFlags
+	from   []string
+	name   string
+	output string
+}
+
+var migrateOpts MigrateOptions
+
+// source is a workload to migrate together with the role it becomes.
+type source struct {
+	kind string
+	name string
+	role string
+}
+
+var sourceGVRs = map[string]schema.GroupVersionResource{
+	"Deployment":      {Group: "apps", Version: "v1", Resource: "deployments"},
+	"StatefulSet":     {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"LeaderWorkerSet": {Group: "leaderworkerset.x-k8s.io", Version: "v1", Resource: "leaderworkersets"},
+}
+
+var sourceKinds = map[string]string{
+	"deployment":       "Deployment",
+	"deployments":      "Deployment",
+	"deploy":           "Deployment",
+	"statefulset":      "StatefulSet",
+	"statefulsets":     "StatefulSet",
+	"sts":              "StatefulSet",
+	"leaderworkerset":  "LeaderWorkerSet",
+	"leaderworkersets": "LeaderWorkerSet",
+	"lws":              "LeaderWorkerSet",
+}
+
+func NewMigrateCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use:   "migrate --from <kind>/<name>[=<role>],...",
+		Short: "Compose a rbg manifest from existing Deployments, StatefulSets and LeaderWorkerSets",
+		Long: `Compose a rbg manifest from existing Deployments, StatefulSets and LeaderWorkerSets.
+
+Every source workload becomes a role that keeps its workload kind, replicas and pod
+template. The role is named after the workload unless given explicitly with =<role>.
+The source workloads are left untouched; apply the manifest and delete them once the
+rbg is ready.`,
+		Example: `  kubectl rbg migrate --from deployment/my-vllm,statefulset/my-router --output rbg.yaml
+  kubectl rbg migrate --name llm --from deploy/vllm-prefill=prefill,lws/vllm-decode=decode`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sources, err := parseSources(migrateOpts.from)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := util.GetDefaultDynamicClient(migrateOpts.cf)
+			if err != nil {
+				return err
+			}
+			out := io.Writer(os.Stdout)
+			if migrateOpts.output != "" && migrateOpts.output != "-" {
+				f, err := os.Create(migrateOpts.output)
+				if err != nil {
+					return fmt.Errorf("failed to create output file: %w", err)
+				}
+				defer func() { _ = f.Close() }()
+				out = f
+			}
+			return runMigrate(context.Background(), dynamicClient, sources, util.GetNamespace(migrateOpts.cf), out)
+		},
+	}
+	migrateOpts.cf = cf
+	migrateCmd.Flags().StringSliceVar(&migrateOpts.from, "from", nil,
+		"Workloads to migrate as <kind>/<name>[=<role>], kind is one of deployment, statefulset or lws")
+	migrateCmd.Flags().StringVar(&migrateOpts.name, "name", "", "Name of the rbg, defaults to the name of the first workload")
+	migrateCmd.Flags().StringVarP(&migrateOpts.output, "output", "o", "-", "File to write the manifest to, - for stdout")
+	_ = migrateCmd.MarkFlagRequired("from")
+
+	return migrateCmd
+}
+
+// parseSources parses the --from values. Roles default to the workload name.
+func parseSources(values []string) ([]source, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("--from is required")
+	}
+	sources := make([]source, 0, len(values))
+	roles := map[string]bool{}
+	for _, value := range values {
+		ref, role, _ := strings.Cut(value, "=")
+		kind, name, ok := strings.Cut(ref, "/")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid workload %q, expected <kind>/<name>", value)
+		}
+		canonical, ok := sourceKinds[strings.ToLower(kind)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported workload kind %q, must be deployment, statefulset or lws", kind)
+		}
+		if role == "" {
+			role = name
+		}
+		if errs := validation.IsDNS1123Label(role); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid role name %q: %s", role, strings.Join(errs, ", "))
+		}
+		if roles[role] {
+			return nil, fmt.Errorf("duplicate role %q", role)
+		}
+		roles[role] = true
+		sources = append(sources, source{kind: canonical, name: name, role: role})
+	}
+	return sources, nil
+}
+
+func runMigrate(ctx context.Context, dynamicClient dynamic.Interface, sources []source, namespace string, out io.Writer) error {
+	name := migrateOpts.name
+	if name == "" {
+		name = sources[0].name
+	}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: workloadsv1alpha2.GroupVersion.String(),
+			Kind:       "RoleBasedGroup",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	for _, src := range sources {
+		obj, err := dynamicClient.Resource(sourceGVRs[src.kind]).Namespace(namespace).Get(ctx, src.name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get %s %s: %w", src.kind, src.name, err)
+		}
+		role, err := roleFromWorkload(obj, src)
+		if err != nil {
+			return err
+		}
+		rbg.Spec.Roles = append(rbg.Spec.Roles, *role)
+	}
+
+	data, err := toYAML(rbg)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// roleFromWorkload builds a role that reproduces the workload: same kind, replicas and pod template.
+func roleFromWorkload(obj *unstructured.Unstructured, src source) (*workloadsv1alpha2.RoleSpec, error) {
+	role := &workloadsv1alpha2.RoleSpec{Name: src.role}
+	switch src.kind {
+	case "Deployment":
+		deploy := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deploy); err != nil {
+			return nil, fmt.Errorf("failed to decode Deployment %s: %w", src.name, err)
+		}
+		role.Replicas = deploy.Spec.Replicas
+		role.Annotations = map[string]string{constants.RoleWorkloadTypeAnnotationKey: constants.DeploymentWorkloadType}
+		role.StandalonePattern = &workloadsv1alpha2.StandalonePattern{
+			TemplateSource: workloadsv1alpha2.TemplateSource{Template: cleanTemplate(&deploy.Spec.Template)},
+		}
+	case "StatefulSet":
+		sts := &appsv1.StatefulSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, sts); err != nil {
+			return nil, fmt.Errorf("failed to decode StatefulSet %s: %w", src.name, err)
+		}
+		role.Replicas = sts.Spec.Replicas
+		role.Annotations = map[string]string{constants.RoleWorkloadTypeAnnotationKey: constants.StatefulSetWorkloadType}
+		role.StandalonePattern = &workloadsv1alpha2.StandalonePattern{
+			TemplateSource: workloadsv1alpha2.TemplateSource{Template: cleanTemplate(&sts.Spec.Template)},
+		}
+	case "LeaderWorkerSet":
+		lws := &lwsv1.LeaderWorkerSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, lws); err != nil {
+			return nil, fmt.Errorf("failed to decode LeaderWorkerSet %s: %w", src.name, err)
+		}
+		lwt := lws.Spec.LeaderWorkerTemplate
+		role.Replicas = lws.Spec.Replicas
+		role.Annotations = map[string]string{constants.RoleWorkloadTypeAnnotationKey: constants.LeaderWorkerSetWorkloadType}
+		pattern := &workloadsv1alpha2.LeaderWorkerPattern{
+			Size:           lwt.Size,
+			TemplateSource: workloadsv1alpha2.TemplateSource{Template: cleanTemplate(&lwt.WorkerTemplate)},
+		}
+		if lwt.LeaderTemplate != nil {
+			// The leader template is a full pod template in LWS; as a strategic merge patch over
+			// the worker template it yields the same leader pod.
+			patch, err := json.Marshal(cleanTemplate(lwt.LeaderTemplate))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode leader template of %s: %w", src.name, err)
+			}
+			pattern.LeaderTemplatePatch = &runtime.RawExtension{Raw: patch}
+		}
+		role.LeaderWorkerPattern = pattern
+	}
+	return role, nil
+}
+
+// cleanTemplate drops pod template metadata that belongs to the old workload.
+func cleanTemplate(template *corev1.PodTemplateSpec) *corev1.PodTemplateSpec {
+	clean := template.DeepCopy()
+	delete(clean.Annotations, restartedAtAnnotation)
+	if len(clean.Annotations) == 0 {
+		clean.Annotations = nil
+	}
+	return clean
+}
+
+// toYAML renders the rbg without the empty status and creation timestamps.
+func toYAML(rbg *workloadsv1alpha2.RoleBasedGroup) ([]byte, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(rbg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert rbg: %w", err)
+	}
+	delete(obj, "status")
+	unstructured.RemoveNestedField(obj, "metadata", "creationTimestamp")
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render rbg: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+func toUnstructured(t *testing.T, obj runtime.Object, apiVersion, kind string) *unstructured.Unstructured {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	u := &unstructured.Unstructured{Object: data}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	return u
+}
+
+func podTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "llm"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: image}}},
+	}
+}
+
+func TestParseSources(t *testing.T) {
+	sources, err := parseSources([]string{"deployment/my-vllm", "sts/my-router=router", "LWS/decode"})
+	assert.NoError(t, err)
+	assert.Equal(t, []source{
+		{kind: "Deployment", name: "my-vllm", role: "my-vllm"},
+		{kind: "StatefulSet", name: "my-router", role: "router"},
+		{kind: "LeaderWorkerSet", name: "decode", role: "decode"},
+	}, sources)
+
+	_, err = parseSources(nil)
+	assert.EqualError(t, err, "--from is required")
+	_, err = parseSources([]string{"my-vllm"})
+	assert.EqualError(t, err, `invalid workload "my-vllm", expected <kind>/<name>`)
+	_, err = parseSources([]string{"daemonset/agent"})
+	assert.EqualError(t, err, `unsupported workload kind "daemonset", must be deployment, statefulset or lws`)
+	_, err = parseSources([]string{"deploy/a=worker", "sts/b=worker"})
+	assert.EqualError(t, err, `duplicate role "worker"`)
+	_, err = parseSources([]string{"deploy/a=Bad_Role"})
+	assert.Error(t, err)
+}
+
+func TestRunMigrate(t *testing.T) {
+	origOpts := migrateOpts
+	defer func() { migrateOpts = origOpts }()
+
+	template := podTemplate("router:v1")
+	template.Annotations = map[string]string{restartedAtAnnotation: "2026-01-01T00:00:00Z"}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-router", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2), Template: template},
+	}
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-vllm", Namespace: "default"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3), Template: podTemplate("vllm:v1")},
+	}
+	leader := podTemplate("vllm-leader:v1")
+	lws := &lwsv1.LeaderWorkerSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-decode", Namespace: "default"},
+		Spec: lwsv1.LeaderWorkerSetSpec{
+			Replicas: ptr.To[int32](1),
+			LeaderWorkerTemplate: lwsv1.LeaderWorkerTemplate{
+				Size:           ptr.To[int32](4),
+				LeaderTemplate: &leader,
+				WorkerTemplate: podTemplate("vllm:v1"),
+			},
+		},
+	}
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(),
+		toUnstructured(t, deploy, "apps/v1", "Deployment"),
+		toUnstructured(t, sts, "apps/v1", "StatefulSet"),
+		toUnstructured(t, lws, "leaderworkerset.x-k8s.io/v1", "LeaderWorkerSet"),
+	)
+
+	migrateOpts = MigrateOptions{name: "llm"}
+	sources, err := parseSources([]string{"deployment/my-router=router", "statefulset/my-vllm", "lws/my-decode=decode"})
+	require.NoError(t, err)
+	var out bytes.Buffer
+	require.NoError(t, runMigrate(context.TODO(), dynamicClient, sources, "default", &out))
+
+	assert.NotContains(t, out.String(), "status")
+	assert.NotContains(t, out.String(), restartedAtAnnotation)
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), rbg))
+	assert.Equal(t, "RoleBasedGroup", rbg.Kind)
+	assert.Equal(t, "llm", rbg.Name)
+	assert.Equal(t, "default", rbg.Namespace)
+	require.Len(t, rbg.Spec.Roles, 3)
+
+	router := rbg.Spec.Roles[0]
+	assert.Equal(t, "router", router.Name)
+	assert.Equal(t, int32(2), *router.Replicas)
+	assert.Equal(t, constants.DeploymentWorkloadType, router.Annotations[constants.RoleWorkloadTypeAnnotationKey])
+	assert.Equal(t, "router:v1", router.StandalonePattern.Template.Spec.Containers[0].Image)
+
+	vllm := rbg.Spec.Roles[1]
+	assert.Equal(t, "my-vllm", vllm.Name)
+	assert.Equal(t, int32(3), *vllm.Replicas)
+	assert.Equal(t, constants.StatefulSetWorkloadType, vllm.Annotations[constants.RoleWorkloadTypeAnnotationKey])
+
+	decode := rbg.Spec.Roles[2]
+	assert.Equal(t, constants.LeaderWorkerSetWorkloadType, decode.Annotations[constants.RoleWorkloadTypeAnnotationKey])
+	require.NotNil(t, decode.LeaderWorkerPattern)
+	assert.Equal(t, int32(4), *decode.LeaderWorkerPattern.Size)
+	assert.Equal(t, "vllm:v1", decode.LeaderWorkerPattern.Template.Spec.Containers[0].Image)
+	patch := corev1.PodTemplateSpec{}
+	require.NoError(t, json.Unmarshal(decode.LeaderWorkerPattern.LeaderTemplatePatch.Raw, &patch))
+	assert.Equal(t, "vllm-leader:v1", patch.Spec.Containers[0].Image)
+
+	migrateOpts = MigrateOptions{}
+	sources, err = parseSources([]string{"deploy/absent"})
+	require.NoError(t, err)
+	assert.Error(t, runMigrate(context.TODO(), dynamicClient, sources, "default", &out))
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+func NewPauseCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:                "pause <rbgName>",
+		Short:              "Freeze the reconciliation of a rbg until it is resumed",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rbgClient, err := util.GetRBGClient(cf)
+			if err != nil {
+				return err
+			}
+			return setPaused(context.Background(), rbgClient, args[0], util.GetNamespace(cf), true, os.Stdout)
+		},
+	}
+}
+
+func NewResumeCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:                "resume <rbgName>",
+		Short:              "Resume the reconciliation of a paused rbg",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rbgClient, err := util.GetRBGClient(cf)
+			if err != nil {
+				return err
+			}
+			return setPaused(context.Background(), rbgClient, args[0], util.GetNamespace(cf), false, os.Stdout)
+		},
+	}
+}
+
+// setPaused sets or removes the paused annotation, the controller reports the state in the Paused condition.
+// Resuming also clears spec.paused, so that a group paused either way is resumed.
+func setPaused(ctx context.Context, rbgClient versioned.Interface, name, namespace string, paused bool, out io.Writer) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	if rbg.IsPaused() == paused {
+		if paused {
+			_, _ = fmt.Fprintf(out, "rbg %s is already paused\n", name)
+		} else {
+			_, _ = fmt.Fprintf(out, "rbg %s is not paused\n", name)
+		}
+		return nil
+	}
+
+	// A null value removes the annotation in a merge patch.
+	var value interface{}
+	if paused {
+		value = "true"
+	}
+	body := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{constants.PausedAnnotationKey: value},
+		},
+	}
+	if !paused && rbg.Spec.Paused {
+		body["spec"] = map[string]interface{}{"paused": false}
+	}
+	patch, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Patch(
+		ctx, name, types.MergePatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to update rbg %s: %w", name, err)
+	}
+	if paused {
+		_, _ = fmt.Fprintf(out, "rbg %s paused\n", name)
+	} else {
+		_, _ = fmt.Fprintf(out, "rbg %s resumed\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+)
+
+func TestSetPaused(t *testing.T) {
+	ctx := context.TODO()
+	client := fakerbgclient.NewSimpleClientset(&workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-rbg",
+			Namespace:   "default",
+			Annotations: map[string]string{"keep": "me"},
+		},
+	})
+	get := func() *workloadsv1alpha2.RoleBasedGroup {
+		rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(ctx, "test-rbg", metav1.GetOptions{})
+		assert.NoError(t, err)
+		return rbg
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, setPaused(ctx, client, "test-rbg", "default", true, &out))
+	assert.Equal(t, "rbg test-rbg paused\n", out.String())
+	assert.Equal(t, "true", get().Annotations[constants.PausedAnnotationKey])
+
+	out.Reset()
+	assert.NoError(t, setPaused(ctx, client, "test-rbg", "default", true, &out))
+	assert.Equal(t, "rbg test-rbg is already paused\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, setPaused(ctx, client, "test-rbg", "default", false, &out))
+	assert.Equal(t, "rbg test-rbg resumed\n", out.String())
+	rbg := get()
+	assert.NotContains(t, rbg.Annotations, constants.PausedAnnotationKey)
+	assert.Equal(t, "me", rbg.Annotations["keep"])
+
+	rbg.Spec.Paused = true
+	_, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Update(ctx, rbg, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	out.Reset()
+	assert.NoError(t, setPaused(ctx, client, "test-rbg", "default", false, &out))
+	assert.Equal(t, "rbg test-rbg resumed\n", out.String())
+	assert.False(t, get().Spec.Paused)
+
+	assert.ErrorContains(t, setPaused(ctx, client, "absent", "default", true, &out), "failed to get RoleBasedGroup")
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const defaultRole = "router"
+
+type PortForwardOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	role      string
+	addresses []string
+}
+
+var portForwardOpts PortForwardOptions
+
+// portForwarder forwards the ports to the resolved pod; replaced in tests.
+var portForwarder = util.ForwardPorts
+
+func NewPortForwardCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	portForwardCmd := &cobra.Command{
+		Use:                "port-forward <rbgName> [LOCAL_PORT:]REMOTE_PORT [...[LOCAL_PORT_N:]REMOTE_PORT_N]",
+		Short:              "Forward local ports to a ready pod of a rbg role",
+		Args:               cobra.MinimumNArgs(2),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validatePortForward(args); err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(portForwardOpts.cf)
+			if err != nil {
+				return err
+			}
+			config, err := util.GetRESTConfig(portForwardOpts.cf)
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			return runPortForward(ctx, k8sClient, config, args[0], util.GetNamespace(portForwardOpts.cf), args[1:], os.Stdout)
+		},
+	}
+	portForwardOpts.cf = cf
+	portForwardCmd.Flags().StringVar(&portForwardOpts.role, "role", defaultRole, "Name of the role to forward to")
+	portForwardCmd.Flags().StringSliceVar(&portForwardOpts.addresses, "address", []string{"localhost"},
+		"Addresses to listen on (comma separated), only accepts IP addresses or localhost")
+
+	return portForwardCmd
+}
+
+func validatePortForward(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("at least one port is required")
+	}
+	if portForwardOpts.role == "" {
+		return fmt.Errorf("--role cannot be empty")
+	}
+	return nil
+}
+
+func runPortForward(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	name, namespace string,
+	ports []string,
+	out io.Writer,
+) error {
+	pod, err := util.ReadyRolePod(ctx, k8sClient, namespace, name, portForwardOpts.role)
+	if err != nil {
+		return err
+	}
+	translated, err := util.TranslatePorts(pod, ports)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "Forwarding to pod %s of role %s\n", pod.Name, portForwardOpts.role)
+	return portForwarder(ctx, k8sClient, config, util.PortForwardRequest{
+		Pod:       pod,
+		Addresses: portForwardOpts.addresses,
+		Ports:     translated,
+		Out:       out,
+		ErrOut:    os.Stderr,
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+func newRouterPod(name string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.GroupNameLabelKey: "test-rbg",
+				constants.RoleNameLabelKey:  "router",
+			},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:  "router",
+			Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8000}},
+		}}},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestValidatePortForward(t *testing.T) {
+	origOpts := portForwardOpts
+	defer func() { portForwardOpts = origOpts }()
+
+	portForwardOpts = PortForwardOptions{role: "router"}
+	assert.NoError(t, validatePortForward([]string{"test-rbg", "8000"}))
+	assert.EqualError(t, validatePortForward([]string{"test-rbg"}), "at least one port is required")
+	assert.EqualError(t, validatePortForward([]string{"", "8000"}), "rbg name is required")
+
+	portForwardOpts = PortForwardOptions{}
+	assert.EqualError(t, validatePortForward([]string{"test-rbg", "8000"}), "--role cannot be empty")
+}
+
+func TestRunPortForward(t *testing.T) {
+	origOpts := portForwardOpts
+	origForwarder := portForwarder
+	defer func() {
+		portForwardOpts = origOpts
+		portForwarder = origForwarder
+	}()
+
+	var got util.PortForwardRequest
+	portForwarder = func(_ context.Context, _ kubernetes.Interface, _ *rest.Config, req util.PortForwardRequest) error {
+		got = req
+		return nil
+	}
+	client := fake.NewSimpleClientset(newRouterPod("test-rbg-router-a", false), newRouterPod("test-rbg-router-b", true))
+
+	portForwardOpts = PortForwardOptions{role: "router", addresses: []string{"localhost"}}
+	var out bytes.Buffer
+	assert.NoError(t, runPortForward(context.TODO(), client, &rest.Config{}, "test-rbg", "default", []string{"9000:http", "8080"}, &out))
+	assert.Equal(t, "test-rbg-router-b", got.Pod.Name)
+	assert.Equal(t, []string{"9000:8000", "8080:8080"}, got.Ports)
+	assert.Contains(t, out.String(), "Forwarding to pod test-rbg-router-b of role router")
+
+	assert.ErrorContains(t, runPortForward(context.TODO(), client, &rest.Config{}, "test-rbg", "default", []string{"grpc"}, &out),
+		`no container port named "grpc"`)
+
+	portForwardOpts.role = "decode"
+	assert.ErrorContains(t, runPortForward(context.TODO(), client, &rest.Config{}, "test-rbg", "default", []string{"8000"}, &out),
+		"no ready pod found for role decode")
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+type PromoteOptions struct {
+	cf    *genericclioptions.ConfigFlags
+	roles []string
+	abort bool
+}
+
+var promoteOpts PromoteOptions
+
+func NewPromoteCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	promoteCmd := &cobra.Command{
+		Use:   "promote <rbgName> [--role <roleName>,...] [--abort]",
+		Short: "Finish or abort the canary rollout of a rbg",
+		Long: `Finish or abort the canary rollout of a rbg.
+
+A role is in canary while its rolloutStrategy.rollingUpdate.partition holds part of its
+replicas on the previous revision. Promoting sets the partition back to 0, so the remaining
+replicas roll forward. Aborting restores the roles from the previous revision of the rbg,
+rolling the canary replicas back while keeping the partition for the next attempt.
+
+Without --role, every partitioned role of the rbg is promoted or aborted.`,
+		Example: `  kubectl rbg promote my-rbg
+  kubectl rbg promote my-rbg --role decode
+  kubectl rbg promote my-rbg --role decode --abort`,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validatePromote(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(promoteOpts.cf)
+			if err != nil {
+				return err
+			}
+			namespace := util.GetNamespace(promoteOpts.cf)
+			if promoteOpts.abort {
+				k8sClient, err := util.GetK8SClientSet(promoteOpts.cf)
+				if err != nil {
+					return err
+				}
+				return runAbort(context.Background(), rbgClient, k8sClient, args[0], namespace, os.Stdout)
+			}
+			return runPromote(context.Background(), rbgClient, args[0], namespace, os.Stdout)
+		},
+	}
+	promoteOpts.cf = cf
+	promoteCmd.Flags().StringSliceVar(&promoteOpts.roles, "role", nil,
+		"Roles to promote or abort, defaults to every partitioned role")
+	promoteCmd.Flags().BoolVar(&promoteOpts.abort, "abort", false,
+		"Roll the canary replicas back to the previous revision instead of promoting them")
+
+	return promoteCmd
+}
+
+func validatePromote(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	for _, role := range promoteOpts.roles {
+		if role == "" {
+			return fmt.Errorf("--role cannot be empty")
+		}
+	}
+	return nil
+}
+
+// partition returns the number of replicas of the role held on the previous revision.
+func partition(role *workloadsv1alpha2.RoleSpec) int32 {
+	if role.RolloutStrategy == nil || role.RolloutStrategy.RollingUpdate == nil ||
+		role.RolloutStrategy.RollingUpdate.Partition == nil {
+		return 0
+	}
+	replicas := int32(1)
+	if role.Replicas != nil {
+		replicas = *role.Replicas
+	}
+	value, err := intstr.GetScaledValueFromIntOrPercent(role.RolloutStrategy.RollingUpdate.Partition, int(replicas), true)
+	if err != nil || value <= 0 {
+		return 0
+	}
+	return int32(min(value, int(replicas)))
+}
+
+// canaryRoles returns the indexes of the roles to promote or abort: the --role roles, which
+// must be partitioned, or every partitioned role.
+func canaryRoles(rbg *workloadsv1alpha2.RoleBasedGroup) ([]int, error) {
+	var indexes []int
+	if len(promoteOpts.roles) == 0 {
+		for i := range rbg.Spec.Roles {
+			if partition(&rbg.Spec.Roles[i]) > 0 {
+				indexes = append(indexes, i)
+			}
+		}
+		if len(indexes) == 0 {
+			return nil, fmt.Errorf("rbg %s has no partitioned role", rbg.Name)
+		}
+		return indexes, nil
+	}
+	for _, roleName := range promoteOpts.roles {
+		index, err := util.RoleIndex(rbg, roleName)
+		if err != nil {
+			return nil, err
+		}
+		if partition(&rbg.Spec.Roles[index]) == 0 {
+			return nil, fmt.Errorf("role %s of rbg %s is not partitioned", roleName, rbg.Name)
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+func runPromote(ctx context.Context, rbgClient versioned.Interface, name, namespace string, out io.Writer) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	indexes, err := canaryRoles(rbg)
+	if err != nil {
+		return err
+	}
+
+	var ops []util.PatchOp
+	promoted := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		role := &rbg.Spec.Roles[index]
+		ops = append(ops, util.GuardedRoleOps(index, role.Name,
+			util.PatchOp{Op: "replace", Path: "/rolloutStrategy/rollingUpdate/partition", Value: 0})...)
+		promoted = append(promoted, role.Name)
+	}
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Patch(
+		ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to promote roles %s: %w", strings.Join(promoted, ","), err)
+	}
+	for _, index := range indexes {
+		role := &rbg.Spec.Roles[index]
+		_, _ = fmt.Fprintf(out, "rbg %s role %s promoted, rolling the remaining %d replicas forward\n",
+			name, role.Name, partition(role))
+	}
+	return nil
+}
+
+func runAbort(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	indexes, err := canaryRoles(rbg)
+	if err != nil {
+		return err
+	}
+	revisions, err := util.ListOwnedRevisions(ctx, k8sClient, rbg)
+	if err != nil {
+		return fmt.Errorf("failed to list revisions: %w", err)
+	}
+	previous := previousRevision(revisions)
+	if previous == nil {
+		return fmt.Errorf("rbg %s has no previous revision to roll back to", name)
+	}
+	restored, err := utils.ApplyRevision(rbg, previous)
+	if err != nil {
+		return fmt.Errorf("failed to apply revision %d: %w", previous.Revision, err)
+	}
+
+	updated := rbg.DeepCopy()
+	aborted := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		role := &updated.Spec.Roles[index]
+		restoredRole, err := restored.GetRole(role.Name)
+		if err != nil {
+			return fmt.Errorf("cannot roll back role %s to revision %d: %w", role.Name, previous.Revision, err)
+		}
+		// Keep the current partition so that the next attempt is a canary again.
+		rolloutStrategy := role.RolloutStrategy
+		*role = *restoredRole.DeepCopy()
+		role.RolloutStrategy = rolloutStrategy
+		aborted = append(aborted, role.Name)
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to abort roles %s: %w", strings.Join(aborted, ","), err)
+	}
+	for _, roleName := range aborted {
+		_, _ = fmt.Fprintf(out, "rbg %s role %s rolled back to revision %d\n", name, roleName, previous.Revision)
+	}
+	return nil
+}
+
+// previousRevision returns the revision preceding the current one, or nil if there is none.
+func previousRevision(revisions []*appsv1.ControllerRevision) *appsv1.ControllerRevision {
+	current := util.CurrentRevision(revisions)
+	var previous *appsv1.ControllerRevision
+	for _, rev := range revisions {
+		if rev != current && (previous == nil || previous.Revision < rev.Revision) {
+			previous = rev
+		}
+	}
+	return previous
+}
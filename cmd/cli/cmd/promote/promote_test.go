@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promote
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	"sigs.k8s.io/rbgs/pkg/utils"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func engineTemplate(image string) *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "engine", Image: image}}}}
+}
+
+func TestPartition(t *testing.T) {
+	role := wrappersv2.BuildStandaloneRole("decode").WithReplicas(4).Obj()
+	assert.Equal(t, int32(0), partition(&role))
+	for value, want := range map[intstr.IntOrString]int32{
+		intstr.FromInt32(3):      3,
+		intstr.FromString("50%"): 2,
+		intstr.FromInt32(10):     4,
+	} {
+		role = wrappersv2.BuildStandaloneRole("decode").WithReplicas(4).
+			WithRollingUpdate(workloadsv1alpha2.RollingUpdate{Partition: ptr.To(value)}).Obj()
+		assert.Equal(t, want, partition(&role), value.String())
+	}
+}
+
+func TestRunPromote(t *testing.T) {
+	origOpts := promoteOpts
+	defer func() { promoteOpts = origOpts }()
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").WithUID("llm-uid").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(engineTemplate("vllm:v2")).
+				WithRollingUpdate(workloadsv1alpha2.RollingUpdate{Partition: ptr.To(intstr.FromInt32(0))}).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(4).WithTemplate(engineTemplate("vllm:v2")).
+				WithRollingUpdate(workloadsv1alpha2.RollingUpdate{Partition: ptr.To(intstr.FromInt32(3))}).Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+
+	promoteOpts = PromoteOptions{roles: []string{"prefill"}}
+	var out bytes.Buffer
+	err := runPromote(context.TODO(), rbgClient, "llm", "default", &out)
+	assert.EqualError(t, err, "role prefill of rbg llm is not partitioned")
+	promoteOpts = PromoteOptions{roles: []string{"router"}}
+	err = runPromote(context.TODO(), rbgClient, "llm", "default", &out)
+	assert.EqualError(t, err, `role "router" not found in rbg llm`)
+
+	promoteOpts = PromoteOptions{}
+	require.NoError(t, runPromote(context.TODO(), rbgClient, "llm", "default", &out))
+	assert.Equal(t, "rbg llm role decode promoted, rolling the remaining 3 replicas forward\n", out.String())
+	updated, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "llm", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, intstr.FromInt32(0), *updated.Spec.Roles[1].RolloutStrategy.RollingUpdate.Partition)
+
+	err = runPromote(context.TODO(), rbgClient, "llm", "default", &out)
+	assert.EqualError(t, err, "rbg llm has no partitioned role")
+}
+
+func TestRunAbort(t *testing.T) {
+	origOpts := promoteOpts
+	defer func() { promoteOpts = origOpts }()
+
+	stable := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").WithUID("llm-uid").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(engineTemplate("vllm:v1")).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(4).WithTemplate(engineTemplate("vllm:v1")).
+				WithRollingUpdate(workloadsv1alpha2.RollingUpdate{Partition: ptr.To(intstr.FromInt32(0))}).Obj(),
+		}).Obj()
+	canary := wrappersv2.BuildBasicRoleBasedGroup("llm", "default").WithUID("llm-uid").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(engineTemplate("vllm:v2")).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(6).WithTemplate(engineTemplate("vllm:v2")).
+				WithRollingUpdate(workloadsv1alpha2.RollingUpdate{Partition: ptr.To(intstr.FromInt32(3))}).Obj(),
+		}).Obj()
+	first, err := utils.NewRevision(context.TODO(), nil, stable, nil)
+	require.NoError(t, err)
+	second, err := utils.NewRevision(context.TODO(), nil, canary, first)
+	require.NoError(t, err)
+
+	promoteOpts = PromoteOptions{abort: true}
+	rbgClient := fakerbgclient.NewSimpleClientset(canary.DeepCopy())
+	var out bytes.Buffer
+	err = runAbort(context.TODO(), rbgClient, fake.NewSimpleClientset(second.DeepCopy()), "llm", "default", &out)
+	assert.EqualError(t, err, "rbg llm has no previous revision to roll back to")
+
+	k8sClient := fake.NewSimpleClientset(first, second)
+	require.NoError(t, runAbort(context.TODO(), rbgClient, k8sClient, "llm", "default", &out))
+	assert.Equal(t, "rbg llm role decode rolled back to revision 1\n", out.String())
+
+	updated, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "llm", metav1.GetOptions{})
+	require.NoError(t, err)
+	decode := updated.Spec.Roles[1]
+	assert.Equal(t, "vllm:v1", decode.StandalonePattern.Template.Spec.Containers[0].Image)
+	assert.Equal(t, int32(6), *decode.Replicas)
+	assert.Equal(t, intstr.FromInt32(3), *decode.RolloutStrategy.RollingUpdate.Partition)
+	// Roles that are not in canary keep their new spec.
+	assert.Equal(t, "vllm:v2", updated.Spec.Roles[0].StandalonePattern.Template.Spec.Containers[0].Image)
+}
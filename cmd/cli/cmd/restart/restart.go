@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restart
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type RestartOptions struct {
+	cf    *genericclioptions.ConfigFlags
+	roles []string
+}
+
+var restartOpts RestartOptions
+
+// now returns the restart timestamp; overridden in tests.
+var now = time.Now
+
+func NewRestartCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	restartCmd := &cobra.Command{
+		Use:                "restart <rbgName> --role <roleName>",
+		Short:              "Trigger a rolling restart of roles in a rbg without changing their spec",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateRestart(args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(restartOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runRestart(context.Background(), rbgClient, args[0], util.GetNamespace(restartOpts.cf), os.Stdout)
+		},
+	}
+	restartOpts.cf = cf
+	restartCmd.Flags().StringSliceVar(&restartOpts.roles, "role", nil, "Name of the role to restart, may be repeated")
+
+	return restartCmd
+}
+
+func validateRestart(args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if len(restartOpts.roles) == 0 {
+		return fmt.Errorf("--role is required")
+	}
+	return nil
+}
+
+func runRestart(ctx context.Context, rbgClient versioned.Interface, name, namespace string, out io.Writer) error {
+	if err := util.RestartRoles(ctx, rbgClient, name, namespace, restartOpts.roles, now()); err != nil {
+		return err
+	}
+	for _, roleName := range restartOpts.roles {
+		_, _ = fmt.Fprintf(out, "rbg %s role %s restarted\n", name, roleName)
+	}
+	return nil
+}
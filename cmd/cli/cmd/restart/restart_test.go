@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restart
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+)
+
+func TestValidateRestart(t *testing.T) {
+	origOpts := restartOpts
+	defer func() { restartOpts = origOpts }()
+
+	restartOpts = RestartOptions{}
+	assert.EqualError(t, validateRestart([]string{"test-rbg"}), "--role is required")
+
+	restartOpts = RestartOptions{roles: []string{"prefill"}}
+	assert.NoError(t, validateRestart([]string{"test-rbg"}))
+	assert.EqualError(t, validateRestart([]string{""}), "rbg name is required")
+}
+
+func TestRunRestart(t *testing.T) {
+	origOpts := restartOpts
+	origNow := now
+	defer func() {
+		restartOpts = origOpts
+		now = origNow
+	}()
+	now = func() time.Time { return time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC) }
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{
+				{Name: "prefill"},
+				{Name: "decode", Annotations: map[string]string{"keep": "me"}},
+				{Name: "router"},
+			},
+		},
+	}
+	client := fakerbgclient.NewSimpleClientset(rbg)
+
+	restartOpts = RestartOptions{roles: []string{"prefill", "decode"}}
+	var out bytes.Buffer
+	assert.NoError(t, runRestart(context.TODO(), client, "test-rbg", "default", &out))
+	assert.Contains(t, out.String(), "rbg test-rbg role decode restarted")
+
+	got, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "test-rbg", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-02T15:04:05Z", got.Spec.Roles[0].Annotations[constants.RoleRestartedAtAnnotationKey])
+	assert.Equal(t, "2026-01-02T15:04:05Z", got.Spec.Roles[1].Annotations[constants.RoleRestartedAtAnnotationKey])
+	assert.Equal(t, "me", got.Spec.Roles[1].Annotations["keep"])
+	assert.Empty(t, got.Spec.Roles[2].Annotations)
+
+	restartOpts = RestartOptions{roles: []string{"missing"}}
+	assert.ErrorContains(t, runRestart(context.TODO(), client, "test-rbg", "default", &out), `role "missing" not found`)
+}
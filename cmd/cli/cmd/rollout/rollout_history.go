@@ -18,20 +18,24 @@ package rollout
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
-	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
 	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/pkg/utils"
 )
 
 var rolloutHistoryCmd = &cobra.Command{
@@ -53,7 +57,7 @@ var rolloutHistoryCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		return runRolloutHistory(context.Background(), rbgClient, k8sClient, args[0], util.GetNamespace(rolloutOpts.cf))
+		return runRolloutHistory(context.Background(), rbgClient, k8sClient, args[0], util.GetNamespace(rolloutOpts.cf), os.Stdout)
 	},
 }
 
@@ -71,7 +75,10 @@ func validateRolloutHistory(args []string) error {
 	return nil
 }
 
-func runRolloutHistory(ctx context.Context, rbgClient versioned.Interface, k8sClient kubernetes.Interface, rbgName, namespace string) error {
+func runRolloutHistory(
+	ctx context.Context, rbgClient versioned.Interface, k8sClient kubernetes.Interface,
+	rbgName, namespace string, out io.Writer,
+) error {
 	rbgObject, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, rbgName, metav1.GetOptions{})
 	if err != nil {
 		return err
@@ -79,53 +86,93 @@ func runRolloutHistory(ctx context.Context, rbgClient versioned.Interface, k8sCl
 	if rbgObject == nil {
 		return fmt.Errorf("RoleBasedGroup %s not found", rbgName)
 	}
-	// List ControllerRevision
-	revisions, err := k8sClient.AppsV1().
-		ControllerRevisions(namespace).
-		List(context.TODO(), metav1.ListOptions{
-			LabelSelector: fmt.Sprintf("%s=%s", constants.GroupNameLabelKey, rbgObject.Name),
-		})
+
+	items, err := util.ListOwnedRevisions(ctx, k8sClient, rbgObject)
 	if err != nil {
 		return err
 	}
 
-	history := revisions.Items
-	var items []*appsv1.ControllerRevision
-	for i := range history {
-		ref := metav1.GetControllerOfNoCopy(&history[i])
-		if ref == nil || ref.UID == rbgObject.GetUID() {
-			items = append(items, &history[i])
+	if rolloutOpts.revision > 0 {
+		for _, rev := range items {
+			if rev.Revision == rolloutOpts.revision {
+				return printRevisionDetail(out, rev)
+			}
 		}
+		return fmt.Errorf("revision %d not found", rolloutOpts.revision)
+	}
 
+	items = sortRevisionsStable(items)
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+	_, _ = fmt.Fprintln(w, "REVISION\tNAME\tCHANGE-CAUSE\tROLES")
+	for _, rev := range items {
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", rev.Revision, rev.Name, changeCause(rev), formatRoleHashes(rev))
 	}
+	return nil
+}
 
-	if rolloutOpts.revision > 0 {
-		for _, rev := range items {
-			if rev.Revision == rolloutOpts.revision {
-				scheme := runtime.NewScheme()
-				_ = workloadsv1alpha2.AddToScheme(scheme)
+// printRevisionDetail prints the metadata of a revision followed by the spec it stores.
+func printRevisionDetail(out io.Writer, rev *appsv1.ControllerRevision) error {
+	_, _ = fmt.Fprintf(out, "Name:          %s\n", rev.Name)
+	_, _ = fmt.Fprintf(out, "Revision:      %d\n", rev.Revision)
+	_, _ = fmt.Fprintf(out, "Change-Cause:  %s\n", changeCause(rev))
+	_, _ = fmt.Fprintf(out, "Roles:         %s\n", formatRoleHashes(rev))
+
+	content, err := revisionSpecYAML(rev)
+	if err != nil {
+		return fmt.Errorf("failed to decode revision %d: %w", rev.Revision, err)
+	}
+	_, _ = fmt.Fprintf(out, "Content:\n%s", content)
+	return nil
+}
 
-				serializer := json.NewSerializerWithOptions(
-					json.DefaultMetaFactory, scheme, scheme,
-					json.SerializerOptions{Yaml: true, Pretty: true},
-				)
+func changeCause(rev *appsv1.ControllerRevision) string {
+	if cause := rev.Annotations[constants.ChangeCauseAnnotationKey]; cause != "" {
+		return cause
+	}
+	return "<none>"
+}
 
-				if err := serializer.Encode(rev, os.Stdout); err != nil {
-					return err
+// formatRoleHashes renders the per-role hashes of a revision as "role=hash" pairs sorted by role name.
+func formatRoleHashes(rev *appsv1.ControllerRevision) string {
+	hashes, err := utils.GetRolesRevisionHash(rev)
+	if err != nil || len(hashes) == 0 {
+		return "<unknown>"
+	}
+	names := make([]string, 0, len(hashes))
+	for name := range hashes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s=%s", name, hashes[name]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// revisionSpecYAML converts the stored revision patch into YAML, dropping the
+// "$patch: replace" directives that are only meaningful when the revision is applied.
+func revisionSpecYAML(rev *appsv1.ControllerRevision) ([]byte, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal(rev.Data.Raw, &data); err != nil {
+		return nil, err
+	}
+	if spec, ok := data["spec"].(map[string]interface{}); ok {
+		for key, value := range spec {
+			list, ok := value.([]interface{})
+			if !ok {
+				continue
+			}
+			filtered := make([]interface{}, 0, len(list))
+			for _, item := range list {
+				if m, ok := item.(map[string]interface{}); ok && m["$patch"] != nil && len(m) == 1 {
+					continue
 				}
-				return nil
+				filtered = append(filtered, item)
 			}
+			spec[key] = filtered
 		}
-		return fmt.Errorf("revision %d not found", rolloutOpts.revision)
-	} else {
-		items = sortRevisionsStable(items)
-
-		fmt.Printf(
-			"%-36s %s\n", "Name", "Revision")
-		for _, rev := range items {
-			fmt.Printf(
-				"%-36s %d\n", rev.Name, rev.Revision)
-		}
-		return nil
 	}
+	return yaml.Marshal(data)
 }
@@ -16,6 +16,7 @@ limitations under the License.
 package rollout
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -111,6 +112,67 @@ func TestRunRolloutHistory(t *testing.T) {
 		rolloutOpts = old
 	}()
 	rolloutOpts.revision = 1
-	err := runRolloutHistory(context.TODO(), fakeRgbClient, fakeClient, "test-rbg", "default")
+	var out bytes.Buffer
+	err := runRolloutHistory(context.TODO(), fakeRgbClient, fakeClient, "test-rbg", "default", &out)
 	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "Name:          rev-1")
+	assert.Contains(t, out.String(), "name: role-1")
+
+	rolloutOpts.revision = 10
+	err = runRolloutHistory(context.TODO(), fakeRgbClient, fakeClient, "test-rbg", "default", &out)
+	assert.EqualError(t, err, "revision 10 not found")
+}
+
+func TestRunRolloutHistoryList(t *testing.T) {
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rbg",
+			Namespace: "default",
+			UID:       "12345",
+		},
+	}
+	revisions := []*appsv1.ControllerRevision{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rbg-abc-1",
+				Namespace: "default",
+				Labels:    map[string]string{constants.GroupNameLabelKey: "test-rbg"},
+			},
+			Data:     runtime.RawExtension{Raw: []byte(`{"spec":{"roles":[{"$patch":"replace"},{"name":"prefill"}]}}`)},
+			Revision: 1,
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-rbg-def-2",
+				Namespace:   "default",
+				Labels:      map[string]string{constants.GroupNameLabelKey: "test-rbg"},
+				Annotations: map[string]string{constants.ChangeCauseAnnotationKey: "bump image"},
+			},
+			Data:     runtime.RawExtension{Raw: []byte(`{"spec":{"roles":[{"$patch":"replace"},{"name":"prefill","labels":{"a":"b"}}]}}`)},
+			Revision: 2,
+		},
+	}
+	old := rolloutOpts
+	defer func() {
+		rolloutOpts = old
+	}()
+
+	rolloutOpts.revision = 0
+	var out bytes.Buffer
+	err := runRolloutHistory(context.TODO(), getFakeRgbClient([]*workloadsv1alpha2.RoleBasedGroup{rbg}),
+		getFakeK8sClient(revisions), "test-rbg", "default", &out)
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "CHANGE-CAUSE")
+	assert.Contains(t, out.String(), "bump image")
+	assert.Contains(t, out.String(), "<none>")
+	assert.Contains(t, out.String(), "prefill=")
+
+	rolloutOpts.revision = 2
+	out.Reset()
+	err = runRolloutHistory(context.TODO(), getFakeRgbClient([]*workloadsv1alpha2.RoleBasedGroup{rbg}),
+		getFakeK8sClient(revisions), "test-rbg", "default", &out)
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "Change-Cause:  bump image")
+	assert.NotContains(t, out.String(), "$patch")
+	assert.Contains(t, out.String(), "name: prefill")
 }
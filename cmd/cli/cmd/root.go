@@ -20,14 +20,51 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/autoscale"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/bench"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/chat"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/clone"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/compare"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/config"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/cost"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/cp"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/debug"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/delete"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/describe"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/diff"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/doctor"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/drainrole"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/events"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/exec"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/get"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/gpu"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/history"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/install"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/logs"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/metrics"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/migrate"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/pause"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/portforward"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/promote"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/restart"
 	"sigs.k8s.io/rbgs/cmd/cli/cmd/rollout"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/scale"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/scalegroup"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/set"
 	"sigs.k8s.io/rbgs/cmd/cli/cmd/status"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/supportbundle"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/suspend"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/template"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/top"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/ui"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/validate"
+	"sigs.k8s.io/rbgs/cmd/cli/cmd/wait"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
 	"sigs.k8s.io/rbgs/version"
 )
 
@@ -35,8 +72,11 @@ var (
 	cf *genericclioptions.ConfigFlags
 )
 
+// rootCmd is installed as the kubectl-rbg binary, which kubectl discovers as the "rbg"
+// plugin. The display name makes usage and help read "kubectl rbg ...".
 var rootCmd = &cobra.Command{
-	Use:               "rbg [command]",
+	Use:               "kubectl-rbg [command]",
+	Annotations:       map[string]string{cobra.CommandDisplayNameAnnotation: "kubectl rbg"},
 	Short:             "Kubectl plugin for RoleBasedGroup",
 	SilenceUsage:      true,
 	DisableAutoGenTag: true,
@@ -71,14 +111,47 @@ func init() {
 	cf.AddFlags(rootCmd.PersistentFlags())
 
 	rootCmd.AddCommand(status.NewStatusCmd(cf))
+	rootCmd.AddCommand(get.NewGetCmd(cf))
+	rootCmd.AddCommand(describe.NewDescribeCmd(cf))
+	rootCmd.AddCommand(events.NewEventsCmd(cf))
+	rootCmd.AddCommand(diff.NewDiffCmd(cf))
+	rootCmd.AddCommand(compare.NewCompareCmd(cf))
+	rootCmd.AddCommand(template.NewTemplateCmd(cf))
+	rootCmd.AddCommand(template.NewFnCmd())
+	rootCmd.AddCommand(clone.NewCloneCmd(cf))
+	rootCmd.AddCommand(validate.NewValidateCmd(cf))
+	rootCmd.AddCommand(logs.NewLogsCmd(cf))
+	rootCmd.AddCommand(exec.NewExecCmd(cf))
+	rootCmd.AddCommand(cp.NewCpCmd(cf))
+	rootCmd.AddCommand(debug.NewDebugCmd(cf))
 	rootCmd.AddCommand(rollout.NewRolloutCmd(cf))
+	rootCmd.AddCommand(history.NewHistoryCmd(cf))
+	rootCmd.AddCommand(promote.NewPromoteCmd(cf))
+	rootCmd.AddCommand(wait.NewWaitCmd(cf))
+	rootCmd.AddCommand(scale.NewScaleCmd(cf))
+	rootCmd.AddCommand(scalegroup.NewScaleGroupCmd(cf))
+	rootCmd.AddCommand(autoscale.NewAutoscaleCmd(cf))
+	rootCmd.AddCommand(set.NewSetCmd(cf))
+	rootCmd.AddCommand(top.NewTopCmd(cf))
+	rootCmd.AddCommand(metrics.NewMetricsCmd(cf))
+	rootCmd.AddCommand(cost.NewCostCmd(cf))
+	rootCmd.AddCommand(gpu.NewGPUCmd(cf))
+	rootCmd.AddCommand(ui.NewUICmd(cf))
+	rootCmd.AddCommand(delete.NewDeleteCmd(cf))
+	rootCmd.AddCommand(restart.NewRestartCmd(cf))
+	rootCmd.AddCommand(drainrole.NewDrainRoleCmd(cf))
+	rootCmd.AddCommand(pause.NewPauseCmd(cf))
+	rootCmd.AddCommand(pause.NewResumeCmd(cf))
+	rootCmd.AddCommand(suspend.NewSuspendCmd(cf))
+	rootCmd.AddCommand(suspend.NewUnsuspendCmd(cf))
+	rootCmd.AddCommand(portforward.NewPortForwardCmd(cf))
+	rootCmd.AddCommand(chat.NewChatCmd(cf))
+	rootCmd.AddCommand(bench.NewBenchCmd(cf))
+	rootCmd.AddCommand(doctor.NewDoctorCmd(cf))
+	rootCmd.AddCommand(supportbundle.NewSupportBundleCmd(cf))
+	rootCmd.AddCommand(migrate.NewMigrateCmd(cf))
+	rootCmd.AddCommand(install.NewInstallCmd(cf))
+	rootCmd.AddCommand(config.NewConfigCmd())
 
-	// Display "kubectl rbg" instead of "rbg" in usage/help output.
-	// This is the standard approach used by kubectl plugins (e.g. krew).
-	replacer := strings.NewReplacer(
-		"{{.UseLine}}", "kubectl {{.UseLine}}",
-		"{{.CommandPath}}", "kubectl {{.CommandPath}}",
-	)
-	rootCmd.SetUsageTemplate(replacer.Replace(rootCmd.UsageTemplate()))
-	rootCmd.SetHelpTemplate(replacer.Replace(rootCmd.HelpTemplate()))
+	util.RegisterCompletions(rootCmd, cf)
 }
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConfigFlagsInherited verifies every subcommand accepts the kubectl connection flags,
+// so the plugin behaves like native kubectl commands.
+func TestConfigFlagsInherited(t *testing.T) {
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		for _, name := range []string{"kubeconfig", "context", "namespace", "as", "as-group", "request-timeout", "server"} {
+			assert.NotNil(t, c.Flag(name), "%s: missing --%s", c.CommandPath(), name)
+		}
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+}
+
+func TestDisplayName(t *testing.T) {
+	statusCmd, _, err := rootCmd.Find([]string{"status"})
+	assert.NoError(t, err)
+	assert.Equal(t, "kubectl rbg status", statusCmd.CommandPath())
+}
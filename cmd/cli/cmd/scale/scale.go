@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultWaitTimeout = 5 * time.Minute
+)
+
+type ScaleOptions struct {
+	cf       *genericclioptions.ConfigFlags
+	role     string
+	replicas int32
+	wait     bool
+	timeout  time.Duration
+}
+
+var scaleOpts ScaleOptions
+
+// scalePollInterval is how often the rbg status is checked while waiting; overridden in tests.
+var scalePollInterval = 2 * time.Second
+
+func NewScaleCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	scaleCmd := &cobra.Command{
+		Use:                "scale <rbgName> --role <roleName> --replicas <count>",
+		Short:              "Set the replicas of a role in a rbg",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateScale(cmd, args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(scaleOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runScale(context.Background(), rbgClient, args[0], util.GetNamespace(scaleOpts.cf))
+		},
+	}
+	scaleOpts.cf = cf
+	scaleCmd.Flags().StringVar(&scaleOpts.role, "role", "", "Name of the role to scale")
+	scaleCmd.Flags().Int32Var(&scaleOpts.replicas, "replicas", 0, "The new desired number of replicas of the role")
+	scaleCmd.Flags().BoolVar(&scaleOpts.wait, "wait", false, "Wait until the role has the desired number of ready replicas")
+	scaleCmd.Flags().DurationVar(&scaleOpts.timeout, "timeout", defaultWaitTimeout, "The length of time to wait when --wait is set")
+
+	return scaleCmd
+}
+
+func validateScale(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	if scaleOpts.role == "" {
+		return fmt.Errorf("--role is required")
+	}
+	if cmd != nil && !cmd.Flags().Changed("replicas") {
+		return fmt.Errorf("--replicas is required")
+	}
+	if scaleOpts.replicas < 0 {
+		return fmt.Errorf("--replicas cannot be negative")
+	}
+	if scaleOpts.timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+	return nil
+}
+
+func runScale(ctx context.Context, rbgClient versioned.Interface, name, namespace string) error {
+	if err := util.ScaleRole(ctx, rbgClient, name, namespace, scaleOpts.role, scaleOpts.replicas); err != nil {
+		return err
+	}
+	fmt.Printf("rbg %s role %s scaled to %d\n", name, scaleOpts.role, scaleOpts.replicas)
+
+	if !scaleOpts.wait {
+		return nil
+	}
+	return waitForRoleReady(ctx, rbgClient, name, namespace)
+}
+
+func waitForRoleReady(ctx context.Context, rbgClient versioned.Interface, name, namespace string) error {
+	err := wait.PollUntilContextTimeout(ctx, scalePollInterval, scaleOpts.timeout, true,
+		func(ctx context.Context) (bool, error) {
+			rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return roleScaled(rbg, scaleOpts.role, scaleOpts.replicas), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed waiting for role %s to become ready: %w", scaleOpts.role, err)
+	}
+	fmt.Printf("rbg %s role %s has %d ready replicas\n", name, scaleOpts.role, scaleOpts.replicas)
+	return nil
+}
+
+// roleScaled reports whether the controller has observed the latest spec and the role
+// runs exactly the desired number of ready replicas.
+func roleScaled(rbg *workloadsv1alpha2.RoleBasedGroup, role string, replicas int32) bool {
+	if rbg.Status.ObservedGeneration < rbg.Generation {
+		return false
+	}
+	status, found := rbg.GetRoleStatus(role)
+	if !found {
+		return replicas == 0
+	}
+	return status.Replicas == replicas && status.ReadyReplicas == replicas
+}
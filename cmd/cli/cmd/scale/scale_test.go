@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestValidateScale(t *testing.T) {
+	origOpts := scaleOpts
+	defer func() { scaleOpts = origOpts }()
+
+	cmd := NewScaleCmd(genericclioptions.NewConfigFlags(true))
+
+	scaleOpts.role = ""
+	assert.EqualError(t, validateScale(cmd, []string{"test-rbg"}), "--role is required")
+
+	scaleOpts.role = "decode"
+	assert.EqualError(t, validateScale(cmd, []string{"test-rbg"}), "--replicas is required")
+
+	assert.NoError(t, cmd.Flags().Set("replicas", "-1"))
+	assert.EqualError(t, validateScale(cmd, []string{"test-rbg"}), "--replicas cannot be negative")
+
+	assert.NoError(t, cmd.Flags().Set("replicas", "6"))
+	assert.NoError(t, validateScale(cmd, []string{"test-rbg"}))
+	assert.EqualError(t, validateScale(cmd, []string{""}), "rbg name is required")
+}
+
+func TestRunScale(t *testing.T) {
+	origOpts := scaleOpts
+	defer func() { scaleOpts = origOpts }()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithGeneration(1).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(1).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{
+			ObservedGeneration: 1,
+			RoleStatuses:       []workloadsv1alpha2.RoleStatus{{Name: "decode", Replicas: 6, ReadyReplicas: 6}},
+		}).Obj()
+
+	t.Run("scale existing role", func(t *testing.T) {
+		client := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		scaleOpts = ScaleOptions{role: "decode", replicas: 6, timeout: time.Second}
+
+		assert.NoError(t, runScale(context.TODO(), client, "test-rbg", "default"))
+
+		rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "test-rbg", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), *rbg.Spec.Roles[0].Replicas)
+		assert.Equal(t, int32(6), *rbg.Spec.Roles[1].Replicas)
+	})
+
+	t.Run("role without replicas", func(t *testing.T) {
+		rbg := rbg.DeepCopy()
+		rbg.Spec.Roles[0].Replicas = nil
+		client := fakerbgclient.NewSimpleClientset(rbg)
+		scaleOpts = ScaleOptions{role: "prefill", replicas: 3, timeout: time.Second}
+
+		assert.NoError(t, runScale(context.TODO(), client, "test-rbg", "default"))
+
+		rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "test-rbg", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), *rbg.Spec.Roles[0].Replicas)
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		client := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		scaleOpts = ScaleOptions{role: "router", replicas: 1, timeout: time.Second}
+
+		assert.ErrorContains(t, runScale(context.TODO(), client, "test-rbg", "default"), `role "router" not found`)
+	})
+
+	t.Run("wait until ready", func(t *testing.T) {
+		origInterval := scalePollInterval
+		scalePollInterval = 10 * time.Millisecond
+		defer func() { scalePollInterval = origInterval }()
+
+		client := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		scaleOpts = ScaleOptions{role: "decode", replicas: 6, wait: true, timeout: time.Second}
+		assert.NoError(t, runScale(context.TODO(), client, "test-rbg", "default"))
+
+		scaleOpts = ScaleOptions{role: "prefill", replicas: 4, wait: true, timeout: 50 * time.Millisecond}
+		assert.Error(t, runScale(context.TODO(), client, "test-rbg", "default"))
+	})
+}
+
+func TestRoleScaled(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithGeneration(1).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(1).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{
+			ObservedGeneration: 1,
+			RoleStatuses:       []workloadsv1alpha2.RoleStatus{{Name: "decode", Replicas: 6, ReadyReplicas: 6}},
+		}).Obj()
+	assert.True(t, roleScaled(rbg, "decode", 6))
+	assert.False(t, roleScaled(rbg, "decode", 4))
+	assert.False(t, roleScaled(rbg, "prefill", 1))
+	assert.True(t, roleScaled(rbg, "prefill", 0))
+
+	rbg.Generation = 2
+	assert.False(t, roleScaled(rbg, "decode", 6))
+}
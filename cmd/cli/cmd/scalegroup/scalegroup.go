@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalegroup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type ScaleGroupOptions struct {
+	cf     *genericclioptions.ConfigFlags
+	factor float64
+	gpus   int64
+	dryRun bool
+}
+
+var scaleGroupOpts ScaleGroupOptions
+
+func NewScaleGroupCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	scaleGroupCmd := &cobra.Command{
+		Use:   "scale-group <rbgName> (--factor <factor> | --gpus <count>)",
+		Short: "Scale every role of a rbg proportionally",
+		Long: `Scale every role of a rbg proportionally, keeping the ratio between the roles.
+
+With --factor the replicas of every role are multiplied by the factor and rounded. With
+--gpus the rbg is resized to the largest multiple of its role ratio that fits in the GPU
+budget, e.g. a 2:1 prefill:decode rbg stays 2:1. Roles are scaled in a single update.`,
+		Example: `  kubectl rbg scale-group my-rbg --factor 2
+  kubectl rbg scale-group my-rbg --gpus 32 --dry-run`,
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateScaleGroup(cmd, args); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(scaleGroupOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runScaleGroup(context.Background(), rbgClient, args[0], util.GetNamespace(scaleGroupOpts.cf), os.Stdout)
+		},
+	}
+	scaleGroupOpts.cf = cf
+	scaleGroupCmd.Flags().Float64Var(&scaleGroupOpts.factor, "factor", 0, "Multiply the replicas of every role by this factor")
+	scaleGroupCmd.Flags().Int64Var(&scaleGroupOpts.gpus, "gpus", 0, "Resize the rbg to fit in this number of GPUs")
+	scaleGroupCmd.Flags().BoolVar(&scaleGroupOpts.dryRun, "dry-run", false, "Only print the new replicas of the roles")
+	scaleGroupCmd.MarkFlagsMutuallyExclusive("factor", "gpus")
+
+	return scaleGroupCmd
+}
+
+func validateScaleGroup(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 || len(args[0]) == 0 {
+		return fmt.Errorf("rbg name is required")
+	}
+	factorSet := cmd != nil && cmd.Flags().Changed("factor")
+	gpusSet := cmd != nil && cmd.Flags().Changed("gpus")
+	if !factorSet && !gpusSet {
+		return fmt.Errorf("one of --factor or --gpus is required")
+	}
+	if factorSet && scaleGroupOpts.factor <= 0 {
+		return fmt.Errorf("--factor must be positive")
+	}
+	if gpusSet && scaleGroupOpts.gpus <= 0 {
+		return fmt.Errorf("--gpus must be positive")
+	}
+	return nil
+}
+
+// roleScale is the current and new size of a role.
+type roleScale struct {
+	name string
+	// gpus is the number of GPUs of one replica of the role.
+	gpus        int64
+	replicas    int32
+	newReplicas int32
+}
+
+func runScaleGroup(ctx context.Context, rbgClient versioned.Interface, name, namespace string, out io.Writer) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	roles, err := currentScale(rbg)
+	if err != nil {
+		return err
+	}
+	if scaleGroupOpts.gpus > 0 {
+		err = scaleToGPUs(roles, scaleGroupOpts.gpus)
+	} else {
+		scaleByFactor(roles, scaleGroupOpts.factor)
+	}
+	if err != nil {
+		return err
+	}
+
+	printPlan(out, roles)
+	if scaleGroupOpts.dryRun {
+		_, _ = fmt.Fprintf(out, "\nrbg %s not scaled (dry run)\n", name)
+		return nil
+	}
+	replicas := map[string]int32{}
+	for _, r := range roles {
+		if r.newReplicas != r.replicas {
+			replicas[r.name] = r.newReplicas
+		}
+	}
+	if len(replicas) == 0 {
+		_, _ = fmt.Fprintf(out, "\nrbg %s already has the requested size\n", name)
+		return nil
+	}
+	if err := util.ScaleRoles(ctx, rbgClient, name, namespace, replicas); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "\nrbg %s scaled\n", name)
+	return nil
+}
+
+func currentScale(rbg *workloadsv1alpha2.RoleBasedGroup) ([]roleScale, error) {
+	roles := make([]roleScale, 0, len(rbg.Spec.Roles))
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		gpus, err := replicaGPUs(rbg, role)
+		if err != nil {
+			return nil, fmt.Errorf("role %s: %w", role.Name, err)
+		}
+		replicas := int32(1)
+		if role.Replicas != nil {
+			replicas = *role.Replicas
+		}
+		roles = append(roles, roleScale{name: role.Name, gpus: gpus, replicas: replicas})
+	}
+	return roles, nil
+}
+
+// replicaGPUs counts the GPUs of one replica of a role, which is a group of pods for the
+// leader-worker and custom components patterns.
+func replicaGPUs(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec) (int64, error) {
+	if ccp := role.GetCustomComponentsPattern(); ccp != nil {
+		var total int64
+		for i := range ccp.Components {
+			size := int64(1)
+			if ccp.Components[i].Size != nil {
+				size = int64(*ccp.Components[i].Size)
+			}
+			total += size * templateGPUs(&ccp.Components[i].Template)
+		}
+		return total, nil
+	}
+	template, err := role.GetResolvedTemplate(rbg)
+	if err != nil {
+		return 0, err
+	}
+	size := int64(1)
+	if lwSize := role.GetLeaderWorkerSize(); lwSize != nil {
+		size = int64(*lwSize)
+	}
+	return size * templateGPUs(&template), nil
+}
+
+func templateGPUs(template *corev1.PodTemplateSpec) int64 {
+	var total int64
+	for _, count := range util.PodGPUs(&corev1.Pod{Spec: template.Spec}) {
+		total += count
+	}
+	return total
+}
+
+// scaleByFactor multiplies the replicas of every role. A role keeps at least one replica
+// so that a small factor does not remove it from the group.
+func scaleByFactor(roles []roleScale, factor float64) {
+	for i := range roles {
+		r := &roles[i]
+		r.newReplicas = int32(math.Round(float64(r.replicas) * factor))
+		if r.replicas > 0 && r.newReplicas == 0 {
+			r.newReplicas = 1
+		}
+	}
+}
+
+// scaleToGPUs resizes the roles to the largest multiple of their smallest integer ratio
+// that fits in the GPU budget.
+func scaleToGPUs(roles []roleScale, budget int64) error {
+	var divisor int32
+	for _, r := range roles {
+		divisor = gcd(divisor, r.replicas)
+	}
+	if divisor == 0 {
+		return fmt.Errorf("the roles have no replicas to keep the ratio of")
+	}
+	var unitGPUs int64
+	for _, r := range roles {
+		unitGPUs += int64(r.replicas/divisor) * r.gpus
+	}
+	if unitGPUs == 0 {
+		return fmt.Errorf("the roles request no GPUs, use --factor instead")
+	}
+	multiple := budget / unitGPUs
+	if multiple == 0 {
+		return fmt.Errorf("%d GPUs are not enough to keep the ratio of the roles, at least %d are required", budget, unitGPUs)
+	}
+	for i := range roles {
+		roles[i].newReplicas = roles[i].replicas / divisor * int32(multiple)
+	}
+	return nil
+}
+
+func gcd(a, b int32) int32 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func printPlan(out io.Writer, roles []roleScale) {
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, "ROLE\tGPUS/REPLICA\tREPLICAS\tNEW REPLICAS\tGPUS\tNEW GPUS")
+	var gpus, newGPUs int64
+	for _, r := range roles {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n", r.name, r.gpus, r.replicas, r.newReplicas,
+			int64(r.replicas)*r.gpus, int64(r.newReplicas)*r.gpus)
+		gpus += int64(r.replicas) * r.gpus
+		newGPUs += int64(r.newReplicas) * r.gpus
+	}
+	_, _ = fmt.Fprintf(w, "TOTAL\t\t\t\t%d\t%d\n", gpus, newGPUs)
+}
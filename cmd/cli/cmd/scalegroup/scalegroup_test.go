@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalegroup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func gpuTemplate(gpus int64) *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+		Name: "engine",
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{"nvidia.com/gpu": *resource.NewQuantity(gpus, resource.DecimalSI)},
+		},
+	}}}}
+}
+
+func TestValidateScaleGroup(t *testing.T) {
+	origOpts := scaleGroupOpts
+	defer func() { scaleGroupOpts = origOpts }()
+
+	cmd := NewScaleGroupCmd(genericclioptions.NewConfigFlags(true))
+	assert.EqualError(t, validateScaleGroup(cmd, []string{"test-rbg"}), "one of --factor or --gpus is required")
+
+	require.NoError(t, cmd.Flags().Set("factor", "0"))
+	assert.EqualError(t, validateScaleGroup(cmd, []string{"test-rbg"}), "--factor must be positive")
+	require.NoError(t, cmd.Flags().Set("factor", "1.5"))
+	assert.NoError(t, validateScaleGroup(cmd, []string{"test-rbg"}))
+	assert.EqualError(t, validateScaleGroup(cmd, []string{""}), "rbg name is required")
+
+	cmd = NewScaleGroupCmd(genericclioptions.NewConfigFlags(true))
+	require.NoError(t, cmd.Flags().Set("gpus", "-8"))
+	assert.EqualError(t, validateScaleGroup(cmd, []string{"test-rbg"}), "--gpus must be positive")
+}
+
+func TestCurrentScale(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").WithReplicas(1).WithTemplate(gpuTemplate(0)).Obj(),
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(gpuTemplate(2)).Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode").WithReplicas(1).WithSize(2).WithTemplate(gpuTemplate(4)).Obj(),
+		}).Obj()
+	roles, err := currentScale(rbg)
+	require.NoError(t, err)
+	assert.Equal(t, []roleScale{
+		{name: "router", gpus: 0, replicas: 1},
+		{name: "prefill", gpus: 2, replicas: 2},
+		{name: "decode", gpus: 8, replicas: 1},
+	}, roles)
+
+	rbg.Spec.Roles[0].StandalonePattern.Template = nil
+	_, err = currentScale(rbg)
+	assert.EqualError(t, err, "role router: role router has no template or templateRef set")
+}
+
+func TestScaleByFactor(t *testing.T) {
+	roles := []roleScale{{replicas: 3}, {replicas: 1}, {replicas: 0}}
+	scaleByFactor(roles, 2)
+	assert.Equal(t, []int32{6, 2, 0}, []int32{roles[0].newReplicas, roles[1].newReplicas, roles[2].newReplicas})
+
+	scaleByFactor(roles, 0.5)
+	assert.Equal(t, []int32{2, 1, 0}, []int32{roles[0].newReplicas, roles[1].newReplicas, roles[2].newReplicas})
+}
+
+func TestScaleToGPUs(t *testing.T) {
+	roles := []roleScale{{gpus: 0, replicas: 2}, {gpus: 2, replicas: 4}, {gpus: 8, replicas: 2}}
+	// The ratio is 1:2:1, each unit has 12 GPUs.
+	require.NoError(t, scaleToGPUs(roles, 40))
+	assert.Equal(t, []int32{3, 6, 3}, []int32{roles[0].newReplicas, roles[1].newReplicas, roles[2].newReplicas})
+
+	assert.EqualError(t, scaleToGPUs(roles, 8), "8 GPUs are not enough to keep the ratio of the roles, at least 12 are required")
+	assert.EqualError(t, scaleToGPUs([]roleScale{{gpus: 0, replicas: 2}}, 8), "the roles request no GPUs, use --factor instead")
+	assert.EqualError(t, scaleToGPUs([]roleScale{{gpus: 1}}, 8), "the roles have no replicas to keep the ratio of")
+}
+
+func TestRunScaleGroup(t *testing.T) {
+	origOpts := scaleGroupOpts
+	defer func() { scaleGroupOpts = origOpts }()
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").WithReplicas(1).WithTemplate(gpuTemplate(0)).Obj(),
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithTemplate(gpuTemplate(2)).Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode").WithReplicas(1).WithSize(2).WithTemplate(gpuTemplate(4)).Obj(),
+		}).Obj()
+	client := fakerbgclient.NewSimpleClientset(rbg)
+	replicas := func() []int32 {
+		rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "test-rbg", metav1.GetOptions{})
+		require.NoError(t, err)
+		var out []int32
+		for _, role := range rbg.Spec.Roles {
+			out = append(out, *role.Replicas)
+		}
+		return out
+	}
+
+	scaleGroupOpts = ScaleGroupOptions{gpus: 32, dryRun: true}
+	var out bytes.Buffer
+	require.NoError(t, runScaleGroup(context.TODO(), client, "test-rbg", "default", &out))
+	assert.Regexp(t, `prefill\s+2\s+2\s+4\s+4\s+8`, out.String())
+	assert.Regexp(t, `decode\s+8\s+1\s+2\s+8\s+16`, out.String())
+	assert.Regexp(t, `TOTAL\s+12\s+24`, out.String())
+	assert.Contains(t, out.String(), "rbg test-rbg not scaled (dry run)")
+	assert.Equal(t, []int32{1, 2, 1}, replicas())
+
+	scaleGroupOpts = ScaleGroupOptions{factor: 2}
+	out.Reset()
+	require.NoError(t, runScaleGroup(context.TODO(), client, "test-rbg", "default", &out))
+	assert.Contains(t, out.String(), "rbg test-rbg scaled")
+	assert.Equal(t, []int32{2, 4, 2}, replicas())
+
+	scaleGroupOpts = ScaleGroupOptions{factor: 1}
+	out.Reset()
+	require.NoError(t, runScaleGroup(context.TODO(), client, "test-rbg", "default", &out))
+	assert.Contains(t, out.String(), "rbg test-rbg already has the requested size")
+
+	assert.Error(t, runScaleGroup(context.TODO(), client, "absent", "default", &out))
+}
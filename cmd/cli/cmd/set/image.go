@@ -0,0 +1,382 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package set
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type SetImageOptions struct {
+	cf          *genericclioptions.ConfigFlags
+	changeCause string
+}
+
+var setImageOpts SetImageOptions
+
+// imageUpdate is one ROLE[/CONTAINER]=IMAGE argument.
+type imageUpdate struct {
+	role      string
+	container string
+	image     string
+}
+
+func NewSetImageCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	setImageCmd := &cobra.Command{
+		Use:   "image <rbgName> ROLE[/CONTAINER]=IMAGE [...]",
+		Short: "Update the container images of roles of a rbg",
+		Long: `Update the container images of roles of a rbg.
+
+Only the given roles change, the others keep their pods. The container may be omitted
+when the role runs a single container. Roles that reference a shared role template get
+the image in their own template patch, so the other roles using the template are not
+affected; image overrides in leader and worker template patches are updated as well.
+
+Images given without a registry are prefixed with the default-registry of the CLI
+configuration, see 'kubectl rbg config'.
+
+The change is recorded in the kubernetes.io/change-cause annotation, which rollout
+history shows for the resulting revision.`,
+		Example: `  kubectl rbg set image my-rbg decode=lmsysorg/sglang:v0.4.9
+  kubectl rbg set image my-rbg prefill/engine=lmsysorg/sglang:v0.4.9 decode/engine=lmsysorg/sglang:v0.4.9
+  kubectl rbg set image my-rbg router=rbg/router:v2 --change-cause "router with sticky sessions"`,
+		Args:               cobra.MinimumNArgs(2),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			updates, err := parseImageUpdates(args[1:])
+			if err != nil {
+				return err
+			}
+			for i := range updates {
+				updates[i].image = util.ResolveImage(updates[i].image)
+			}
+			rbgClient, err := util.GetRBGClient(setImageOpts.cf)
+			if err != nil {
+				return err
+			}
+			changeCause := setImageOpts.changeCause
+			if changeCause == "" {
+				changeCause = "set image " + strings.Join(args[1:], " ")
+			}
+			return runSetImage(context.Background(), rbgClient, args[0], util.GetNamespace(setImageOpts.cf),
+				updates, changeCause, os.Stdout)
+		},
+	}
+	setImageOpts.cf = cf
+	setImageCmd.Flags().StringVar(&setImageOpts.changeCause, "change-cause", "",
+		"Change cause recorded on the rbg, defaults to the command arguments")
+
+	return setImageCmd
+}
+
+func parseImageUpdates(args []string) ([]imageUpdate, error) {
+	updates := make([]imageUpdate, 0, len(args))
+	seen := map[string]bool{}
+	for _, arg := range args {
+		target, image, ok := strings.Cut(arg, "=")
+		if !ok || target == "" || image == "" {
+			return nil, fmt.Errorf("invalid image update %q, must be ROLE[/CONTAINER]=IMAGE", arg)
+		}
+		role, container, _ := strings.Cut(target, "/")
+		if role == "" {
+			return nil, fmt.Errorf("invalid image update %q, the role is empty", arg)
+		}
+		if seen[target] {
+			return nil, fmt.Errorf("image of %s is set more than once", target)
+		}
+		seen[target] = true
+		updates = append(updates, imageUpdate{role: role, container: container, image: image})
+	}
+	return updates, nil
+}
+
+func runSetImage(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	name, namespace string,
+	updates []imageUpdate,
+	changeCause string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+
+	var ops []util.PatchOp
+	var changes []string
+	for _, update := range updates {
+		roleOps, change, err := planImageUpdate(rbg, update)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, roleOps...)
+		changes = append(changes, change)
+	}
+	if len(ops) == 0 {
+		_, _ = fmt.Fprintf(out, "rbg %s unchanged, the images are already set\n", name)
+		return nil
+	}
+
+	if rbg.Annotations == nil {
+		ops = append(ops, util.PatchOp{
+			Op: "add", Path: "/metadata/annotations",
+			Value: map[string]string{constants.ChangeCauseAnnotationKey: changeCause},
+		})
+	} else {
+		ops = append(ops, util.PatchOp{
+			Op: "add", Path: "/metadata/annotations/" + escapePointer(constants.ChangeCauseAnnotationKey),
+			Value: changeCause,
+		})
+	}
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Patch(
+		ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to update images: %w", err)
+	}
+	for _, change := range changes {
+		_, _ = fmt.Fprintf(out, "rbg %s %s\n", name, change)
+	}
+	return nil
+}
+
+// planImageUpdate returns the patch operations setting the image of the role container.
+func planImageUpdate(rbg *workloadsv1alpha2.RoleBasedGroup, update imageUpdate) ([]util.PatchOp, string, error) {
+	index, err := util.RoleIndex(rbg, update.role)
+	if err != nil {
+		return nil, "", err
+	}
+	role := &rbg.Spec.Roles[index]
+
+	var ops []util.PatchOp
+	templates, err := roleTemplates(rbg, role)
+	if err != nil {
+		return nil, "", err
+	}
+	container := update.container
+	if container == "" {
+		if container, err = soleContainer(role.Name, templates); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// The rbg is updated along with the operations, so that a later update of the same role
+	// builds on this one instead of overwriting the template patches with stale content.
+	found := false
+	for _, t := range templates {
+		kind, j := findContainer(&t.template.Spec, container)
+		if j < 0 {
+			continue
+		}
+		found = true
+		current := &t.template.Spec.Containers
+		if kind == "initContainers" {
+			current = &t.template.Spec.InitContainers
+		}
+		if (*current)[j].Image == update.image {
+			continue
+		}
+		if t.ref == nil {
+			(*current)[j].Image = update.image
+			ops = append(ops, util.PatchOp{
+				Op: "replace", Path: fmt.Sprintf("%s/spec/%s/%d/image", t.path, kind, j), Value: update.image,
+			})
+			continue
+		}
+		patch, err := setPatchImage(t.ref.Patch, kind, container, update.image, true)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid template patch of role %s: %w", role.Name, err)
+		}
+		t.ref.Patch = &runtime.RawExtension{Raw: mustMarshal(patch)}
+		ops = append(ops, util.PatchOp{Op: "add", Path: t.path, Value: patch})
+	}
+	if !found {
+		return nil, "", fmt.Errorf("role %s has no container %q", role.Name, container)
+	}
+
+	// Leader and worker patches are applied last, an image they set would win over the template.
+	if lwp := role.GetLeaderWorkerPattern(); lwp != nil {
+		for _, p := range []struct {
+			raw  **runtime.RawExtension
+			path string
+		}{
+			{&lwp.LeaderTemplatePatch, "/leaderWorkerPattern/leaderTemplatePatch"},
+			{&lwp.WorkerTemplatePatch, "/leaderWorkerPattern/workerTemplatePatch"},
+		} {
+			if *p.raw == nil || len((*p.raw).Raw) == 0 {
+				continue
+			}
+			for _, kind := range []string{"containers", "initContainers"} {
+				patch, err := setPatchImage(*p.raw, kind, container, update.image, false)
+				if err != nil {
+					return nil, "", fmt.Errorf("invalid template patch of role %s: %w", role.Name, err)
+				}
+				if patch != nil {
+					*p.raw = &runtime.RawExtension{Raw: mustMarshal(patch)}
+					ops = append(ops, util.PatchOp{Op: "add", Path: p.path, Value: patch})
+				}
+			}
+		}
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Sprintf("role %s container %s image unchanged", role.Name, container), nil
+	}
+	change := fmt.Sprintf("role %s container %s image set to %s", role.Name, container, update.image)
+	return util.GuardedRoleOps(index, role.Name, ops...), change, nil
+}
+
+// roleTemplate is a pod template of a role at path, relative to the role. Templates resolved from a
+// role template are changed through the template patch of the role, at path, instead of in place.
+type roleTemplate struct {
+	template *corev1.PodTemplateSpec
+	path     string
+	ref      *workloadsv1alpha2.TemplateRef
+}
+
+func roleTemplates(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec) ([]roleTemplate, error) {
+	if ccp := role.GetCustomComponentsPattern(); ccp != nil {
+		templates := make([]roleTemplate, 0, len(ccp.Components))
+		for i := range ccp.Components {
+			templates = append(templates, roleTemplate{
+				template: &ccp.Components[i].Template,
+				path:     fmt.Sprintf("/customComponentsPattern/components/%d/template", i),
+			})
+		}
+		return templates, nil
+	}
+
+	patternPath := "/standalonePattern"
+	if role.IsLeaderWorkerPattern() {
+		patternPath = "/leaderWorkerPattern"
+	}
+	if ref := role.GetTemplateRef(); ref != nil {
+		resolved, err := role.GetResolvedTemplate(rbg)
+		if err != nil {
+			return nil, err
+		}
+		return []roleTemplate{{template: &resolved, path: patternPath + "/templateRef/patch", ref: ref}}, nil
+	}
+	if template := role.GetTemplate(); template != nil {
+		return []roleTemplate{{template: template, path: patternPath + "/template"}}, nil
+	}
+	return nil, fmt.Errorf("role %s has no pod template", role.Name)
+}
+
+// soleContainer returns the container of a role running a single one.
+func soleContainer(role string, templates []roleTemplate) (string, error) {
+	names := map[string]bool{}
+	for _, t := range templates {
+		for _, c := range t.template.Spec.Containers {
+			names[c.Name] = true
+		}
+	}
+	if len(names) == 1 {
+		for name := range names {
+			return name, nil
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return "", fmt.Errorf("role %s has %d containers [%s], use %s/CONTAINER=IMAGE",
+		role, len(sorted), strings.Join(sorted, ", "), role)
+}
+
+func findContainer(spec *corev1.PodSpec, name string) (string, int) {
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == name {
+			return "containers", i
+		}
+	}
+	for i := range spec.InitContainers {
+		if spec.InitContainers[i].Name == name {
+			return "initContainers", i
+		}
+	}
+	return "", -1
+}
+
+// setPatchImage sets the image of the named container in a strategic merge patch of a pod
+// template. The container entry is added when missing only if add is set; nil is returned
+// when the patch is left unchanged.
+func setPatchImage(raw *runtime.RawExtension, kind, container, image string, add bool) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+	if raw != nil && len(raw.Raw) > 0 {
+		if err := json.Unmarshal(raw.Raw, &patch); err != nil {
+			return nil, err
+		}
+	}
+	spec, _ := patch["spec"].(map[string]interface{})
+	if spec == nil {
+		spec = map[string]interface{}{}
+	}
+	containers, _ := spec[kind].([]interface{})
+	for _, item := range containers {
+		entry, ok := item.(map[string]interface{})
+		if !ok || entry["name"] != container {
+			continue
+		}
+		if _, hasImage := entry["image"]; !hasImage && !add {
+			return nil, nil
+		}
+		if entry["image"] == image {
+			return nil, nil
+		}
+		entry["image"] = image
+		return patch, nil
+	}
+	if !add {
+		return nil, nil
+	}
+	spec[kind] = append(containers, map[string]interface{}{"name": container, "image": image})
+	patch["spec"] = spec
+	return patch, nil
+}
+
+func mustMarshal(v interface{}) []byte {
+	data, _ := json.Marshal(v)
+	return data
+}
+
+// escapePointer escapes a key for use in a JSON pointer.
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package set
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func podTemplate(containers ...corev1.Container) *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: containers}}
+}
+
+func TestParseImageUpdates(t *testing.T) {
+	updates, err := parseImageUpdates([]string{"decode=lmsysorg/sglang:v0.4.9", "router/metrics=rbg/exporter:v2"})
+	require.NoError(t, err)
+	assert.Equal(t, []imageUpdate{
+		{role: "decode", image: "lmsysorg/sglang:v0.4.9"},
+		{role: "router", container: "metrics", image: "rbg/exporter:v2"},
+	}, updates)
+
+	_, err = parseImageUpdates([]string{"decode"})
+	assert.EqualError(t, err, `invalid image update "decode", must be ROLE[/CONTAINER]=IMAGE`)
+	_, err = parseImageUpdates([]string{"/engine=img"})
+	assert.EqualError(t, err, `invalid image update "/engine=img", the role is empty`)
+	_, err = parseImageUpdates([]string{"decode=a", "decode=b"})
+	assert.EqualError(t, err, "image of decode is set more than once")
+}
+
+func TestRunSetImage(t *testing.T) {
+	get := func(client *fakerbgclient.Clientset) *workloadsv1alpha2.RoleBasedGroup {
+		rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "test-rbg", metav1.GetOptions{})
+		require.NoError(t, err)
+		return rbg
+	}
+
+	sglang := podTemplate(corev1.Container{Name: "engine", Image: "lmsysorg/sglang:v0.4.8"})
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoleTemplates([]workloadsv1alpha2.RoleTemplate{{Name: "sglang", Template: *sglang}}).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").WithTemplate(podTemplate(
+				corev1.Container{Name: "router", Image: "rbg/router:v1"},
+				corev1.Container{Name: "metrics", Image: "rbg/exporter:v1"},
+			)).Obj(),
+			wrappersv2.BuildStandaloneRole("prefill").WithTemplateRef("sglang").Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode").
+				WithPatchRef("sglang", &runtime.RawExtension{Raw: []byte(`{"metadata":{"labels":{"tier":"decode"}}}`)}).
+				WithWorkerTemplatePatch(&runtime.RawExtension{
+					Raw: []byte(`{"spec":{"containers":[{"name":"engine","image":"lmsysorg/sglang:v0.4.8-worker"}]}}`),
+				}).Obj(),
+		}).Obj()
+
+	t.Run("inline template", func(t *testing.T) {
+		client := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		var out bytes.Buffer
+		require.NoError(t, runSetImage(context.TODO(), client, "test-rbg", "default",
+			[]imageUpdate{{role: "router", container: "metrics", image: "rbg/exporter:v2"}}, "bump exporter", &out))
+		assert.Equal(t, "rbg test-rbg role router container metrics image set to rbg/exporter:v2\n", out.String())
+
+		rbg := get(client)
+		containers := rbg.Spec.Roles[0].GetTemplate().Spec.Containers
+		assert.Equal(t, "rbg/router:v1", containers[0].Image)
+		assert.Equal(t, "rbg/exporter:v2", containers[1].Image)
+		assert.Equal(t, "bump exporter", rbg.Annotations[constants.ChangeCauseAnnotationKey])
+	})
+
+	t.Run("role template is patched per role", func(t *testing.T) {
+		client := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		var out bytes.Buffer
+		require.NoError(t, runSetImage(context.TODO(), client, "test-rbg", "default",
+			[]imageUpdate{{role: "decode", image: "lmsysorg/sglang:v0.4.9"}}, "set image decode=lmsysorg/sglang:v0.4.9", &out))
+		assert.Equal(t, "rbg test-rbg role decode container engine image set to lmsysorg/sglang:v0.4.9\n", out.String())
+
+		rbg := get(client)
+		assert.Equal(t, "lmsysorg/sglang:v0.4.8", rbg.Spec.RoleTemplates[0].Template.Spec.Containers[0].Image)
+		prefill, err := rbg.Spec.Roles[1].GetResolvedTemplate(rbg)
+		require.NoError(t, err)
+		assert.Equal(t, "lmsysorg/sglang:v0.4.8", prefill.Spec.Containers[0].Image)
+
+		decode, err := rbg.Spec.Roles[2].GetResolvedTemplate(rbg)
+		require.NoError(t, err)
+		assert.Equal(t, "lmsysorg/sglang:v0.4.9", decode.Spec.Containers[0].Image)
+		assert.Equal(t, "decode", decode.Labels["tier"])
+		assert.JSONEq(t, `{"spec":{"containers":[{"name":"engine","image":"lmsysorg/sglang:v0.4.9"}]}}`,
+			string(rbg.Spec.Roles[2].GetWorkerTemplatePatch().Raw))
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		client := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		var out bytes.Buffer
+		require.NoError(t, runSetImage(context.TODO(), client, "test-rbg", "default",
+			[]imageUpdate{{role: "prefill", image: "lmsysorg/sglang:v0.4.8"}}, "noop", &out))
+		assert.Equal(t, "rbg test-rbg unchanged, the images are already set\n", out.String())
+		assert.Empty(t, get(client).Annotations)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		client := fakerbgclient.NewSimpleClientset(rbg.DeepCopy())
+		var out bytes.Buffer
+		err := runSetImage(context.TODO(), client, "test-rbg", "default", []imageUpdate{{role: "router", image: "x"}}, "", &out)
+		assert.EqualError(t, err, "role router has 2 containers [metrics, router], use router/CONTAINER=IMAGE")
+		err = runSetImage(context.TODO(), client, "test-rbg", "default", []imageUpdate{{role: "router", container: "proxy", image: "x"}}, "", &out)
+		assert.EqualError(t, err, `role router has no container "proxy"`)
+		err = runSetImage(context.TODO(), client, "test-rbg", "default", []imageUpdate{{role: "embed", image: "x"}}, "", &out)
+		assert.EqualError(t, err, `role "embed" not found in rbg test-rbg`)
+	})
+}
+
+func TestSetPatchImage(t *testing.T) {
+	patch, err := setPatchImage(nil, "containers", "engine", "img:v2", true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"spec": map[string]interface{}{
+		"containers": []interface{}{map[string]interface{}{"name": "engine", "image": "img:v2"}},
+	}}, patch)
+
+	raw := &runtime.RawExtension{Raw: []byte(`{"spec":{"containers":[{"name":"engine","env":[{"name":"A","value":"1"}]}]}}`)}
+	patch, err = setPatchImage(raw, "containers", "engine", "img:v2", false)
+	require.NoError(t, err)
+	assert.Nil(t, patch)
+	patch, err = setPatchImage(raw, "containers", "engine", "img:v2", true)
+	require.NoError(t, err)
+	assert.Equal(t, "img:v2", patch["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})["image"])
+
+	_, err = setPatchImage(&runtime.RawExtension{Raw: []byte(`[`)}, "containers", "engine", "img", true)
+	assert.Error(t, err)
+}
@@ -19,14 +19,17 @@ package status
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	"sigs.k8s.io/rbgs/cmd/cli/util"
 )
 
@@ -35,7 +38,10 @@ const (
 )
 
 type StatusOptions struct {
-	cf *genericclioptions.ConfigFlags
+	cf       *genericclioptions.ConfigFlags
+	watch    bool
+	interval time.Duration
+	timeout  time.Duration
 }
 
 var statusOpts StatusOptions
@@ -48,10 +54,18 @@ func NewStatusCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
 		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
+			if statusOpts.watch {
+				return runStatusWatch(ctx, args[0])
+			}
 			return runStatus(ctx, args[0])
 		},
 	}
 	statusOpts.cf = cf
+	statusCmd.Flags().BoolVarP(&statusOpts.watch, "watch", "w", false,
+		"Watch the per-role progress until every role is ready and updated")
+	statusCmd.Flags().DurationVar(&statusOpts.interval, "interval", 2*time.Second, "How often to refresh in --watch mode")
+	statusCmd.Flags().DurationVar(&statusOpts.timeout, "timeout", 0,
+		"The length of time to wait in --watch mode before giving up, zero means forever")
 
 	return statusCmd
 }
@@ -60,6 +74,19 @@ func runStatus(ctx context.Context, rbg string) error {
 	return runWithClient(ctx, nil, rbg, nil)
 }
 
+func runStatusWatch(ctx context.Context, name string) error {
+	dynamicClient, err := util.GetDefaultDynamicClient(statusOpts.cf)
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	k8sClient, err := util.GetK8SClientSet(statusOpts.cf)
+	if err != nil {
+		return err
+	}
+	return runWatch(ctx, dynamicClient, k8sClient, name, util.GetNamespace(statusOpts.cf), os.Stdout,
+		term.IsTerminal(int(os.Stdout.Fd())))
+}
+
 func runWithClient(ctx context.Context, _ *cobra.Command, name string, dynamicClient dynamic.Interface) error {
 	var err error
 	// Create a dynamic client if not provided
@@ -136,6 +163,10 @@ func printReport(resource *unstructured.Unstructured, roleStatuses []map[string]
 	fmt.Printf("  Namespace: %s\n", util.GetNamespace(statusOpts.cf))
 	fmt.Printf("  Name:      %s\n\n", resource.GetName())
 	fmt.Printf("  Age:       %s\n\n", ageStr)
+	specPaused, _, _ := unstructured.NestedBool(resource.Object, "spec", "paused")
+	if specPaused || resource.GetAnnotations()[constants.PausedAnnotationKey] == "true" {
+		fmt.Printf("  ⏸  Paused: reconciliation is frozen, run 'kubectl rbg resume %s' to continue\n\n", resource.GetName())
+	}
 	fmt.Println("📦 Role Statuses")
 
 	totalReady := 0
@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	// clearScreen moves the cursor home and clears the terminal before each frame.
+	clearScreen = "\033[H\033[2J"
+	// maxEventLength truncates the last event column to keep the table on one line per role.
+	maxEventLength = 60
+)
+
+// roleProgress is the convergence state of a role in one watch frame.
+type roleProgress struct {
+	name      string
+	desired   int32
+	replicas  int32
+	scheduled int32
+	ready     int32
+	updated   int32
+	lastEvent string
+}
+
+func (p *roleProgress) converged() bool {
+	return p.replicas == p.desired && p.ready == p.desired && p.updated == p.desired
+}
+
+// runWatch redraws the per-role progress until every role is ready and updated, or the
+// timeout expires. Frames are redrawn in place when out is a terminal and appended otherwise.
+func runWatch(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+	k8sClient kubernetes.Interface,
+	name, namespace string,
+	out io.Writer,
+	redraw bool,
+) error {
+	if statusOpts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, statusOpts.timeout)
+		defer cancel()
+	}
+	for {
+		rbg, progress, err := collectProgress(ctx, dynamicClient, k8sClient, name, namespace)
+		if err != nil {
+			return err
+		}
+		if redraw {
+			_, _ = fmt.Fprint(out, clearScreen)
+		}
+		renderFrame(out, rbg, progress)
+
+		if rolledOut(rbg, progress) {
+			_, _ = fmt.Fprintf(out, "\nrbg %s successfully rolled out\n", name)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for rbg %s to roll out", name)
+		case <-time.After(statusOpts.interval):
+		}
+	}
+}
+
+// rolledOut reports whether the controller has observed the latest spec and every role converged.
+func rolledOut(rbg *workloadsv1alpha2.RoleBasedGroup, progress []roleProgress) bool {
+	if rbg.Status.ObservedGeneration < rbg.Generation {
+		return false
+	}
+	for i := range progress {
+		if !progress[i].converged() {
+			return false
+		}
+	}
+	return true
+}
+
+func collectProgress(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+	k8sClient kubernetes.Interface,
+	name, namespace string,
+) (*workloadsv1alpha2.RoleBasedGroup, []roleProgress, error) {
+	obj, err := util.GetRBGObjectByDynamicClient(ctx, name, namespace, dynamicClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, rbg); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode RoleBasedGroup: %w", err)
+	}
+	pods, err := util.ListRolePods(ctx, k8sClient, namespace, name, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	events, err := k8sClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	statuses := map[string]workloadsv1alpha2.RoleStatus{}
+	for _, rs := range rbg.Status.RoleStatuses {
+		statuses[rs.Name] = rs
+	}
+	progress := make([]roleProgress, 0, len(rbg.Spec.Roles))
+	byRole := map[string]*roleProgress{}
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		desired := int32(1)
+		if role.Replicas != nil {
+			desired = *role.Replicas
+		}
+		rs := statuses[role.Name]
+		progress = append(progress, roleProgress{
+			name:     role.Name,
+			desired:  desired,
+			replicas: rs.Replicas,
+			ready:    rs.ReadyReplicas,
+			updated:  rs.UpdatedReplicas,
+		})
+	}
+	for i := range progress {
+		byRole[progress[i].name] = &progress[i]
+	}
+
+	for i := range pods {
+		if p, ok := byRole[pods[i].Labels[constants.RoleNameLabelKey]]; ok && isPodScheduled(&pods[i]) {
+			p.scheduled++
+		}
+	}
+
+	index := util.NewEventRoleIndex(rbg, pods)
+	latest := map[string]metav1.Time{}
+	for i := range events.Items {
+		event := &events.Items[i]
+		role, ok := index.RoleOf(event)
+		if !ok {
+			continue
+		}
+		p, ok := byRole[role]
+		if !ok {
+			continue
+		}
+		seen := util.EventTime(*event)
+		if last, found := latest[role]; found && seen.Before(&last) {
+			continue
+		}
+		latest[role] = seen
+		p.lastEvent = formatEvent(event)
+	}
+	return rbg, progress, nil
+}
+
+func isPodScheduled(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func formatEvent(event *corev1.Event) string {
+	msg := fmt.Sprintf("%s %s: %s", event.Type, event.Reason, strings.Join(strings.Fields(event.Message), " "))
+	if len(msg) > maxEventLength {
+		msg = msg[:maxEventLength-3] + "..."
+	}
+	return msg
+}
+
+func renderFrame(out io.Writer, rbg *workloadsv1alpha2.RoleBasedGroup, progress []roleProgress) {
+	_, _ = fmt.Fprintf(out, "rbg %s/%s  generation %d, observed %d  %s\n",
+		rbg.Namespace, rbg.Name, rbg.Generation, rbg.Status.ObservedGeneration, time.Now().Format(time.TimeOnly))
+	if rbg.IsPaused() {
+		_, _ = fmt.Fprintf(out, "⏸  Paused: reconciliation is frozen, run 'kubectl rbg resume %s' to continue\n", rbg.Name)
+	}
+	_, _ = fmt.Fprintln(out)
+
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+	_, _ = fmt.Fprintln(w, "ROLE\tDESIRED\tSCHEDULED\tREADY\tUPDATED\tPROGRESS\tLAST EVENT")
+	for i := range progress {
+		p := &progress[i]
+		percent := 0.0
+		if p.desired > 0 {
+			percent = float64(p.ready) / float64(p.desired) * 100
+		} else if p.converged() {
+			percent = 100
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t[%s] %d%%\t%s\n", p.name, p.desired, p.scheduled, p.ready, p.updated,
+			progressBar(percent, progressBarWidth), int(percent), orNone(p.lastEvent))
+	}
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func newWatchRBG(t *testing.T, ready int32) *unstructured.Unstructured {
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default", Generation: 2},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{{Name: "decode", Replicas: ptr.To[int32](2)}},
+		},
+		Status: workloadsv1alpha2.RoleBasedGroupStatus{
+			ObservedGeneration: 2,
+			RoleStatuses: []workloadsv1alpha2.RoleStatus{
+				{Name: "decode", Replicas: 2, ReadyReplicas: ready, UpdatedReplicas: 2},
+			},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(rbg)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func newWatchK8sClient() *fake.Clientset {
+	pod := func(name string, scheduled corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					constants.GroupNameLabelKey: "test-rbg",
+					constants.RoleNameLabelKey:  "decode",
+				},
+			},
+			Status: corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodScheduled, Status: scheduled}}},
+		}
+	}
+	now := time.Now()
+	return fake.NewSimpleClientset(
+		pod("test-rbg-decode-0", corev1.ConditionTrue),
+		pod("test-rbg-decode-1", corev1.ConditionFalse),
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "old", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-rbg-decode-1"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "Pulling",
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "new", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-rbg-decode-1"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+			Message:        "0/3 nodes are available:\n insufficient nvidia.com/gpu",
+			LastTimestamp:  metav1.NewTime(now),
+		},
+	)
+}
+
+func TestRunWatch(t *testing.T) {
+	old := statusOpts
+	defer func() { statusOpts = old }()
+
+	statusOpts = StatusOptions{interval: 10 * time.Millisecond, timeout: 50 * time.Millisecond}
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), newWatchRBG(t, 1))
+	var out bytes.Buffer
+	err := runWatch(context.TODO(), dynamicClient, newWatchK8sClient(), "test-rbg", "default", &out, false)
+	assert.EqualError(t, err, "timed out waiting for rbg test-rbg to roll out")
+	assert.Regexp(t, `decode\s+2\s+1\s+1\s+2\s+\[█+\s+\] 50%\s+Warning FailedScheduling: 0/3 nodes are available: insuff\.\.\.`, out.String())
+	assert.NotContains(t, out.String(), clearScreen)
+
+	dynamicClient = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), newWatchRBG(t, 2))
+	out.Reset()
+	err = runWatch(context.TODO(), dynamicClient, newWatchK8sClient(), "test-rbg", "default", &out, true)
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), clearScreen)
+	assert.Contains(t, out.String(), "rbg test-rbg successfully rolled out")
+}
+
+func TestRolledOut(t *testing.T) {
+	rbg := &workloadsv1alpha2.RoleBasedGroup{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+	rbg.Status.ObservedGeneration = 2
+	assert.False(t, rolledOut(rbg, nil))
+
+	rbg.Status.ObservedGeneration = 3
+	assert.True(t, rolledOut(rbg, []roleProgress{{desired: 1, replicas: 1, ready: 1, updated: 1}}))
+	assert.False(t, rolledOut(rbg, []roleProgress{{desired: 2, replicas: 2, ready: 2, updated: 1}}))
+}
+
+func TestFormatEvent(t *testing.T) {
+	event := &corev1.Event{Type: "Warning", Reason: "BackOff", Message: "Back-off restarting failed container engine in pod test-rbg-decode-0"}
+	msg := formatEvent(event)
+	assert.Len(t, msg, maxEventLength)
+	assert.True(t, strings.HasSuffix(msg, "..."))
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	redacted = "<redacted>"
+
+	lastAppliedAnnotationKey = "kubectl.kubernetes.io/last-applied-configuration"
+
+	// sensitiveName matches names ending like a credential, e.g. HF_TOKEN or --api-key,
+	// while leaving look-alikes such as max_tokens or tokenizer alone.
+	sensitiveName = `(?:token|secret|passw(?:or)?d|api[_-]?key|access[_-]?key|private[_-]?key|credentials?)`
+)
+
+var (
+	sensitiveNameRe = regexp.MustCompile(`(?i)` + sensitiveName + `$`)
+
+	// logPatterns redact credentials in free text, keeping the name so the context stays readable.
+	logPatterns = []struct {
+		re          *regexp.Regexp
+		replacement string
+	}{
+		// key=value and "key": "value" pairs.
+		{regexp.MustCompile(`(?i)(\b[\w.-]*` + sensitiveName + `\b["']?\s*[=:]\s*)("[^"]*"|'[^']*'|[^\s,;&]+)`), "${1}" + redacted},
+		// Flags followed by their value, e.g. --api-key abc.
+		{regexp.MustCompile(`(?i)(--?[\w-]*` + sensitiveName + `\s+)([^\s-]\S*)`), "${1}" + redacted},
+		{regexp.MustCompile(`(?i)(\bbearer\s+)[\w.~+/=-]+`), "${1}" + redacted},
+		// Well-known token formats of Hugging Face and OpenAI compatible APIs.
+		{regexp.MustCompile(`\b(?:hf_|sk-)[A-Za-z0-9_-]{20,}`), redacted},
+	}
+)
+
+func isSensitive(name string) bool {
+	return sensitiveNameRe.MatchString(strings.TrimLeft(name, "-"))
+}
+
+// redactLog hides credentials printed in container logs.
+func redactLog(data []byte) []byte {
+	for _, p := range logPatterns {
+		data = p.re.ReplaceAll(data, []byte(p.replacement))
+	}
+	return data
+}
+
+// redactObject walks an unstructured object in place and hides the values of sensitive env
+// vars and flags, wherever the pod template is embedded, and the last applied configuration
+// which holds a copy of them.
+func redactObject(obj map[string]interface{}) {
+	for key, value := range obj {
+		switch key {
+		case "annotations":
+			if annotations, ok := value.(map[string]interface{}); ok {
+				if _, found := annotations[lastAppliedAnnotationKey]; found {
+					annotations[lastAppliedAnnotationKey] = redacted
+				}
+			}
+		case "env":
+			if env, ok := value.([]interface{}); ok {
+				redactEnv(env)
+			}
+		case "command", "args":
+			if args, ok := value.([]interface{}); ok {
+				redactArgs(args)
+			}
+		}
+		redactValue(value)
+	}
+}
+
+func redactValue(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redactObject(v)
+	case []interface{}:
+		for _, item := range v {
+			redactValue(item)
+		}
+	}
+}
+
+func redactEnv(env []interface{}) {
+	for _, item := range env {
+		envVar, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := envVar["name"].(string)
+		if _, hasValue := envVar["value"].(string); hasValue && isSensitive(name) {
+			envVar["value"] = redacted
+		}
+	}
+}
+
+// redactArgs hides the values of sensitive flags, given as --flag=value or as the next
+// argument, and credentials inside shell scripts passed as a single argument.
+func redactArgs(args []interface{}) {
+	for i, item := range args {
+		arg, ok := item.(string)
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(arg, "-") {
+			name, _, hasValue := strings.Cut(arg, "=")
+			if isSensitive(name) {
+				if hasValue {
+					args[i] = name + "=" + redacted
+				} else if i+1 < len(args) {
+					if next, ok := args[i+1].(string); ok && !strings.HasPrefix(next, "-") {
+						args[i+1] = redacted
+					}
+				}
+				continue
+			}
+		}
+		args[i] = string(redactLog([]byte(arg)))
+	}
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/yaml"
+)
+
+const defaultTailLines = 1000
+
+type SupportBundleOptions struct {
+	cf        *genericclioptions.ConfigFlags
+	output    string
+	tailLines int64
+}
+
+var supportBundleOpts SupportBundleOptions
+
+func NewSupportBundleCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	supportBundleCmd := &cobra.Command{
+		Use:   "support-bundle <rbgName>",
+		Short: "Collect the diagnostics of a rbg into a tarball for bug reports",
+		Long: `Collect the diagnostics of a rbg into a tarball for bug reports.
+
+The archive holds the RoleBasedGroup, its ControllerRevisions, the role workloads, the
+pod specs and statuses, the recent logs of every container, including the previous
+instance of restarted ones, and the events of the rbg.
+
+Secrets are never read. Values that look like credentials are redacted: environment
+variables and command-line flags named like tokens, keys or passwords, the
+last-applied-configuration annotation, and such key-value pairs in the logs. Review the
+archive before sharing it all the same.
+
+Resources that cannot be collected are listed in errors.txt instead of failing the bundle.`,
+		Example: `  kubectl rbg support-bundle my-rbg
+  kubectl rbg support-bundle my-rbg -n inference --tail 200 -o /tmp/my-rbg.tar.gz`,
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if supportBundleOpts.tailLines <= 0 {
+				return fmt.Errorf("--tail must be positive")
+			}
+			rbgClient, err := util.GetRBGClient(supportBundleOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(supportBundleOpts.cf)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := util.GetDefaultDynamicClient(supportBundleOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runSupportBundle(context.Background(), rbgClient, k8sClient, dynamicClient,
+				args[0], util.GetNamespace(supportBundleOpts.cf), time.Now(), os.Stdout)
+		},
+	}
+	supportBundleOpts.cf = cf
+	supportBundleCmd.Flags().StringVarP(&supportBundleOpts.output, "output", "o", "",
+		"Path of the archive, defaults to rbg-<name>-<timestamp>.tar.gz in the working directory")
+	supportBundleCmd.Flags().Int64Var(&supportBundleOpts.tailLines, "tail", defaultTailLines,
+		"Number of recent log lines to collect per container")
+
+	return supportBundleCmd
+}
+
+// bundle writes the collected files below a single root directory of a gzipped tarball.
+type bundle struct {
+	tw   *tar.Writer
+	root string
+	now  time.Time
+	// errors lists what could not be collected, so a partial bundle is still useful.
+	errors []string
+}
+
+func (b *bundle) add(name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:     path.Join(b.root, name),
+		Typeflag: tar.TypeReg,
+		Mode:     0o644,
+		Size:     int64(len(data)),
+		ModTime:  b.now,
+	}
+	if err := b.tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if _, err := b.tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// addObject redacts the object and adds it as YAML.
+func (b *bundle) addObject(name string, obj interface{}) error {
+	content, ok := obj.(map[string]interface{})
+	if !ok {
+		var err error
+		if content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+			return fmt.Errorf("failed to convert %s: %w", name, err)
+		}
+	}
+	redactObject(content)
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	return b.add(name, data)
+}
+
+func (b *bundle) failed(format string, args ...interface{}) {
+	b.errors = append(b.errors, fmt.Sprintf(format, args...))
+}
+
+func runSupportBundle(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+	name, namespace string,
+	now time.Time,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+
+	root := fmt.Sprintf("rbg-%s-%s", name, now.UTC().Format("20060102T150405Z"))
+	output := supportBundleOpts.output
+	if output == "" {
+		output = root + ".tar.gz"
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", output, err)
+	}
+	defer func() { _ = f.Close() }()
+	gz := gzip.NewWriter(f)
+	b := &bundle{tw: tar.NewWriter(gz), root: root, now: now}
+
+	if err := collect(ctx, b, rbg, k8sClient, dynamicClient); err != nil {
+		return err
+	}
+	if len(b.errors) > 0 {
+		if err := b.add("errors.txt", []byte(strings.Join(b.errors, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+	if err := b.tw.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	_, _ = fmt.Fprintf(out, "Support bundle for rbg %s written to %s\n", name, output)
+	if len(b.errors) > 0 {
+		_, _ = fmt.Fprintf(out, "Warning: %d items could not be collected, see errors.txt in the bundle\n", len(b.errors))
+	}
+	return nil
+}
+
+// collect adds the rbg and everything it owns to the bundle. Only write errors abort the
+// collection; API errors are recorded and the remaining resources are still collected.
+func collect(
+	ctx context.Context,
+	b *bundle,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	k8sClient kubernetes.Interface,
+	dynamicClient dynamic.Interface,
+) error {
+	// Typed clients drop the TypeMeta, restore it so every file is self-describing.
+	rbg.SetGroupVersionKind(workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroup"))
+	if err := b.addObject("rolebasedgroup.yaml", rbg); err != nil {
+		return err
+	}
+
+	revisions, err := util.ListOwnedRevisions(ctx, k8sClient, rbg)
+	if err != nil {
+		b.failed("controllerrevisions: %v", err)
+	}
+	for _, rev := range revisions {
+		rev.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("ControllerRevision"))
+		if err := b.addObject(path.Join("controllerrevisions", rev.Name+".yaml"), rev); err != nil {
+			return err
+		}
+	}
+
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		kind, workloadName := role.GetWorkloadSpec().Kind, rbg.GetWorkloadName(role)
+		obj, err := dynamicClient.Resource(util.WorkloadGVR(role)).Namespace(rbg.Namespace).Get(ctx, workloadName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			b.failed("workload %s %s of role %s not found", kind, workloadName, role.Name)
+			continue
+		}
+		if err != nil {
+			b.failed("workload %s %s: %v", kind, workloadName, err)
+			continue
+		}
+		file := path.Join("workloads", strings.ToLower(kind)+"-"+workloadName+".yaml")
+		if err := b.addObject(file, obj.Object); err != nil {
+			return err
+		}
+	}
+
+	pods, err := util.ListRolePods(ctx, k8sClient, rbg.Namespace, rbg.Name, "")
+	if err != nil {
+		b.failed("pods: %v", err)
+	}
+	for i := range pods {
+		pod := &pods[i]
+		pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+		if err := b.addObject(path.Join("pods", pod.Name+".yaml"), pod); err != nil {
+			return err
+		}
+		if err := collectLogs(ctx, b, k8sClient, pod); err != nil {
+			return err
+		}
+	}
+
+	return collectEvents(ctx, b, k8sClient, rbg, pods)
+}
+
+// collectLogs adds the recent logs of every container, and of the previous instance of the
+// containers that restarted, as the crash is usually what the bug report is about.
+func collectLogs(ctx context.Context, b *bundle, k8sClient kubernetes.Interface, pod *corev1.Pod) error {
+	restarts := map[string]int32{}
+	for _, status := range pod.Status.InitContainerStatuses {
+		restarts[status.Name] = status.RestartCount
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		restarts[status.Name] = status.RestartCount
+	}
+	containers := make([]string, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, c := range pod.Spec.InitContainers {
+		containers = append(containers, c.Name)
+	}
+	for _, c := range pod.Spec.Containers {
+		containers = append(containers, c.Name)
+	}
+
+	for _, container := range containers {
+		if err := collectLog(ctx, b, k8sClient, pod, container, false); err != nil {
+			return err
+		}
+		if restarts[container] == 0 {
+			continue
+		}
+		if err := collectLog(ctx, b, k8sClient, pod, container, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func collectLog(ctx context.Context, b *bundle, k8sClient kubernetes.Interface, pod *corev1.Pod, container string, previous bool) error {
+	file := container + ".log"
+	if previous {
+		file = container + ".previous.log"
+	}
+	data, err := k8sClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: ptr.To(supportBundleOpts.tailLines),
+		Previous:  previous,
+	}).DoRaw(ctx)
+	if err != nil {
+		b.failed("logs of container %s in pod %s: %v", container, pod.Name, err)
+		return nil
+	}
+	return b.add(path.Join("logs", pod.Name, file), redactLog(data))
+}
+
+// collectEvents adds the events of the rbg, its workloads and pods, oldest first.
+func collectEvents(
+	ctx context.Context,
+	b *bundle,
+	k8sClient kubernetes.Interface,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	pods []corev1.Pod,
+) error {
+	// Events cannot be selected by owner, so list the namespace and match locally.
+	list, err := k8sClient.CoreV1().Events(rbg.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		b.failed("events: %v", err)
+		return nil
+	}
+	index := util.NewEventRoleIndex(rbg, pods)
+	var events []corev1.Event
+	for i := range list.Items {
+		if _, ok := index.RoleOf(&list.Items[i]); ok {
+			events = append(events, list.Items[i])
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		ti, tj := util.EventTime(events[i]), util.EventTime(events[j])
+		return ti.Before(&tj)
+	})
+
+	items := make([]interface{}, 0, len(events))
+	for i := range events {
+		events[i].SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Event"))
+		item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&events[i])
+		if err != nil {
+			return fmt.Errorf("failed to convert event %s: %w", events[i].Name, err)
+		}
+		items = append(items, item)
+	}
+	return b.addObject("events.yaml", map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	})
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+// readBundle returns the files of the archive keyed by their path below the root directory.
+func readBundle(t *testing.T, file, root string) map[string]string {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		rel, err := filepath.Rel(root, hdr.Name)
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[rel] = string(data)
+	}
+	return files
+}
+
+func TestRunSupportBundle(t *testing.T) {
+	origOpts := supportBundleOpts
+	defer func() { supportBundleOpts = origOpts }()
+
+	template := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "engine",
+				Image: "sglang:v0.5",
+				Env:   []corev1.EnvVar{{Name: "HF_TOKEN", Value: "hf_secret"}},
+			}},
+		},
+	}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithUID("rbg-uid").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithWorkload("apps/v1", "StatefulSet").WithTemplate(template).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "Deployment").WithTemplate(template).Obj(),
+		}).Obj()
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rbg-prefill-0",
+				Namespace: "default",
+				Labels:    map[string]string{constants.GroupNameLabelKey: "test-rbg", constants.RoleNameLabelKey: "prefill"},
+			},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "download"}},
+				Containers:     []corev1.Container{{Name: "engine", Args: []string{"--api-key", "abc"}}},
+			},
+			Status: corev1.PodStatus{
+				Phase:             corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "engine", RestartCount: 2}},
+			},
+		},
+		&appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rbg-7d9f",
+				Namespace: "default",
+				Labels:    map[string]string{constants.GroupNameLabelKey: "test-rbg"},
+			},
+			Data:     runtime.RawExtension{Raw: []byte(`{"spec":{"roles":[{"name":"prefill","env":[{"name":"HF_TOKEN","value":"hf_secret"}]}]}}`)},
+			Revision: 1,
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "rbg-event", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "RoleBasedGroup", Name: "test-rbg"},
+			Reason:         "Progressing",
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "other-event", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-0"},
+			Reason:         "Pulled",
+		},
+	)
+	statefulSet := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "StatefulSet",
+		"metadata":   map[string]interface{}{"name": "test-rbg-prefill", "namespace": "default"},
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{
+				"name": "engine",
+				"env":  []interface{}{map[string]interface{}{"name": "HF_TOKEN", "value": "hf_secret"}},
+			}},
+		}}},
+	}}
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), statefulSet)
+
+	output := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	supportBundleOpts = SupportBundleOptions{output: output, tailLines: 100}
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	var out bytes.Buffer
+	require.NoError(t, runSupportBundle(context.TODO(), rbgClient, k8sClient, dynamicClient, "test-rbg", "default", now, &out))
+	assert.Contains(t, out.String(), "Support bundle for rbg test-rbg written to "+output)
+	assert.Contains(t, out.String(), "Warning: 1 items could not be collected")
+
+	files := readBundle(t, output, "rbg-test-rbg-20260301T123000Z")
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{
+		"rolebasedgroup.yaml",
+		"controllerrevisions/test-rbg-7d9f.yaml",
+		"workloads/statefulset-test-rbg-prefill.yaml",
+		"pods/test-rbg-prefill-0.yaml",
+		"logs/test-rbg-prefill-0/download.log",
+		"logs/test-rbg-prefill-0/engine.log",
+		"logs/test-rbg-prefill-0/engine.previous.log",
+		"events.yaml",
+		"errors.txt",
+	}, keys)
+
+	for _, file := range []string{"rolebasedgroup.yaml", "controllerrevisions/test-rbg-7d9f.yaml", "workloads/statefulset-test-rbg-prefill.yaml"} {
+		assert.NotContains(t, files[file], "hf_secret", file)
+		assert.Contains(t, files[file], redacted, file)
+	}
+	assert.Contains(t, files["rolebasedgroup.yaml"], "kind: RoleBasedGroup")
+	assert.Contains(t, files["pods/test-rbg-prefill-0.yaml"], "- "+redacted)
+	assert.Equal(t, "fake logs", files["logs/test-rbg-prefill-0/engine.log"])
+	assert.Contains(t, files["events.yaml"], "Progressing")
+	assert.NotContains(t, files["events.yaml"], "Pulled")
+	assert.Equal(t, "workload Deployment test-rbg-decode of role decode not found\n", files["errors.txt"])
+
+	err := runSupportBundle(context.TODO(), rbgClient, k8sClient, dynamicClient, "absent", "default", now, &out)
+	assert.ErrorContains(t, err, "failed to get RoleBasedGroup")
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suspend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+func NewSuspendCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:                "suspend <rbgName>",
+		Short:              "Scale all roles of a rbg to zero, keeping the rbg and its revisions",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rbgClient, err := util.GetRBGClient(cf)
+			if err != nil {
+				return err
+			}
+			return setSuspended(context.Background(), rbgClient, args[0], util.GetNamespace(cf), true, os.Stdout)
+		},
+	}
+}
+
+func NewUnsuspendCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:                "unsuspend <rbgName>",
+		Short:              "Scale the roles of a suspended rbg back to their replicas",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rbgClient, err := util.GetRBGClient(cf)
+			if err != nil {
+				return err
+			}
+			return setSuspended(context.Background(), rbgClient, args[0], util.GetNamespace(cf), false, os.Stdout)
+		},
+	}
+}
+
+// setSuspended sets or removes spec.suspend. The replicas of the roles are left untouched, so the
+// controller scales the workloads back to them once the group is unsuspended.
+func setSuspended(
+	ctx context.Context, rbgClient versioned.Interface, name, namespace string, suspended bool, out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	if rbg.IsSuspended() == suspended {
+		if suspended {
+			_, _ = fmt.Fprintf(out, "rbg %s is already suspended\n", name)
+		} else {
+			_, _ = fmt.Fprintf(out, "rbg %s is not suspended\n", name)
+		}
+		return nil
+	}
+
+	// A null value removes the field in a merge patch.
+	var value interface{}
+	if suspended {
+		value = true
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"suspend": value},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Patch(
+		ctx, name, types.MergePatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to update rbg %s: %w", name, err)
+	}
+	if suspended {
+		_, _ = fmt.Fprintf(out, "rbg %s suspended\n", name)
+	} else {
+		_, _ = fmt.Fprintf(out, "rbg %s unsuspended\n", name)
+	}
+	return nil
+}
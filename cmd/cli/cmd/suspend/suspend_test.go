@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suspend
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+)
+
+func TestSetSuspended(t *testing.T) {
+	ctx := context.TODO()
+	client := fakerbgclient.NewSimpleClientset(&workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{{Name: "decode", Replicas: ptr.To(int32(4))}},
+		},
+	})
+	get := func() *workloadsv1alpha2.RoleBasedGroup {
+		rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(ctx, "test-rbg", metav1.GetOptions{})
+		assert.NoError(t, err)
+		return rbg
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, setSuspended(ctx, client, "test-rbg", "default", true, &out))
+	assert.Equal(t, "rbg test-rbg suspended\n", out.String())
+	assert.True(t, get().IsSuspended())
+
+	out.Reset()
+	assert.NoError(t, setSuspended(ctx, client, "test-rbg", "default", true, &out))
+	assert.Equal(t, "rbg test-rbg is already suspended\n", out.String())
+
+	out.Reset()
+	assert.NoError(t, setSuspended(ctx, client, "test-rbg", "default", false, &out))
+	assert.Equal(t, "rbg test-rbg unsuspended\n", out.String())
+	rbg := get()
+	assert.Nil(t, rbg.Spec.Suspend)
+	assert.Equal(t, int32(4), *rbg.Spec.Roles[0].Replicas)
+
+	out.Reset()
+	assert.NoError(t, setSuspended(ctx, client, "test-rbg", "default", false, &out))
+	assert.Equal(t, "rbg test-rbg is not suspended\n", out.String())
+
+	assert.ErrorContains(t, setSuspended(ctx, client, "absent", "default", true, &out), "failed to get RoleBasedGroup")
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	resourceListAPIVersion = "config.kubernetes.io/v1"
+	resourceListKind       = "ResourceList"
+
+	// Keys of the data of the ConfigMap given as function config.
+	fnConfigNamespace = "namespace"
+	fnConfigKeepRBGs  = "keepRoleBasedGroups"
+)
+
+// resourceList is the input and output of a KRM function.
+type resourceList struct {
+	APIVersion     string                   `json:"apiVersion"`
+	Kind           string                   `json:"kind"`
+	Items          []map[string]interface{} `json:"items"`
+	FunctionConfig map[string]interface{}   `json:"functionConfig,omitempty"`
+	Results        []fnResult               `json:"results,omitempty"`
+}
+
+type fnResult struct {
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+func NewFnCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "fn",
+		Short: "Render RoleBasedGroups as a KRM function, e.g. a Kustomize generator",
+		Long: `Render RoleBasedGroups as a KRM function, e.g. a Kustomize generator.
+
+A ResourceList is read from stdin and written back to stdout with the workloads and
+services rendered for its RoleBasedGroups appended to the items, as done by template.
+The function config is an optional ConfigMap whose data may set:
+
+  namespace            namespace of the RoleBasedGroups without one, default "default"
+  keepRoleBasedGroups  "false" to drop the RoleBasedGroups from the output
+
+Errors are reported in the results of the ResourceList and make the function fail.`,
+		Example: `  # kustomization.yaml
+  generators:
+  - rbg-render.yaml
+
+  # rbg-render.yaml
+  apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: rbg-render
+    annotations:
+      config.kubernetes.io/function: |
+        exec:
+          path: kubectl-rbg
+          args: ["fn"]
+  data:
+    namespace: llm`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFn(context.Background(), os.Stdin, os.Stdout)
+		},
+	}
+}
+
+// runFn processes the ResourceList read from in. Unless the input cannot be read, the
+// output is written even on failure, so the caller sees the results.
+func runFn(ctx context.Context, in io.Reader, out io.Writer) error {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("failed to read ResourceList: %w", err)
+	}
+	list := &resourceList{}
+	if err := yaml.Unmarshal(data, list); err != nil {
+		return fmt.Errorf("failed to decode ResourceList: %w", err)
+	}
+	if list.Kind != resourceListKind {
+		return fmt.Errorf("input must be a %s, got kind %q", resourceListKind, list.Kind)
+	}
+
+	fnErr := renderResourceList(ctx, list)
+	if fnErr != nil {
+		list.Results = append(list.Results, fnResult{Message: fnErr.Error(), Severity: "error"})
+	}
+	list.APIVersion = resourceListAPIVersion
+	output, err := yaml.Marshal(list)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ResourceList: %w", err)
+	}
+	if _, err := out.Write(output); err != nil {
+		return err
+	}
+	return fnErr
+}
+
+func renderResourceList(ctx context.Context, list *resourceList) error {
+	namespace, keepRBGs, err := parseFnConfig(list.FunctionConfig)
+	if err != nil {
+		return err
+	}
+	scheme, err := newScheme()
+	if err != nil {
+		return err
+	}
+	docs := make([]*unstructured.Unstructured, 0, len(list.Items))
+	for _, item := range list.Items {
+		docs = append(docs, &unstructured.Unstructured{Object: item})
+	}
+	rbgs, objects, err := splitObjects(docs, scheme, namespace)
+	if err != nil {
+		return err
+	}
+	rendered, err := renderAll(ctx, scheme, rbgs, objects)
+	if err != nil {
+		return err
+	}
+
+	items := make([]map[string]interface{}, 0, len(list.Items)+len(rendered))
+	for _, doc := range docs {
+		gvk := doc.GroupVersionKind()
+		if !keepRBGs && gvk.Group == workloadsv1alpha2.GroupVersion.Group && gvk.Kind == "RoleBasedGroup" {
+			continue
+		}
+		items = append(items, doc.Object)
+	}
+	for _, r := range rendered {
+		items = append(items, r.obj.Object)
+	}
+	list.Items = items
+	return nil
+}
+
+func parseFnConfig(config map[string]interface{}) (string, bool, error) {
+	namespace, keepRBGs := "default", true
+	if len(config) == 0 {
+		return namespace, keepRBGs, nil
+	}
+	data, _, err := unstructured.NestedStringMap(config, "data")
+	if err != nil {
+		return "", false, fmt.Errorf("invalid function config data: %w", err)
+	}
+	if ns := data[fnConfigNamespace]; ns != "" {
+		namespace = ns
+	}
+	if keep, ok := data[fnConfigKeepRBGs]; ok {
+		if keepRBGs, err = strconv.ParseBool(keep); err != nil {
+			return "", false, fmt.Errorf("invalid function config %s %q, must be true or false", fnConfigKeepRBGs, keep)
+		}
+	}
+	return namespace, keepRBGs, nil
+}
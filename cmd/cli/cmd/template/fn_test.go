@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+// newResourceList wraps the documents of manifest in a ResourceList with the given config data.
+func newResourceList(t *testing.T, data map[string]string) string {
+	list := resourceList{APIVersion: resourceListAPIVersion, Kind: resourceListKind}
+	for _, doc := range strings.Split(manifest, "---\n") {
+		item := map[string]interface{}{}
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &item))
+		list.Items = append(list.Items, item)
+	}
+	if data != nil {
+		list.FunctionConfig = map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "rbg-render"},
+			"data":       data,
+		}
+	}
+	out, err := yaml.Marshal(list)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func decodeResourceList(t *testing.T, out *bytes.Buffer) (*resourceList, []string) {
+	list := &resourceList{}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), list))
+	kinds := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		name, _ := item["metadata"].(map[string]interface{})["name"].(string)
+		kinds = append(kinds, item["kind"].(string)+"/"+name)
+	}
+	return list, kinds
+}
+
+func TestRunFn(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, runFn(context.TODO(), strings.NewReader(newResourceList(t, nil)), &out))
+	list, kinds := decodeResourceList(t, &out)
+	assert.Equal(t, resourceListKind, list.Kind)
+	assert.Empty(t, list.Results)
+	assert.Equal(t, []string{
+		"RoleBasedGroup/llm", "Unknown/ignored",
+		"Deployment/llm-router", "Service/s-llm-router", "RoleInstanceSet/llm-decode", "Service/s-llm-decode",
+	}, kinds)
+	assert.Equal(t, "default", list.Items[2]["metadata"].(map[string]interface{})["namespace"])
+
+	out.Reset()
+	input := newResourceList(t, map[string]string{"namespace": "team-a", "keepRoleBasedGroups": "false"})
+	require.NoError(t, runFn(context.TODO(), strings.NewReader(input), &out))
+	list, kinds = decodeResourceList(t, &out)
+	assert.Equal(t, []string{
+		"Unknown/ignored", "Deployment/llm-router", "Service/s-llm-router", "RoleInstanceSet/llm-decode", "Service/s-llm-decode",
+	}, kinds)
+	assert.Equal(t, "team-a", list.Items[1]["metadata"].(map[string]interface{})["namespace"])
+}
+
+func TestRunFnErrors(t *testing.T) {
+	var out bytes.Buffer
+	err := runFn(context.TODO(), strings.NewReader("apiVersion: v1\nkind: ConfigMap\n"), &out)
+	assert.EqualError(t, err, `input must be a ResourceList, got kind "ConfigMap"`)
+	assert.Empty(t, out.String())
+
+	input := newResourceList(t, map[string]string{"keepRoleBasedGroups": "maybe"})
+	err = runFn(context.TODO(), strings.NewReader(input), &out)
+	assert.EqualError(t, err, `invalid function config keepRoleBasedGroups "maybe", must be true or false`)
+	list, kinds := decodeResourceList(t, &out)
+	assert.Equal(t, []fnResult{{Message: err.Error(), Severity: "error"}}, list.Results)
+	assert.Equal(t, []string{"RoleBasedGroup/llm", "Unknown/ignored"}, kinds)
+
+	out.Reset()
+	input = strings.Replace(newResourceList(t, nil), "v1alpha2", "v1alpha1", 1)
+	err = runFn(context.TODO(), strings.NewReader(input), &out)
+	assert.EqualError(t, err, "RoleBasedGroup llm: only workloads.x-k8s.io/v1alpha2 is supported")
+	list, _ = decodeResourceList(t, &out)
+	assert.Len(t, list.Results, 1)
+}
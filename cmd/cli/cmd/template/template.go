@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/pkg/reconciler"
+	"sigs.k8s.io/rbgs/pkg/utils"
+	"sigs.k8s.io/yaml"
+)
+
+type TemplateOptions struct {
+	cf       *genericclioptions.ConfigFlags
+	filename string
+}
+
+var templateOpts TemplateOptions
+
+func NewTemplateCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	templateCmd := &cobra.Command{
+		Use:   "template -f <file>",
+		Short: "Render the workloads and services the controller would create for a RoleBasedGroup",
+		Long: `Render the workloads and services the controller would create for a RoleBasedGroup.
+
+The manifest is rendered locally with the controller's own logic, without contacting the
+cluster, as if the rbg was created from scratch. Objects the rendering depends on, such as
+the ClusterEngineRuntimeProfiles referenced by the roles, are read from the same manifest.`,
+		Example: `  kubectl rbg template -f rbg.yaml
+  cat rbg.yaml profiles.yaml | kubectl rbg template -f -`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if templateOpts.filename == "" {
+				return fmt.Errorf("-f/--filename is required")
+			}
+			in, err := util.OpenManifest(templateOpts.filename)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = in.Close() }()
+			return runTemplate(context.Background(), in, util.GetNamespace(templateOpts.cf), os.Stdout)
+		},
+	}
+	templateOpts.cf = cf
+	templateCmd.Flags().StringVarP(&templateOpts.filename, "filename", "f", "",
+		"Manifest containing the RoleBasedGroup(s) to render, use - for stdin")
+
+	return templateCmd
+}
+
+func newScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme, workloadsv1alpha2.AddToScheme, lwsv1.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			return nil, err
+		}
+	}
+	return scheme, nil
+}
+
+func runTemplate(ctx context.Context, in io.Reader, namespace string, out io.Writer) error {
+	scheme, err := newScheme()
+	if err != nil {
+		return err
+	}
+	rbgs, objects, err := decodeManifest(in, scheme, namespace)
+	if err != nil {
+		return err
+	}
+	if len(rbgs) == 0 {
+		return fmt.Errorf("no RoleBasedGroup found in manifest")
+	}
+
+	rendered, err := renderAll(ctx, scheme, rbgs, objects)
+	if err != nil {
+		return err
+	}
+	for _, r := range rendered {
+		data, err := yaml.Marshal(r.obj.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", r.obj.GetKind(), r.obj.GetName(), err)
+		}
+		_, _ = fmt.Fprintf(out, "---\n# Source: %s/%s\n%s", r.rbg, r.role, data)
+	}
+	return nil
+}
+
+// renderAll renders the rbgs in order, reading the objects they depend on from objects.
+func renderAll(
+	ctx context.Context, scheme *runtime.Scheme, rbgs []*workloadsv1alpha2.RoleBasedGroup, objects []client.Object,
+) ([]renderedObject, error) {
+	// The reconcilers read a few objects besides the rbg, serve them from the manifest.
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	var all []renderedObject
+	for _, rbg := range rbgs {
+		rendered, err := renderRoleBasedGroup(ctx, scheme, c, rbg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render RoleBasedGroup %s: %w", rbg.Name, err)
+		}
+		all = append(all, rendered...)
+	}
+	return all, nil
+}
+
+// decodeManifest splits a YAML or JSON stream into RoleBasedGroups and the other objects
+// known to the scheme. Documents of unknown kinds are ignored.
+func decodeManifest(
+	in io.Reader, scheme *runtime.Scheme, namespace string,
+) ([]*workloadsv1alpha2.RoleBasedGroup, []client.Object, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(in), 4096)
+	var docs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		docs = append(docs, obj)
+	}
+	return splitObjects(docs, scheme, namespace)
+}
+
+// splitObjects sorts the decoded documents into RoleBasedGroups and the other objects known
+// to the scheme. RoleBasedGroups without a namespace are put in namespace.
+func splitObjects(
+	docs []*unstructured.Unstructured, scheme *runtime.Scheme, namespace string,
+) ([]*workloadsv1alpha2.RoleBasedGroup, []client.Object, error) {
+	var rbgs []*workloadsv1alpha2.RoleBasedGroup
+	var objects []client.Object
+	for _, obj := range docs {
+		gvk := obj.GroupVersionKind()
+		if gvk.Group == workloadsv1alpha2.GroupVersion.Group && gvk.Kind == "RoleBasedGroup" {
+			if gvk.Version != workloadsv1alpha2.GroupVersion.Version {
+				return nil, nil, fmt.Errorf("RoleBasedGroup %s: only %s is supported", obj.GetName(), workloadsv1alpha2.GroupVersion)
+			}
+			rbg := &workloadsv1alpha2.RoleBasedGroup{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, rbg); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode RoleBasedGroup %s: %w", obj.GetName(), err)
+			}
+			if rbg.Name == "" {
+				return nil, nil, fmt.Errorf("RoleBasedGroup in manifest has no name")
+			}
+			if rbg.Namespace == "" {
+				rbg.Namespace = namespace
+			}
+			rbgs = append(rbgs, rbg)
+			continue
+		}
+		if !scheme.Recognizes(gvk) {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+	return rbgs, objects, nil
+}
+
+// renderedObject is an object rendered for a role of a rbg.
+type renderedObject struct {
+	rbg  string
+	role string
+	obj  *unstructured.Unstructured
+}
+
+func renderRoleBasedGroup(
+	ctx context.Context, scheme *runtime.Scheme, c client.Client, rbg *workloadsv1alpha2.RoleBasedGroup,
+) ([]renderedObject, error) {
+	// The manifest has not gone through the API server, apply the defaults the
+	// rendering relies on.
+	rbg.SetGroupVersionKind(workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroup"))
+	for i := range rbg.Spec.Roles {
+		if rbg.Spec.Roles[i].Replicas == nil {
+			rbg.Spec.Roles[i].Replicas = ptr.To[int32](1)
+		}
+	}
+
+	if err := workloadsv1alpha2.ValidateRoleTemplates(rbg); err != nil {
+		return nil, fmt.Errorf("invalid role templates: %w", err)
+	}
+	if err := workloadsv1alpha2.ValidateRoleTemplateReferences(rbg); err != nil {
+		return nil, fmt.Errorf("invalid template references: %w", err)
+	}
+
+	revision, err := utils.NewRevision(ctx, c, rbg, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute revision: %w", err)
+	}
+	revisionHashes, err := utils.GetRolesRevisionHash(revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute role revisions: %w", err)
+	}
+
+	var rendered []renderedObject
+	for i := range rbg.Spec.Roles {
+		role := rbg.Spec.Roles[i].DeepCopy()
+		r, err := reconciler.NewWorkloadReconciler(role.GetWorkloadSpec(), scheme, c)
+		if err != nil {
+			return nil, fmt.Errorf("role %s: %w", role.Name, err)
+		}
+		if err := r.Validate(ctx, role); err != nil {
+			return nil, fmt.Errorf("role %s: %w", role.Name, err)
+		}
+		renderer, ok := r.(reconciler.WorkloadRenderer)
+		if !ok {
+			return nil, fmt.Errorf("role %s: workload %s cannot be rendered", role.Name, role.GetWorkloadType())
+		}
+		objs, err := renderer.Render(ctx, rbg, role, revisionHashes[role.Name])
+		if err != nil {
+			return nil, fmt.Errorf("role %s: %w", role.Name, err)
+		}
+		for _, obj := range objs {
+			rendered = append(rendered, renderedObject{rbg: rbg.Name, role: role.Name, obj: obj})
+		}
+	}
+	return rendered, nil
+}
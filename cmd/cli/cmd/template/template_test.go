@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+const manifest = `apiVersion: workloads.x-k8s.io/v1alpha2
+kind: RoleBasedGroup
+metadata:
+  name: llm
+spec:
+  roles:
+  - name: router
+    annotations:
+      rbg.workloads.x-k8s.io/role-workload-type: apps/v1/Deployment
+    standalonePattern:
+      template:
+        spec:
+          containers:
+          - name: router
+            image: router:v1
+  - name: decode
+    replicas: 2
+    standalonePattern:
+      template:
+        spec:
+          containers:
+          - name: engine
+            image: vllm:v1
+---
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: ignored
+`
+
+func TestRunTemplate(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, runTemplate(context.TODO(), strings.NewReader(manifest), "team-a", &out))
+
+	docs := strings.Split(strings.TrimPrefix(out.String(), "---\n"), "---\n")
+	require.Len(t, docs, 4)
+	assert.True(t, strings.HasPrefix(docs[0], "# Source: llm/router\n"))
+	assert.True(t, strings.HasPrefix(docs[2], "# Source: llm/decode\n"))
+
+	kinds := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		obj := map[string]interface{}{}
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &obj))
+		metadata := obj["metadata"].(map[string]interface{})
+		assert.Equal(t, "team-a", metadata["namespace"])
+		kinds = append(kinds, obj["kind"].(string)+"/"+metadata["name"].(string))
+	}
+	assert.Equal(t, []string{
+		"Deployment/llm-router", "Service/s-llm-router", "RoleInstanceSet/llm-decode", "Service/s-llm-decode",
+	}, kinds)
+	assert.Contains(t, docs[0], "replicas: 1")
+	assert.Contains(t, docs[2], "replicas: 2")
+	assert.Contains(t, docs[2], "image: vllm:v1")
+}
+
+func TestRunTemplateErrors(t *testing.T) {
+	var out bytes.Buffer
+	err := runTemplate(context.TODO(), strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"), "default", &out)
+	assert.EqualError(t, err, "no RoleBasedGroup found in manifest")
+
+	err = runTemplate(context.TODO(), strings.NewReader(strings.Replace(manifest, "v1alpha2", "v1alpha1", 1)), "default", &out)
+	assert.EqualError(t, err, "RoleBasedGroup llm: only workloads.x-k8s.io/v1alpha2 is supported")
+}
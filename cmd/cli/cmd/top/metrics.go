@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	// DCGM exporter metrics, see https://github.com/NVIDIA/dcgm-exporter.
+	dcgmGPUUtilMetric = "DCGM_FI_DEV_GPU_UTIL"
+	dcgmFBUsedMetric  = "DCGM_FI_DEV_FB_USED"
+)
+
+// podUsage is the CPU and memory usage of a pod as reported by metrics-server.
+type podUsage struct {
+	cpu    resource.Quantity
+	memory resource.Quantity
+}
+
+// gpuUsage is the GPU usage of a pod as reported by the DCGM exporter.
+type gpuUsage struct {
+	gpus int
+	// utilSum is the sum of the utilization percentages of all GPUs of the pod.
+	utilSum float64
+	// memoryMiB is the framebuffer memory in use across all GPUs of the pod.
+	memoryMiB float64
+}
+
+// podMetricsFetcher returns the usage of the pods in a namespace keyed by pod name.
+type podMetricsFetcher func(ctx context.Context, namespace, labelSelector string) (map[string]podUsage, error)
+
+// gpuMetricsFetcher returns the GPU usage of the pods in a namespace keyed by pod name.
+type gpuMetricsFetcher func(ctx context.Context, namespace string) (map[string]gpuUsage, error)
+
+// podMetricsList is the subset of metrics.k8s.io/v1beta1 PodMetricsList used here.
+type podMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Containers []struct {
+			Usage map[string]resource.Quantity `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// newMetricsServerFetcher reads pod metrics from the metrics.k8s.io API served by metrics-server.
+func newMetricsServerFetcher(k8sClient kubernetes.Interface) podMetricsFetcher {
+	return func(ctx context.Context, namespace, labelSelector string) (map[string]podUsage, error) {
+		data, err := k8sClient.Discovery().RESTClient().Get().
+			AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", namespace, "pods").
+			Param("labelSelector", labelSelector).
+			DoRaw(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query metrics-server, is it installed? %w", err)
+		}
+		return parsePodMetrics(data)
+	}
+}
+
+func parsePodMetrics(data []byte) (map[string]podUsage, error) {
+	var list podMetricsList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode pod metrics: %w", err)
+	}
+	result := make(map[string]podUsage, len(list.Items))
+	for _, item := range list.Items {
+		usage := podUsage{}
+		for _, c := range item.Containers {
+			if cpu, ok := c.Usage["cpu"]; ok {
+				usage.cpu.Add(cpu)
+			}
+			if mem, ok := c.Usage["memory"]; ok {
+				usage.memory.Add(mem)
+			}
+		}
+		result[item.Metadata.Name] = usage
+	}
+	return result, nil
+}
+
+// newPrometheusGPUFetcher reads the DCGM exporter series from a Prometheus server.
+func newPrometheusGPUFetcher(baseURL string) gpuMetricsFetcher {
+	client := &http.Client{Timeout: 10 * time.Second}
+	return func(ctx context.Context, namespace string) (map[string]gpuUsage, error) {
+		result := map[string]gpuUsage{}
+		for _, metric := range []string{dcgmGPUUtilMetric, dcgmFBUsedMetric} {
+			// The exporter labels series with the pod it is attached to; depending on the
+			// scrape config the labels may be prefixed with "exported_".
+			query := fmt.Sprintf(`%s{namespace=%q} or %s{exported_namespace=%q}`, metric, namespace, metric, namespace)
+			samples, err := util.QueryPrometheus(ctx, client, baseURL, query)
+			if err != nil {
+				return nil, err
+			}
+			mergeGPUSeries(result, metric, samples)
+		}
+		return result, nil
+	}
+}
+
+func mergeGPUSeries(result map[string]gpuUsage, metric string, samples []util.PrometheusSample) {
+	for _, sample := range samples {
+		pod := sample.Labels["exported_pod"]
+		if pod == "" {
+			pod = sample.Labels["pod"]
+		}
+		if pod == "" {
+			continue
+		}
+		usage := result[pod]
+		switch metric {
+		case dcgmGPUUtilMetric:
+			usage.gpus++
+			usage.utilSum += sample.Value
+		case dcgmFBUsedMetric:
+			usage.memoryMiB += sample.Value
+		}
+		result[pod] = usage
+	}
+}
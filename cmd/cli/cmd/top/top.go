@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type TopOptions struct {
+	cf            *genericclioptions.ConfigFlags
+	prometheusURL string
+	showPods      bool
+	output        util.OutputOptions
+}
+
+var topOpts TopOptions
+
+func NewTopCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	topCmd := &cobra.Command{
+		Use:                "top <rbgName>",
+		Short:              "Display resource and GPU usage of a rbg, aggregated by role",
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := topOpts.output.Validate(); err != nil {
+				return err
+			}
+			rbgClient, err := util.GetRBGClient(topOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(topOpts.cf)
+			if err != nil {
+				return err
+			}
+			var gpuFetcher gpuMetricsFetcher
+			if topOpts.prometheusURL != "" {
+				gpuFetcher = newPrometheusGPUFetcher(topOpts.prometheusURL)
+			}
+			return runTop(context.Background(), rbgClient, k8sClient, newMetricsServerFetcher(k8sClient), gpuFetcher,
+				args[0], util.GetNamespace(topOpts.cf), os.Stdout)
+		},
+	}
+	topOpts.cf = cf
+	topCmd.Flags().StringVar(&topOpts.prometheusURL, "prometheus-url", "",
+		"Prometheus server scraping the DCGM exporter, e.g. http://prometheus.monitoring:9090; GPU columns are empty when unset")
+	topCmd.Flags().BoolVar(&topOpts.showPods, "pods", false, "Also print the usage of every pod")
+	topOpts.output.AddOutputFlags(topCmd, util.OutputJSON, util.OutputYAML, util.OutputWide, util.OutputName)
+
+	return topCmd
+}
+
+// roleUsage aggregates the usage of all pods of a role.
+type roleUsage struct {
+	pods int
+	podUsage
+	gpu gpuUsage
+}
+
+func runTop(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	k8sClient kubernetes.Interface,
+	fetchPodMetrics podMetricsFetcher,
+	fetchGPUMetrics gpuMetricsFetcher,
+	name, namespace string,
+	out io.Writer,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	pods, err := util.ListRolePods(ctx, k8sClient, namespace, name, "")
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("no pods found for rbg %s", name)
+	}
+
+	usages, err := fetchPodMetrics(ctx, namespace, fmt.Sprintf("%s=%s", constants.GroupNameLabelKey, name))
+	if err != nil {
+		return err
+	}
+	var gpus map[string]gpuUsage
+	if fetchGPUMetrics != nil {
+		if gpus, err = fetchGPUMetrics(ctx, namespace); err != nil {
+			return err
+		}
+	}
+
+	roles := map[string]*roleUsage{}
+	for i := range rbg.Spec.Roles {
+		roles[rbg.Spec.Roles[i].Name] = &roleUsage{}
+	}
+	podRows := make([]podRow, 0, len(pods))
+	for i := range pods {
+		pod := &pods[i]
+		roleName := pod.Labels[constants.RoleNameLabelKey]
+		agg, ok := roles[roleName]
+		if !ok {
+			// Pods of roles that were removed from the spec but not cleaned up yet.
+			agg = &roleUsage{}
+			roles[roleName] = agg
+		}
+		usage := usages[pod.Name]
+		gpu := gpus[pod.Name]
+		agg.pods++
+		agg.cpu.Add(usage.cpu)
+		agg.memory.Add(usage.memory)
+		agg.gpu.gpus += gpu.gpus
+		agg.gpu.utilSum += gpu.utilSum
+		agg.gpu.memoryMiB += gpu.memoryMiB
+		podRows = append(podRows, podRow{role: roleName, name: pod.Name, node: pod.Spec.NodeName, podUsage: usage, gpu: gpu})
+	}
+	roleNames := orderedRoles(rbg.Spec.Roles, roles)
+
+	switch {
+	case topOpts.output.IsStructured():
+		return topOpts.output.PrintObject(out, buildReport(rbg, roleNames, roles, podRows, gpus != nil))
+	case topOpts.output.Format == util.OutputName:
+		for _, row := range podRows {
+			_, _ = fmt.Fprintf(out, "pod/%s\n", row.name)
+		}
+		return nil
+	}
+
+	w := printers.GetNewTabWriter(out)
+	defer func() { _ = w.Flush() }()
+
+	// -o wide implies --pods and adds the node of every pod.
+	wide := topOpts.output.Format == util.OutputWide
+	if topOpts.showPods || wide {
+		header := "ROLE\tPOD\tCPU(cores)\tMEMORY(bytes)\tGPUS\tGPU-UTIL\tGPU-MEM"
+		if wide {
+			header += "\tNODE"
+		}
+		topOpts.output.PrintHeader(w, header)
+		for _, row := range podRows {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s", row.role, row.name,
+				formatCPU(row.podUsage), formatMemory(row.podUsage), formatGPU(row.gpu, gpus != nil))
+			if wide {
+				_, _ = fmt.Fprintf(w, "\t%s", row.node)
+			}
+			_, _ = fmt.Fprintln(w)
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+
+	topOpts.output.PrintHeader(w, "ROLE\tPODS\tCPU(cores)\tMEMORY(bytes)\tGPUS\tGPU-UTIL\tGPU-MEM")
+	for _, roleName := range roleNames {
+		agg := roles[roleName]
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", roleName, agg.pods,
+			formatCPU(agg.podUsage), formatMemory(agg.podUsage), formatGPU(agg.gpu, gpus != nil))
+	}
+	return nil
+}
+
+// podRow is the usage of a single pod.
+type podRow struct {
+	role string
+	name string
+	node string
+	podUsage
+	gpu gpuUsage
+}
+
+// usageReport is the -o json|yaml form of the top output.
+type usageReport struct {
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace"`
+	Roles     []usageEntry `json:"roles"`
+	Pods      []usageEntry `json:"pods"`
+}
+
+// usageEntry is the usage of a role or of a pod. GPU fields are omitted when no GPU
+// metrics source is configured.
+type usageEntry struct {
+	Role           string            `json:"role"`
+	Pod            string            `json:"pod,omitempty"`
+	Node           string            `json:"node,omitempty"`
+	Pods           int               `json:"pods,omitempty"`
+	CPU            resource.Quantity `json:"cpu"`
+	Memory         resource.Quantity `json:"memory"`
+	GPUs           *int              `json:"gpus,omitempty"`
+	GPUUtilization *float64          `json:"gpuUtilization,omitempty"`
+	GPUMemoryMiB   *float64          `json:"gpuMemoryMiB,omitempty"`
+}
+
+func buildReport(
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	roleNames []string,
+	roles map[string]*roleUsage,
+	podRows []podRow,
+	gpuAvailable bool,
+) *usageReport {
+	entry := func(usage podUsage, gpu gpuUsage) usageEntry {
+		e := usageEntry{CPU: usage.cpu, Memory: usage.memory}
+		if gpuAvailable {
+			e.GPUs = ptr.To(gpu.gpus)
+			e.GPUMemoryMiB = ptr.To(gpu.memoryMiB)
+			if gpu.gpus > 0 {
+				e.GPUUtilization = ptr.To(gpu.utilSum / float64(gpu.gpus))
+			}
+		}
+		return e
+	}
+	report := &usageReport{Name: rbg.Name, Namespace: rbg.Namespace, Roles: []usageEntry{}, Pods: []usageEntry{}}
+	for _, roleName := range roleNames {
+		agg := roles[roleName]
+		e := entry(agg.podUsage, agg.gpu)
+		e.Role = roleName
+		e.Pods = agg.pods
+		report.Roles = append(report.Roles, e)
+	}
+	for _, row := range podRows {
+		e := entry(row.podUsage, row.gpu)
+		e.Role, e.Pod, e.Node = row.role, row.name, row.node
+		report.Pods = append(report.Pods, e)
+	}
+	return report
+}
+
+func formatCPU(usage podUsage) string {
+	return fmt.Sprintf("%dm", usage.cpu.MilliValue())
+}
+
+func formatMemory(usage podUsage) string {
+	return fmt.Sprintf("%dMi", usage.memory.Value()/(1024*1024))
+}
+
+// formatGPU renders the GPU count, the mean utilization across GPUs and the framebuffer usage.
+func formatGPU(usage gpuUsage, available bool) string {
+	if !available {
+		return "-\t-\t-"
+	}
+	if usage.gpus == 0 {
+		return "0\t-\t-"
+	}
+	return fmt.Sprintf("%d\t%.0f%%\t%.0fMi", usage.gpus, usage.utilSum/float64(usage.gpus), usage.memoryMiB)
+}
+
+// orderedRoles lists the roles in spec order followed by leftover roles in name order.
+func orderedRoles(specRoles []workloadsv1alpha2.RoleSpec, roles map[string]*roleUsage) []string {
+	names := make([]string, 0, len(roles))
+	seen := map[string]bool{}
+	for i := range specRoles {
+		names = append(names, specRoles[i].Name)
+		seen[specRoles[i].Name] = true
+	}
+	var extra []string
+	for name := range roles {
+		if !seen[name] {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	return append(names, extra...)
+}
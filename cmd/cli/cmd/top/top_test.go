@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestParsePodMetrics(t *testing.T) {
+	data := []byte(`{"items":[{"metadata":{"name":"p0"},"containers":[
+		{"name":"a","usage":{"cpu":"250m","memory":"64Mi"}},
+		{"name":"b","usage":{"cpu":"750m","memory":"64Mi"}}]}]}`)
+	usages, err := parsePodMetrics(data)
+	assert.NoError(t, err)
+	usage := usages["p0"]
+	assert.Equal(t, int64(1000), usage.cpu.MilliValue())
+	assert.Equal(t, int64(128*1024*1024), usage.memory.Value())
+
+	_, err = parsePodMetrics([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestPrometheusGPUFetcher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		query := r.URL.Query().Get("query")
+		w.Header().Set("Content-Type", "application/json")
+		if bytes.Contains([]byte(query), []byte(dcgmGPUUtilMetric)) {
+			_, _ = w.Write([]byte(`{"status":"success","data":{"result":[
+				{"metric":{"pod":"p0","gpu":"0"},"value":[1,"80"]},
+				{"metric":{"exported_pod":"p0","gpu":"1"},"value":[1,"40"]}]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"success","data":{"result":[
+			{"metric":{"pod":"p0","gpu":"0"},"value":[1,"1024"]},
+			{"metric":{"pod":"p0","gpu":"1"},"value":[1,"2048"]}]}}`))
+	}))
+	defer server.Close()
+
+	gpus, err := newPrometheusGPUFetcher(server.URL)(context.TODO(), "default")
+	assert.NoError(t, err)
+	assert.Equal(t, gpuUsage{gpus: 2, utilSum: 120, memoryMiB: 3072}, gpus["p0"])
+	assert.Equal(t, "2\t60%\t3072Mi", formatGPU(gpus["p0"], true))
+}
+
+func TestFormatGPU(t *testing.T) {
+	assert.Equal(t, "-\t-\t-", formatGPU(gpuUsage{}, false))
+	assert.Equal(t, "0\t-\t-", formatGPU(gpuUsage{}, true))
+}
+
+func TestRunTop(t *testing.T) {
+	origOpts := topOpts
+	defer func() { topOpts = origOpts }()
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{{Name: "prefill"}, {Name: "decode"}},
+		},
+	}
+	rbgClient := fakerbgclient.NewSimpleClientset(rbg)
+	k8sClient := fake.NewSimpleClientset(
+		wrappersv2.BuildBasicPod().WithName("test-rbg-prefill-0").WithNamespace("default").WithRole("test-rbg", "prefill").Obj(),
+		wrappersv2.BuildBasicPod().WithName("test-rbg-decode-0").WithNamespace("default").WithRole("test-rbg", "decode").Obj(),
+		wrappersv2.BuildBasicPod().WithName("test-rbg-decode-1").WithNamespace("default").WithRole("test-rbg", "decode").Obj(),
+	)
+	podMetrics := func(_ context.Context, _ string, _ string) (map[string]podUsage, error) {
+		return map[string]podUsage{
+			"test-rbg-prefill-0": {cpu: resource.MustParse("2"), memory: resource.MustParse("1Gi")},
+			"test-rbg-decode-0":  {cpu: resource.MustParse("500m"), memory: resource.MustParse("512Mi")},
+			"test-rbg-decode-1":  {cpu: resource.MustParse("500m"), memory: resource.MustParse("512Mi")},
+		}, nil
+	}
+	gpuMetrics := func(_ context.Context, _ string) (map[string]gpuUsage, error) {
+		return map[string]gpuUsage{
+			"test-rbg-decode-0": {gpus: 1, utilSum: 90, memoryMiB: 1000},
+			"test-rbg-decode-1": {gpus: 1, utilSum: 70, memoryMiB: 3000},
+		}, nil
+	}
+
+	topOpts = TopOptions{}
+	var out bytes.Buffer
+	err := runTop(context.TODO(), rbgClient, k8sClient, podMetrics, gpuMetrics, "test-rbg", "default", &out)
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "prefill   1      2000m        1024Mi          0      -          -")
+	assert.Contains(t, out.String(), "decode    2      1000m        1024Mi          2      80%        4000Mi")
+	assert.NotContains(t, out.String(), "test-rbg-decode-0")
+
+	topOpts = TopOptions{showPods: true}
+	out.Reset()
+	err = runTop(context.TODO(), rbgClient, k8sClient, podMetrics, nil, "test-rbg", "default", &out)
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "test-rbg-decode-0")
+	assert.Contains(t, out.String(), "-          -")
+	topOpts = TopOptions{output: util.OutputOptions{Format: util.OutputJSON}}
+	out.Reset()
+	err = runTop(context.TODO(), rbgClient, k8sClient, podMetrics, gpuMetrics, "test-rbg", "default", &out)
+	assert.NoError(t, err)
+	report := &usageReport{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), report))
+	assert.Len(t, report.Pods, 3)
+	assert.Equal(t, "decode", report.Roles[1].Role)
+	assert.Equal(t, 2, report.Roles[1].Pods)
+	assert.Equal(t, "1", report.Roles[1].CPU.String())
+	assert.Equal(t, 80.0, *report.Roles[1].GPUUtilization)
+	assert.Nil(t, report.Roles[0].GPUUtilization)
+
+	topOpts = TopOptions{output: util.OutputOptions{Format: util.OutputName}}
+	out.Reset()
+	err = runTop(context.TODO(), rbgClient, k8sClient, podMetrics, nil, "test-rbg", "default", &out)
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "pod/test-rbg-decode-1\n")
+
+	topOpts = TopOptions{output: util.OutputOptions{Format: util.OutputWide, NoHeaders: true}}
+	out.Reset()
+	err = runTop(context.TODO(), rbgClient, k8sClient, podMetrics, nil, "test-rbg", "default", &out)
+	assert.NoError(t, err)
+	assert.Contains(t, out.String(), "test-rbg-decode-0")
+	assert.NotContains(t, out.String(), "ROLE")
+}
@@ -0,0 +1,516 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+// screen is the page of the dashboard currently shown.
+type screen int
+
+const (
+	screenList screen = iota
+	screenDetail
+	screenLogs
+)
+
+// pane is the table of the detail screen the selection moves in.
+type pane int
+
+const (
+	paneRoles pane = iota
+	panePods
+)
+
+// Keys decoded from the terminal input, printable keys are passed as themselves.
+const (
+	keyUp        = "up"
+	keyDown      = "down"
+	keyEnter     = "enter"
+	keyEsc       = "esc"
+	keyTab       = "tab"
+	keyBackspace = "backspace"
+	keyCtrlC     = "ctrl+c"
+)
+
+// actionKind is what the dashboard loop has to do after a key press.
+type actionKind int
+
+const (
+	actionNone actionKind = iota
+	actionQuit
+	actionRefresh
+	actionScale
+	actionRestart
+)
+
+type action struct {
+	kind     actionKind
+	role     string
+	replicas int32
+}
+
+// prompt is an input line asking for the replicas of a scale or a restart confirmation.
+type prompt struct {
+	restart bool
+	role    string
+	input   string
+}
+
+// roleEvent is a recent event of the rbg tagged with its role.
+type roleEvent struct {
+	role  string
+	event corev1.Event
+}
+
+// model is the state of the dashboard. Keys update it and tell the loop what to do, the
+// loop fetches the data of the current screen into it and renders it.
+type model struct {
+	namespace string
+	screen    screen
+	pane      pane
+
+	rbgs     []workloadsv1alpha2.RoleBasedGroup
+	rbgIndex int
+
+	// name, rbg, pods and events are the rbg of the detail screen.
+	name      string
+	rbg       *workloadsv1alpha2.RoleBasedGroup
+	pods      []corev1.Pod
+	events    []roleEvent
+	roleIndex int
+	podIndex  int
+
+	// logPod and logs are the pod of the logs screen and its last lines.
+	logPod string
+	logs   []string
+
+	prompt  *prompt
+	message string
+}
+
+func newModel(namespace string) *model {
+	return &model{namespace: namespace}
+}
+
+// parseKeys decodes the bytes read from a raw terminal into keys.
+func parseKeys(b []byte) []string {
+	var keys []string
+	for len(b) > 0 {
+		switch {
+		case bytes.HasPrefix(b, []byte("\x1b[A")), bytes.HasPrefix(b, []byte("\x1bOA")):
+			keys, b = append(keys, keyUp), b[3:]
+		case bytes.HasPrefix(b, []byte("\x1b[B")), bytes.HasPrefix(b, []byte("\x1bOB")):
+			keys, b = append(keys, keyDown), b[3:]
+		case bytes.HasPrefix(b, []byte("\x1b[")):
+			// Other escape sequences, such as the left and right arrows, are ignored.
+			b = b[min(3, len(b)):]
+		default:
+			switch b[0] {
+			case '\x1b':
+				keys = append(keys, keyEsc)
+			case '\r', '\n':
+				keys = append(keys, keyEnter)
+			case '\t':
+				keys = append(keys, keyTab)
+			case '\x7f', '\b':
+				keys = append(keys, keyBackspace)
+			case '\x03':
+				keys = append(keys, keyCtrlC)
+			default:
+				keys = append(keys, string(b[0]))
+			}
+			b = b[1:]
+		}
+	}
+	return keys
+}
+
+// handleKey updates the model for a key press and returns the action to run.
+func (m *model) handleKey(key string) action {
+	if key == keyCtrlC {
+		return action{kind: actionQuit}
+	}
+	if m.prompt != nil {
+		return m.handlePromptKey(key)
+	}
+	m.message = ""
+
+	switch m.screen {
+	case screenList:
+		switch key {
+		case "q":
+			return action{kind: actionQuit}
+		case keyUp, "k":
+			m.rbgIndex = max(m.rbgIndex-1, 0)
+		case keyDown, "j":
+			m.rbgIndex = min(m.rbgIndex+1, max(len(m.rbgs)-1, 0))
+		case keyEnter:
+			if len(m.rbgs) == 0 {
+				return action{}
+			}
+			m.name = m.rbgs[m.rbgIndex].Name
+			m.screen, m.pane, m.roleIndex, m.podIndex = screenDetail, paneRoles, 0, 0
+			m.rbg, m.pods, m.events = nil, nil, nil
+			return action{kind: actionRefresh}
+		}
+	case screenDetail:
+		switch key {
+		case "q":
+			return action{kind: actionQuit}
+		case keyEsc:
+			m.screen = screenList
+			return action{kind: actionRefresh}
+		case keyTab:
+			if m.pane == paneRoles {
+				m.pane = panePods
+			} else {
+				m.pane = paneRoles
+			}
+		case keyUp, "k":
+			if m.pane == paneRoles {
+				m.roleIndex = max(m.roleIndex-1, 0)
+			} else {
+				m.podIndex = max(m.podIndex-1, 0)
+			}
+		case keyDown, "j":
+			if m.pane == paneRoles {
+				m.roleIndex = min(m.roleIndex+1, max(m.roleCount()-1, 0))
+			} else {
+				m.podIndex = min(m.podIndex+1, max(len(m.pods)-1, 0))
+			}
+		case "s":
+			if role := m.selectedRole(); role != nil {
+				m.prompt = &prompt{role: role.Name, input: strconv.Itoa(int(replicasOf(role)))}
+			}
+		case "r":
+			if role := m.selectedRole(); role != nil {
+				m.prompt = &prompt{restart: true, role: role.Name}
+			}
+		case "l", keyEnter:
+			if pod := m.selectedPod(); pod != nil {
+				m.screen, m.logPod, m.logs = screenLogs, pod.Name, nil
+				return action{kind: actionRefresh}
+			}
+			m.message = "No pod to show the logs of"
+		}
+	case screenLogs:
+		switch key {
+		case "q":
+			return action{kind: actionQuit}
+		case keyEsc:
+			m.screen = screenDetail
+			return action{kind: actionRefresh}
+		}
+	}
+	return action{}
+}
+
+func (m *model) handlePromptKey(key string) action {
+	p := m.prompt
+	if p.restart {
+		m.prompt = nil
+		if key == "y" || key == "Y" {
+			return action{kind: actionRestart, role: p.role}
+		}
+		return action{}
+	}
+	switch key {
+	case keyEsc:
+		m.prompt = nil
+	case keyBackspace:
+		if p.input != "" {
+			p.input = p.input[:len(p.input)-1]
+		}
+	case keyEnter:
+		m.prompt = nil
+		replicas, err := strconv.ParseInt(p.input, 10, 32)
+		if err != nil || replicas < 0 {
+			m.message = fmt.Sprintf("Invalid replicas %q", p.input)
+			return action{}
+		}
+		return action{kind: actionScale, role: p.role, replicas: int32(replicas)}
+	default:
+		if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+			p.input += key
+		}
+	}
+	return action{}
+}
+
+func (m *model) roleCount() int {
+	if m.rbg == nil {
+		return 0
+	}
+	return len(m.rbg.Spec.Roles)
+}
+
+// selectedRole is the role under the cursor, or the role of the selected pod in the pods pane.
+func (m *model) selectedRole() *workloadsv1alpha2.RoleSpec {
+	if m.rbg == nil {
+		return nil
+	}
+	if m.pane == panePods {
+		pod := m.selectedPod()
+		if pod == nil {
+			return nil
+		}
+		for i := range m.rbg.Spec.Roles {
+			if m.rbg.Spec.Roles[i].Name == pod.Labels[constants.RoleNameLabelKey] {
+				return &m.rbg.Spec.Roles[i]
+			}
+		}
+		return nil
+	}
+	if m.roleIndex < len(m.rbg.Spec.Roles) {
+		return &m.rbg.Spec.Roles[m.roleIndex]
+	}
+	return nil
+}
+
+// selectedPod is the pod under the cursor, or the first pod of the selected role in the roles pane.
+func (m *model) selectedPod() *corev1.Pod {
+	if m.pane == panePods {
+		if m.podIndex < len(m.pods) {
+			return &m.pods[m.podIndex]
+		}
+		return nil
+	}
+	role := m.selectedRole()
+	if role == nil {
+		return nil
+	}
+	for i := range m.pods {
+		if m.pods[i].Labels[constants.RoleNameLabelKey] == role.Name {
+			return &m.pods[i]
+		}
+	}
+	return nil
+}
+
+// clampSelection keeps the cursors in range after the data changed.
+func (m *model) clampSelection() {
+	m.rbgIndex = min(m.rbgIndex, max(len(m.rbgs)-1, 0))
+	m.roleIndex = min(m.roleIndex, max(m.roleCount()-1, 0))
+	m.podIndex = min(m.podIndex, max(len(m.pods)-1, 0))
+}
+
+func replicasOf(role *workloadsv1alpha2.RoleSpec) int32 {
+	if role.Replicas != nil {
+		return *role.Replicas
+	}
+	return 1
+}
+
+// render draws the current screen, cut to the terminal size.
+func (m *model) render(width, height int, now time.Time) string {
+	var buf bytes.Buffer
+	title := fmt.Sprintf("RBG dashboard  namespace: %s", m.namespace)
+	if m.name != "" && m.screen != screenList {
+		title += "  rbg: " + m.name
+	}
+	_, _ = fmt.Fprintf(&buf, "%s  %s\n\n", title, now.Format(time.TimeOnly))
+
+	w := printers.GetNewTabWriter(&buf)
+	switch m.screen {
+	case screenList:
+		m.renderList(w, now)
+	case screenDetail:
+		m.renderDetail(w, now)
+	case screenLogs:
+		_, _ = fmt.Fprintf(w, "Logs of pod %s:\n", m.logPod)
+		for _, line := range m.logs {
+			_, _ = fmt.Fprintln(w, strings.ReplaceAll(line, "\t", "    "))
+		}
+	}
+	_ = w.Flush()
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	footer := []string{""}
+	switch {
+	case m.prompt != nil && m.prompt.restart:
+		footer = append(footer, fmt.Sprintf("Restart role %s? (y/n)", m.prompt.role))
+	case m.prompt != nil:
+		footer = append(footer, fmt.Sprintf("Replicas for role %s: %s_", m.prompt.role, m.prompt.input))
+	case m.message != "":
+		footer = append(footer, m.message)
+	}
+	footer = append(footer, m.help())
+
+	if height > 0 && len(lines)+len(footer) > height {
+		keep := max(height-len(footer), 0)
+		if m.screen == screenLogs && len(lines) > 3 {
+			// Keep the header and the most recent lines of the logs.
+			lines = append(lines[:min(3, keep)], lines[len(lines)-max(keep-3, 0):]...)
+		} else {
+			lines = lines[:keep]
+		}
+	}
+	lines = append(lines, footer...)
+	for i := range lines {
+		lines[i] = truncate(lines[i], width)
+	}
+	return strings.Join(lines, "\r\n")
+}
+
+func (m *model) help() string {
+	switch m.screen {
+	case screenList:
+		return "[↑/↓] select  [enter] open  [q] quit"
+	case screenDetail:
+		return "[tab] roles/pods  [↑/↓] select  [s] scale  [r] restart  [l] logs  [esc] back  [q] quit"
+	default:
+		return "[esc] back  [q] quit"
+	}
+}
+
+// cursor marks the selected row of a table.
+func cursor(selected bool) string {
+	if selected {
+		return ">"
+	}
+	return " "
+}
+
+func (m *model) renderList(w io.Writer, now time.Time) {
+	if len(m.rbgs) == 0 {
+		_, _ = fmt.Fprintf(w, "No RoleBasedGroups found in %s namespace.\n", m.namespace)
+		return
+	}
+	_, _ = fmt.Fprintln(w, " \tNAME\tROLES\tREADY\tPAUSED\tAGE")
+	for i := range m.rbgs {
+		rbg := &m.rbgs[i]
+		roles := make([]string, 0, len(rbg.Spec.Roles))
+		for j := range rbg.Spec.Roles {
+			role := &rbg.Spec.Roles[j]
+			status, _ := rbg.GetRoleStatus(role.Name)
+			roles = append(roles, fmt.Sprintf("%s:%d/%d", role.Name, status.ReadyReplicas, replicasOf(role)))
+		}
+		ready := string(metav1.ConditionUnknown)
+		if cond := meta.FindStatusCondition(rbg.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupReady)); cond != nil {
+			ready = string(cond.Status)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\n", cursor(i == m.rbgIndex), rbg.Name,
+			orNone(strings.Join(roles, ",")), ready, rbg.IsPaused(), age(rbg.CreationTimestamp, now))
+	}
+}
+
+func (m *model) renderDetail(w io.Writer, now time.Time) {
+	if m.rbg == nil {
+		_, _ = fmt.Fprintln(w, "Loading...")
+		return
+	}
+	rbg := m.rbg
+	if rbg.IsPaused() {
+		_, _ = fmt.Fprintln(w, "Paused: reconciliation is frozen")
+	}
+
+	gpus := map[string]int64{}
+	for i := range m.pods {
+		for _, count := range util.PodGPUs(&m.pods[i]) {
+			gpus[m.pods[i].Labels[constants.RoleNameLabelKey]] += count
+		}
+	}
+	_, _ = fmt.Fprintln(w, "Roles:")
+	_, _ = fmt.Fprintln(w, " \tROLE\tWORKLOAD\tDESIRED\tREADY\tUPDATED\tGPUS")
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		status, _ := rbg.GetRoleStatus(role.Name)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\n", cursor(m.pane == paneRoles && i == m.roleIndex),
+			role.Name, role.GetWorkloadSpec().Kind, replicasOf(role), status.ReadyReplicas, status.UpdatedReplicas, gpus[role.Name])
+	}
+
+	_, _ = fmt.Fprintln(w, "\nPods:")
+	if len(m.pods) == 0 {
+		_, _ = fmt.Fprintln(w, "  <none>")
+	} else {
+		_, _ = fmt.Fprintln(w, " \tROLE\tPOD\tPHASE\tREADY\tRESTARTS\tNODE\tGPUS\tAGE")
+	}
+	for i := range m.pods {
+		pod := &m.pods[i]
+		ready, restarts := 0, int32(0)
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Ready {
+				ready++
+			}
+			restarts += cs.RestartCount
+		}
+		var podGPUs int64
+		for _, count := range util.PodGPUs(pod) {
+			podGPUs += count
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d/%d\t%d\t%s\t%d\t%s\n", cursor(m.pane == panePods && i == m.podIndex),
+			orNone(pod.Labels[constants.RoleNameLabelKey]), pod.Name, pod.Status.Phase, ready, len(pod.Spec.Containers),
+			restarts, orNone(pod.Spec.NodeName), podGPUs, age(pod.CreationTimestamp, now))
+	}
+
+	_, _ = fmt.Fprintln(w, "\nRecent events:")
+	if len(m.events) == 0 {
+		_, _ = fmt.Fprintln(w, "  <none>")
+		return
+	}
+	_, _ = fmt.Fprintln(w, " \tLAST SEEN\tROLE\tTYPE\tREASON\tMESSAGE")
+	for _, e := range m.events {
+		role := e.role
+		if role == "" {
+			role = "-"
+		}
+		_, _ = fmt.Fprintf(w, " \t%s\t%s\t%s\t%s\t%s\n", age(util.EventTime(e.event), now), role, e.event.Type,
+			e.event.Reason, strings.Join(strings.Fields(e.event.Message), " "))
+	}
+}
+
+// truncate cuts a line to the terminal width, counting runes.
+func truncate(line string, width int) string {
+	if width <= 0 {
+		return line
+	}
+	runes := []rune(line)
+	if len(runes) <= width {
+		return line
+	}
+	return string(runes[:width])
+}
+
+func age(t metav1.Time, now time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(now.Sub(t.Time))
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newDetailModel() *model {
+	m := newModel("default")
+	m.screen, m.name = screenDetail, "test-rbg"
+	m.rbg = wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(1).Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{
+			RoleStatuses: []workloadsv1alpha2.RoleStatus{{Name: "decode", Replicas: 2, ReadyReplicas: 1}},
+		}).Obj()
+	m.pods = []corev1.Pod{
+		*wrappersv2.BuildBasicPod().WithName("test-rbg-prefill-0").WithNamespace("default").WithRole("test-rbg", "prefill").
+			WithNodeName("node-a").WithGPUs(1).WithPhase(corev1.PodRunning).Obj(),
+		*wrappersv2.BuildBasicPod().WithName("test-rbg-decode-0").WithNamespace("default").WithRole("test-rbg", "decode").
+			WithNodeName("node-a").WithGPUs(2).WithPhase(corev1.PodRunning).Obj(),
+		*wrappersv2.BuildBasicPod().WithName("test-rbg-decode-1").WithNamespace("default").WithRole("test-rbg", "decode").
+			WithNodeName("node-a").WithGPUs(2).WithPhase(corev1.PodRunning).Obj(),
+	}
+	return m
+}
+
+func TestParseKeys(t *testing.T) {
+	assert.Equal(t, []string{keyUp, keyDown, keyUp, keyDown}, parseKeys([]byte("\x1b[A\x1b[B\x1bOA\x1bOB")))
+	assert.Equal(t, []string{"j", keyEnter, keyTab, keyBackspace, keyCtrlC, keyEsc}, parseKeys([]byte("j\r\t\x7f\x03\x1b")))
+	assert.Equal(t, []string{"q"}, parseKeys([]byte("\x1b[Cq")))
+	assert.Empty(t, parseKeys([]byte("\x1b[")))
+}
+
+func TestHandleKeyNavigation(t *testing.T) {
+	m := newModel("default")
+	m.rbgs = []workloadsv1alpha2.RoleBasedGroup{
+		*wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj(),
+		*wrappersv2.BuildBasicRoleBasedGroup("other-rbg", "default").Obj(),
+	}
+
+	assert.Equal(t, action{}, m.handleKey(keyUp))
+	assert.Equal(t, 0, m.rbgIndex)
+	m.handleKey("j")
+	m.handleKey(keyDown)
+	assert.Equal(t, 1, m.rbgIndex)
+
+	assert.Equal(t, action{kind: actionRefresh}, m.handleKey(keyEnter))
+	assert.Equal(t, screenDetail, m.screen)
+	assert.Equal(t, "other-rbg", m.name)
+
+	m = newDetailModel()
+	m.handleKey(keyDown)
+	assert.Equal(t, "decode", m.selectedRole().Name)
+	assert.Equal(t, "test-rbg-decode-0", m.selectedPod().Name)
+
+	m.handleKey(keyTab)
+	m.handleKey(keyDown)
+	m.handleKey(keyDown)
+	m.handleKey(keyDown)
+	assert.Equal(t, 2, m.podIndex)
+	assert.Equal(t, "decode", m.selectedRole().Name)
+
+	assert.Equal(t, action{kind: actionRefresh}, m.handleKey("l"))
+	assert.Equal(t, screenLogs, m.screen)
+	assert.Equal(t, "test-rbg-decode-1", m.logPod)
+	assert.Equal(t, action{kind: actionRefresh}, m.handleKey(keyEsc))
+	assert.Equal(t, screenDetail, m.screen)
+	assert.Equal(t, action{kind: actionRefresh}, m.handleKey(keyEsc))
+	assert.Equal(t, screenList, m.screen)
+	assert.Equal(t, action{kind: actionQuit}, m.handleKey("q"))
+	assert.Equal(t, action{kind: actionQuit}, m.handleKey(keyCtrlC))
+}
+
+func TestHandleKeyPrompts(t *testing.T) {
+	m := newDetailModel()
+	m.roleIndex = 1
+
+	m.handleKey("s")
+	assert.Equal(t, &prompt{role: "decode", input: "2"}, m.prompt)
+	m.handleKey(keyBackspace)
+	m.handleKey("4")
+	m.handleKey("x")
+	assert.Equal(t, action{kind: actionScale, role: "decode", replicas: 4}, m.handleKey(keyEnter))
+	assert.Nil(t, m.prompt)
+
+	m.handleKey("s")
+	m.handleKey(keyBackspace)
+	assert.Equal(t, action{}, m.handleKey(keyEnter))
+	assert.Equal(t, `Invalid replicas ""`, m.message)
+
+	m.handleKey("s")
+	assert.Equal(t, action{}, m.handleKey(keyEsc))
+	assert.Nil(t, m.prompt)
+	assert.Equal(t, screenDetail, m.screen)
+
+	m.handleKey("r")
+	assert.Equal(t, action{}, m.handleKey("n"))
+	m.handleKey("r")
+	assert.Equal(t, action{kind: actionRestart, role: "decode"}, m.handleKey("y"))
+}
+
+func TestRender(t *testing.T) {
+	now := time.Now()
+	m := newModel("default")
+	assert.Contains(t, m.render(0, 0, now), "No RoleBasedGroups found in default namespace.")
+
+	m = newDetailModel()
+	m.events = []roleEvent{{role: "decode", event: corev1.Event{
+		Type: corev1.EventTypeWarning, Reason: "BackOff", Message: "Back-off\n restarting", LastTimestamp: metav1.NewTime(now),
+	}}}
+	screen := m.render(0, 0, now)
+	assert.Contains(t, screen, "rbg: test-rbg")
+	assert.Regexp(t, `>\s+prefill\s+RoleInstanceSet\s+1\s+0\s+0\s+1`, screen)
+	assert.Regexp(t, `decode\s+RoleInstanceSet\s+2\s+1\s+0\s+4`, screen)
+	assert.Regexp(t, `decode\s+test-rbg-decode-1\s+Running\s+0/1\s+0\s+node-a\s+2`, screen)
+	assert.Regexp(t, `decode\s+Warning\s+BackOff\s+Back-off restarting`, screen)
+	assert.Contains(t, screen, "[s] scale")
+
+	m.prompt = &prompt{restart: true, role: "decode"}
+	screen = m.render(20, 5, now)
+	lines := strings.Split(screen, "\r\n")
+	assert.Len(t, lines, 5)
+	assert.Equal(t, "Restart role decode?", lines[3])
+	for _, line := range lines {
+		assert.LessOrEqual(t, len([]rune(line)), 20)
+	}
+
+	m.prompt = nil
+	m.screen, m.logPod = screenLogs, "test-rbg-decode-0"
+	for i := 0; i < 10; i++ {
+		m.logs = append(m.logs, strings.Repeat("x", i))
+	}
+	lines = strings.Split(m.render(0, 8, now), "\r\n")
+	assert.Len(t, lines, 8)
+	assert.Equal(t, "Logs of pod test-rbg-decode-0:", lines[2])
+	assert.Equal(t, []string{"xxxxxxxx", "xxxxxxxxx", "", "[esc] back  [q] quit"}, lines[4:])
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	enterAltScreen = "\033[?1049h\033[?25l"
+	exitAltScreen  = "\033[?25h\033[?1049l"
+	clearScreen    = "\033[H\033[2J"
+
+	// maxEvents is the number of recent events shown on the detail screen.
+	maxEvents = 8
+	// logTailLines is the number of log lines fetched for the logs screen.
+	logTailLines = 200
+)
+
+type UIOptions struct {
+	cf       *genericclioptions.ConfigFlags
+	interval time.Duration
+}
+
+var uiOpts UIOptions
+
+func NewUICmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	uiCmd := &cobra.Command{
+		Use:   "ui",
+		Short: "Interactive terminal dashboard of the rbgs in a namespace",
+		Long: `Interactive terminal dashboard of the rbgs in a namespace.
+
+The dashboard lists the rbgs of the namespace; open one to see its roles, pods, GPUs and
+recent events. From there a role can be scaled or restarted and the logs of a pod shown.
+
+Keys:
+  ↑/↓, j/k   move the selection
+  enter      open the selected rbg, or the logs of the selected pod
+  tab        switch between the roles and the pods of a rbg
+  s          scale the selected role
+  r          restart the selected role
+  l          show the logs of the selected pod
+  esc        go back
+  q          quit`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if uiOpts.interval <= 0 {
+				return fmt.Errorf("--interval must be positive")
+			}
+			if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+				return fmt.Errorf("the dashboard requires an interactive terminal")
+			}
+			rbgClient, err := util.GetRBGClient(uiOpts.cf)
+			if err != nil {
+				return err
+			}
+			k8sClient, err := util.GetK8SClientSet(uiOpts.cf)
+			if err != nil {
+				return err
+			}
+			d := &dashboard{rbgClient: rbgClient, k8sClient: k8sClient, m: newModel(util.GetNamespace(uiOpts.cf))}
+			return d.run(context.Background(), os.Stdin, os.Stdout)
+		},
+	}
+	uiOpts.cf = cf
+	uiCmd.Flags().DurationVar(&uiOpts.interval, "interval", 2*time.Second, "How often to refresh the dashboard")
+
+	return uiCmd
+}
+
+// dashboard connects the model to the cluster and the terminal.
+type dashboard struct {
+	rbgClient versioned.Interface
+	k8sClient kubernetes.Interface
+	m         *model
+}
+
+// run puts the terminal in raw mode on the alternate screen and redraws the dashboard on
+// every key press and refresh, until the user quits.
+func (d *dashboard) run(ctx context.Context, in, out *os.File) error {
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return fmt.Errorf("failed to set up the terminal: %w", err)
+	}
+	defer func() { _ = term.Restore(int(in.Fd()), state) }()
+	_, _ = fmt.Fprint(out, enterAltScreen)
+	defer func() { _, _ = fmt.Fprint(out, exitAltScreen) }()
+
+	keys := make(chan []string)
+	go readKeys(in, keys)
+
+	ticker := time.NewTicker(uiOpts.interval)
+	defer ticker.Stop()
+	d.refresh(ctx)
+	for {
+		width, height, err := term.GetSize(int(out.Fd()))
+		if err != nil {
+			width, height = 0, 0
+		}
+		_, _ = fmt.Fprint(out, clearScreen+d.m.render(width, height, time.Now()))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.refresh(ctx)
+		case pressed, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			for _, key := range pressed {
+				if d.execute(ctx, d.m.handleKey(key)) {
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// readKeys forwards the keys typed on the terminal until it is closed.
+func readKeys(in io.Reader, keys chan<- []string) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			keys <- parseKeys(buf[:n])
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// execute runs the action of a key press and reports whether the dashboard should exit.
+func (d *dashboard) execute(ctx context.Context, a action) bool {
+	m := d.m
+	switch a.kind {
+	case actionQuit:
+		return true
+	case actionScale:
+		if err := util.ScaleRole(ctx, d.rbgClient, m.name, m.namespace, a.role, a.replicas); err != nil {
+			m.message = err.Error()
+		} else {
+			m.message = fmt.Sprintf("Role %s scaled to %d", a.role, a.replicas)
+		}
+	case actionRestart:
+		if err := util.RestartRoles(ctx, d.rbgClient, m.name, m.namespace, []string{a.role}, time.Now()); err != nil {
+			m.message = err.Error()
+		} else {
+			m.message = fmt.Sprintf("Role %s restarted", a.role)
+		}
+	case actionRefresh:
+	default:
+		return false
+	}
+	d.refresh(ctx)
+	return false
+}
+
+// refresh fetches the data of the current screen. Errors are shown in the message line
+// so a transient failure does not end the session.
+func (d *dashboard) refresh(ctx context.Context) {
+	var err error
+	switch d.m.screen {
+	case screenList:
+		err = d.refreshList(ctx)
+	case screenDetail:
+		err = d.refreshDetail(ctx)
+	case screenLogs:
+		err = d.refreshLogs(ctx)
+	}
+	if err != nil {
+		d.m.message = err.Error()
+	}
+	d.m.clampSelection()
+}
+
+func (d *dashboard) refreshList(ctx context.Context) error {
+	list, err := d.rbgClient.WorkloadsV1alpha2().RoleBasedGroups(d.m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list RoleBasedGroups: %w", err)
+	}
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].Name < list.Items[j].Name })
+	d.m.rbgs = list.Items
+	return nil
+}
+
+func (d *dashboard) refreshDetail(ctx context.Context) error {
+	m := d.m
+	rbg, err := d.rbgClient.WorkloadsV1alpha2().RoleBasedGroups(m.namespace).Get(ctx, m.name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	pods, err := util.ListRolePods(ctx, d.k8sClient, m.namespace, m.name, "")
+	if err != nil {
+		return err
+	}
+	events, err := d.k8sClient.CoreV1().Events(m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list events: %w", err)
+	}
+
+	index := util.NewEventRoleIndex(rbg, pods)
+	var tagged []roleEvent
+	for i := range events.Items {
+		if role, ok := index.RoleOf(&events.Items[i]); ok {
+			tagged = append(tagged, roleEvent{role: role, event: events.Items[i]})
+		}
+	}
+	sort.SliceStable(tagged, func(i, j int) bool {
+		a, b := util.EventTime(tagged[i].event), util.EventTime(tagged[j].event)
+		return a.Before(&b)
+	})
+	if len(tagged) > maxEvents {
+		tagged = tagged[len(tagged)-maxEvents:]
+	}
+	m.rbg, m.pods, m.events = rbg, pods, tagged
+	return nil
+}
+
+func (d *dashboard) refreshLogs(ctx context.Context) error {
+	m := d.m
+	pod, err := d.k8sClient.CoreV1().Pods(m.namespace).Get(ctx, m.logPod, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s: %w", m.logPod, err)
+	}
+	data, err := d.k8sClient.CoreV1().Pods(m.namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: util.DefaultContainer(pod),
+		TailLines: ptr.To[int64](logTailLines),
+	}).DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get logs of pod %s: %w", pod.Name, err)
+	}
+	m.logs = strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ui
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newTestDashboard() *dashboard {
+	roles := []workloadsv1alpha2.RoleSpec{
+		wrappersv2.BuildStandaloneRole("prefill").WithReplicas(1).Obj(),
+		wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+	}
+	now := time.Now()
+	var events []*corev1.Event
+	for i := 0; i < maxEvents+2; i++ {
+		events = append(events, &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: fmt.Sprintf("event-%d", i), Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-rbg-decode-0"},
+			Reason:         fmt.Sprintf("Reason%d", i),
+			LastTimestamp:  metav1.NewTime(now.Add(time.Duration(i) * time.Second)),
+		})
+	}
+	events = append(events, &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "unrelated", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-0"},
+	})
+
+	k8sClient := fake.NewSimpleClientset(
+		wrappersv2.BuildBasicPod().WithName("test-rbg-decode-0").WithNamespace("default").WithRole("test-rbg", "decode").
+			WithNodeName("node-a").WithGPUs(2).WithPhase(corev1.PodRunning).Obj(),
+		wrappersv2.BuildBasicPod().WithName("other-0").WithNamespace("default").WithRole("test-rbg", "decode").
+			WithNodeName("node-a").WithGPUs(1).WithPhase(corev1.PodRunning).Obj(),
+	)
+	for _, event := range events {
+		_ = k8sClient.Tracker().Add(event)
+	}
+	return &dashboard{
+		rbgClient: fakerbgclient.NewSimpleClientset(
+			wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithRoles(roles).Obj(),
+			wrappersv2.BuildBasicRoleBasedGroup("another-rbg", "default").WithRoles(roles).Obj(),
+		),
+		k8sClient: k8sClient,
+		m:         newModel("default"),
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	d := newTestDashboard()
+	// The pod of another rbg is not listed.
+	other, err := d.k8sClient.CoreV1().Pods("default").Get(context.TODO(), "other-0", metav1.GetOptions{})
+	require.NoError(t, err)
+	other.Labels[constants.GroupNameLabelKey] = "another-rbg"
+	_, err = d.k8sClient.CoreV1().Pods("default").Update(context.TODO(), other, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	d.m.rbgIndex = 5
+	d.refresh(context.TODO())
+	require.Len(t, d.m.rbgs, 2)
+	assert.Equal(t, "another-rbg", d.m.rbgs[0].Name)
+	assert.Equal(t, 1, d.m.rbgIndex)
+
+	d.execute(context.TODO(), d.m.handleKey(keyEnter))
+	assert.Equal(t, "test-rbg", d.m.rbg.Name)
+	require.Len(t, d.m.pods, 1)
+	assert.Equal(t, "test-rbg-decode-0", d.m.pods[0].Name)
+	require.Len(t, d.m.events, maxEvents)
+	assert.Equal(t, "Reason2", d.m.events[0].event.Reason)
+	assert.Equal(t, fmt.Sprintf("Reason%d", maxEvents+1), d.m.events[maxEvents-1].event.Reason)
+	assert.Equal(t, "decode", d.m.events[0].role)
+
+	d.m.handleKey(keyDown)
+	d.execute(context.TODO(), d.m.handleKey("l"))
+	assert.Equal(t, []string{"fake logs"}, d.m.logs)
+	assert.Empty(t, d.m.message)
+
+	d.m.logPod = "absent"
+	d.refresh(context.TODO())
+	assert.Contains(t, d.m.message, "failed to get pod absent")
+}
+
+func TestExecute(t *testing.T) {
+	d := newTestDashboard()
+	d.m.screen, d.m.name = screenDetail, "test-rbg"
+
+	assert.False(t, d.execute(context.TODO(), action{kind: actionScale, role: "decode", replicas: 4}))
+	assert.Equal(t, "Role decode scaled to 4", d.m.message)
+	assert.Equal(t, int32(4), *d.m.rbg.Spec.Roles[1].Replicas)
+
+	assert.False(t, d.execute(context.TODO(), action{kind: actionRestart, role: "prefill"}))
+	assert.Equal(t, "Role prefill restarted", d.m.message)
+	assert.NotEmpty(t, d.m.rbg.Spec.Roles[0].Annotations[constants.RoleRestartedAtAnnotationKey])
+
+	assert.False(t, d.execute(context.TODO(), action{kind: actionScale, role: "absent", replicas: 1}))
+	assert.Equal(t, `role "absent" not found in rbg test-rbg`, d.m.message)
+
+	assert.True(t, d.execute(context.TODO(), action{kind: actionQuit}))
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+	"sigs.k8s.io/rbgs/pkg/dependency"
+	"sigs.k8s.io/rbgs/pkg/reconciler"
+)
+
+// podGroupGroups are the API groups of the PodGroups the gang scheduling integrations create.
+var podGroupGroups = map[string]bool{
+	"scheduling.x-k8s.io":   true,
+	"scheduling.volcano.sh": true,
+}
+
+// collectPodGroups indexes the minMember of the PodGroups of the manifest by namespace and name.
+func collectPodGroups(docs []*unstructured.Unstructured) map[string]int32 {
+	podGroups := map[string]int32{}
+	for _, obj := range docs {
+		gvk := obj.GroupVersionKind()
+		if gvk.Kind != "PodGroup" || !podGroupGroups[gvk.Group] {
+			continue
+		}
+		// Both PodGroup flavours share the spec.minMember field.
+		podGroup := struct {
+			Spec struct {
+				MinMember int32 `json:"minMember"`
+			} `json:"spec"`
+		}{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &podGroup); err != nil {
+			continue
+		}
+		podGroups[obj.GetNamespace()+"/"+obj.GetName()] = podGroup.Spec.MinMember
+	}
+	return podGroups
+}
+
+// validateRoleBasedGroup checks the rules that the CRD schema cannot express.
+func validateRoleBasedGroup(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, podGroups map[string]int32, r *report,
+) {
+	rolesPath := field.NewPath("spec", "roles")
+	names := map[string]bool{}
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		path := rolesPath.Index(i)
+		if errs := validation.IsDNS1123Label(role.Name); len(errs) > 0 {
+			r.errorf(path.Child("name"), "%q is not a valid DNS label: %s", role.Name, errs[0])
+		}
+		if names[role.Name] {
+			r.errorf(path.Child("name"), "duplicate role name %q", role.Name)
+		}
+		names[role.Name] = true
+		if role.Replicas == nil {
+			r.errorf(path.Child("replicas"), "is required")
+		}
+		validateWorkload(role, path, r)
+	}
+	if len(r.errors) > 0 {
+		// The remaining rules resolve roles by name and count their pods.
+		return
+	}
+
+	templatesValid := true
+	for _, err := range []error{
+		workloadsv1alpha2.ValidateRoleTemplates(rbg),
+		workloadsv1alpha2.ValidateRoleTemplateReferences(rbg),
+	} {
+		if err != nil {
+			r.errors = append(r.errors, err.Error())
+			templatesValid = false
+		}
+	}
+	// The dependency manager logs the cycles it finds, which is noise for a command line tool.
+	quiet := logf.IntoContext(ctx, logr.Discard())
+	if _, err := dependency.NewDefaultDependencyManager(runtime.NewScheme(), nil).SortRoles(quiet, rbg); err != nil {
+		r.errorf(rolesPath, "invalid dependencies: %v", err)
+	}
+
+	validatePodGroup(rbg, podGroups, r)
+
+	if !templatesValid {
+		return
+	}
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		for _, t := range roleTemplates(rbg, role, rolesPath.Index(i)) {
+			if t.err != nil {
+				r.errorf(t.path, "%v", t.err)
+				continue
+			}
+			validateProbes(&t.template.Spec, t.path.Child("spec"), r)
+		}
+	}
+}
+
+// validateWorkload checks the workload kind of a role and the patterns it supports.
+func validateWorkload(role *workloadsv1alpha2.RoleSpec, path *field.Path, r *report) {
+	workloadPath := path.Child("annotations").Key(constants.RoleWorkloadTypeAnnotationKey)
+	workload := role.GetWorkloadSpec()
+	if workload.String() == constants.JobSetWorkloadType {
+		r.errorf(workloadPath, "JobSet is not supported yet, use %s for one-shot roles", constants.JobWorkloadType)
+		return
+	}
+	if _, err := reconciler.NewWorkloadReconciler(workload, runtime.NewScheme(), fake.NewFakeClient()); err != nil {
+		r.errorf(workloadPath, "unsupported workload %q, supported workloads are %s, %s, %s, %s and %s",
+			role.GetWorkloadType(), constants.RoleInstanceSetWorkloadType, constants.StatefulSetWorkloadType,
+			constants.DeploymentWorkloadType, constants.LeaderWorkerSetWorkloadType, constants.JobWorkloadType)
+		return
+	}
+
+	patterns := 0
+	for _, set := range []bool{role.StandalonePattern != nil, role.LeaderWorkerPattern != nil, role.CustomComponentsPattern != nil} {
+		if set {
+			patterns++
+		}
+	}
+	switch {
+	case patterns == 0:
+		r.errorf(path, "one of standalonePattern, leaderWorkerPattern or customComponentsPattern is required")
+		return
+	case patterns > 1:
+		r.errorf(path, "standalonePattern, leaderWorkerPattern and customComponentsPattern are mutually exclusive")
+		return
+	}
+
+	if lwp := role.LeaderWorkerPattern; lwp != nil && lwp.Size != nil && *lwp.Size < 1 {
+		r.errorf(path.Child("leaderWorkerPattern", "size"), "must be at least 1")
+	}
+	switch workload.String() {
+	case constants.DeploymentWorkloadType, constants.StatefulSetWorkloadType, constants.JobWorkloadType:
+		if role.LeaderWorkerPattern != nil {
+			r.errorf(path.Child("leaderWorkerPattern"), "not supported for %s workloads, use LeaderWorkerSet or RoleInstanceSet",
+				workload.Kind)
+		}
+	}
+	if role.CustomComponentsPattern != nil && workload.String() != constants.RoleInstanceSetWorkloadType {
+		r.errorf(path.Child("customComponentsPattern"), "only supported for RoleInstanceSet workloads")
+	}
+}
+
+// validatePodGroup checks the PodGroup of the manifest gang scheduling the rbg, if any.
+// The controller creates the PodGroup with a minMember of the number of pods of the rbg.
+func validatePodGroup(rbg *workloadsv1alpha2.RoleBasedGroup, podGroups map[string]int32, r *report) {
+	size := int32(rbg.GetGroupSize())
+	gang := rbg.Annotations[constants.GangSchedulingAnnotationKey] == "true"
+	minMember, found := podGroups[rbg.Namespace+"/"+rbg.Name]
+
+	rolesPath := field.NewPath("spec", "roles")
+	switch {
+	case found && minMember > size:
+		r.errorf(rolesPath, "PodGroup %s requires a minMember of %d pods but the roles only have %d, the group can never be scheduled",
+			rbg.Name, minMember, size)
+	case found && gang && minMember != size:
+		r.warnf(rolesPath, "PodGroup %s has a minMember of %d but the roles have %d pods, the controller will reset it to %d",
+			rbg.Name, minMember, size, size)
+	}
+	if gang && size == 0 {
+		r.warnf(rolesPath, "gang scheduling is enabled but the roles have no replicas")
+	}
+}
+
+// podTemplate is a pod template a role creates pods from.
+type podTemplate struct {
+	path     *field.Path
+	template corev1.PodTemplateSpec
+	err      error
+}
+
+func roleTemplates(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, path *field.Path) []podTemplate {
+	if ccp := role.CustomComponentsPattern; ccp != nil {
+		templates := make([]podTemplate, 0, len(ccp.Components))
+		for i := range ccp.Components {
+			templates = append(templates, podTemplate{
+				path:     path.Child("customComponentsPattern", "components").Index(i).Child("template"),
+				template: ccp.Components[i].Template,
+			})
+		}
+		return templates
+	}
+
+	patternPath := path.Child("standalonePattern")
+	if role.LeaderWorkerPattern != nil {
+		patternPath = path.Child("leaderWorkerPattern")
+	}
+	if role.UsesRoleTemplate() {
+		patternPath = patternPath.Child("templateRef")
+	} else {
+		patternPath = patternPath.Child("template")
+	}
+	if !role.HasTemplate() {
+		return []podTemplate{{path: patternPath, err: fmt.Errorf("is required")}}
+	}
+	// A ClusterRoleTemplate is not part of the group, its template is checked by the controller.
+	if role.UsesClusterRoleTemplate() {
+		return nil
+	}
+	template, err := role.GetResolvedTemplate(rbg)
+	return []podTemplate{{path: patternPath, template: template, err: err}}
+}
+
+// validateProbes checks the probes of the containers of a pod template. The API server
+// validates them on the pods only, long after the rbg was accepted.
+func validateProbes(spec *corev1.PodSpec, path *field.Path, r *report) {
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		containerPath := path.Child("containers").Index(i)
+		probes := []struct {
+			name  string
+			probe *corev1.Probe
+		}{
+			{"readinessProbe", c.ReadinessProbe},
+			{"livenessProbe", c.LivenessProbe},
+			{"startupProbe", c.StartupProbe},
+		}
+		for _, p := range probes {
+			if p.probe != nil {
+				validateProbe(c, p.name, p.probe, containerPath.Child(p.name), r)
+			}
+		}
+
+		// Inference engines take minutes to load the model, a liveness probe starting with the
+		// container restarts it before it ever becomes ready.
+		gpus := util.PodGPUs(&corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{*c}}})
+		if c.LivenessProbe != nil && c.StartupProbe == nil && c.LivenessProbe.InitialDelaySeconds == 0 && len(gpus) > 0 {
+			r.warnf(containerPath.Child("livenessProbe"),
+				"starts with the container of a GPU workload, add a startupProbe to cover the model loading")
+		}
+	}
+}
+
+func validateProbe(c *corev1.Container, name string, probe *corev1.Probe, path *field.Path, r *report) {
+	handlers := 0
+	var port *intstr.IntOrString
+	if probe.Exec != nil {
+		handlers++
+	}
+	if probe.HTTPGet != nil {
+		handlers++
+		port = &probe.HTTPGet.Port
+	}
+	if probe.TCPSocket != nil {
+		handlers++
+		port = &probe.TCPSocket.Port
+	}
+	if probe.GRPC != nil {
+		handlers++
+		port = ptr.To(intstr.FromInt32(probe.GRPC.Port))
+	}
+	if handlers != 1 {
+		r.errorf(path, "must specify exactly one of exec, httpGet, tcpSocket or grpc")
+		return
+	}
+
+	if port != nil {
+		validateProbePort(c, *port, path, r)
+	}
+	if name != "readinessProbe" && probe.SuccessThreshold > 1 {
+		r.errorf(path.Child("successThreshold"), "must be 1 for a %s", name)
+	}
+	if probe.TimeoutSeconds > 0 && probe.PeriodSeconds > 0 && probe.TimeoutSeconds > probe.PeriodSeconds {
+		r.warnf(path.Child("timeoutSeconds"), "%ds exceeds the period of %ds", probe.TimeoutSeconds, probe.PeriodSeconds)
+	}
+}
+
+func validateProbePort(c *corev1.Container, port intstr.IntOrString, path *field.Path, r *report) {
+	if port.Type == intstr.Int {
+		if errs := validation.IsValidPortNum(port.IntValue()); len(errs) > 0 {
+			r.errorf(path, "invalid port %d: %s", port.IntValue(), errs[0])
+		}
+		return
+	}
+	for _, p := range c.Ports {
+		if p.Name == port.StrVal {
+			return
+		}
+	}
+	r.errorf(path, "port %q is not a named port of container %s", port.StrVal, c.Name)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func newRole(name string, replicas int32, workloadType string) workloadsv1alpha2.RoleSpec {
+	role := workloadsv1alpha2.RoleSpec{
+		Name:     name,
+		Replicas: ptr.To(replicas),
+		Pattern: workloadsv1alpha2.Pattern{StandalonePattern: &workloadsv1alpha2.StandalonePattern{
+			TemplateSource: workloadsv1alpha2.TemplateSource{Template: &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "engine", Image: "sglang"}}},
+			}},
+		}},
+	}
+	if workloadType != "" {
+		role.Annotations = map[string]string{constants.RoleWorkloadTypeAnnotationKey: workloadType}
+	}
+	return role
+}
+
+func newRBG(roles ...workloadsv1alpha2.RoleSpec) *workloadsv1alpha2.RoleBasedGroup {
+	return &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec:       workloadsv1alpha2.RoleBasedGroupSpec{Roles: roles},
+	}
+}
+
+func TestValidateRoles(t *testing.T) {
+	lws := newRole("decode", 1, constants.StatefulSetWorkloadType)
+	lws.LeaderWorkerPattern = &workloadsv1alpha2.LeaderWorkerPattern{
+		Size: ptr.To[int32](0), TemplateSource: lws.StandalonePattern.TemplateSource,
+	}
+	lws.StandalonePattern = nil
+	components := newRole("router", 1, constants.DeploymentWorkloadType)
+	components.StandalonePattern = nil
+	components.CustomComponentsPattern = &workloadsv1alpha2.CustomComponentsPattern{}
+	none := newRole("none", 1, "")
+	none.StandalonePattern = nil
+
+	r := &report{}
+	validateRoleBasedGroup(context.TODO(), newRBG(
+		newRole("prefill", 1, ""),
+		newRole("prefill", 1, ""),
+		newRole("Bad_Name", 1, ""),
+		newRole("cron", 1, "batch/v1/CronJob"),
+		lws, components, none,
+		newRole("eval", 1, constants.JobSetWorkloadType),
+	), nil, r)
+	assert.Equal(t, []string{
+		`spec.roles[1].name: duplicate role name "prefill"`,
+		`spec.roles[2].name: "Bad_Name" is not a valid DNS label: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+		`spec.roles[3].annotations[rbg.workloads.x-k8s.io/role-workload-type]: unsupported workload "batch/v1/CronJob", supported workloads are workloads.x-k8s.io/v1alpha2/RoleInstanceSet, apps/v1/StatefulSet, apps/v1/Deployment, leaderworkerset.x-k8s.io/v1/LeaderWorkerSet and batch/v1/Job`,
+		`spec.roles[4].leaderWorkerPattern.size: must be at least 1`,
+		`spec.roles[4].leaderWorkerPattern: not supported for StatefulSet workloads, use LeaderWorkerSet or RoleInstanceSet`,
+		`spec.roles[5].customComponentsPattern: only supported for RoleInstanceSet workloads`,
+		`spec.roles[6]: one of standalonePattern, leaderWorkerPattern or customComponentsPattern is required`,
+		`spec.roles[7].annotations[rbg.workloads.x-k8s.io/role-workload-type]: JobSet is not supported yet, use batch/v1/Job for one-shot roles`,
+	}, r.errors)
+
+	prefill, decode := newRole("prefill", 1, ""), newRole("decode", 1, "")
+	prefill.Dependencies, decode.Dependencies = []string{"decode"}, []string{"prefill"}
+	r = &report{}
+	validateRoleBasedGroup(context.TODO(), newRBG(prefill, decode), nil, r)
+	assert.Equal(t, []string{"spec.roles: invalid dependencies: failed to sort roles by dependency order: cycle detected for role 'decode'"}, r.errors)
+
+	decode = newRole("decode", 1, "")
+	decode.StandalonePattern.Template = nil
+	decode.StandalonePattern.TemplateRef = &workloadsv1alpha2.TemplateRef{Name: "absent"}
+	r = &report{}
+	validateRoleBasedGroup(context.TODO(), newRBG(decode), nil, r)
+	assert.Equal(t, []string{`spec.roles[0].templateRef.name: template "absent" not found in spec.roleTemplates`}, r.errors)
+}
+
+func TestValidatePodGroup(t *testing.T) {
+	rbg := newRBG(newRole("prefill", 1, ""), newRole("decode", 2, ""))
+	cases := []struct {
+		name      string
+		gang      bool
+		podGroups map[string]int32
+		errors    []string
+		warnings  []string
+	}{
+		{name: "no podgroup", gang: true},
+		{name: "matching", gang: true, podGroups: map[string]int32{"default/llm": 3}},
+		{name: "other rbg", podGroups: map[string]int32{"default/other": 10}},
+		{
+			name:      "too large",
+			podGroups: map[string]int32{"default/llm": 4},
+			errors:    []string{"spec.roles: PodGroup llm requires a minMember of 4 pods but the roles only have 3, the group can never be scheduled"},
+		},
+		{
+			name:      "reset",
+			gang:      true,
+			podGroups: map[string]int32{"default/llm": 2},
+			warnings:  []string{"spec.roles: PodGroup llm has a minMember of 2 but the roles have 3 pods, the controller will reset it to 3"},
+		},
+		{name: "not gang scheduled", podGroups: map[string]int32{"default/llm": 2}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rbg.Annotations = nil
+			if c.gang {
+				rbg.Annotations = map[string]string{constants.GangSchedulingAnnotationKey: "true"}
+			}
+			r := &report{}
+			validatePodGroup(rbg, c.podGroups, r)
+			assert.Equal(t, c.errors, r.errors)
+			assert.Equal(t, c.warnings, r.warnings)
+		})
+	}
+}
+
+func TestValidateProbes(t *testing.T) {
+	httpGet := func(port intstr.IntOrString) corev1.ProbeHandler {
+		return corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/health", Port: port}}
+	}
+	spec := &corev1.PodSpec{Containers: []corev1.Container{
+		{
+			Name:  "engine",
+			Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8000}},
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			},
+			ReadinessProbe: &corev1.Probe{ProbeHandler: httpGet(intstr.FromString("http")), SuccessThreshold: 2},
+			LivenessProbe: &corev1.Probe{
+				ProbeHandler: httpGet(intstr.FromString("metrics")), SuccessThreshold: 2, TimeoutSeconds: 30, PeriodSeconds: 10,
+			},
+		},
+		{
+			Name: "sidecar",
+			ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+				Exec: &corev1.ExecAction{Command: []string{"true"}}, TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(80)},
+			}},
+			LivenessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(0)}}},
+			StartupProbe:  &corev1.Probe{ProbeHandler: corev1.ProbeHandler{GRPC: &corev1.GRPCAction{Port: 9000}}},
+		},
+	}}
+
+	r := &report{}
+	validateProbes(spec, nil, r)
+	assert.Equal(t, []string{
+		`containers[0].livenessProbe: port "metrics" is not a named port of container engine`,
+		`containers[0].livenessProbe.successThreshold: must be 1 for a livenessProbe`,
+		`containers[1].readinessProbe: must specify exactly one of exec, httpGet, tcpSocket or grpc`,
+		`containers[1].livenessProbe: invalid port 0: must be between 1 and 65535, inclusive`,
+	}, r.errors)
+	assert.Equal(t, []string{
+		`containers[0].livenessProbe.timeoutSeconds: 30s exceeds the period of 10s`,
+		`containers[0].livenessProbe: starts with the container of a GPU workload, add a startupProbe to cover the model loading`,
+	}, r.warnings)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"sigs.k8s.io/rbgs/config/crd"
+	"sigs.k8s.io/yaml"
+)
+
+// schemaValidator checks objects against one version of the CRD the way the API server
+// does on create: the schema defaults are applied, then the OpenAPI schema and the CEL
+// rules are checked.
+type schemaValidator struct {
+	structural *structuralschema.Structural
+	validator  validation.SchemaValidator
+	// cel is nil when the version has no CEL rules.
+	cel *cel.Validator
+}
+
+func (s schemaValidator) validate(ctx context.Context, obj *unstructured.Unstructured) field.ErrorList {
+	structuraldefaulting.Default(obj.Object, s.structural)
+	errs := validation.ValidateCustomResource(nil, obj.Object, s.validator)
+	if s.cel != nil {
+		celErrs, _ := s.cel.Validate(ctx, nil, s.structural, obj.Object, nil, celconfig.RuntimeCELCostBudget)
+		errs = append(errs, celErrs...)
+	}
+	return errs
+}
+
+// newSchemaValidators builds a schema validator per version of the embedded RoleBasedGroup CRD.
+func newSchemaValidators() (map[string]schemaValidator, error) {
+	def := &apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(crd.RoleBasedGroups, def); err != nil {
+		return nil, fmt.Errorf("failed to decode RoleBasedGroup CRD: %w", err)
+	}
+	validators := map[string]schemaValidator{}
+	for _, version := range def.Spec.Versions {
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+		schema := &apiextensions.JSONSchemaProps{}
+		if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(
+			version.Schema.OpenAPIV3Schema, schema, nil,
+		); err != nil {
+			return nil, fmt.Errorf("failed to convert schema of RoleBasedGroup %s: %w", version.Name, err)
+		}
+		structural, err := structuralschema.NewStructural(schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema of RoleBasedGroup %s: %w", version.Name, err)
+		}
+		validator, _, err := validation.NewSchemaValidator(schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build schema validator of RoleBasedGroup %s: %w", version.Name, err)
+		}
+		validators[version.Name] = schemaValidator{
+			structural: structural,
+			validator:  validator,
+			cel:        cel.NewValidator(structural, true, celconfig.PerCallLimit),
+		}
+	}
+	return validators, nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	workloadsv1alpha1 "sigs.k8s.io/rbgs/api/workloads/v1alpha1"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+type ValidateOptions struct {
+	cf       *genericclioptions.ConfigFlags
+	filename string
+	strict   bool
+}
+
+var validateOpts ValidateOptions
+
+func NewValidateCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	validateCmd := &cobra.Command{
+		Use:   "validate -f <file>",
+		Short: "Validate RoleBasedGroup manifests without contacting the cluster",
+		Long: `Validate RoleBasedGroup manifests without contacting the cluster.
+
+Every RoleBasedGroup of the manifest is checked against the schema of the RoleBasedGroup
+CRD, then against the rules the controller enforces or relies on: unique role names,
+supported workload kinds and patterns, role dependencies and templates, the minMember of
+the PodGroups of the manifest and the sanity of the container probes.`,
+		Example: `  kubectl rbg validate -f rbg.yaml
+  cat rbg.yaml podgroup.yaml | kubectl rbg validate -f -
+  kubectl rbg validate -f rbg.yaml --strict`,
+		Args:               cobra.NoArgs,
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if validateOpts.filename == "" {
+				return fmt.Errorf("-f/--filename is required")
+			}
+			in, err := util.OpenManifest(validateOpts.filename)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = in.Close() }()
+			return runValidate(context.Background(), in, util.GetNamespace(validateOpts.cf), validateOpts.strict, os.Stdout)
+		},
+	}
+	validateOpts.cf = cf
+	validateCmd.Flags().StringVarP(&validateOpts.filename, "filename", "f", "",
+		"Manifest containing the RoleBasedGroup(s) to validate, use - for stdin")
+	validateCmd.Flags().BoolVar(&validateOpts.strict, "strict", false, "Fail on warnings as well as errors")
+
+	return validateCmd
+}
+
+// report collects the problems found in a RoleBasedGroup.
+type report struct {
+	errors   []string
+	warnings []string
+}
+
+func (r *report) errorf(path *field.Path, format string, args ...interface{}) {
+	r.errors = append(r.errors, path.String()+": "+fmt.Sprintf(format, args...))
+}
+
+func (r *report) warnf(path *field.Path, format string, args ...interface{}) {
+	r.warnings = append(r.warnings, path.String()+": "+fmt.Sprintf(format, args...))
+}
+
+func runValidate(ctx context.Context, in io.Reader, namespace string, strict bool, out io.Writer) error {
+	docs, err := decodeManifest(in, namespace)
+	if err != nil {
+		return err
+	}
+	validators, err := newSchemaValidators()
+	if err != nil {
+		return err
+	}
+	podGroups := collectPodGroups(docs)
+
+	var rbgs, errCount, warnCount int
+	for _, obj := range docs {
+		if !isRoleBasedGroup(obj) {
+			continue
+		}
+		rbgs++
+		r := validateDocument(ctx, obj, validators, podGroups)
+		errCount += len(r.errors)
+		warnCount += len(r.warnings)
+
+		if len(r.errors) == 0 && len(r.warnings) == 0 {
+			_, _ = fmt.Fprintf(out, "RoleBasedGroup %s is valid\n", obj.GetName())
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "RoleBasedGroup %s:\n", obj.GetName())
+		for _, msg := range r.errors {
+			_, _ = fmt.Fprintf(out, "  error: %s\n", msg)
+		}
+		for _, msg := range r.warnings {
+			_, _ = fmt.Fprintf(out, "  warning: %s\n", msg)
+		}
+	}
+	if rbgs == 0 {
+		return fmt.Errorf("no RoleBasedGroup found in manifest")
+	}
+	if errCount > 0 || (strict && warnCount > 0) {
+		return fmt.Errorf("validation failed with %d error(s) and %d warning(s)", errCount, warnCount)
+	}
+	return nil
+}
+
+// decodeManifest splits a YAML or JSON stream into objects, defaulting their namespace.
+func decodeManifest(in io.Reader, namespace string) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bufio.NewReader(in), 4096)
+	var docs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		docs = append(docs, obj)
+	}
+	return docs, nil
+}
+
+func isRoleBasedGroup(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == workloadsv1alpha2.GroupVersion.Group && gvk.Kind == "RoleBasedGroup"
+}
+
+// validateDocument checks a RoleBasedGroup against the CRD schema of its version, then
+// converts it to v1alpha2 to check the semantic rules.
+func validateDocument(
+	ctx context.Context,
+	obj *unstructured.Unstructured,
+	validators map[string]schemaValidator,
+	podGroups map[string]int32,
+) *report {
+	r := &report{}
+	version := obj.GroupVersionKind().Version
+	validator, ok := validators[version]
+	if !ok {
+		r.errorf(field.NewPath("apiVersion"), "unsupported version %q of RoleBasedGroup", obj.GetAPIVersion())
+		return r
+	}
+	if obj.GetName() == "" && obj.GetGenerateName() == "" {
+		r.errorf(field.NewPath("metadata", "name"), "name or generateName is required")
+	}
+	for _, err := range validator.validate(ctx, obj) {
+		r.errors = append(r.errors, err.Error())
+	}
+	if len(r.errors) > 0 {
+		// The semantic rules assume a well-formed object.
+		return r
+	}
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	switch version {
+	case workloadsv1alpha2.GroupVersion.Version:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(obj.Object, rbg, true); err != nil {
+			r.errors = append(r.errors, err.Error())
+			return r
+		}
+	case workloadsv1alpha1.GroupVersion.Version:
+		old := &workloadsv1alpha1.RoleBasedGroup{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(obj.Object, old, true); err != nil {
+			r.errors = append(r.errors, err.Error())
+			return r
+		}
+		if err := old.ConvertTo(rbg); err != nil {
+			r.errorf(field.NewPath("spec"), "cannot be converted to %s: %v", workloadsv1alpha2.GroupVersion, err)
+			return r
+		}
+	}
+	validateRoleBasedGroup(ctx, rbg, podGroups, r)
+	return r
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validRBG = `apiVersion: workloads.x-k8s.io/v1alpha2
+kind: RoleBasedGroup
+metadata:
+  name: llm
+spec:
+  roles:
+  - name: prefill
+    replicas: 1
+    standalonePattern:
+      template:
+        spec:
+          containers:
+          - name: engine
+            image: sglang:latest
+  - name: decode
+    replicas: 2
+    dependencies: [prefill]
+    leaderWorkerPattern:
+      size: 2
+      template:
+        spec:
+          containers:
+          - name: engine
+            image: sglang:latest
+`
+
+func TestRunValidate(t *testing.T) {
+	cases := []struct {
+		name     string
+		manifest string
+		strict   bool
+		err      string
+		output   []string
+	}{
+		{
+			name:     "valid",
+			manifest: validRBG + "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other\n",
+			output:   []string{"RoleBasedGroup llm is valid"},
+		},
+		{
+			name:     "schema",
+			manifest: strings.Replace(validRBG, "replicas: 1", "replicas: one", 1),
+			err:      "validation failed with 1 error(s) and 0 warning(s)",
+			output:   []string{`error: spec.roles[0].replicas: Invalid value: "string": spec.roles[0].replicas in body must be of type integer`},
+		},
+		{
+			name: "cel rule",
+			manifest: strings.Replace(validRBG, "      template:\n        spec:\n          containers:\n          - name: engine\n            image: sglang:latest\n  - name: decode",
+				"      templateRef:\n        name: base\n      template:\n        spec:\n          containers:\n          - name: engine\n            image: sglang:latest\n  - name: decode", 1),
+			err:    "validation failed",
+			output: []string{"template and templateRef are mutually exclusive"},
+		},
+		{
+			name:     "unknown field",
+			manifest: strings.Replace(validRBG, "    replicas: 2\n", "    replicas: 2\n    replica: 3\n", 1),
+			err:      "validation failed",
+			output:   []string{`unknown field "spec.roles[1].replica"`},
+		},
+		{
+			name:     "semantic",
+			manifest: strings.Replace(validRBG, "dependencies: [prefill]", "dependencies: [router]", 1),
+			err:      "validation failed",
+			output:   []string{"error: spec.roles: invalid dependencies: role [decode] with dependency role [router] not found in rbg"},
+		},
+		{
+			name: "v1alpha1",
+			manifest: `apiVersion: workloads.x-k8s.io/v1alpha1
+kind: RoleBasedGroup
+metadata:
+  name: old
+spec:
+  roles:
+  - name: worker
+    replicas: 1
+    workload:
+      apiVersion: apps/v1
+      kind: StatefulSet
+    rolloutStrategy:
+      rollingUpdate:
+        maxUnavailable: 1
+    template:
+      spec:
+        containers:
+        - name: engine
+          image: nginx
+`,
+			output: []string{"RoleBasedGroup old is valid"},
+		},
+		{
+			name:     "unsupported version",
+			manifest: strings.Replace(validRBG, "v1alpha2", "v1beta1", 1),
+			err:      "validation failed",
+			output:   []string{`error: apiVersion: unsupported version "workloads.x-k8s.io/v1beta1" of RoleBasedGroup`},
+		},
+		{
+			name:     "no rbg",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other\n",
+			err:      "no RoleBasedGroup found in manifest",
+		},
+		{
+			name:     "strict",
+			manifest: validRBG + "---\napiVersion: scheduling.x-k8s.io/v1alpha1\nkind: PodGroup\nmetadata:\n  name: llm\nspec:\n  minMember: 3\n",
+			strict:   true,
+			err:      "validation failed with 0 error(s) and 1 warning(s)",
+			output:   []string{"warning: spec.roles: PodGroup llm has a minMember of 3 but the roles have 5 pods"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			manifest := c.manifest
+			if c.strict {
+				manifest = strings.Replace(manifest, "  name: llm\n", "  name: llm\n  annotations:\n    rbg.workloads.x-k8s.io/group-gang-scheduling: \"true\"\n", 1)
+			}
+			var out bytes.Buffer
+			err := runValidate(context.TODO(), strings.NewReader(manifest), "default", c.strict, &out)
+			if c.err == "" {
+				require.NoError(t, err, out.String())
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), c.err)
+			}
+			for _, o := range c.output {
+				assert.Contains(t, out.String(), o)
+			}
+		})
+	}
+}
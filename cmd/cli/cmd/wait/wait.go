@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+	"sigs.k8s.io/rbgs/cmd/cli/util"
+)
+
+const (
+	defaultWaitTimeout = 30 * time.Minute
+
+	forDelete          = "delete"
+	forConditionPrefix = "condition="
+
+	// Role-level conditions, derived from the role status as roles carry no conditions.
+	roleConditionReady   = "Ready"
+	roleConditionUpdated = "Updated"
+)
+
+type WaitOptions struct {
+	cf      *genericclioptions.ConfigFlags
+	forCond string
+	roles   []string
+	timeout time.Duration
+}
+
+var waitOpts WaitOptions
+
+// waitPollInterval is how often the rbg is checked; overridden in tests.
+var waitPollInterval = 2 * time.Second
+
+// waitCondition is the parsed --for flag.
+type waitCondition struct {
+	delete bool
+	// conditionType is a rbg condition type, or a role condition when roles are set.
+	conditionType string
+	status        metav1.ConditionStatus
+}
+
+func NewWaitCmd(cf *genericclioptions.ConfigFlags) *cobra.Command {
+	waitCmd := &cobra.Command{
+		Use:   "wait <rbgName> [--for=condition=<type>[=<status>]|--for=delete] [--role <roleName>...]",
+		Short: "Wait until a rbg or some of its roles meet a condition",
+		Long: `Wait until a rbg or some of its roles meet a condition.
+
+By default the condition is one of the rbg status conditions, such as Ready, Progressing
+or RollingUpdateInProgress, with the expected status defaulting to True. The condition
+is only considered once the controller observed the latest generation, so a wait right
+after an apply does not pass on the status of the previous spec.
+
+With --role the condition applies to each of the given roles instead: Ready waits until
+the role runs its desired number of ready replicas, Updated additionally requires every
+replica to run the latest revision.
+
+--for=delete waits until the rbg is gone. The command fails when the timeout expires,
+reporting what was last observed.`,
+		Example: `  # Gate a pipeline on the whole group being ready
+  kubectl rbg wait my-rbg --for=condition=Ready --timeout=30m
+
+  # Wait for the decode and prefill roles to be rolled out
+  kubectl rbg wait my-rbg --for=condition=Updated --role decode --role prefill
+
+  # Wait until the rollout finished
+  kubectl rbg wait my-rbg --for=condition=RollingUpdateInProgress=False
+
+  kubectl rbg wait my-rbg --for=delete`,
+		Args:               cobra.ExactArgs(1),
+		DisableAutoGenTag:  true,
+		SilenceUsage:       true,
+		FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cond, err := parseCondition(waitOpts.forCond, len(waitOpts.roles) > 0)
+			if err != nil {
+				return err
+			}
+			if waitOpts.timeout <= 0 {
+				return fmt.Errorf("--timeout must be positive")
+			}
+			rbgClient, err := util.GetRBGClient(waitOpts.cf)
+			if err != nil {
+				return err
+			}
+			return runWait(context.Background(), rbgClient, args[0], util.GetNamespace(waitOpts.cf), cond, os.Stdout)
+		},
+	}
+	waitOpts.cf = cf
+	waitCmd.Flags().StringVar(&waitOpts.forCond, "for", forConditionPrefix+string(workloadsv1alpha2.RoleBasedGroupReady),
+		"The condition to wait on: condition=<type>[=<status>] or delete")
+	waitCmd.Flags().StringSliceVar(&waitOpts.roles, "role", nil,
+		"Wait on the Ready or Updated condition of the given roles instead of the rbg, may be repeated")
+	waitCmd.Flags().DurationVar(&waitOpts.timeout, "timeout", defaultWaitTimeout, "The length of time to wait before giving up")
+
+	return waitCmd
+}
+
+func parseCondition(value string, roleLevel bool) (waitCondition, error) {
+	if value == forDelete {
+		if roleLevel {
+			return waitCondition{}, fmt.Errorf("--for=delete cannot be combined with --role")
+		}
+		return waitCondition{delete: true}, nil
+	}
+	if !strings.HasPrefix(value, forConditionPrefix) {
+		return waitCondition{}, fmt.Errorf("invalid --for %q, must be condition=<type>[=<status>] or delete", value)
+	}
+	conditionType, status, hasStatus := strings.Cut(strings.TrimPrefix(value, forConditionPrefix), "=")
+	if conditionType == "" {
+		return waitCondition{}, fmt.Errorf("invalid --for %q, the condition type is empty", value)
+	}
+	cond := waitCondition{conditionType: conditionType, status: metav1.ConditionTrue}
+	if hasStatus {
+		switch {
+		case strings.EqualFold(status, string(metav1.ConditionTrue)):
+		case strings.EqualFold(status, string(metav1.ConditionFalse)):
+			cond.status = metav1.ConditionFalse
+		case strings.EqualFold(status, string(metav1.ConditionUnknown)):
+			cond.status = metav1.ConditionUnknown
+		default:
+			return waitCondition{}, fmt.Errorf("invalid condition status %q, must be True, False or Unknown", status)
+		}
+	}
+	if roleLevel {
+		switch {
+		case strings.EqualFold(conditionType, roleConditionReady):
+			cond.conditionType = roleConditionReady
+		case strings.EqualFold(conditionType, roleConditionUpdated):
+			cond.conditionType = roleConditionUpdated
+		default:
+			return waitCondition{}, fmt.Errorf("roles only support the %s and %s conditions, got %q",
+				roleConditionReady, roleConditionUpdated, conditionType)
+		}
+		if cond.status == metav1.ConditionUnknown {
+			return waitCondition{}, fmt.Errorf("role conditions are either True or False")
+		}
+	}
+	return cond, nil
+}
+
+func runWait(
+	ctx context.Context,
+	rbgClient versioned.Interface,
+	name, namespace string,
+	cond waitCondition,
+	out io.Writer,
+) error {
+	// Keep the last observation to explain a timeout.
+	var last string
+	err := wait.PollUntilContextTimeout(ctx, waitPollInterval, waitOpts.timeout, true,
+		func(ctx context.Context) (bool, error) {
+			rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				if cond.delete {
+					return true, nil
+				}
+				last = "rbg not found"
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			if cond.delete {
+				last = "rbg still exists"
+				return false, nil
+			}
+			met, observed, err := evaluate(rbg, cond, waitOpts.roles)
+			last = observed
+			return met, err
+		})
+	if err != nil {
+		if wait.Interrupted(err) {
+			return fmt.Errorf("timed out waiting for rbg %s: %s", name, last)
+		}
+		return fmt.Errorf("failed waiting for rbg %s: %w", name, err)
+	}
+
+	if cond.delete {
+		_, _ = fmt.Fprintf(out, "rbg %s deleted\n", name)
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "rbg %s condition met\n", name)
+	return nil
+}
+
+// evaluate reports whether the condition is met and describes what was observed otherwise.
+// An unknown role is an error, as waiting would never succeed.
+func evaluate(rbg *workloadsv1alpha2.RoleBasedGroup, cond waitCondition, roles []string) (bool, string, error) {
+	if rbg.Status.ObservedGeneration < rbg.Generation {
+		return false, fmt.Sprintf("generation %d not observed yet, observed %d", rbg.Generation, rbg.Status.ObservedGeneration), nil
+	}
+	if len(roles) == 0 {
+		return evaluateGroup(rbg, cond)
+	}
+	for _, roleName := range roles {
+		role, err := rbg.GetRole(roleName)
+		if err != nil {
+			return false, "", err
+		}
+		met, observed := evaluateRole(rbg, role, cond)
+		if !met {
+			return false, observed, nil
+		}
+	}
+	return true, "", nil
+}
+
+func evaluateGroup(rbg *workloadsv1alpha2.RoleBasedGroup, cond waitCondition) (bool, string, error) {
+	for _, c := range rbg.Status.Conditions {
+		if !strings.EqualFold(c.Type, cond.conditionType) {
+			continue
+		}
+		if c.Status == cond.status {
+			return true, "", nil
+		}
+		observed := fmt.Sprintf("condition %s is %s", c.Type, c.Status)
+		if c.Reason != "" {
+			observed += ", reason " + c.Reason
+		}
+		if c.Message != "" {
+			observed += ": " + c.Message
+		}
+		return false, observed, nil
+	}
+	// A condition false by absence, e.g. RollingUpdateInProgress once the rollout ended.
+	if cond.status == metav1.ConditionFalse {
+		return true, "", nil
+	}
+	return false, fmt.Sprintf("condition %s not reported", cond.conditionType), nil
+}
+
+func evaluateRole(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, cond waitCondition) (bool, string) {
+	desired := int32(1)
+	if role.Replicas != nil {
+		desired = *role.Replicas
+	}
+	status, _ := rbg.GetRoleStatus(role.Name)
+	ready := status.Replicas == desired && status.ReadyReplicas == desired
+	if cond.conditionType == roleConditionUpdated {
+		ready = ready && status.UpdatedReplicas == desired
+	}
+	observed := fmt.Sprintf("role %s has %d/%d ready and %d/%d updated replicas",
+		role.Name, status.ReadyReplicas, desired, status.UpdatedReplicas, desired)
+	return ready == (cond.status == metav1.ConditionTrue), observed
+}
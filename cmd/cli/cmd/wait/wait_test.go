@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestParseCondition(t *testing.T) {
+	cond, err := parseCondition("condition=ready", false)
+	require.NoError(t, err)
+	assert.Equal(t, waitCondition{conditionType: "ready", status: metav1.ConditionTrue}, cond)
+
+	cond, err = parseCondition("condition=RollingUpdateInProgress=false", false)
+	require.NoError(t, err)
+	assert.Equal(t, metav1.ConditionFalse, cond.status)
+
+	cond, err = parseCondition("condition=updated", true)
+	require.NoError(t, err)
+	assert.Equal(t, roleConditionUpdated, cond.conditionType)
+
+	cond, err = parseCondition("delete", false)
+	require.NoError(t, err)
+	assert.True(t, cond.delete)
+
+	for value, msg := range map[string]string{
+		"ready":                 `invalid --for "ready", must be condition=<type>[=<status>] or delete`,
+		"condition=":            `invalid --for "condition=", the condition type is empty`,
+		"condition=Ready=maybe": `invalid condition status "maybe", must be True, False or Unknown`,
+	} {
+		_, err := parseCondition(value, false)
+		assert.EqualError(t, err, msg, value)
+	}
+	for value, msg := range map[string]string{
+		"condition=Progressing":   `roles only support the Ready and Updated conditions, got "Progressing"`,
+		"condition=Ready=Unknown": "role conditions are either True or False",
+		"delete":                  "--for=delete cannot be combined with --role",
+	} {
+		_, err := parseCondition(value, true)
+		assert.EqualError(t, err, msg, value)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithGeneration(2).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{
+			ObservedGeneration: 2,
+			Conditions: []metav1.Condition{{
+				Type:    string(workloadsv1alpha2.RoleBasedGroupReady),
+				Status:  metav1.ConditionFalse,
+				Reason:  "RoleNotReady",
+				Message: "role decode is not ready",
+			}},
+			RoleStatuses: []workloadsv1alpha2.RoleStatus{
+				{Name: "router", Replicas: 1, ReadyReplicas: 1, UpdatedReplicas: 1},
+				{Name: "decode", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 1},
+			},
+		}).Obj()
+	ready := waitCondition{conditionType: "Ready", status: metav1.ConditionTrue}
+	met, observed, err := evaluate(rbg, ready, nil)
+	require.NoError(t, err)
+	assert.False(t, met)
+	assert.Equal(t, "condition Ready is False, reason RoleNotReady: role decode is not ready", observed)
+
+	met, _, _ = evaluate(rbg, waitCondition{conditionType: "Ready", status: metav1.ConditionFalse}, nil)
+	assert.True(t, met)
+	met, _, _ = evaluate(rbg, waitCondition{conditionType: "RollingUpdateInProgress", status: metav1.ConditionFalse}, nil)
+	assert.True(t, met)
+	met, observed, _ = evaluate(rbg, waitCondition{conditionType: "Paused", status: metav1.ConditionTrue}, nil)
+	assert.False(t, met)
+	assert.Equal(t, "condition Paused not reported", observed)
+
+	roleReady := waitCondition{conditionType: roleConditionReady, status: metav1.ConditionTrue}
+	met, _, err = evaluate(rbg, roleReady, []string{"router", "decode"})
+	require.NoError(t, err)
+	assert.True(t, met)
+
+	met, observed, _ = evaluate(rbg, waitCondition{conditionType: roleConditionUpdated, status: metav1.ConditionTrue}, []string{"router", "decode"})
+	assert.False(t, met)
+	assert.Equal(t, "role decode has 2/2 ready and 1/2 updated replicas", observed)
+
+	_, _, err = evaluate(rbg, roleReady, []string{"prefill"})
+	assert.Error(t, err)
+
+	rbg.Generation = 3
+	met, observed, _ = evaluate(rbg, waitCondition{conditionType: "Ready", status: metav1.ConditionFalse}, nil)
+	assert.False(t, met)
+	assert.Equal(t, "generation 3 not observed yet, observed 2", observed)
+}
+
+func TestRunWait(t *testing.T) {
+	origOpts := waitOpts
+	origInterval := waitPollInterval
+	defer func() {
+		waitOpts = origOpts
+		waitPollInterval = origInterval
+	}()
+	waitPollInterval = 10 * time.Millisecond
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithGeneration(2).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj(),
+		}).
+		WithStatus(workloadsv1alpha2.RoleBasedGroupStatus{
+			ObservedGeneration: 2,
+			Conditions: []metav1.Condition{{
+				Type:    string(workloadsv1alpha2.RoleBasedGroupReady),
+				Status:  metav1.ConditionFalse,
+				Reason:  "RoleNotReady",
+				Message: "role decode is not ready",
+			}},
+			RoleStatuses: []workloadsv1alpha2.RoleStatus{
+				{Name: "router", Replicas: 1, ReadyReplicas: 1, UpdatedReplicas: 1},
+				{Name: "decode", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 1},
+			},
+		}).Obj()
+	client := fakerbgclient.NewSimpleClientset(rbg)
+	var out bytes.Buffer
+
+	waitOpts = WaitOptions{roles: []string{"decode"}, timeout: time.Second}
+	require.NoError(t, runWait(context.TODO(), client, "test-rbg", "default",
+		waitCondition{conditionType: roleConditionReady, status: metav1.ConditionTrue}, &out))
+	assert.Equal(t, "rbg test-rbg condition met\n", out.String())
+
+	waitOpts = WaitOptions{timeout: 50 * time.Millisecond}
+	err := runWait(context.TODO(), client, "test-rbg", "default",
+		waitCondition{conditionType: "Ready", status: metav1.ConditionTrue}, &out)
+	assert.EqualError(t, err, "timed out waiting for rbg test-rbg: condition Ready is False, reason RoleNotReady: role decode is not ready")
+
+	err = runWait(context.TODO(), client, "test-rbg", "default", waitCondition{delete: true}, &out)
+	assert.EqualError(t, err, "timed out waiting for rbg test-rbg: rbg still exists")
+
+	waitOpts = WaitOptions{roles: []string{"prefill"}, timeout: time.Second}
+	err = runWait(context.TODO(), client, "test-rbg", "default",
+		waitCondition{conditionType: roleConditionReady, status: metav1.ConditionTrue}, &out)
+	assert.ErrorContains(t, err, "failed waiting for rbg test-rbg")
+
+	require.NoError(t, client.WorkloadsV1alpha2().RoleBasedGroups("default").Delete(context.TODO(), "test-rbg", metav1.DeleteOptions{}))
+	out.Reset()
+	waitOpts = WaitOptions{timeout: time.Second}
+	require.NoError(t, runWait(context.TODO(), client, "test-rbg", "default", waitCondition{delete: true}, &out))
+	assert.Equal(t, "rbg test-rbg deleted\n", out.String())
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+)
+
+// completionTimeout bounds the cluster lookups of shell completion, so a slow or unreachable
+// cluster does not hang the shell.
+const completionTimeout = 5 * time.Second
+
+// RegisterCompletions sets up the dynamic shell completion of a command tree: the <rbgName>
+// argument completes with the rbgs of the namespace, --role with the roles of that rbg and
+// --namespace with the namespaces of the cluster. Commands that already set a
+// ValidArgsFunction keep it.
+func RegisterCompletions(root *cobra.Command, cf *genericclioptions.ConfigFlags) {
+	if root.PersistentFlags().Lookup("namespace") != nil {
+		_ = root.RegisterFlagCompletionFunc("namespace", CompleteNamespaces(cf))
+	}
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		if cmd.ValidArgsFunction == nil && strings.Contains(cmd.Use, "<rbgName>") {
+			cmd.ValidArgsFunction = CompleteRBGNames(cf, 1)
+		}
+		if cmd.Flags().Lookup("role") != nil {
+			if _, found := cmd.GetFlagCompletionFunc("role"); !found {
+				_ = cmd.RegisterFlagCompletionFunc("role", CompleteRoleNames(cf))
+			}
+		}
+		for _, sub := range cmd.Commands() {
+			walk(sub)
+		}
+	}
+	walk(root)
+}
+
+// CompleteRBGNames completes the first maxArgs arguments with the names of the rbgs in the
+// namespace; the arguments after them complete as files.
+func CompleteRBGNames(cf *genericclioptions.ConfigFlags, maxArgs int) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) >= maxArgs {
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+		rbgClient, err := GetRBGClient(cf)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return completeRBGNames(rbgClient, GetNamespace(cf), toComplete)
+	}
+}
+
+// CompleteRoleNames completes a role flag with the roles of the rbg named by the first
+// argument. Comma separated values complete their last element.
+func CompleteRoleNames(cf *genericclioptions.ConfigFlags) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		rbgClient, err := GetRBGClient(cf)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return completeRoleNames(rbgClient, GetNamespace(cf), args[0], toComplete)
+	}
+}
+
+// CompleteNamespaces completes a namespace flag with the namespaces of the cluster.
+func CompleteNamespaces(cf *genericclioptions.ConfigFlags) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		k8sClient, err := GetK8SClientSet(cf)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return completeNamespaces(k8sClient, toComplete)
+	}
+}
+
+func completeRBGNames(rbgClient versioned.Interface, namespace, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	list, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		names = append(names, list.Items[i].Name)
+	}
+	return filterCompletions(names, "", toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeRoleNames(
+	rbgClient versioned.Interface,
+	namespace, rbgName, toComplete string,
+) ([]cobra.Completion, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, rbgName, metav1.GetOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	// The roles already given in a comma separated value are not offered again.
+	var prefix string
+	given := map[string]bool{}
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix, toComplete = toComplete[:i+1], toComplete[i+1:]
+		for _, role := range strings.Split(prefix, ",") {
+			given[role] = true
+		}
+	}
+	names := make([]string, 0, len(rbg.Spec.Roles))
+	for _, role := range rbg.Spec.Roles {
+		if !given[role.Name] {
+			names = append(names, role.Name)
+		}
+	}
+	return filterCompletions(names, prefix, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeNamespaces(k8sClient kubernetes.Interface, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	list, err := k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		names = append(names, list.Items[i].Name)
+	}
+	return filterCompletions(names, "", toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// filterCompletions returns the sorted names starting with toComplete, prefixed with prefix.
+func filterCompletions(names []string, prefix, toComplete string) []cobra.Completion {
+	sort.Strings(names)
+	var completions []cobra.Completion
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, prefix+name)
+		}
+	}
+	return completions
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+)
+
+func TestCompleteRBGNames(t *testing.T) {
+	rbgClient := fakerbgclient.NewSimpleClientset(
+		&workloadsv1alpha2.RoleBasedGroup{ObjectMeta: metav1.ObjectMeta{Name: "llm-small", Namespace: "default"}},
+		&workloadsv1alpha2.RoleBasedGroup{ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"}},
+		&workloadsv1alpha2.RoleBasedGroup{ObjectMeta: metav1.ObjectMeta{Name: "chat", Namespace: "default"}},
+		&workloadsv1alpha2.RoleBasedGroup{ObjectMeta: metav1.ObjectMeta{Name: "llm-other", Namespace: "team-b"}},
+	)
+	completions, directive := completeRBGNames(rbgClient, "default", "ll")
+	assert.Equal(t, []string{"llm", "llm-small"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	completions, _ = completeRBGNames(rbgClient, "default", "")
+	assert.Equal(t, []string{"chat", "llm", "llm-small"}, completions)
+}
+
+func TestCompleteRoleNames(t *testing.T) {
+	rbgClient := fakerbgclient.NewSimpleClientset(&workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{{Name: "router"}, {Name: "prefill"}, {Name: "decode"}},
+		},
+	})
+	completions, directive := completeRoleNames(rbgClient, "default", "llm", "")
+	assert.Equal(t, []string{"decode", "prefill", "router"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	completions, _ = completeRoleNames(rbgClient, "default", "llm", "p")
+	assert.Equal(t, []string{"prefill"}, completions)
+	completions, _ = completeRoleNames(rbgClient, "default", "llm", "prefill,")
+	assert.Equal(t, []string{"prefill,decode", "prefill,router"}, completions)
+
+	_, directive = completeRoleNames(rbgClient, "default", "absent", "")
+	assert.Equal(t, cobra.ShellCompDirectiveError, directive)
+}
+
+func TestCompleteNamespaces(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+	)
+	completions, directive := completeNamespaces(k8sClient, "team")
+	assert.Equal(t, []string{"team-a", "team-b"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestRegisterCompletions(t *testing.T) {
+	cf := genericclioptions.NewConfigFlags(true)
+	root := &cobra.Command{Use: "rbgctl"}
+	cf.AddFlags(root.PersistentFlags())
+	scale := &cobra.Command{Use: "scale <rbgName> --role <roleName>"}
+	scale.Flags().String("role", "", "")
+	custom := &cobra.Command{Use: "compare <rbg1> [<rbg2>]", ValidArgsFunction: cobra.NoFileCompletions}
+	gpu := &cobra.Command{Use: "gpu"}
+	set := &cobra.Command{Use: "set SUBCOMMAND"}
+	image := &cobra.Command{Use: "image <rbgName> ROLE=IMAGE"}
+	set.AddCommand(image)
+	root.AddCommand(scale, custom, gpu, set)
+
+	RegisterCompletions(root, cf)
+	assert.NotNil(t, scale.ValidArgsFunction)
+	assert.NotNil(t, image.ValidArgsFunction)
+	assert.Nil(t, gpu.ValidArgsFunction)
+	_, found := scale.GetFlagCompletionFunc("role")
+	assert.True(t, found)
+	_, found = root.GetFlagCompletionFunc("namespace")
+	assert.True(t, found)
+
+	// Arguments after the rbg name keep the default completion.
+	_, directive := scale.ValidArgsFunction(scale, []string{"llm"}, "")
+	assert.Equal(t, cobra.ShellCompDirectiveDefault, directive)
+	_, directive = CompleteRoleNames(cf)(scale, nil, "")
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
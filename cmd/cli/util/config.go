@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigEnv overrides the path of the CLI configuration file.
+	ConfigEnv = "RBGCTL_CONFIG"
+
+	ConfigDefaultNamespace = "default-namespace"
+	ConfigDefaultRegistry  = "default-registry"
+)
+
+// CLIConfig holds the defaults of the CLI, stored in ~/.rbgctl/config.yaml. Flags given on
+// the command line always take precedence.
+type CLIConfig struct {
+	// DefaultNamespace is used when --namespace is not set, before the kubeconfig namespace.
+	DefaultNamespace string `json:"default-namespace,omitempty"`
+	// DefaultRegistry prefixes the images given without a registry, e.g. my.registry/.
+	DefaultRegistry string `json:"default-registry,omitempty"`
+}
+
+// ConfigKeys returns the keys accepted by CLIConfig.Get and CLIConfig.Set.
+func ConfigKeys() []string {
+	return []string{ConfigDefaultNamespace, ConfigDefaultRegistry}
+}
+
+func (c *CLIConfig) field(key string) (*string, error) {
+	switch key {
+	case ConfigDefaultNamespace:
+		return &c.DefaultNamespace, nil
+	case ConfigDefaultRegistry:
+		return &c.DefaultRegistry, nil
+	}
+	return nil, fmt.Errorf("unknown config key %q, must be one of %s", key, strings.Join(ConfigKeys(), ", "))
+}
+
+func (c *CLIConfig) Get(key string) (string, error) {
+	field, err := c.field(key)
+	if err != nil {
+		return "", err
+	}
+	return *field, nil
+}
+
+// Set sets the value of a key; an empty value unsets it.
+func (c *CLIConfig) Set(key, value string) error {
+	field, err := c.field(key)
+	if err != nil {
+		return err
+	}
+	if key == ConfigDefaultNamespace && value != "" {
+		if errs := validation.IsDNS1123Label(value); len(errs) > 0 {
+			return fmt.Errorf("invalid %s %q: %s", key, value, strings.Join(errs, ", "))
+		}
+	}
+	if key == ConfigDefaultRegistry && strings.ContainsAny(value, " \t") {
+		return fmt.Errorf("invalid %s %q: must not contain spaces", key, value)
+	}
+	*field = value
+	return nil
+}
+
+// ConfigPath returns the path of the CLI configuration file, $RBGCTL_CONFIG if set.
+func ConfigPath() (string, error) {
+	if path := os.Getenv(ConfigEnv); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the home directory: %w", err)
+	}
+	return filepath.Join(home, ".rbgctl", "config.yaml"), nil
+}
+
+// LoadConfig reads the CLI configuration file. A missing file is an empty configuration.
+func LoadConfig() (*CLIConfig, error) {
+	config := &CLIConfig{}
+	path, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// SaveConfig writes the CLI configuration file, creating its directory if needed.
+func SaveConfig(config *CLIConfig) error {
+	path, err := ConfigPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// loadConfigOrEmpty is LoadConfig for the defaults lookups, which only warn about an invalid
+// file so a broken configuration does not block every command.
+func loadConfigOrEmpty() *CLIConfig {
+	config, err := LoadConfig()
+	if err != nil {
+		klog.Warningln(err)
+		return &CLIConfig{}
+	}
+	return config
+}
+
+// ResolveImage prefixes an image given without a registry with the configured default
+// registry. Images with a registry host, e.g. docker.io/library/nginx, are kept as is.
+func ResolveImage(image string) string {
+	registry := strings.TrimSuffix(loadConfigOrEmpty().DefaultRegistry, "/")
+	if registry == "" || image == "" || hasRegistry(image) {
+		return image
+	}
+	return registry + "/" + image
+}
+
+// hasRegistry reports whether the first component of an image reference is a registry
+// host, following the rules of the docker reference format.
+func hasRegistry(image string) bool {
+	first, _, found := strings.Cut(image, "/")
+	if !found {
+		return false
+	}
+	return strings.ContainsAny(first, ".:") || first == "localhost"
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSaveConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rbgctl", "config.yaml")
+	t.Setenv(ConfigEnv, path)
+
+	config, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, &CLIConfig{}, config)
+
+	require.NoError(t, config.Set(ConfigDefaultNamespace, "llm"))
+	require.NoError(t, config.Set(ConfigDefaultRegistry, "my.registry/"))
+	require.NoError(t, SaveConfig(config))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "default-namespace: llm\ndefault-registry: my.registry/\n", string(data))
+
+	loaded, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, config, loaded)
+	value, err := loaded.Get(ConfigDefaultRegistry)
+	assert.NoError(t, err)
+	assert.Equal(t, "my.registry/", value)
+
+	require.NoError(t, os.WriteFile(path, []byte("default-cluster: prod\n"), 0o600))
+	_, err = LoadConfig()
+	assert.ErrorContains(t, err, "failed to parse config file")
+}
+
+func TestCLIConfigSet(t *testing.T) {
+	config := &CLIConfig{}
+	assert.EqualError(t, config.Set("default-cluster", "prod"),
+		`unknown config key "default-cluster", must be one of default-namespace, default-registry`)
+	assert.ErrorContains(t, config.Set(ConfigDefaultNamespace, "LLM"), `invalid default-namespace "LLM"`)
+	assert.EqualError(t, config.Set(ConfigDefaultRegistry, "my registry"),
+		`invalid default-registry "my registry": must not contain spaces`)
+
+	require.NoError(t, config.Set(ConfigDefaultNamespace, "llm"))
+	require.NoError(t, config.Set(ConfigDefaultNamespace, ""))
+	assert.Equal(t, &CLIConfig{}, config)
+}
+
+func TestResolveImage(t *testing.T) {
+	t.Setenv(ConfigEnv, filepath.Join(t.TempDir(), "config.yaml"))
+	assert.Equal(t, "lmsysorg/sglang:v0.4.9", ResolveImage("lmsysorg/sglang:v0.4.9"))
+
+	require.NoError(t, SaveConfig(&CLIConfig{DefaultRegistry: "my.registry/"}))
+	cases := map[string]string{
+		"nginx":                        "my.registry/nginx",
+		"lmsysorg/sglang:v0.4.9":       "my.registry/lmsysorg/sglang:v0.4.9",
+		"docker.io/library/nginx":      "docker.io/library/nginx",
+		"localhost/engine":             "localhost/engine",
+		"registry:5000/engine@sha256:": "registry:5000/engine@sha256:",
+		"":                             "",
+	}
+	for image, expected := range cases {
+		assert.Equal(t, expected, ResolveImage(image), image)
+	}
+}
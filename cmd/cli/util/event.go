@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// EventTime returns when the event was last seen, falling back to the fields set by
+// the newer events API and finally to the creation time.
+func EventTime(event corev1.Event) metav1.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	if !event.EventTime.IsZero() {
+		return metav1.NewTime(event.EventTime.Time)
+	}
+	return event.CreationTimestamp
+}
+
+// EventRoleIndex maps the objects of a rbg to the role they belong to, so that events
+// can be attributed to roles.
+type EventRoleIndex struct {
+	group string
+	// objects maps "<kind>/<name>" of known objects to their role.
+	objects map[string]string
+	// workloads maps the role workload names to their role. Objects named after a workload,
+	// such as its pods or the sub-workloads of a LeaderWorkerSet, belong to the same role.
+	workloads map[string]string
+}
+
+// NewEventRoleIndex indexes the rbg, its role workloads and the given pods of the rbg.
+func NewEventRoleIndex(rbg *workloadsv1alpha2.RoleBasedGroup, pods []corev1.Pod) *EventRoleIndex {
+	index := &EventRoleIndex{
+		group:     rbg.Name,
+		objects:   map[string]string{"RoleBasedGroup/" + rbg.Name: ""},
+		workloads: map[string]string{},
+	}
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		workloadName := rbg.GetWorkloadName(role)
+		index.objects[role.GetWorkloadSpec().Kind+"/"+workloadName] = role.Name
+		index.workloads[workloadName] = role.Name
+	}
+	for i := range pods {
+		index.objects["Pod/"+pods[i].Name] = pods[i].Labels[constants.RoleNameLabelKey]
+	}
+	return index
+}
+
+// RoleOf returns the role the event's object belongs to and whether it belongs to the rbg
+// at all. The role is empty for the rbg itself and objects shared by all roles.
+func (idx *EventRoleIndex) RoleOf(event *corev1.Event) (string, bool) {
+	obj := event.InvolvedObject
+	if role, ok := idx.objects[obj.Kind+"/"+obj.Name]; ok {
+		return role, true
+	}
+	// Prefer the longest workload name so that role "decode" does not claim the
+	// objects of role "decode-large".
+	role, longest := "", 0
+	for workloadName, r := range idx.workloads {
+		if strings.HasPrefix(obj.Name, workloadName+"-") && len(workloadName) > longest {
+			role, longest = r, len(workloadName)
+		}
+	}
+	if longest > 0 {
+		return role, true
+	}
+	if obj.Name == idx.group {
+		// E.g. the pod group created for gang scheduling.
+		return "", true
+	}
+	return "", false
+}
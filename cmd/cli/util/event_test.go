@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func TestEventTime(t *testing.T) {
+	created := metav1.NewTime(time.Unix(100, 0))
+	eventTime := metav1.NewMicroTime(time.Unix(200, 0))
+	last := metav1.NewTime(time.Unix(300, 0))
+
+	event := corev1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created}}
+	assert.Equal(t, created, EventTime(event))
+
+	event.EventTime = eventTime
+	assert.Equal(t, int64(200), EventTime(event).Unix())
+
+	event.LastTimestamp = last
+	assert.Equal(t, last, EventTime(event))
+}
+
+func TestEventRoleIndex(t *testing.T) {
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{
+				{
+					Name:        "decode",
+					Annotations: map[string]string{constants.RoleWorkloadTypeAnnotationKey: constants.StatefulSetWorkloadType},
+				},
+				{
+					Name:        "decode-large",
+					Annotations: map[string]string{constants.RoleWorkloadTypeAnnotationKey: constants.LeaderWorkerSetWorkloadType},
+				},
+			},
+		},
+	}
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:   "custom-name",
+		Labels: map[string]string{constants.RoleNameLabelKey: "decode"},
+	}}
+	index := NewEventRoleIndex(rbg, []corev1.Pod{pod})
+
+	cases := []struct {
+		kind, name string
+		role       string
+		found      bool
+	}{
+		{"RoleBasedGroup", "test-rbg", "", true},
+		{"PodGroup", "test-rbg", "", true},
+		{"StatefulSet", "test-rbg-decode", "decode", true},
+		{"Pod", "test-rbg-decode-0", "decode", true},
+		{"Pod", "custom-name", "decode", true},
+		{"LeaderWorkerSet", "test-rbg-decode-large", "decode-large", true},
+		{"Pod", "test-rbg-decode-large-0-1", "decode-large", true},
+		{"Pod", "other-0", "", false},
+	}
+	for _, c := range cases {
+		event := &corev1.Event{InvolvedObject: corev1.ObjectReference{Kind: c.kind, Name: c.name}}
+		role, found := index.RoleOf(event)
+		assert.Equal(t, c.found, found, c.name)
+		assert.Equal(t, c.role, role, c.name)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// OpenManifest opens the manifest given with -f/--filename, "-" standing for stdin.
+func OpenManifest(filename string) (io.ReadCloser, error) {
+	if filename == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest: %w", err)
+	}
+	return f, nil
+}
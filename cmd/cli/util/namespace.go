@@ -21,6 +21,8 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// GetNamespace returns the --namespace flag, else the default namespace of the CLI
+// configuration, else the namespace of the kubeconfig context.
 func GetNamespace(cf *genericclioptions.ConfigFlags) string {
 	if cf == nil {
 		return "default"
@@ -28,6 +30,9 @@ func GetNamespace(cf *genericclioptions.ConfigFlags) string {
 	if ns := cf.Namespace; ns != nil && *ns != "" {
 		return *ns
 	}
+	if ns := loadConfigOrEmpty().DefaultNamespace; ns != "" {
+		return ns
+	}
 
 	clientConfig := cf.ToRawKubeConfigLoader()
 	ns, _, err := clientConfig.Namespace()
@@ -17,9 +17,11 @@ limitations under the License.
 package util
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -33,4 +35,11 @@ func TestGetNamespace(t *testing.T) {
 	cf.Namespace = &expectedNs
 	ns = GetNamespace(cf)
 	assert.Equal(t, expectedNs, ns)
+
+	// The CLI configuration default applies when the flag is not set.
+	t.Setenv(ConfigEnv, filepath.Join(t.TempDir(), "config.yaml"))
+	require.NoError(t, SaveConfig(&CLIConfig{DefaultNamespace: "llm"}))
+	assert.Equal(t, expectedNs, GetNamespace(cf))
+	cf.Namespace = nil
+	assert.Equal(t, "llm", GetNamespace(cf))
 }
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats accepted by -o/--output. The empty format is the default table.
+const (
+	OutputJSON = "json"
+	OutputYAML = "yaml"
+	OutputWide = "wide"
+	OutputName = "name"
+)
+
+// OutputOptions holds the -o/--output and --no-headers flags of the read commands.
+type OutputOptions struct {
+	Format    string
+	NoHeaders bool
+
+	allowed []string
+}
+
+// AddOutputFlags registers -o/--output, restricted to the given formats, and --no-headers on cmd.
+func (o *OutputOptions) AddOutputFlags(cmd *cobra.Command, formats ...string) {
+	o.allowed = formats
+	cmd.Flags().StringVarP(&o.Format, "output", "o", "",
+		fmt.Sprintf("Output format, one of: %s", strings.Join(formats, "|")))
+	cmd.Flags().BoolVar(&o.NoHeaders, "no-headers", false, "Don't print column headers in table output")
+}
+
+// Validate checks the output format is one of the formats the command registered.
+func (o *OutputOptions) Validate() error {
+	if o.Format == "" || slices.Contains(o.allowed, o.Format) {
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q, allowed formats are: %s", o.Format, strings.Join(o.allowed, "|"))
+}
+
+// IsStructured reports whether the output is JSON or YAML rather than text.
+func (o *OutputOptions) IsStructured() bool {
+	return o.Format == OutputJSON || o.Format == OutputYAML
+}
+
+// PrintHeader prints the header line of a table unless --no-headers is set.
+func (o *OutputOptions) PrintHeader(w io.Writer, header string) {
+	if !o.NoHeaders {
+		_, _ = fmt.Fprintln(w, header)
+	}
+}
+
+// PrintObject writes obj as indented JSON or as YAML, according to the output format.
+func (o *OutputOptions) PrintObject(out io.Writer, obj interface{}) error {
+	var data []byte
+	var err error
+	switch o.Format {
+	case OutputJSON:
+		data, err = json.MarshalIndent(obj, "", "    ")
+		data = append(data, '\n')
+	case OutputYAML:
+		data, err = yaml.Marshal(obj)
+	default:
+		return fmt.Errorf("output format %q is not structured", o.Format)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal output: %w", err)
+	}
+	_, err = out.Write(data)
+	return err
+}
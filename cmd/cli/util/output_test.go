@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputOptions(t *testing.T) {
+	o := &OutputOptions{}
+	cmd := &cobra.Command{}
+	o.AddOutputFlags(cmd, OutputJSON, OutputYAML)
+	assert.NotNil(t, cmd.Flags().Lookup("no-headers"))
+	assert.Equal(t, "o", cmd.Flags().Lookup("output").Shorthand)
+
+	assert.NoError(t, o.Validate())
+	o.Format = OutputYAML
+	assert.NoError(t, o.Validate())
+	assert.True(t, o.IsStructured())
+	o.Format = OutputWide
+	assert.EqualError(t, o.Validate(), `unsupported output format "wide", allowed formats are: json|yaml`)
+	assert.False(t, o.IsStructured())
+}
+
+func TestPrintObject(t *testing.T) {
+	obj := map[string]interface{}{"kind": "List", "items": []interface{}{}}
+	var out bytes.Buffer
+
+	o := &OutputOptions{Format: OutputJSON}
+	assert.NoError(t, o.PrintObject(&out, obj))
+	assert.Equal(t, "{\n    \"items\": [],\n    \"kind\": \"List\"\n}\n", out.String())
+
+	out.Reset()
+	o.Format = OutputYAML
+	assert.NoError(t, o.PrintObject(&out, obj))
+	assert.Equal(t, "items: []\nkind: List\n", out.String())
+
+	o.Format = OutputName
+	assert.Error(t, o.PrintObject(&out, obj))
+}
+
+func TestPrintHeader(t *testing.T) {
+	var out bytes.Buffer
+	(&OutputOptions{}).PrintHeader(&out, "NAME")
+	(&OutputOptions{NoHeaders: true}).PrintHeader(&out, "HIDDEN")
+	assert.Equal(t, "NAME\n", out.String())
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+const (
+	// statefulSetPodIndexLabelKey is set by the StatefulSet controller on every pod.
+	statefulSetPodIndexLabelKey = "apps.kubernetes.io/pod-index"
+	// lwsGroupIndexLabelKey identifies the group a LeaderWorkerSet pod belongs to.
+	lwsGroupIndexLabelKey = constants.LeaderWorkerSetPrefix + "group-index"
+	// defaultContainerAnnotationKey is the annotation kubectl uses to pick the container of a multi-container pod.
+	defaultContainerAnnotationKey = "kubectl.kubernetes.io/default-container"
+
+	// GPUProductLabel is set on nodes by the NVIDIA GPU feature discovery, e.g. "NVIDIA-H100-80GB-HBM3".
+	GPUProductLabel = "nvidia.com/gpu.product"
+)
+
+// podIndexLabelKeys are the labels carrying the replica index of a role pod, by workload type.
+var podIndexLabelKeys = []string{
+	constants.RoleInstanceIndexLabelKey,
+	statefulSetPodIndexLabelKey,
+	lwsGroupIndexLabelKey,
+}
+
+// ListRolePods lists the pods of a role in a RoleBasedGroup, sorted by name.
+func ListRolePods(ctx context.Context, k8sClient kubernetes.Interface, namespace, rbgName, role string) ([]corev1.Pod, error) {
+	selector := fmt.Sprintf("%s=%s", constants.GroupNameLabelKey, rbgName)
+	if role != "" {
+		selector += fmt.Sprintf(",%s=%s", constants.RoleNameLabelKey, role)
+	}
+	pods, err := k8sClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	items := pods.Items
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	return items, nil
+}
+
+// ResolveRolePod returns the pod serving the given replica index of a role.
+// The index is read from the RoleInstance, StatefulSet or LeaderWorkerSet index label;
+// for multi-pod replicas the leader (component or worker index 0) is preferred.
+// Workloads without an index label, such as Deployments, fall back to the pod position in name order.
+func ResolveRolePod(ctx context.Context, k8sClient kubernetes.Interface, namespace, rbgName, role string, index int) (*corev1.Pod, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("index cannot be negative")
+	}
+	pods, err := ListRolePods(ctx, k8sClient, namespace, rbgName, role)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no pods found for role %s in rbg %s", role, rbgName)
+	}
+
+	want := strconv.Itoa(index)
+	var candidates []*corev1.Pod
+	indexed := false
+	for i := range pods {
+		for _, key := range podIndexLabelKeys {
+			value, ok := pods[i].Labels[key]
+			if !ok {
+				continue
+			}
+			indexed = true
+			if value == want {
+				candidates = append(candidates, &pods[i])
+			}
+			break
+		}
+	}
+
+	if !indexed {
+		if index >= len(pods) {
+			return nil, fmt.Errorf("index %d out of range, role %s has %d pods", index, role, len(pods))
+		}
+		return &pods[index], nil
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no pod with index %d found for role %s in rbg %s", index, role, rbgName)
+	}
+	for _, pod := range candidates {
+		if isLeaderPod(pod) {
+			return pod, nil
+		}
+	}
+	return candidates[0], nil
+}
+
+func isLeaderPod(pod *corev1.Pod) bool {
+	if v, ok := pod.Labels[constants.ComponentIndexLabelKey]; ok {
+		return v == "0"
+	}
+	if v, ok := pod.Labels[constants.LwsWorkerIndexLabelKey]; ok {
+		return v == "0"
+	}
+	return true
+}
+
+// ReadyRolePod returns a ready pod of a role, preferring the leader of multi-pod replicas.
+func ReadyRolePod(ctx context.Context, k8sClient kubernetes.Interface, namespace, rbgName, role string) (*corev1.Pod, error) {
+	pods, err := ListRolePods(ctx, k8sClient, namespace, rbgName, role)
+	if err != nil {
+		return nil, err
+	}
+	var ready *corev1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil || !isPodReady(pod) {
+			continue
+		}
+		if isLeaderPod(pod) {
+			return pod, nil
+		}
+		if ready == nil {
+			ready = pod
+		}
+	}
+	if ready == nil {
+		return nil, fmt.Errorf("no ready pod found for role %s in rbg %s", role, rbgName)
+	}
+	return ready, nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// DefaultContainer mirrors kubectl: honour the default-container annotation, otherwise
+// use the first container so multi-container pods don't fail the request.
+func DefaultContainer(pod *corev1.Pod) string {
+	if name := pod.Annotations[defaultContainerAnnotationKey]; name != "" {
+		return name
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
+
+// PodGPUs sums the GPU-like extended resources requested by the pod's containers. Extended
+// resources may be set as limits only, in which case the request defaults to the limit.
+func PodGPUs(pod *corev1.Pod) map[corev1.ResourceName]int64 {
+	return utils.PodSpecGPUs(&pod.Spec)
+}
+
+// NodeGPUs returns the GPU-like extended resources the node can allocate to pods.
+func NodeGPUs(node *corev1.Node) map[corev1.ResourceName]int64 {
+	return utils.NodeGPUs(node)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+)
+
+func newRolePod(name, role string, extraLabels map[string]string) *corev1.Pod {
+	labels := map[string]string{
+		constants.GroupNameLabelKey: "test-rbg",
+		constants.RoleNameLabelKey:  role,
+	}
+	for k, v := range extraLabels {
+		labels[k] = v
+	}
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+}
+
+func TestResolveRolePod(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		// RoleInstanceSet with leader/worker components.
+		newRolePod("decode-1-worker", "decode", map[string]string{
+			constants.RoleInstanceIndexLabelKey: "1", constants.ComponentIndexLabelKey: "1",
+		}),
+		newRolePod("decode-1-leader", "decode", map[string]string{
+			constants.RoleInstanceIndexLabelKey: "1", constants.ComponentIndexLabelKey: "0",
+		}),
+		newRolePod("decode-0", "decode", map[string]string{constants.RoleInstanceIndexLabelKey: "0"}),
+		// StatefulSet.
+		newRolePod("prefill-2", "prefill", map[string]string{statefulSetPodIndexLabelKey: "2"}),
+		// Deployment without index labels.
+		newRolePod("router-b", "router", nil),
+		newRolePod("router-a", "router", nil),
+	)
+	ctx := context.TODO()
+
+	pod, err := ResolveRolePod(ctx, client, "default", "test-rbg", "decode", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "decode-1-leader", pod.Name)
+
+	pod, err = ResolveRolePod(ctx, client, "default", "test-rbg", "decode", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "decode-0", pod.Name)
+
+	pod, err = ResolveRolePod(ctx, client, "default", "test-rbg", "prefill", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "prefill-2", pod.Name)
+
+	pod, err = ResolveRolePod(ctx, client, "default", "test-rbg", "router", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "router-b", pod.Name)
+
+	_, err = ResolveRolePod(ctx, client, "default", "test-rbg", "router", 2)
+	assert.ErrorContains(t, err, "out of range")
+
+	_, err = ResolveRolePod(ctx, client, "default", "test-rbg", "prefill", 0)
+	assert.ErrorContains(t, err, "no pod with index 0")
+
+	_, err = ResolveRolePod(ctx, client, "default", "test-rbg", "scheduler", 0)
+	assert.ErrorContains(t, err, "no pods found")
+
+	_, err = ResolveRolePod(ctx, client, "default", "test-rbg", "decode", -1)
+	assert.Error(t, err)
+}
+
+func TestReadyRolePod(t *testing.T) {
+	ready := corev1.PodStatus{
+		Phase:      corev1.PodRunning,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+	}
+	worker := newRolePod("decode-0-worker", "decode", map[string]string{constants.ComponentIndexLabelKey: "1"})
+	worker.Status = ready
+	leader := newRolePod("decode-0-leader", "decode", map[string]string{constants.ComponentIndexLabelKey: "0"})
+	leader.Status = ready
+	pending := newRolePod("prefill-0", "prefill", nil)
+	pending.Status.Phase = corev1.PodPending
+	client := fake.NewSimpleClientset(worker, leader, pending)
+
+	pod, err := ReadyRolePod(context.TODO(), client, "default", "test-rbg", "decode")
+	assert.NoError(t, err)
+	assert.Equal(t, "decode-0-leader", pod.Name)
+
+	_, err = ReadyRolePod(context.TODO(), client, "default", "test-rbg", "prefill")
+	assert.ErrorContains(t, err, "no ready pod found")
+}
+
+func TestDefaultContainer(t *testing.T) {
+	pod := newRolePod("p", "prefill", nil)
+	pod.Spec.Containers = []corev1.Container{{Name: "main"}, {Name: "sidecar"}}
+	assert.Equal(t, "main", DefaultContainer(pod))
+
+	pod.Annotations = map[string]string{defaultContainerAnnotationKey: "sidecar"}
+	assert.Equal(t, "sidecar", DefaultContainer(pod))
+
+	assert.Equal(t, "", DefaultContainer(&corev1.Pod{}))
+}
+
+func TestPodGPUs(t *testing.T) {
+	pod := newRolePod("p", "prefill", nil)
+	pod.Spec.Containers = []corev1.Container{
+		{Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			Limits:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+		}},
+		{Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			Limits:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+		}},
+	}
+	assert.Equal(t, map[corev1.ResourceName]int64{"nvidia.com/gpu": 3}, PodGPUs(pod))
+}
+
+func TestNodeGPUs(t *testing.T) {
+	node := &corev1.Node{Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("96"),
+		"nvidia.com/gpu":   resource.MustParse("8"),
+		"amd.com/gpu":      resource.MustParse("0"),
+	}}}
+	assert.Equal(t, map[corev1.ResourceName]int64{"nvidia.com/gpu": 8}, NodeGPUs(node))
+}
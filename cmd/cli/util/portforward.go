@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// PortForwardRequest describes the ports to forward from the local machine to a pod.
+type PortForwardRequest struct {
+	Pod       *corev1.Pod
+	Addresses []string
+	// Ports are "LOCAL:REMOTE" or "PORT" specs with numeric remote ports, see TranslatePorts.
+	Ports []string
+	// Ready is closed once the listeners are bound.
+	Ready  chan struct{}
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// ForwardPorts forwards the requested ports until the context is cancelled.
+func ForwardPorts(ctx context.Context, k8sClient kubernetes.Interface, config *rest.Config, req PortForwardRequest) error {
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return fmt.Errorf("failed to create round tripper: %w", err)
+	}
+	url := k8sClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(req.Pod.Namespace).
+		Name(req.Pod.Name).
+		SubResource("portforward").
+		URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopCh := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stopCh)
+	}()
+	fw, err := portforward.NewOnAddresses(dialer, req.Addresses, req.Ports, stopCh, req.Ready, req.Out, req.ErrOut)
+	if err != nil {
+		return fmt.Errorf("failed to create port forwarder: %w", err)
+	}
+	return fw.ForwardPorts()
+}
+
+// TranslatePorts resolves named remote ports against the container ports of the pod.
+// A bare named port listens locally on the resolved port number, like kubectl does.
+func TranslatePorts(pod *corev1.Pod, ports []string) ([]string, error) {
+	translated := make([]string, 0, len(ports))
+	for _, spec := range ports {
+		local, remote, hasLocal := strings.Cut(spec, ":")
+		if !hasLocal {
+			remote = local
+		}
+		if _, err := strconv.ParseUint(remote, 10, 16); err != nil {
+			number, found := containerPortByName(pod, remote)
+			if !found {
+				return nil, fmt.Errorf("pod %s has no container port named %q", pod.Name, remote)
+			}
+			remote = strconv.Itoa(int(number))
+			if !hasLocal {
+				local = remote
+			}
+		}
+		if local != "" {
+			if _, err := strconv.ParseUint(local, 10, 16); err != nil {
+				return nil, fmt.Errorf("invalid local port %q in %q", local, spec)
+			}
+		}
+		translated = append(translated, local+":"+remote)
+	}
+	return translated, nil
+}
+
+func containerPortByName(pod *corev1.Pod, name string) (int32, bool) {
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == name {
+				return p.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// ForwardLocalEndpoint forwards a free local port to the remote port of the pod in the background
+// and returns the base URL of the forwarded HTTP endpoint once it is ready.
+// Forwarding stops when the context is cancelled.
+func ForwardLocalEndpoint(
+	ctx context.Context,
+	k8sClient kubernetes.Interface,
+	config *rest.Config,
+	pod *corev1.Pod,
+	remotePort string,
+) (string, error) {
+	localPort, err := freeLocalPort()
+	if err != nil {
+		return "", err
+	}
+	ports, err := TranslatePorts(pod, []string{fmt.Sprintf("%d:%s", localPort, remotePort)})
+	if err != nil {
+		return "", err
+	}
+
+	ready := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ForwardPorts(ctx, k8sClient, config, PortForwardRequest{
+			Pod:       pod,
+			Addresses: []string{"127.0.0.1"},
+			Ports:     ports,
+			Ready:     ready,
+			Out:       io.Discard,
+			ErrOut:    os.Stderr,
+		})
+	}()
+	select {
+	case <-ready:
+		return fmt.Sprintf("http://127.0.0.1:%d", localPort), nil
+	case err := <-errCh:
+		return "", fmt.Errorf("failed to forward to pod %s: %w", pod.Name, err)
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func freeLocalPort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, fmt.Errorf("failed to find a free local port: %w", err)
+	}
+	defer func() { _ = l.Close() }()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
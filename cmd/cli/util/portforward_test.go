@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTranslatePorts(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "router-0"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:  "router",
+			Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8000}},
+		}}},
+	}
+
+	ports, err := TranslatePorts(pod, []string{"8000", "9000:8000", "http", "9000:http", ":http"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"8000:8000", "9000:8000", "8000:8000", "9000:8000", ":8000"}, ports)
+
+	_, err = TranslatePorts(pod, []string{"grpc"})
+	assert.ErrorContains(t, err, `no container port named "grpc"`)
+
+	_, err = TranslatePorts(pod, []string{"abc:8000"})
+	assert.ErrorContains(t, err, "invalid local port")
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// PrometheusSample is a series of the result of a Prometheus instant query.
+type PrometheusSample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// promQueryResponse is the subset of the Prometheus instant query response used here.
+type promQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// QueryPrometheus runs an instant query against the Prometheus server at baseURL. Series
+// whose value cannot be parsed are skipped.
+func QueryPrometheus(ctx context.Context, client *http.Client, baseURL, query string) ([]PrometheusSample, error) {
+	endpoint, err := url.JoinPath(baseURL, "/api/v1/query")
+	if err != nil {
+		return nil, fmt.Errorf("invalid prometheus url: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?query="+url.QueryEscape(query), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query prometheus: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("prometheus returned %s: %s", resp.Status, string(body))
+	}
+
+	var result promQueryResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode prometheus response: %w", err)
+	}
+	if result.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", result.Error)
+	}
+	samples := make([]PrometheusSample, 0, len(result.Data.Result))
+	for _, series := range result.Data.Result {
+		if len(series.Value) != 2 {
+			continue
+		}
+		raw, ok := series.Value[1].(string)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, PrometheusSample{Labels: series.Metric, Value: value})
+	}
+	return samples, nil
+}
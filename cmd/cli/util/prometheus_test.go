@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryPrometheus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("query") {
+		case "up":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"result":[
+				{"metric":{"pod":"p0"},"value":[1,"1"]},
+				{"metric":{"pod":"p1"},"value":[1,"not a number"]},
+				{"metric":{"pod":"p2"},"value":[1]}]}}`))
+		case "bad(":
+			_, _ = w.Write([]byte(`{"status":"error","error":"parse error"}`))
+		default:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	samples, err := QueryPrometheus(context.TODO(), server.Client(), server.URL, "up")
+	require.NoError(t, err)
+	assert.Equal(t, []PrometheusSample{{Labels: map[string]string{"pod": "p0"}, Value: 1}}, samples)
+
+	_, err = QueryPrometheus(context.TODO(), server.Client(), server.URL, "bad(")
+	assert.EqualError(t, err, "prometheus query failed: parse error")
+	_, err = QueryPrometheus(context.TODO(), server.Client(), server.URL, "other")
+	assert.ErrorContains(t, err, "prometheus returned 503 Service Unavailable")
+}
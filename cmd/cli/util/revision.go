@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// ListOwnedRevisions lists the ControllerRevisions recorded for the given RoleBasedGroup.
+// Revisions controlled by another object with the same group-name label are skipped.
+func ListOwnedRevisions(
+	ctx context.Context, k8sClient kubernetes.Interface, rbg *workloadsv1alpha2.RoleBasedGroup,
+) ([]*appsv1.ControllerRevision, error) {
+	revisions, err := k8sClient.AppsV1().ControllerRevisions(rbg.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", constants.GroupNameLabelKey, rbg.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*appsv1.ControllerRevision
+	for i := range revisions.Items {
+		ref := metav1.GetControllerOfNoCopy(&revisions.Items[i])
+		if ref == nil || ref.UID == rbg.GetUID() {
+			items = append(items, &revisions.Items[i])
+		}
+	}
+	return items, nil
+}
+
+// CurrentRevision returns the revision with the highest number, or nil if there is none.
+func CurrentRevision(revisions []*appsv1.ControllerRevision) *appsv1.ControllerRevision {
+	var current *appsv1.ControllerRevision
+	for _, rev := range revisions {
+		if current == nil || current.Revision <= rev.Revision {
+			current = rev
+		}
+	}
+	return current
+}
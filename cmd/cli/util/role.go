@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/client-go/clientset/versioned"
+)
+
+// PatchOp is a JSON patch (RFC 6902) operation.
+type PatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// RoleIndex returns the position of the role in the roles of the rbg.
+func RoleIndex(rbg *workloadsv1alpha2.RoleBasedGroup, roleName string) (int, error) {
+	for i := range rbg.Spec.Roles {
+		if rbg.Spec.Roles[i].Name == roleName {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("role %q not found in rbg %s", roleName, rbg.Name)
+}
+
+// GuardedRoleOps returns the operations on the role at index, whose paths are relative to the role,
+// after a test op of the role name. The test op guards against the role list being reordered
+// between the read and the patch.
+func GuardedRoleOps(index int, name string, ops ...PatchOp) []PatchOp {
+	rolePath := fmt.Sprintf("/spec/roles/%d", index)
+	guarded := make([]PatchOp, 0, len(ops)+1)
+	guarded = append(guarded, PatchOp{Op: "test", Path: rolePath + "/name", Value: name})
+	for _, op := range ops {
+		op.Path = rolePath + op.Path
+		guarded = append(guarded, op)
+	}
+	return guarded
+}
+
+// ScaleRole sets the replicas of a role of the rbg.
+func ScaleRole(ctx context.Context, rbgClient versioned.Interface, name, namespace, roleName string, replicas int32) error {
+	return ScaleRoles(ctx, rbgClient, name, namespace, map[string]int32{roleName: replicas})
+}
+
+// ScaleRoles sets the replicas of several roles of the rbg in a single patch.
+func ScaleRoles(ctx context.Context, rbgClient versioned.Interface, name, namespace string, replicas map[string]int32) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+	roles := make([]string, 0, len(replicas))
+	for roleName := range replicas {
+		roles = append(roles, roleName)
+	}
+	sort.Strings(roles)
+
+	var ops []PatchOp
+	for _, roleName := range roles {
+		index, err := RoleIndex(rbg, roleName)
+		if err != nil {
+			return err
+		}
+		op := "replace"
+		if rbg.Spec.Roles[index].Replicas == nil {
+			op = "add"
+		}
+		ops = append(ops, GuardedRoleOps(index, roleName, PatchOp{Op: op, Path: "/replicas", Value: replicas[roleName]})...)
+	}
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Patch(
+		ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		if len(roles) == 1 {
+			return fmt.Errorf("failed to scale role %s: %w", roles[0], err)
+		}
+		return fmt.Errorf("failed to scale roles %s: %w", strings.Join(roles, ","), err)
+	}
+	return nil
+}
+
+// RestartRoles triggers a rolling restart of the roles by stamping their restartedAt annotation.
+func RestartRoles(
+	ctx context.Context, rbgClient versioned.Interface, name, namespace string, roles []string, at time.Time,
+) error {
+	rbg, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBasedGroup: %w", err)
+	}
+
+	restartedAt := at.UTC().Format(time.RFC3339)
+	// JSON pointer escaping of the "/" in the annotation key.
+	annotationPath := strings.ReplaceAll(constants.RoleRestartedAtAnnotationKey, "/", "~1")
+
+	var ops []PatchOp
+	for _, roleName := range roles {
+		index, err := RoleIndex(rbg, roleName)
+		if err != nil {
+			return err
+		}
+		op := PatchOp{Op: "add", Path: "/annotations/" + annotationPath, Value: restartedAt}
+		if rbg.Spec.Roles[index].Annotations == nil {
+			op = PatchOp{
+				Op:    "add",
+				Path:  "/annotations",
+				Value: map[string]string{constants.RoleRestartedAtAnnotationKey: restartedAt},
+			}
+		}
+		ops = append(ops, GuardedRoleOps(index, roleName, op)...)
+	}
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	if _, err := rbgClient.WorkloadsV1alpha2().RoleBasedGroups(namespace).Patch(
+		ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to restart roles %s: %w", strings.Join(roles, ","), err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	fakerbgclient "sigs.k8s.io/rbgs/client-go/clientset/versioned/fake"
+)
+
+func newRoleTestRBG() *workloadsv1alpha2.RoleBasedGroup {
+	return &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{
+				{Name: "prefill", Replicas: ptr.To[int32](1), Annotations: map[string]string{"team": "llm"}},
+				{Name: "decode"},
+			},
+		},
+	}
+}
+
+func TestScaleRoles(t *testing.T) {
+	client := fakerbgclient.NewSimpleClientset(newRoleTestRBG())
+	require.NoError(t, ScaleRoles(context.TODO(), client, "test-rbg", "default", map[string]int32{"prefill": 4, "decode": 2}))
+
+	rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "test-rbg", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), *rbg.Spec.Roles[0].Replicas)
+	assert.Equal(t, int32(2), *rbg.Spec.Roles[1].Replicas)
+
+	assert.EqualError(t, ScaleRole(context.TODO(), client, "test-rbg", "default", "absent", 1),
+		`role "absent" not found in rbg test-rbg`)
+	assert.Error(t, ScaleRole(context.TODO(), client, "absent", "default", "prefill", 1))
+}
+
+func TestGuardedRoleOps(t *testing.T) {
+	assert.Equal(t, []PatchOp{
+		{Op: "test", Path: "/spec/roles/1/name", Value: "decode"},
+		{Op: "replace", Path: "/spec/roles/1/replicas", Value: 2},
+		{Op: "add", Path: "/spec/roles/1/annotations", Value: map[string]string{"team": "llm"}},
+	}, GuardedRoleOps(1, "decode",
+		PatchOp{Op: "replace", Path: "/replicas", Value: 2},
+		PatchOp{Op: "add", Path: "/annotations", Value: map[string]string{"team": "llm"}},
+	))
+}
+
+func TestRestartRoles(t *testing.T) {
+	client := fakerbgclient.NewSimpleClientset(newRoleTestRBG())
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, RestartRoles(context.TODO(), client, "test-rbg", "default", []string{"prefill", "decode"}, at))
+
+	rbg, err := client.WorkloadsV1alpha2().RoleBasedGroups("default").Get(context.TODO(), "test-rbg", metav1.GetOptions{})
+	require.NoError(t, err)
+	for _, role := range rbg.Spec.Roles {
+		assert.Equal(t, "2026-01-02T03:04:05Z", role.Annotations[constants.RoleRestartedAtAnnotationKey], role.Name)
+	}
+	assert.Equal(t, "llm", rbg.Spec.Roles[0].Annotations["team"])
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// WorkloadGVR derives the resource of the role's underlying workload from its workload type.
+func WorkloadGVR(role *workloadsv1alpha2.RoleSpec) schema.GroupVersionResource {
+	spec := role.GetWorkloadSpec()
+	gv, _ := schema.ParseGroupVersion(spec.APIVersion)
+	return gv.WithResource(strings.ToLower(spec.Kind) + "s")
+}
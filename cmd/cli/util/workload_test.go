@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func TestWorkloadGVR(t *testing.T) {
+	tests := []struct {
+		workloadType string
+		expected     schema.GroupVersionResource
+	}{
+		{"", schema.GroupVersionResource{Group: "workloads.x-k8s.io", Version: "v1alpha2", Resource: "roleinstancesets"}},
+		{constants.StatefulSetWorkloadType, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}},
+		{constants.DeploymentWorkloadType, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}},
+		{constants.LeaderWorkerSetWorkloadType,
+			schema.GroupVersionResource{Group: "leaderworkerset.x-k8s.io", Version: "v1", Resource: "leaderworkersets"}},
+	}
+	for _, tt := range tests {
+		role := &workloadsv1alpha2.RoleSpec{Name: "test"}
+		if tt.workloadType != "" {
+			role.Annotations = map[string]string{constants.RoleWorkloadTypeAnnotationKey: tt.workloadType}
+		}
+		assert.Equal(t, tt.expected, WorkloadGVR(role))
+	}
+}
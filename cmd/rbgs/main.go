@@ -24,8 +24,11 @@ import (
 	"os"
 	"path/filepath"
 	goruntime "runtime"
+	"strings"
 	"time"
 
+	kruiseappsv1alpha1 "github.com/openkruise/kruise/apis/apps/v1alpha1"
+	kruiseappsv1beta1 "github.com/openkruise/kruise/apis/apps/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -56,7 +59,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadscontroller "sigs.k8s.io/rbgs/internal/controller/workloads"
+	workloadswebhook "sigs.k8s.io/rbgs/internal/webhook/workloads"
+	"sigs.k8s.io/rbgs/pkg/activator"
 	"sigs.k8s.io/rbgs/pkg/scheduler"
+	"sigs.k8s.io/rbgs/pkg/utils"
 	"sigs.k8s.io/rbgs/pkg/utils/fieldindex"
 	rbgwebhook "sigs.k8s.io/rbgs/pkg/webhook"
 	"sigs.k8s.io/rbgs/version"
@@ -96,6 +102,8 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(apiextv1.AddToScheme(scheme))
 	utilruntime.Must(lwsv1.AddToScheme(scheme))
+	utilruntime.Must(kruiseappsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(kruiseappsv1beta1.AddToScheme(scheme))
 	utilruntime.Must(schev1alpha1.AddToScheme(scheme))
 	utilruntime.Must(volcanoschedulingv1beta1.AddToScheme(scheme))
 
@@ -123,11 +131,13 @@ func main() {
 		metricsCertPath, metricsCertName, metricsCertKey string
 		enableLeaderElection                             bool
 		probeAddr                                        string
+		activatorAddr                                    string
 		secureMetrics                                    bool
 		enableHTTP2                                      bool
 		tlsOpts                                          []func(*tls.Config)
 		development                                      bool
 		webhookMode                                      string
+		gpuCapacityCheck                                 string
 		// Controller runtime options
 		maxConcurrentReconciles int
 		cacheSyncTimeout        time.Duration
@@ -137,12 +147,24 @@ func main() {
 		enablePortAllocator     bool
 		// Gang scheduling scheduler name: scheduler-plugins or volcano
 		schedulerName string
+		// Metadata prefixes injected by admission controllers, ignored when comparing workloads
+		ignoredLabelPrefixes      string
+		ignoredAnnotationPrefixes string
+		// Cache scoping and leader election tuning
+		watchNamespaces    string
+		cacheLabelSelector string
+		leaderElection     leaderElectionOptions
 	)
 	flag.StringVar(
 		&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 			"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.",
 	)
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8082", "The address the probe endpoint binds to.")
+	flag.StringVar(
+		&activatorAddr, "activator-bind-address", "0",
+		"The address the activator resuming suspended RoleBasedGroups with spec.activation binds to. "+
+			"Use 0 to disable the activator.",
+	)
 	flag.BoolVar(
 		&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
@@ -169,6 +191,11 @@ func main() {
 			"Use "+WebhookModeNone+" to disable all webhooks (cert bootstrap, conversion, admission) for local debugging only. "+
 			"When set to "+WebhookModeNone+", leader election is also disabled and metrics are served insecurely over HTTP.",
 	)
+	flag.StringVar(
+		&gpuCapacityCheck, "gpu-capacity-check", string(workloadswebhook.GPUCapacityCheckNone),
+		"How the admission webhook handles RoleBasedGroups requesting more GPUs per pod than the largest node, "+
+			"or in total than the cluster, allocates. Supported values: none, warn, deny.",
+	)
 	flag.IntVar(
 		&maxConcurrentReconciles, "max-concurrent-reconciles", 10,
 		"The number of worker threads used by the the RBGS controller.",
@@ -183,6 +210,41 @@ func main() {
 		"The scheduler name to use for gang scheduling. Supported values: scheduler-plugins, volcano. "+
 			"Defaults to scheduler-plugins.",
 	)
+	flag.StringVar(
+		&ignoredLabelPrefixes, "ignored-label-prefixes", "",
+		"Comma-separated label key prefixes injected by admission controllers that are ignored when "+
+			"comparing the desired and the current workloads, e.g. istio.io/.",
+	)
+	flag.StringVar(
+		&ignoredAnnotationPrefixes, "ignored-annotation-prefixes", "",
+		"Comma-separated annotation key prefixes injected by admission controllers that are ignored when "+
+			"comparing the desired and the current workloads, e.g. sidecar.istio.io/.",
+	)
+	flag.StringVar(
+		&watchNamespaces, "watch-namespaces", "",
+		"Comma-separated namespaces the controller watches and reconciles. All namespaces are watched when empty.",
+	)
+	flag.StringVar(
+		&cacheLabelSelector, "cache-label-selector", "",
+		"Label selector restricting the RoleBasedGroups and RoleBasedGroupSets the controller caches and reconciles, "+
+			"e.g. tenant=a. All of them are reconciled when empty.",
+	)
+	flag.StringVar(
+		&leaderElection.namespace, "leader-elect-namespace", "",
+		"The namespace of the leader election lease. Defaults to the namespace the controller runs in.",
+	)
+	flag.DurationVar(
+		&leaderElection.leaseDuration, "leader-elect-lease-duration", 15*time.Second,
+		"The duration non-leader candidates wait before trying to acquire the leadership.",
+	)
+	flag.DurationVar(
+		&leaderElection.renewDeadline, "leader-elect-renew-deadline", 10*time.Second,
+		"The duration the leader retries refreshing the leadership before giving it up.",
+	)
+	flag.DurationVar(
+		&leaderElection.retryPeriod, "leader-elect-retry-period", 2*time.Second,
+		"The duration the candidates wait between tries of acquiring or renewing the leadership.",
+	)
 	flag.Parse()
 
 	// Validate webhook mode to prevent typos silently disabling webhooks.
@@ -190,6 +252,15 @@ func main() {
 		setupLog.Error(err, "invalid --enable-webhooks value")
 		os.Exit(1)
 	}
+	capacityCheck, err := workloadswebhook.ParseGPUCapacityCheck(gpuCapacityCheck)
+	if err != nil {
+		setupLog.Error(err, "invalid --gpu-capacity-check value")
+		os.Exit(1)
+	}
+
+	utils.SetIgnoredMetadataPrefixes(
+		strings.Split(ignoredLabelPrefixes, ","), strings.Split(ignoredAnnotationPrefixes, ","),
+	)
 
 	opts := zap.Options{
 		Development: development,
@@ -287,8 +358,15 @@ func main() {
 		)
 	}
 
+	groupSelector, err := labels.Parse(cacheLabelSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid --cache-label-selector value")
+		os.Exit(1)
+	}
+	leaderElection.enabled = enableLeaderElection
 	mgr, err := ctrl.NewManager(
-		ctrl.GetConfigOrDie(), newManagerOptions(webhookMode, webhookServer, metricsServerOptions, probeAddr, enableLeaderElection),
+		ctrl.GetConfigOrDie(), newManagerOptions(webhookMode, webhookServer, metricsServerOptions, probeAddr,
+			leaderElection, cacheOptions(splitList(watchNamespaces), groupSelector)),
 	)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -308,7 +386,7 @@ func main() {
 	// ---------------------------------------------------------------------------
 	var webhookResult *webhookBootstrapResult
 	if webhooksEnabled(webhookMode) {
-		webhookResult, err = bootstrapWebhookCerts(mgr)
+		webhookResult, err = bootstrapWebhookCerts(mgr, capacityCheck)
 		if err != nil {
 			setupLog.Error(err, "unable to bootstrap webhook certs")
 			os.Exit(1)
@@ -338,6 +416,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	nodeReconciler := workloadscontroller.NewNodeReconciler(mgr)
+	if err = nodeReconciler.SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create node failure controller", "controller", "Node")
+		os.Exit(1)
+	}
+
+	schedulingGateReconciler := workloadscontroller.NewSchedulingGateReconciler(mgr)
+	if err = schedulingGateReconciler.SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create scheduling gate controller", "controller", "SchedulingGate")
+		os.Exit(1)
+	}
+
 	rbgScalingAdapterReconciler := workloadscontroller.NewRoleBasedGroupScalingAdapterReconciler(mgr)
 	if err = rbgScalingAdapterReconciler.CheckCrdExists(); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RoleBasedGroupScalingAdapter")
@@ -348,6 +438,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	rbgAutoscalerReconciler := workloadscontroller.NewRoleBasedGroupAutoscalerReconciler(mgr)
+	if err = rbgAutoscalerReconciler.SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create rbg autoscaler controller", "controller", "RoleBasedGroupAutoscaler")
+		os.Exit(1)
+	}
+
 	rbgsReconciler := workloadscontroller.NewRoleBasedGroupSetReconciler(mgr)
 	if err = rbgsReconciler.CheckCrdExists(); err != nil {
 		setupLog.Error(err, "unable to create rbgs controller", "controller", "RoleBasedGroupSet")
@@ -406,6 +502,14 @@ func main() {
 		}
 	}
 
+	if activatorAddr != "0" {
+		server := activator.NewServer(activatorAddr, mgr.GetClient(), mgr.GetEventRecorderFor("Activator"))
+		if err := mgr.Add(server); err != nil {
+			setupLog.Error(err, "unable to add activator to manager")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -445,18 +549,34 @@ func newWebhookServer(webhookMode string, tlsOpts []func(*tls.Config)) webhook.S
 	})
 }
 
+// leaderElectionOptions tune the leader election of the manager.
+type leaderElectionOptions struct {
+	enabled       bool
+	namespace     string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+}
+
 // newManagerOptions builds the controller-runtime manager options.
 // When webhooks are disabled (enable-webhooks=none), webhook server and leader
 // election are disabled, and metrics are served insecurely.
-func newManagerOptions(webhookMode string, webhookServer webhook.Server, metricsOpts metricsserver.Options, probeAddr string, enableLeaderElection bool) ctrl.Options {
+func newManagerOptions(
+	webhookMode string, webhookServer webhook.Server, metricsOpts metricsserver.Options, probeAddr string,
+	leaderElection leaderElectionOptions, cacheOpts cache.Options,
+) ctrl.Options {
 	opts := ctrl.Options{
-		Scheme:                 scheme,
-		Metrics:                metricsOpts,
-		WebhookServer:          webhookServer,
-		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       constants.ControllerName,
-		Cache:                  cacheOptions(),
+		Scheme:                  scheme,
+		Metrics:                 metricsOpts,
+		WebhookServer:           webhookServer,
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          leaderElection.enabled,
+		LeaderElectionID:        constants.ControllerName,
+		LeaderElectionNamespace: leaderElection.namespace,
+		LeaseDuration:           &leaderElection.leaseDuration,
+		RenewDeadline:           &leaderElection.renewDeadline,
+		RetryPeriod:             &leaderElection.retryPeriod,
+		Cache:                   cacheOpts,
 	}
 	if !webhooksEnabled(webhookMode) {
 		setupLog.Info("Webhooks disabled: forcing LeaderElection=false, Metrics.SecureServing=false")
@@ -469,9 +589,11 @@ func newManagerOptions(webhookMode string, webhookServer webhook.Server, metrics
 }
 
 // bootstrapWebhookCerts bootstraps the self-signed TLS certificate for the
-// conversion webhook, patches the caBundle on CRDs, and registers conversion
-// webhooks with the manager. This should only be called when webhook is enabled.
-func bootstrapWebhookCerts(mgr ctrl.Manager) (*webhookBootstrapResult, error) {
+// webhook server, patches the caBundle on CRDs and the admission webhook
+// configurations, and registers conversion and admission webhooks with the manager. This should only be called when webhook is enabled.
+func bootstrapWebhookCerts(
+	mgr ctrl.Manager, gpuCapacityCheck workloadswebhook.GPUCapacityCheck,
+) (*webhookBootstrapResult, error) {
 	webhookServiceNamespace := os.Getenv("POD_NAMESPACE")
 	if webhookServiceNamespace == "" {
 		setupLog.Info("WARNING: POD_NAMESPACE env not found; caBundle patching may fail")
@@ -510,6 +632,20 @@ func bootstrapWebhookCerts(mgr ctrl.Manager) (*webhookBootstrapResult, error) {
 		return nil, fmt.Errorf("unable to create conversion webhook for RoleBasedGroupSet: %w", err)
 	}
 
+	// Register admission webhooks and make the API server trust their certificate.
+	if err = workloadswebhook.SetupRoleBasedGroupWebhookWithManager(mgr, gpuCapacityCheck); err != nil {
+		return nil, fmt.Errorf("unable to create admission webhooks for RoleBasedGroup: %w", err)
+	}
+	if err = workloadswebhook.SetupRoleBasedGroupSetWebhookWithManager(mgr); err != nil {
+		return nil, fmt.Errorf("unable to create admission webhooks for RoleBasedGroupSet: %w", err)
+	}
+	if err = certMgr.PatchValidatingWebhookCABundle(ctx, rbgwebhook.ValidatingWebhookConfigurationName, caCert); err != nil {
+		return nil, fmt.Errorf("unable to patch caBundle on validating webhook configuration: %w", err)
+	}
+	if err = certMgr.PatchMutatingWebhookCABundle(ctx, rbgwebhook.MutatingWebhookConfigurationName, caCert); err != nil {
+		return nil, fmt.Errorf("unable to patch caBundle on mutating webhook configuration: %w", err)
+	}
+
 	return &webhookBootstrapResult{certMgr: certMgr, caCert: caCert}, nil
 }
 
@@ -517,22 +653,27 @@ func bootstrapWebhookCerts(mgr ctrl.Manager) (*webhookBootstrapResult, error) {
 // the conversion-webhook CRDs and keeps caBundle in sync with the self-signed CA certificate.
 func setupWebhookCertController(mgr ctrl.Manager, result *webhookBootstrapResult, options controller.Options) error {
 	webhookCertReconciler := &workloadscontroller.WebhookCertReconciler{
-		Client:      mgr.GetClient(),
-		CertManager: result.certMgr,
-		CACert:      result.caCert,
-		CRDNames:    rbgwebhook.ConversionWebhookCRDs(),
+		Client:                             mgr.GetClient(),
+		CertManager:                        result.certMgr,
+		CACert:                             result.caCert,
+		CRDNames:                           rbgwebhook.ConversionWebhookCRDs(),
+		ValidatingWebhookConfigurationName: rbgwebhook.ValidatingWebhookConfigurationName,
+		MutatingWebhookConfigurationName:   rbgwebhook.MutatingWebhookConfigurationName,
 	}
 	return webhookCertReconciler.SetupWithManager(mgr, options)
 }
 
-func cacheOptions() cache.Options {
+// cacheOptions restricts the cache to the given namespaces, all of them when empty, and the
+// RoleBasedGroups and RoleBasedGroupSets to the ones matching groupSelector. The workloads and
+// services are always restricted to the ones labeled by the controller.
+func cacheOptions(watchNamespaces []string, groupSelector labels.Selector) cache.Options {
 	keyExistsRequirement, err := labels.NewRequirement(constants.GroupNameLabelKey, selection.Exists, nil)
 	if err != nil {
 		panic(err)
 	}
 	keyExistsSelector := labels.NewSelector().Add(*keyExistsRequirement)
 
-	return cache.Options{
+	opts := cache.Options{
 		Scheme: scheme,
 		ByObject: map[client.Object]cache.ByObject{
 			&appsv1.StatefulSet{}: {
@@ -546,4 +687,26 @@ func cacheOptions() cache.Options {
 			},
 		},
 	}
+	if groupSelector != nil && !groupSelector.Empty() {
+		opts.ByObject[&workloadsv1alpha2.RoleBasedGroup{}] = cache.ByObject{Label: groupSelector}
+		opts.ByObject[&workloadsv1alpha2.RoleBasedGroupSet{}] = cache.ByObject{Label: groupSelector}
+	}
+	if len(watchNamespaces) > 0 {
+		opts.DefaultNamespaces = make(map[string]cache.Config, len(watchNamespaces))
+		for _, namespace := range watchNamespaces {
+			opts.DefaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+	return opts
+}
+
+// splitList splits a comma-separated flag value, dropping the empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
@@ -21,12 +21,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 	schev1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 )
@@ -81,3 +85,24 @@ func TestMainSchemeRegistration(t *testing.T) {
 	assert.True(t, scheme.Recognizes(appsv1.SchemeGroupVersion.WithKind("StatefulSet")))
 	assert.True(t, scheme.Recognizes(workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroup")))
 }
+
+func TestCacheOptions(t *testing.T) {
+	opts := cacheOptions(nil, labels.Everything())
+	assert.Nil(t, opts.DefaultNamespaces)
+	assert.Len(t, opts.ByObject, 3)
+
+	selector, err := labels.Parse("tenant=a")
+	require.NoError(t, err)
+	opts = cacheOptions(splitList(" team-a,,team-b "), selector)
+	assert.Equal(t, map[string]cache.Config{"team-a": {}, "team-b": {}}, opts.DefaultNamespaces)
+	assert.Len(t, opts.ByObject, 5)
+	for obj, byObject := range opts.ByObject {
+		switch obj.(type) {
+		case *workloadsv1alpha2.RoleBasedGroup, *workloadsv1alpha2.RoleBasedGroupSet:
+			assert.Equal(t, selector, byObject.Label)
+		default:
+			assert.True(t, byObject.Label.Matches(labels.Set{constants.GroupNameLabelKey: "test"}))
+			assert.False(t, byObject.Label.Matches(labels.Set{"tenant": "a"}))
+		}
+	}
+}
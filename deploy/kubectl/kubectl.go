@@ -0,0 +1,26 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package kubectl embeds the consolidated installer manifest generated by
+// `make build-installer`, so the CLI can install the release it was built with.
+package kubectl
+
+import _ "embed"
+
+// Manifests holds the Namespace, CustomResourceDefinitions, RBAC, Service and controller
+// Deployment of RBG, in the order they are applied.
+//
+//go:embed manifests.yaml
+var Manifests []byte
@@ -18,11 +18,11 @@ package workloads
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -138,7 +138,7 @@ func TestEnsureDiscoveryConfigMode(t *testing.T) {
 }
 
 func TestReconcileRefinedDiscoveryConfigMap(t *testing.T) {
-	t.Run("creates shared configmap for stateful role", func(t *testing.T) {
+	t.Run("creates shared configmap for all roles", func(t *testing.T) {
 		scheme := runtime.NewScheme()
 		_ = workloadsv1alpha2.AddToScheme(scheme)
 		_ = appsv1.AddToScheme(scheme)
@@ -175,18 +175,22 @@ func TestReconcileRefinedDiscoveryConfigMap(t *testing.T) {
 			t.Fatalf("unmarshal shared configmap data error: %v", err)
 		}
 
-		if len(cfg.Roles) != 1 {
-			t.Fatalf("stateful roles in config = %d, want 1", len(cfg.Roles))
+		if len(cfg.Roles) != 2 {
+			t.Fatalf("roles in config = %d, want 2", len(cfg.Roles))
 		}
-		if _, ok := cfg.Roles["test-role"]; !ok {
-			t.Fatalf("stateful role test-role should exist in refined config")
+		if len(cfg.Roles["test-role"].Instances) != 1 {
+			t.Fatalf("stateful role test-role should list its instances in refined config")
 		}
-		if _, ok := cfg.Roles["router"]; ok {
-			t.Fatalf("stateless role router should not exist in refined config")
+		router, ok := cfg.Roles["router"]
+		if !ok {
+			t.Fatalf("stateless role router should exist in refined config")
+		}
+		if router.Service != "s-test-rbg-router" || len(router.Instances) != 0 {
+			t.Fatalf("stateless role router = %+v, want its service without instances", router)
 		}
 	})
 
-	t.Run("skips configmap creation for stateless-only roles", func(t *testing.T) {
+	t.Run("creates configmap for stateless-only roles", func(t *testing.T) {
 		scheme := runtime.NewScheme()
 		_ = workloadsv1alpha2.AddToScheme(scheme)
 		_ = appsv1.AddToScheme(scheme)
@@ -212,13 +216,15 @@ func TestReconcileRefinedDiscoveryConfigMap(t *testing.T) {
 		}
 
 		cm := &corev1.ConfigMap{}
-		err := client.Get(
+		if err := client.Get(
 			context.Background(),
 			types.NamespacedName{Name: rbg.Name, Namespace: rbg.Namespace},
 			cm,
-		)
-		if !apierrors.IsNotFound(err) {
-			t.Fatalf("configmap should not exist for stateless-only rbg, err = %v", err)
+		); err != nil {
+			t.Fatalf("get shared configmap error: %v", err)
+		}
+		if !strings.Contains(cm.Data["config.yaml"], "service: s-test-rbg-router") {
+			t.Fatalf("configmap should describe the router service, got %q", cm.Data["config.yaml"])
 		}
 	})
 
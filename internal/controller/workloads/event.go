@@ -23,22 +23,43 @@ const (
 	InvalidTemplateRef                = "InvalidTemplateRef"
 	InvalidRoleDependency             = "InvalidRoleDependency"
 	FailedCheckRoleDependency         = "FailedCheckRoleDependency"
+	FailedCheckGroupDependency        = "FailedCheckGroupDependency"
 	DependencyNotMet                  = "DependencyNotMet"
 	FailedReconcileWorkload           = "FailedReconcileWorkload"
+	FailedRenderWorkload              = "FailedRenderWorkload"
+	FailedAdoptWorkload               = "FailedAdoptWorkload"
+	RoleCreated                       = "RoleCreated"
+	RoleScaled                        = "RoleScaled"
+	FailedDeleteOrphanRoles           = "FailedDeleteOrphanRoles"
 	FailedCreateScalingAdapter        = "FailedCreateScalingAdapter"
 	FailedCalculateScaling            = "FailedCalculateScaling"
 	Succeed                           = "Succeed"
 	FailedUpdateStatus                = "FailedUpdateStatus"
 	FailedCreatePodGroup              = "FailedCreatePodGroup"
 	FailedReconcilePodGroup           = "FailedReconcilePodGroup"
+	FailedReconcileKueueWorkload      = "FailedReconcileKueueWorkload"
+	FailedReconcilePropagation        = "FailedReconcilePropagation"
+	FailedReconcileInferencePool      = "FailedReconcileInferencePool"
+	FailedReconcileMonitor            = "FailedReconcileMonitor"
 	FailedCreateRevision              = "FailedCreateRevision"
 	FailedReconcileDiscoveryConfigMap = "FailedReconcileDiscoveryConfigMap"
 	SucceedCreateRevision             = "SucceedCreateRevision"
+	DeletedExpiredRevision            = "DeletedExpiredRevision"
+	FailedDeleteExpiredRevision       = "FailedDeleteExpiredRevision"
+	RolledBack                        = "RolledBack"
+	FailedRollback                    = "FailedRollback"
+	ProgressDeadlineExceeded          = "ProgressDeadlineExceeded"
 	// InvalidGangSchedulingAnnotations is emitted when group-gang-scheduling and
 	// role-instance-gang-scheduling annotations are set simultaneously on the same RBG.
 	InvalidGangSchedulingAnnotations = "InvalidGangSchedulingAnnotations"
 )
 
+// rbg-set events
+const (
+	SwitchedBlueGreen           = "SwitchedBlueGreen"
+	FailedBlueGreenVerification = "FailedBlueGreenVerification"
+)
+
 // rbg-scaling-adapter events
 const (
 	SuccessfulBound            = "SuccessfulBound"
@@ -47,3 +68,23 @@ const (
 	FailedGetRBGRole           = "FailedGetRBGRole"
 	FailedGetRBGScalingAdapter = "FailedGetRBGScalingAdapter"
 )
+
+// rbg-autoscaler events
+const (
+	AutoscaledRole              = "AutoscaledRole"
+	AutoscaledGroup             = "AutoscaledGroup"
+	FailedAutoscaleRole         = "FailedAutoscaleRole"
+	FailedReadAutoscalingMetric = "FailedReadAutoscalingMetric"
+)
+
+// scheduling-gate events
+const (
+	ReleasedSchedulingGates      = "ReleasedSchedulingGates"
+	FailedReleaseSchedulingGates = "FailedReleaseSchedulingGates"
+)
+
+// node-failure events
+const (
+	ForceDeletedPod      = "ForceDeletedPod"
+	FailedForceDeletePod = "FailedForceDeletePod"
+)
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// podNodeNameField indexes pods by the node they are scheduled to.
+const podNodeNameField = "spec.nodeName"
+
+// NodeReconciler force-deletes the pods of roles with a NodeFailurePolicy that are left on a node
+// which stopped responding. The kubelet of such a node cannot confirm the deletion, so without it the
+// pods stay Terminating and their replacements are not created until the node is back.
+type NodeReconciler struct {
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+func NewNodeReconciler(mgr ctrl.Manager) *NodeReconciler {
+	return &NodeReconciler{
+		client:   mgr.GetClient(),
+		recorder: mgr.GetEventRecorderFor("NodeFailure"),
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	node := &corev1.Node{}
+	if err := r.client.Get(ctx, req.NamespacedName, node); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	notReadySince, ok := nodeNotReadySince(node)
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx).WithValues("node", node.Name)
+
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, client.HasLabels{constants.GroupNameLabelKey},
+		client.MatchingFields{podNodeNameField: node.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+	notReadyFor := time.Since(notReadySince)
+	groups := map[types.NamespacedName]*workloadsv1alpha2.RoleBasedGroup{}
+	var requeueAfter time.Duration
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Labels[constants.GroupNameLabelKey]}
+		rbg, found := groups[key]
+		if !found {
+			rbg = &workloadsv1alpha2.RoleBasedGroup{}
+			if err := r.client.Get(ctx, key, rbg); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return ctrl.Result{}, err
+				}
+				rbg = nil
+			}
+			groups[key] = rbg
+		}
+		if rbg == nil || rbg.IsPaused() {
+			continue
+		}
+		role, err := rbg.GetRole(pod.Labels[constants.RoleNameLabelKey])
+		if err != nil || role.NodeFailurePolicy == nil {
+			continue
+		}
+
+		threshold := time.Duration(role.NodeFailurePolicy.NotReadySeconds) * time.Second
+		if notReadyFor < threshold {
+			if remaining := threshold - notReadyFor; requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+
+		logger.Info("Force deleting pod on failed node", "pod", klog.KObj(pod), "notReadyFor", notReadyFor.Round(time.Second))
+		if err := r.client.Delete(ctx, pod, client.GracePeriodSeconds(0)); err != nil && !apierrors.IsNotFound(err) {
+			r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedForceDeletePod,
+				"Failed to force delete pod %s on node %s: %v", pod.Name, node.Name, err)
+			return ctrl.Result{}, err
+		}
+		r.recorder.Eventf(rbg, corev1.EventTypeWarning, ForceDeletedPod,
+			"Force deleted pod %s of role %s, node %s is not ready for %s",
+			pod.Name, role.Name, node.Name, notReadyFor.Round(time.Second))
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// nodeNotReadySince returns when the node stopped being ready, false is returned for a ready node.
+// A node whose kubelet stopped reporting has its Ready condition set to Unknown.
+func nodeNotReadySince(node *corev1.Node) (time.Time, bool) {
+	cond := nodeReadyCondition(node)
+	if cond == nil || cond.Status == corev1.ConditionTrue {
+		return time.Time{}, false
+	}
+	return cond.LastTransitionTime.Time, true
+}
+
+func nodeReadyCondition(node *corev1.Node) *corev1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == corev1.NodeReady {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// podNodeName is the index function of podNodeNameField.
+func podNodeName(obj client.Object) []string {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+	return []string{pod.Spec.NodeName}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, podNodeNameField, podNodeName); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		Named("node-failure-controller").
+		For(&corev1.Node{}, builder.WithPredicates(nodeNotReadyPredicate())).
+		Complete(r)
+}
+
+// nodeNotReadyPredicate only passes nodes whose Ready condition transitioned to not ready.
+func nodeNotReadyPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			node, ok := e.Object.(*corev1.Node)
+			if !ok {
+				return false
+			}
+			_, notReady := nodeNotReadySince(node)
+			return notReady
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, ok1 := e.ObjectOld.(*corev1.Node)
+			newNode, ok2 := e.ObjectNew.(*corev1.Node)
+			if !ok1 || !ok2 {
+				return false
+			}
+			// Node status is updated by every kubelet heartbeat, only a transition of the Ready
+			// condition into not ready is of interest.
+			oldCond, newCond := nodeReadyCondition(oldNode), nodeReadyCondition(newNode)
+			if newCond == nil || newCond.Status == corev1.ConditionTrue {
+				return false
+			}
+			return oldCond == nil || oldCond.Status != newCond.Status ||
+				!oldCond.LastTransitionTime.Equal(&newCond.LastTransitionTime)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
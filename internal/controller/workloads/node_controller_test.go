@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestNodeReconciler_Reconcile(t *testing.T) {
+	schema := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(schema)
+	_ = workloadsv1alpha2.AddToScheme(schema)
+
+	decode := wrappersv2.BuildStandaloneRole("decode").Obj()
+	decode.NodeFailurePolicy = &workloadsv1alpha2.NodeFailurePolicy{NotReadySeconds: 60}
+	prefill := wrappersv2.BuildStandaloneRole("prefill").Obj()
+	prefill.NodeFailurePolicy = &workloadsv1alpha2.NodeFailurePolicy{NotReadySeconds: 600}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{decode, prefill, wrappersv2.BuildStandaloneRole("router").Obj()}).Obj()
+
+	node := func(name string, status corev1.ConditionStatus, since time.Duration) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+				Type:               corev1.NodeReady,
+				Status:             status,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-since)),
+			}}},
+		}
+	}
+	pod := func(name, role, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					constants.GroupNameLabelKey: "test-rbg",
+					constants.RoleNameLabelKey:  role,
+				},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+
+	fclient := fake.NewClientBuilder().WithScheme(schema).WithIndex(&corev1.Pod{}, podNodeNameField, podNodeName).WithObjects(
+		rbg,
+		node("gpu-0", corev1.ConditionUnknown, 2*time.Minute),
+		node("gpu-1", corev1.ConditionTrue, time.Hour),
+		pod("test-rbg-decode-0", "decode", "gpu-0"),
+		pod("test-rbg-decode-1", "decode", "gpu-1"),
+		pod("test-rbg-prefill-0", "prefill", "gpu-0"),
+		pod("test-rbg-router-0", "router", "gpu-0"),
+	).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &NodeReconciler{client: fclient, recorder: recorder}
+
+	getPod := func(name string) error {
+		return fclient.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "default"}, &corev1.Pod{})
+	}
+
+	// Only the pods of the roles whose threshold passed are deleted, the others are checked again later.
+	result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "gpu-0"}})
+	require.NoError(t, err)
+	assert.InDelta(t, (8 * time.Minute).Seconds(), result.RequeueAfter.Seconds(), 5)
+	assert.True(t, apierrors.IsNotFound(getPod("test-rbg-decode-0")))
+	assert.NoError(t, getPod("test-rbg-prefill-0"))
+	assert.NoError(t, getPod("test-rbg-router-0"))
+	assert.Contains(t, <-recorder.Events, "Warning ForceDeletedPod Force deleted pod test-rbg-decode-0 of role decode, node gpu-0 is not ready for 2m")
+
+	// Pods on ready nodes are left alone.
+	result, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "gpu-1"}})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.NoError(t, getPod("test-rbg-decode-1"))
+}
+
+func Test_nodeNotReadySince(t *testing.T) {
+	since := metav1.NewTime(time.Unix(100, 0))
+	node := &corev1.Node{}
+	_, notReady := nodeNotReadySince(node)
+	assert.False(t, notReady)
+
+	node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse, LastTransitionTime: since}}
+	got, notReady := nodeNotReadySince(node)
+	assert.True(t, notReady)
+	assert.Equal(t, since.Time, got)
+
+	node.Status.Conditions[0].Status = corev1.ConditionTrue
+	_, notReady = nodeNotReadySince(node)
+	assert.False(t, notReady)
+}
+
+func Test_nodeNotReadyPredicate(t *testing.T) {
+	p := nodeNotReadyPredicate()
+	node := func(status corev1.ConditionStatus, since int64, heartbeat int64) *corev1.Node {
+		return &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+			Type:               corev1.NodeReady,
+			Status:             status,
+			LastTransitionTime: metav1.NewTime(time.Unix(since, 0)),
+			LastHeartbeatTime:  metav1.NewTime(time.Unix(heartbeat, 0)),
+		}}}}
+	}
+
+	assert.True(t, p.Create(event.CreateEvent{Object: node(corev1.ConditionUnknown, 100, 100)}))
+	assert.False(t, p.Create(event.CreateEvent{Object: node(corev1.ConditionTrue, 100, 100)}))
+
+	// A heartbeat of a ready node is not a transition.
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: node(corev1.ConditionTrue, 100, 100), ObjectNew: node(corev1.ConditionTrue, 100, 110)}))
+	// Neither is a status update of a node that stays not ready.
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: node(corev1.ConditionFalse, 100, 100), ObjectNew: node(corev1.ConditionFalse, 100, 110)}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: node(corev1.ConditionTrue, 100, 100), ObjectNew: node(corev1.ConditionUnknown, 110, 100)}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: node(corev1.ConditionFalse, 100, 100), ObjectNew: node(corev1.ConditionUnknown, 110, 100)}))
+	// Becoming ready again needs no action.
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: node(corev1.ConditionUnknown, 100, 100), ObjectNew: node(corev1.ConditionTrue, 110, 110)}))
+}
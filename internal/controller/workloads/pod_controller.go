@@ -19,6 +19,8 @@ package workloads
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"golang.org/x/net/context"
 	corev1 "k8s.io/api/core/v1"
@@ -46,6 +48,14 @@ import (
 	"sigs.k8s.io/rbgs/pkg/utils"
 )
 
+// roleRestartSeparator joins the group and role names in the requests of roles whose
+// workloads are recreated without the rest of the group. Object names cannot contain it, so they never collide
+// with the requests of groups.
+const roleRestartSeparator = "/"
+
+// roleListSeparator joins the names of the roles recreated together by the failure policy of a group.
+const roleListSeparator = ","
+
 // PodReconciler reconciles a Pod object owned by RBG
 type PodReconciler struct {
 	client    client.Client
@@ -62,16 +72,29 @@ func NewPodReconciler(mgr ctrl.Manager) *PodReconciler {
 }
 
 func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	rbgName, roleNames, _ := strings.Cut(req.Name, roleRestartSeparator)
 	var rbg workloadsv1alpha2.RoleBasedGroup
 	if err := r.client.Get(
 		ctx, types.NamespacedName{
-			Name:      req.Name,
+			Name:      rbgName,
 			Namespace: req.Namespace,
 		}, &rbg,
 	); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	logger := log.FromContext(ctx).WithValues("rbg", klog.KObj(&rbg))
+	if rbg.IsPaused() {
+		logger.Info("Reconciliation is paused, skip restarting RoleBasedGroup")
+		return ctrl.Result{}, nil
+	}
+
+	if roleNames != "" {
+		if err := r.restartRoles(ctx, &rbg, strings.Split(roleNames, roleListSeparator)); err != nil {
+			logger.Error(err, "restartRoles error", "roles", roleNames)
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
 
 	if err := r.restartRBG(ctx, &rbg); err != nil {
 		logger.Error(err, fmt.Sprintf("restartRBG error, err: %+v", err))
@@ -86,7 +109,7 @@ func (r *PodReconciler) restartRBG(ctx context.Context, rbg *workloadsv1alpha2.R
 	logger.Info("Recreating RoleBasedGroup")
 
 	// 1. update rbg status
-	if err := r.setRestartCondition(ctx, rbg, false); err != nil {
+	if err := r.setRestartCondition(ctx, rbg, "", false); err != nil {
 		return err
 	}
 
@@ -118,18 +141,83 @@ func (r *PodReconciler) restartRBG(ctx context.Context, rbg *workloadsv1alpha2.R
 	}
 
 	// 4. remove restart status
-	if err := r.setRestartCondition(ctx, rbg, true); err != nil {
+	if err := r.setRestartCondition(ctx, rbg, "", true); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// restartRoles recreates the workloads of the given roles, so that all of their pods are
+// replaced together while the other roles keep running.
+func (r *PodReconciler) restartRoles(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, roleNames []string) error {
+	var roles []*workloadsv1alpha2.RoleSpec
+	for _, roleName := range roleNames {
+		// Roles removed since the pod restarted have nothing to recreate.
+		if role, err := rbg.GetRole(roleName); err == nil {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		return nil
+	}
+	names := strings.Join(roleNames, ", ")
+	log.FromContext(ctx).Info("Recreating roles", "roles", names)
+
+	if err := r.setRestartCondition(ctx, rbg, names, false); err != nil {
+		return err
+	}
+	for _, role := range roles {
+		recon, err := reconciler.NewWorkloadReconciler(role.GetWorkloadSpec(), r.scheme, r.client)
+		if err != nil {
+			return err
+		}
+		if err := recon.RecreateWorkload(ctx, rbg, role); err != nil {
+			return err
+		}
+	}
+	return r.setRestartCondition(ctx, rbg, names, true)
+}
+
+// dependentRoles returns the role and the roles depending on it, directly or through other roles.
+func dependentRoles(rbg *workloadsv1alpha2.RoleBasedGroup, roleName string) []string {
+	roles := []string{roleName}
+	seen := map[string]bool{roleName: true}
+	for i := 0; i < len(roles); i++ {
+		for _, role := range rbg.Spec.Roles {
+			if !seen[role.Name] && slices.Contains(role.Dependencies, roles[i]) {
+				seen[role.Name] = true
+				roles = append(roles, role.Name)
+			}
+		}
+	}
+	return roles
+}
+
+// setRestartCondition sets the RestartInProgress condition of the group, for the restart
+// of the given role or of the whole group when roleName is empty.
 func (r *PodReconciler) setRestartCondition(
-	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, restartCompleted bool,
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, roleName string, restartCompleted bool,
 ) error {
 	var restartCondition metav1.Condition
-	if restartCompleted {
+	switch {
+	case roleName != "" && restartCompleted:
+		restartCondition = metav1.Condition{
+			Type:               string(workloadsv1alpha2.RoleBasedGroupRestartInProgress),
+			Status:             metav1.ConditionStatus(corev1.ConditionFalse),
+			LastTransitionTime: metav1.Now(),
+			Reason:             "RoleRestartCompleted",
+			Message:            fmt.Sprintf("Role %s Restart Completed", roleName),
+		}
+	case roleName != "":
+		restartCondition = metav1.Condition{
+			Type:               string(workloadsv1alpha2.RoleBasedGroupRestartInProgress),
+			Status:             metav1.ConditionStatus(corev1.ConditionTrue),
+			LastTransitionTime: metav1.Now(),
+			Reason:             "RoleRestart",
+			Message:            fmt.Sprintf("Role %s Restart in progress", roleName),
+		}
+	case restartCompleted:
 		restartCondition = metav1.Condition{
 			Type:               string(workloadsv1alpha2.RoleBasedGroupRestartInProgress),
 			Status:             metav1.ConditionStatus(corev1.ConditionFalse),
@@ -137,7 +225,7 @@ func (r *PodReconciler) setRestartCondition(
 			Reason:             "RBGRestartCompleted",
 			Message:            "RBG Restart Completed",
 		}
-	} else {
+	default:
 		restartCondition = metav1.Condition{
 			Type:               string(workloadsv1alpha2.RoleBasedGroupRestartInProgress),
 			Status:             metav1.ConditionStatus(corev1.ConditionTrue),
@@ -222,11 +310,22 @@ func ToRoleStatusApplyConfiguration(roleStatus []workloadsv1alpha2.RoleStatus) [
 	}
 	out := make([]*applyconfiguration.RoleStatusApplyConfiguration, 0, len(roleStatus))
 	for _, rs := range roleStatus {
-		out = append(out, applyconfiguration.RoleStatus().
+		ac := applyconfiguration.RoleStatus().
 			WithName(rs.Name).
 			WithReplicas(rs.Replicas).
 			WithReadyReplicas(rs.ReadyReplicas).
-			WithUpdatedReplicas(rs.UpdatedReplicas))
+			WithUpdatedReplicas(rs.UpdatedReplicas).
+			WithConditions(ToConditionApplyConfigurations(rs.Conditions)...)
+		if rs.CurrentRevision != "" {
+			ac.WithCurrentRevision(rs.CurrentRevision)
+		}
+		if rs.UpdateRevision != "" {
+			ac.WithUpdateRevision(rs.UpdateRevision)
+		}
+		if rs.CompletedRevision != "" {
+			ac.WithCompletedRevision(rs.CompletedRevision)
+		}
+		out = append(out, ac)
 	}
 	return out
 }
@@ -294,21 +393,49 @@ func (r *PodReconciler) podToRBG(ctx context.Context, obj client.Object) []recon
 	if err != nil {
 		return []reconcile.Request{}
 	}
-
-	// 1. if RestartPolicy is None, do nothing
-	// 2. if RestartPolicy is RecreateRoleInstanceOnPodRestart, the lws controller will recreate lws. RBG controller does nothing.
-	if curRole.RestartPolicy != workloadsv1alpha2.RecreateRBGOnPodRestart {
+	// The Job of a one-shot role retries its own pods within its backoff limit, and its pods go away
+	// whenever the Job runs again.
+	if curRole.GetWorkloadType() == constants.JobWorkloadType {
 		return []reconcile.Request{}
 	}
 
-	// restart rbg
-	return []reconcile.Request{
-		{
-			NamespacedName: types.NamespacedName{
-				Name:      rbgName,
-				Namespace: rbg.Namespace,
+	// A role whose pod restarted too often is recreated with the roles the failure policy ties it to,
+	// whatever its own restart policy.
+	if policy := rbg.Spec.FailurePolicy; policy != nil && containerRestarted &&
+		utils.PodRestartCount(pod) >= policy.MaxPodRestarts {
+		logger.Info("Role is unrecoverable, applying the failure policy", "role", roleName, "action", policy.Action)
+		name := rbgName
+		if policy.Action == workloadsv1alpha2.FailurePolicyRestartDependents {
+			name += roleRestartSeparator + strings.Join(dependentRoles(&rbg, roleName), roleListSeparator)
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: rbg.Namespace}}}
+	}
+
+	// 1. if RestartPolicy is None, do nothing
+	// 2. if RestartPolicy is RecreateRoleInstanceOnPodRestart, the lws controller will recreate lws. RBG controller does nothing.
+	// 3. if RestartPolicy is RecreateRoleOnPodRestart, restart the role only.
+	switch curRole.RestartPolicy {
+	case workloadsv1alpha2.RecreateRBGOnPodRestart:
+		// restart rbg
+		return []reconcile.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Name:      rbgName,
+					Namespace: rbg.Namespace,
+				},
 			},
-		},
+		}
+	case workloadsv1alpha2.RecreateRoleOnPodRestart:
+		return []reconcile.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Name:      rbgName + roleRestartSeparator + roleName,
+					Namespace: rbg.Namespace,
+				},
+			},
+		}
+	default:
+		return []reconcile.Request{}
 	}
 }
 
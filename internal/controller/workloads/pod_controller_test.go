@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -710,3 +711,109 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, reconcile.Result{}, result)
 }
+
+func TestPodReconciler_RoleRestart(t *testing.T) {
+	schema := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(schema)
+	_ = workloadsv1alpha2.AddToScheme(schema)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").
+				WithRestartPolicy(workloadsv1alpha2.RecreateRoleOnPodRestart).
+				Obj(),
+		}).Obj()
+	pod := wrappers.BuildDeletingPod().WithLabels(map[string]string{
+		constants.GroupNameLabelKey: "test-rbg",
+		constants.RoleNameLabelKey:  "decode",
+	}).Obj()
+	pod.Namespace = "default"
+
+	fclient := fake.NewClientBuilder().WithScheme(schema).WithObjects(rbg, pod).WithStatusSubresource(rbg).Build()
+	r := &PodReconciler{
+		client:    fclient,
+		apiReader: fclient,
+		scheme:    schema,
+	}
+
+	want := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg/decode", Namespace: "default"}}
+	assert.Equal(t, []reconcile.Request{want}, r.podToRBG(context.TODO(), pod))
+
+	result, err := r.Reconcile(context.TODO(), want)
+	assert.NoError(t, err)
+	assert.Equal(t, reconcile.Result{}, result)
+
+	latest := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fclient.Get(context.TODO(), types.NamespacedName{Name: "test-rbg", Namespace: "default"}, latest))
+	cond := meta.FindStatusCondition(latest.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupRestartInProgress))
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "RoleRestartCompleted", cond.Reason)
+		assert.Equal(t, "Role decode Restart Completed", cond.Message)
+	}
+}
+
+func TestPodReconciler_FailurePolicy(t *testing.T) {
+	schema := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(schema)
+	_ = workloadsv1alpha2.AddToScheme(schema)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").Obj(),
+			wrappersv2.BuildStandaloneRole("prefill").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithDependencies([]string{"prefill"}).Obj(),
+			wrappersv2.BuildStandaloneRole("proxy").WithDependencies([]string{"decode"}).Obj(),
+		}).Obj()
+	rbg.Spec.FailurePolicy = &workloadsv1alpha2.FailurePolicy{
+		MaxPodRestarts: 3,
+		Action:         workloadsv1alpha2.FailurePolicyRestartDependents,
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rbg-prefill-0",
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.GroupNameLabelKey: "test-rbg",
+				constants.RoleNameLabelKey:  "prefill",
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "engine", RestartCount: 2}},
+		},
+	}
+
+	fclient := fake.NewClientBuilder().WithScheme(schema).WithObjects(rbg, pod).WithStatusSubresource(rbg).Build()
+	r := &PodReconciler{
+		client:    fclient,
+		apiReader: fclient,
+		scheme:    schema,
+	}
+
+	// Below the threshold the restart policy of the role applies, which is None here.
+	assert.Empty(t, r.podToRBG(context.TODO(), pod))
+
+	pod.Status.ContainerStatuses[0].RestartCount = 3
+	want := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg/prefill,decode,proxy", Namespace: "default"}}
+	assert.Equal(t, []reconcile.Request{want}, r.podToRBG(context.TODO(), pod))
+
+	_, err := r.Reconcile(context.TODO(), want)
+	assert.NoError(t, err)
+	latest := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fclient.Get(context.TODO(), types.NamespacedName{Name: "test-rbg", Namespace: "default"}, latest))
+	cond := meta.FindStatusCondition(latest.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupRestartInProgress))
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, "RoleRestartCompleted", cond.Reason)
+		assert.Equal(t, "Role prefill, decode, proxy Restart Completed", cond.Message)
+	}
+
+	// RestartGroup recreates the whole group.
+	latest.Spec.FailurePolicy.Action = workloadsv1alpha2.FailurePolicyRestartGroup
+	latest.Status.Conditions = nil
+	assert.NoError(t, fclient.Update(context.TODO(), latest))
+	assert.NoError(t, fclient.Status().Update(context.TODO(), latest))
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}},
+		r.podToRBG(context.TODO(), pod))
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/autoscaler"
+)
+
+// autoscalerSyncPeriod is how often the metrics of the autoscaled roles are read.
+const autoscalerSyncPeriod = 15 * time.Second
+
+// RoleBasedGroupAutoscalerReconciler scales the roles of RoleBasedGroups with spec.roles[].autoscaling
+// by their metrics, and the roles of spec.autoscaling together by the objectives of the group, updating
+// the replicas of the roles as the scaling adapter does for an external autoscaler.
+type RoleBasedGroupAutoscalerReconciler struct {
+	client     client.Client
+	recorder   record.EventRecorder
+	autoscaler *autoscaler.Autoscaler
+	now        func() time.Time
+}
+
+func NewRoleBasedGroupAutoscalerReconciler(mgr ctrl.Manager) *RoleBasedGroupAutoscalerReconciler {
+	return &RoleBasedGroupAutoscalerReconciler{
+		client:     mgr.GetClient(),
+		recorder:   mgr.GetEventRecorderFor("RoleBasedGroupAutoscaler"),
+		autoscaler: autoscaler.New(mgr.GetClient()),
+		now:        time.Now,
+	}
+}
+
+func (r *RoleBasedGroupAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	if err := r.client.Get(ctx, req.NamespacedName, rbg); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.autoscaler.Forget(req.NamespacedName, nil)
+			r.autoscaler.ForgetGroup(req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	logger := log.FromContext(ctx).WithValues("rbg", klog.KObj(rbg))
+	ctx = ctrl.LoggerInto(ctx, logger)
+
+	autoscaled := map[string]bool{}
+	for i := range rbg.Spec.Roles {
+		if rbg.Spec.Roles[i].Autoscaling != nil {
+			autoscaled[rbg.Spec.Roles[i].Name] = true
+		}
+	}
+	r.autoscaler.Forget(req.NamespacedName, autoscaled)
+	if rbg.Spec.Autoscaling == nil {
+		r.autoscaler.ForgetGroup(req.NamespacedName)
+		if len(autoscaled) == 0 {
+			return ctrl.Result{}, nil
+		}
+	}
+	// The roles of a set follow its group template, and paused or suspended groups are left alone.
+	if !rbg.DeletionTimestamp.IsZero() || rbg.IsPaused() || rbg.IsSuspended() ||
+		rbg.Labels[constants.GroupSetNameLabelKey] != "" {
+		return ctrl.Result{}, nil
+	}
+
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		if role.Autoscaling == nil {
+			continue
+		}
+		value, err := r.autoscaler.ReadMetric(ctx, rbg, role)
+		if err != nil {
+			logger.Error(err, "Failed to read the autoscaling metric", "role", role.Name)
+			r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedReadAutoscalingMetric,
+				"Failed to read the autoscaling metric of role %s: %v", role.Name, err)
+			continue
+		}
+
+		current := ptr.Deref(role.Replicas, 1)
+		desired := autoscaler.DesiredReplicas(role.Autoscaling, current, value)
+		replicas := r.autoscaler.Stabilize(req.NamespacedName, role, current, desired, r.now())
+		if replicas == current {
+			continue
+		}
+		if err := r.updateRoleReplicas(ctx, rbg, map[string]int32{role.Name: replicas}); err != nil {
+			r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedAutoscaleRole,
+				"Failed to scale role %s to %d replicas: %v", role.Name, replicas, err)
+			return ctrl.Result{}, err
+		}
+		logger.Info("Autoscaled role", "role", role.Name, "from", current, "to", replicas, "metric", value)
+		r.recorder.Eventf(rbg, corev1.EventTypeNormal, AutoscaledRole,
+			"Scaled role %s from %d to %d replicas, the metric is %g for a target of %s per replica",
+			role.Name, current, replicas, value, role.Autoscaling.Target.String())
+	}
+	if rbg.Spec.Autoscaling != nil {
+		if err := r.autoscaleGroup(ctx, rbg); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: autoscalerSyncPeriod}, nil
+}
+
+// autoscaleGroup scales the roles of spec.autoscaling in ratio, to the fewest units meeting every
+// objective of the group that its GPU budget allows.
+func (r *RoleBasedGroupAutoscalerReconciler) autoscaleGroup(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) error {
+	logger := log.FromContext(ctx)
+	key := types.NamespacedName{Namespace: rbg.Namespace, Name: rbg.Name}
+	autoscaling := rbg.Spec.Autoscaling
+
+	objectives, err := r.autoscaler.ReadObjectives(ctx, autoscaling)
+	if err != nil {
+		logger.Error(err, "Failed to read the objectives of the group")
+		r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedReadAutoscalingMetric,
+			"Failed to read the objectives of the group: %v", err)
+		return nil
+	}
+	maxUnits, err := autoscaler.MaxUnits(ctx, r.client, rbg)
+	if err != nil {
+		logger.Error(err, "Failed to compute the GPU budget of the group")
+		r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedAutoscaleRole,
+			"Failed to scale the roles of the group within its GPU budget: %v", err)
+		return nil
+	}
+
+	current := autoscaler.CurrentUnits(rbg)
+	desired := autoscaler.DesiredUnits(autoscaling, current, maxUnits, objectives)
+	units := r.autoscaler.StabilizeGroup(key, autoscaling, current, desired, r.now())
+	replicas := autoscaler.UnitReplicas(rbg, units)
+	if len(replicas) == 0 {
+		return nil
+	}
+	if err := r.updateRoleReplicas(ctx, rbg, replicas); err != nil {
+		r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedAutoscaleRole,
+			"Failed to scale the roles of the group to %d units: %v", units, err)
+		return err
+	}
+
+	values := make([]string, 0, len(objectives))
+	for _, objective := range objectives {
+		values = append(values, fmt.Sprintf("%s %g for a target of %g", objective.Name, objective.Value, objective.Target))
+	}
+	logger.Info("Autoscaled group", "from", current, "to", units, "replicas", replicas)
+	r.recorder.Eventf(rbg, corev1.EventTypeNormal, AutoscaledGroup,
+		"Scaled the roles of the group from %d to %d units of at most %d, %s",
+		current, units, maxUnits, strings.Join(values, ", "))
+	return nil
+}
+
+// updateRoleReplicas sets the replicas of roles of the group in a single update, reading the group again
+// on conflicts.
+func (r *RoleBasedGroupAutoscalerReconciler) updateRoleReplicas(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, replicas map[string]int32,
+) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		for roleName, roleReplicas := range replicas {
+			role, err := rbg.GetRole(roleName)
+			if err != nil {
+				return err
+			}
+			role.Replicas = ptr.To(roleReplicas)
+		}
+		if err := r.client.Update(ctx, rbg); err != nil {
+			if apierrors.IsConflict(err) {
+				if err := r.client.Get(ctx, types.NamespacedName{Name: rbg.Name, Namespace: rbg.Namespace}, rbg); err != nil {
+					return err
+				}
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager. The metrics are read periodically, so
+// only the creation of groups and changes of their spec start the loop.
+func (r *RoleBasedGroupAutoscalerReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		Named("workloads-rolebasedgroup-autoscaler").
+		For(&workloadsv1alpha2.RoleBasedGroup{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
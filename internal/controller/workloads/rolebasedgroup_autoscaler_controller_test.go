@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/autoscaler"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestRoleBasedGroupAutoscalerReconciler_Reconcile(t *testing.T) {
+	schema := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(schema)
+	_ = workloadsv1alpha2.AddToScheme(schema)
+
+	queued := "40"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1,%q]}]}}`, queued)
+	}))
+	defer server.Close()
+
+	decode := wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj()
+	decode.Autoscaling = &workloadsv1alpha2.RoleAutoscaling{
+		MinReplicas:                   ptr.To[int32](1),
+		MaxReplicas:                   6,
+		Prometheus:                    &workloadsv1alpha2.PrometheusMetric{ServerAddress: server.URL, Query: "queued"},
+		Target:                        resource.MustParse("10"),
+		ScaleDownStabilizationSeconds: ptr.To[int32](300),
+	}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{decode, wrappersv2.BuildStandaloneRole("router").WithReplicas(3).Obj()}).Obj()
+
+	fclient := fake.NewClientBuilder().WithScheme(schema).WithObjects(rbg).Build()
+	now := time.Now()
+	r := &RoleBasedGroupAutoscalerReconciler{
+		client:     fclient,
+		recorder:   record.NewFakeRecorder(10),
+		autoscaler: autoscaler.New(fclient),
+		now:        func() time.Time { return now },
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-rbg"}}
+	replicas := func() (int32, int32) {
+		got := &workloadsv1alpha2.RoleBasedGroup{}
+		require.NoError(t, fclient.Get(context.TODO(), req.NamespacedName, got))
+		return *got.Spec.Roles[0].Replicas, *got.Spec.Roles[1].Replicas
+	}
+
+	// The role is scaled up to the queued requests divided by the target, the other role is left alone.
+	result, err := r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, autoscalerSyncPeriod, result.RequeueAfter)
+	decodeReplicas, routerReplicas := replicas()
+	assert.Equal(t, int32(4), decodeReplicas)
+	assert.Equal(t, int32(3), routerReplicas)
+
+	// A drop of the metric does not scale the role down within the stabilization window.
+	queued = "5"
+	now = now.Add(time.Minute)
+	_, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	decodeReplicas, _ = replicas()
+	assert.Equal(t, int32(4), decodeReplicas)
+
+	// Once the window has passed, the role is scaled down, bounded by its minimum replicas.
+	now = now.Add(10 * time.Minute)
+	_, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	decodeReplicas, _ = replicas()
+	assert.Equal(t, int32(1), decodeReplicas)
+
+	// A paused group is not scaled.
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	require.NoError(t, fclient.Get(context.TODO(), req.NamespacedName, got))
+	got.Spec.Paused = true
+	require.NoError(t, fclient.Update(context.TODO(), got))
+	queued = "60"
+	result, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	decodeReplicas, _ = replicas()
+	assert.Equal(t, int32(1), decodeReplicas)
+}
+
+func TestRoleBasedGroupAutoscalerReconciler_Reconcile_GroupAutoscaling(t *testing.T) {
+	schema := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(schema)
+	_ = workloadsv1alpha2.AddToScheme(schema)
+
+	values := map[string]string{"ttft": "3", "queued": "10"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1,%q]}]}}`,
+			values[r.URL.Query().Get("query")])
+	}))
+	defer server.Close()
+
+	withGPUs := func(role workloadsv1alpha2.RoleSpec) workloadsv1alpha2.RoleSpec {
+		role.StandalonePattern.Template.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+			"nvidia.com/gpu": resource.MustParse("1"),
+		}
+		return role
+	}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			withGPUs(wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj()),
+			withGPUs(wrappersv2.BuildStandaloneRole("decode").WithReplicas(1).Obj()),
+		}).Obj()
+	rbg.Spec.Autoscaling = &workloadsv1alpha2.GroupAutoscaling{
+		Roles: []workloadsv1alpha2.GroupAutoscalingRole{{Name: "prefill", Replicas: 2}, {Name: "decode", Replicas: 1}},
+		// The budget fits 4 units of 3 GPUs.
+		MaxGPUs:                       12,
+		ServerAddress:                 server.URL,
+		TTFT:                          &workloadsv1alpha2.ServiceLevelObjective{Query: "ttft", Target: resource.MustParse("1")},
+		QueueLength:                   &workloadsv1alpha2.ServiceLevelObjective{Query: "queued", Target: resource.MustParse("20")},
+		ScaleDownStabilizationSeconds: ptr.To[int32](300),
+	}
+
+	fclient := fake.NewClientBuilder().WithScheme(schema).WithObjects(rbg).Build()
+	now := time.Now()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoleBasedGroupAutoscalerReconciler{
+		client:     fclient,
+		recorder:   recorder,
+		autoscaler: autoscaler.New(fclient),
+		now:        func() time.Time { return now },
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-rbg"}}
+	replicas := func() (int32, int32) {
+		got := &workloadsv1alpha2.RoleBasedGroup{}
+		require.NoError(t, fclient.Get(context.TODO(), req.NamespacedName, got))
+		return *got.Spec.Roles[0].Replicas, *got.Spec.Roles[1].Replicas
+	}
+
+	// A missed TTFT scales both roles up in ratio, bounded by the GPU budget.
+	result, err := r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, autoscalerSyncPeriod, result.RequeueAfter)
+	prefillReplicas, decodeReplicas := replicas()
+	assert.Equal(t, int32(6), prefillReplicas)
+	assert.Equal(t, int32(3), decodeReplicas)
+	assert.Equal(t, "Normal AutoscaledGroup Scaled the roles of the group from 1 to 3 units of at most 4, "+
+		"ttft 3 for a target of 1, queueLength 10 for a target of 20", <-recorder.Events)
+
+	// Once every objective is met, a unit is removed after the stabilization window.
+	values["ttft"] = "0.2"
+	now = now.Add(time.Minute)
+	_, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	prefillReplicas, _ = replicas()
+	assert.Equal(t, int32(6), prefillReplicas)
+
+	now = now.Add(10 * time.Minute)
+	_, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	prefillReplicas, decodeReplicas = replicas()
+	assert.Equal(t, int32(4), prefillReplicas)
+	assert.Equal(t, int32(2), decodeReplicas)
+}
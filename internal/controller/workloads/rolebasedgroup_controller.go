@@ -23,16 +23,23 @@ import (
 	"maps"
 	"math"
 	"reflect"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	kruiseappsv1alpha1 "github.com/openkruise/kruise/apis/apps/v1alpha1"
+	kruiseappsv1beta1 "github.com/openkruise/kruise/apis/apps/v1beta1"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -49,6 +56,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -58,6 +66,11 @@ import (
 	"sigs.k8s.io/rbgs/pkg/coordination/coordinationscaling"
 	"sigs.k8s.io/rbgs/pkg/dependency"
 	"sigs.k8s.io/rbgs/pkg/discovery"
+	"sigs.k8s.io/rbgs/pkg/inferencepool"
+	"sigs.k8s.io/rbgs/pkg/kueue"
+	"sigs.k8s.io/rbgs/pkg/metrics"
+	"sigs.k8s.io/rbgs/pkg/monitoring"
+	"sigs.k8s.io/rbgs/pkg/multicluster"
 	"sigs.k8s.io/rbgs/pkg/reconciler"
 	"sigs.k8s.io/rbgs/pkg/scale"
 	"sigs.k8s.io/rbgs/pkg/scheduler"
@@ -66,6 +79,13 @@ import (
 	volcanoschedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 )
 
+// dependencyRequeueInterval is how often a group with roles waiting for their dependencies is reconciled again.
+const dependencyRequeueInterval = 5 * time.Second
+
+// propagationRequeueInterval is how often a multi-cluster group whose workloads are not applied to
+// their member clusters yet is reconciled again, the ResourceBindings of Karmada are not watched.
+const propagationRequeueInterval = 10 * time.Second
+
 var (
 	runtimeController *builder.TypedBuilder[reconcile.Request]
 	watchedWorkload   sync.Map
@@ -77,13 +97,17 @@ func init() {
 
 // RoleBasedGroupReconciler reconciles a RoleBasedGroup object
 type RoleBasedGroupReconciler struct {
-	client             client.Client
-	apiReader          client.Reader
-	scheme             *runtime.Scheme
-	recorder           record.EventRecorder
-	workloadReconciler map[string]reconciler.WorkloadReconciler
-	reconcilerMu       sync.RWMutex
-	podGroupManager    scheduler.PodGroupManager
+	client               client.Client
+	apiReader            client.Reader
+	scheme               *runtime.Scheme
+	recorder             record.EventRecorder
+	workloadReconciler   map[string]reconciler.WorkloadReconciler
+	reconcilerMu         sync.RWMutex
+	podGroupManager      scheduler.PodGroupManager
+	kueueManager         *kueue.Manager
+	multiClusterManager  *multicluster.Manager
+	inferencePoolManager *inferencepool.Manager
+	monitoringManager    *monitoring.Manager
 }
 
 func NewRoleBasedGroupReconciler(mgr ctrl.Manager, schedulerName scheduler.SchedulerPluginType) (*RoleBasedGroupReconciler, error) {
@@ -92,12 +116,16 @@ func NewRoleBasedGroupReconciler(mgr ctrl.Manager, schedulerName scheduler.Sched
 		return nil, err
 	}
 	return &RoleBasedGroupReconciler{
-		client:             mgr.GetClient(),
-		apiReader:          mgr.GetAPIReader(),
-		scheme:             mgr.GetScheme(),
-		recorder:           mgr.GetEventRecorderFor("RoleBasedGroup"),
-		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
-		podGroupManager:    podGroupManager,
+		client:               mgr.GetClient(),
+		apiReader:            mgr.GetAPIReader(),
+		scheme:               mgr.GetScheme(),
+		recorder:             mgr.GetEventRecorderFor("RoleBasedGroup"),
+		workloadReconciler:   make(map[string]reconciler.WorkloadReconciler),
+		podGroupManager:      podGroupManager,
+		kueueManager:         kueue.New(mgr.GetClient()),
+		multiClusterManager:  multicluster.New(mgr.GetClient()),
+		inferencePoolManager: inferencepool.New(mgr.GetClient()),
+		monitoringManager:    monitoring.New(mgr.GetClient()),
 	}, nil
 }
 
@@ -108,6 +136,7 @@ func NewRoleBasedGroupReconciler(mgr ctrl.Manager, schedulerName scheduler.Sched
 // +kubebuilder:rbac:groups=workloads.x-k8s.io,resources=coordinatedpolicies/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=workloads.x-k8s.io,resources=clusterengineruntimeprofiles,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=workloads.x-k8s.io,resources=clusterengineruntimeprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=workloads.x-k8s.io,resources=clusterroletemplates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=workloads.x-k8s.io,resources=rolebasedgroupscalingadapters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=workloads.x-k8s.io,resources=rolebasedgroupscalingadapters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
@@ -120,14 +149,31 @@ func NewRoleBasedGroupReconciler(mgr ctrl.Manager, schedulerName scheduler.Sched
 // +kubebuilder:rbac:groups=apps,resources=statefulsets;deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=statefulsets/finalizers;deployments/finalizers,verbs=update
 // +kubebuilder:rbac:groups=apps,resources=statefulsets/status;deployments/status,verbs=get;patch;update
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs/status,verbs=get
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=scheduling.volcano.sh,resources=podgroups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=leaderworkerset.x-k8s.io,resources=leaderworkersets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=leaderworkerset.x-k8s.io,resources=leaderworkersets/status,verbs=get;patch;update
+// +kubebuilder:rbac:groups=apps.kruise.io,resources=clonesets;statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps.kruise.io,resources=clonesets/status;statefulsets/status,verbs=get;patch;update
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=inference.networking.k8s.io,resources=inferencepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=podmonitors;servicemonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy.karmada.io,resources=propagationpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=work.karmada.io,resources=resourcebindings,verbs=get;list;watch
 
 func (r *RoleBasedGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	result, err := r.reconcile(ctx, req)
+	metrics.ObserveReconcile(req.Namespace, req.Name, time.Since(start), err)
+	return result, err
+}
+
+func (r *RoleBasedGroupReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	// Fetch the RoleBasedGroup instance
@@ -138,6 +184,8 @@ func (r *RoleBasedGroupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			logger.Info("RoleBasedGroup resource not found. Ignoring since object must be deleted",
 				"name", req.Name,
 				"namespace", req.Namespace)
+			metrics.ForgetGroup(req.Namespace, req.Name)
+			reconciler.ForgetAppliedWorkloads(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -147,7 +195,7 @@ func (r *RoleBasedGroupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 	if !rbg.DeletionTimestamp.IsZero() {
-		return ctrl.Result{}, nil
+		return r.reconcileTermination(ctx, rbg)
 	}
 
 	logger = logger.WithValues("rbg", klog.KObj(rbg))
@@ -158,6 +206,26 @@ func (r *RoleBasedGroupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		logger.Info("Finished reconciling", "duration", time.Since(start))
 	}()
 
+	if err := r.ensureTerminationFinalizer(ctx, rbg); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// A paused group only keeps its status up to date, leaving all child objects untouched.
+	if rbg.IsPaused() {
+		logger.Info("Reconciliation is paused, only refreshing status")
+		roleStatuses, err := r.constructAndUpdateRoleStatuses(ctx, rbg, nil)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		metrics.RecordRoles(rbg, roleStatuses, nil)
+		return ctrl.Result{}, nil
+	}
+
+	// A requested rollback rewrites the spec, the update triggers a new reconciliation.
+	if rolledBack, err := r.handleRollback(ctx, rbg); err != nil || rolledBack {
+		return ctrl.Result{}, err
+	}
+
 	// Step 0: Pre-check validations
 	if err := r.preCheck(ctx, rbg); err != nil {
 		return ctrl.Result{}, err
@@ -186,10 +254,11 @@ func (r *RoleBasedGroupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	// Step 4: Construct role statuses
-	roleStatuses, err := r.constructAndUpdateRoleStatuses(ctx, rbg)
+	roleStatuses, err := r.constructAndUpdateRoleStatuses(ctx, rbg, expectedRolesRevisionHash)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	metrics.RecordRoles(rbg, roleStatuses, expectedRolesRevisionHash)
 
 	// Step 5: Ensure CoordinatedPolicy for objects that originated from v1alpha1.
 	// When v1alpha1 support is removed, delete this step and coordinatedpolicy_migration_controller.go.
@@ -204,24 +273,95 @@ func (r *RoleBasedGroupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	// Step 7: Reconcile PodGroup for gang scheduling (annotation-driven).
+	// Step 7: Keep the roles at zero replicas while suspended or waiting for the admission by Kueue.
+	admission, err := r.reconcileKueueWorkload(ctx, rbg)
+	if err != nil {
+		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedReconcileKueueWorkload, err.Error())
+		return ctrl.Result{}, err
+	}
+	suspended := rbg.IsSuspended() || (admission != nil && !admission.Admitted)
+	if suspended {
+		scalingTargets = suspendedScalingTargets(rbg)
+	}
+	if err := r.updateSuspendedCondition(ctx, rbg, suspended, admission); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Roles of a suspended group are kept at zero replicas, they cannot miss their progress deadline.
+	var deadlineRequeue time.Duration
+	if !suspended {
+		rolledBack, requeueAfter, err := r.handleProgressDeadline(ctx, rbg, roleStatuses)
+		if err != nil || rolledBack {
+			return ctrl.Result{}, err
+		}
+		deadlineRequeue = requeueAfter
+	}
+	var nodeSelectors map[string]map[string]string
+	if admission != nil {
+		nodeSelectors = admission.NodeSelectors
+	}
+
+	// Step 8: Reconcile PodGroup for gang scheduling (annotation-driven).
 	if err := r.reconcilePodGroup(ctx, rbg); err != nil {
 		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedReconcilePodGroup, err.Error())
 		return ctrl.Result{}, err
 	}
 
-	// Step 8: Reconcile roles, do create/update actions for roles.
-	if err := r.reconcileRoles(ctx, rbg, expectedRolesRevisionHash, scalingTargets, rollingUpdateStrategies); err != nil {
+	// Step 9: Reconcile roles, do create/update actions for roles. No role is created or scaled up
+	// until the groups in spec.dependsOn are ready.
+	unreadyGroups, err := dependency.NewDefaultDependencyManager(r.scheme, r.client).UnreadyGroupDependencies(ctx, rbg)
+	if err != nil {
+		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedCheckGroupDependency, err.Error())
+		return ctrl.Result{}, err
+	}
+	if len(unreadyGroups) > 0 {
+		r.recorder.Eventf(rbg, corev1.EventTypeWarning, DependencyNotMet,
+			"dependencies not met for the group, waiting for rolebasedgroups %s", strings.Join(unreadyGroups, ", "))
+	}
+	waiting, err := r.reconcileRoles(ctx, rbg, expectedRolesRevisionHash, scalingTargets, rollingUpdateStrategies,
+		nodeSelectors, len(unreadyGroups) > 0)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.updateDependencyCondition(ctx, rbg, unreadyGroups, waiting); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Step 10: Propagate the workloads to the member clusters of a multi-cluster group.
+	propagation, err := r.reconcilePropagation(ctx, rbg)
+	if err != nil {
+		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedReconcilePropagation, err.Error())
+		return ctrl.Result{}, err
+	}
+	if err := r.updatePropagatedCondition(ctx, rbg, propagation); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Step 11: Expose the roles of the group to the gateways of the inference extension.
+	if err := r.reconcileInferencePools(ctx, rbg); err != nil {
+		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedReconcileInferencePool, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	// Step 12: Scrape the metrics of the roles listed in spec.monitoring.
+	if err := r.reconcileMonitors(ctx, rbg); err != nil {
+		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedReconcileMonitor, err.Error())
 		return ctrl.Result{}, err
 	}
 
-	// Step 9: Cleanup orphaned resources
+	// Step 13: Cleanup orphaned resources
 	if err := r.cleanup(ctx, rbg); err != nil {
 		return ctrl.Result{}, err
 	}
 
+	if len(unreadyGroups) > 0 || len(waiting) > 0 {
+		return ctrl.Result{RequeueAfter: dependencyRequeueInterval}, nil
+	}
+	if propagation != nil && !propagation.Propagated {
+		return ctrl.Result{RequeueAfter: propagationRequeueInterval}, nil
+	}
 	r.recorder.Event(rbg, corev1.EventTypeNormal, Succeed, "ReconcileSucceed")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: deadlineRequeue}, nil
 }
 
 func (r *RoleBasedGroupReconciler) handleRevisions(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) (map[string]string, error) {
@@ -238,6 +378,12 @@ func (r *RoleBasedGroupReconciler) handleRevisions(ctx context.Context, rbg *wor
 		return nil, err
 	}
 
+	expectedRolesRevisionHash, err := utils.GetRolesRevisionHash(expectedRevision)
+	if err != nil {
+		logger.Error(err, "Failed to get roles revision hash")
+		return nil, err
+	}
+
 	if !utils.EqualRevision(currentRevision, expectedRevision) {
 		logger.Info("Current revision need to be updated")
 		if err := r.client.Create(ctx, expectedRevision); err != nil {
@@ -246,17 +392,34 @@ func (r *RoleBasedGroupReconciler) handleRevisions(ctx context.Context, rbg *wor
 			return nil, err
 		} else {
 			logger.Info(fmt.Sprintf("Create revision [%s] successfully", expectedRevision.Name))
-			r.recorder.Event(rbg, corev1.EventTypeNormal, SucceedCreateRevision, "Successful create revision for RoleBasedGroup")
+			r.recorder.Eventf(rbg, corev1.EventTypeNormal, SucceedCreateRevision,
+				"Created revision %s%s", expectedRevision.Name, rolloutMessage(currentRevision, expectedRolesRevisionHash))
 		}
 	}
 
-	expectedRolesRevisionHash, err := utils.GetRolesRevisionHash(expectedRevision)
+	return expectedRolesRevisionHash, nil
+}
+
+// rolloutMessage names the roles whose revision changed from the current revision, these roles are rolled out.
+func rolloutMessage(currentRevision *appsv1.ControllerRevision, expectedRolesRevisionHash map[string]string) string {
+	if currentRevision == nil {
+		return ""
+	}
+	currentRolesRevisionHash, err := utils.GetRolesRevisionHash(currentRevision)
 	if err != nil {
-		logger.Error(err, "Failed to get roles revision hash")
-		return nil, err
+		return ""
 	}
-
-	return expectedRolesRevisionHash, nil
+	var roles []string
+	for role, hash := range expectedRolesRevisionHash {
+		if current, ok := currentRolesRevisionHash[role]; ok && current != hash {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		return ""
+	}
+	sort.Strings(roles)
+	return fmt.Sprintf(", rolling out roles %s", strings.Join(roles, ", "))
 }
 
 func (r *RoleBasedGroupReconciler) preCheck(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) error {
@@ -289,6 +452,10 @@ func (r *RoleBasedGroupReconciler) preCheck(ctx context.Context, rbg *workloadsv
 		r.recorder.Event(rbg, corev1.EventTypeWarning, InvalidTemplateRef, err.Error())
 		return errors.Wrap(err, "invalid template references")
 	}
+	if _, err := utils.GetClusterRoleTemplates(ctx, r.client, rbg); err != nil {
+		r.recorder.Event(rbg, corev1.EventTypeWarning, InvalidTemplateRef, err.Error())
+		return errors.Wrap(err, "invalid template references")
+	}
 
 	// Validate role workload declarations
 	var errs []error
@@ -386,22 +553,10 @@ func (r *RoleBasedGroupReconciler) reconcileRefinedDiscoveryConfigMap(
 	if rbg.GetDiscoveryConfigMode() != constants.RefineDiscoveryConfigMode {
 		return nil
 	}
-	if !rbg.HasStatefulRole() {
-		return nil
-	}
 
 	const configKey = "config.yaml"
 
-	statefulOnlyRBG := rbg.DeepCopy()
-	statefulOnlyRBG.Spec.Roles = nil
-	for i := range rbg.Spec.Roles {
-		role := rbg.Spec.Roles[i]
-		if workloadsv1alpha2.IsStatefulRole(&role) {
-			statefulOnlyRBG.Spec.Roles = append(statefulOnlyRBG.Spec.Roles, role)
-		}
-	}
-
-	builder := discovery.NewConfigBuilder(r.client, statefulOnlyRBG, nil)
+	builder := discovery.NewConfigBuilder(r.client, rbg, nil)
 	configData, err := builder.Build()
 	if err != nil {
 		return err
@@ -436,21 +591,88 @@ func (r *RoleBasedGroupReconciler) reconcilePodGroup(
 	return r.podGroupManager.ReconcilePodGroup(ctx, rbg, runtimeController, &watchedWorkload, r.apiReader)
 }
 
+// reconcileKueueWorkload submits a group labeled with a queue name to Kueue and returns its admission,
+// nil is returned for a group that is not queued.
+func (r *RoleBasedGroupReconciler) reconcileKueueWorkload(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+) (*kueue.Admission, error) {
+	if r.kueueManager == nil {
+		return nil, nil
+	}
+	return r.kueueManager.ReconcileWorkload(ctx, rbg, runtimeController, &watchedWorkload, r.apiReader)
+}
+
+// reconcilePropagation propagates the workloads of a multi-cluster group to its member clusters and
+// returns the propagation, nil is returned for a group that is not multi-cluster.
+func (r *RoleBasedGroupReconciler) reconcilePropagation(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+) (*multicluster.Propagation, error) {
+	if r.multiClusterManager == nil {
+		return nil, nil
+	}
+	return r.multiClusterManager.ReconcilePropagationPolicy(ctx, rbg, runtimeController, &watchedWorkload, r.apiReader)
+}
+
+// reconcileInferencePools maintains the InferencePools of the pools in spec.networking of a group.
+func (r *RoleBasedGroupReconciler) reconcileInferencePools(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+) error {
+	if r.inferencePoolManager == nil {
+		return nil
+	}
+	return r.inferencePoolManager.ReconcileInferencePools(ctx, rbg, runtimeController, &watchedWorkload, r.apiReader)
+}
+
+// reconcileMonitors maintains the Prometheus Operator monitors of the roles in spec.monitoring of a group.
+func (r *RoleBasedGroupReconciler) reconcileMonitors(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+) error {
+	if r.monitoringManager == nil {
+		return nil
+	}
+	return r.monitoringManager.ReconcileMonitors(ctx, rbg, runtimeController, &watchedWorkload, r.apiReader)
+}
+
+// suspendedScalingTargets returns a zero scaling target for every role of rbg.
+func suspendedScalingTargets(rbg *workloadsv1alpha2.RoleBasedGroup) map[string]int32 {
+	targets := make(map[string]int32, len(rbg.Spec.Roles))
+	for _, role := range rbg.Spec.Roles {
+		targets[role.Name] = 0
+	}
+	return targets
+}
+
+// reconcileRoles creates and updates the workloads of the roles in dependency order. A role whose
+// dependencies are not ready, or any role while the group waits for its dependencies, is not created,
+// and not scaled up if it exists already. The waiting roles are returned with their unready dependencies.
 func (r *RoleBasedGroupReconciler) reconcileRoles(
 	ctx context.Context,
 	rbg *workloadsv1alpha2.RoleBasedGroup,
 	expectedRolesRevisionHash map[string]string,
 	scalingTargets map[string]int32,
 	rollingUpdateStrategies map[string]workloadsv1alpha2.RollingUpdate,
-) error {
+	nodeSelectors map[string]map[string]string,
+	groupWaiting bool,
+) (map[string][]string, error) {
 	// Process roles in dependency order
 	dependencyManager := dependency.NewDefaultDependencyManager(r.scheme, r.client)
 	sortedRoles, err := dependencyManager.SortRoles(ctx, rbg)
 	if err != nil {
 		r.recorder.Event(rbg, corev1.EventTypeWarning, InvalidRoleDependency, err.Error())
-		return err
+		return nil, err
+	}
+
+	// Roles terminated after others keep their replicas until the roles before them are drained.
+	heldScaleIns, err := r.heldScaleIns(ctx, rbg, scalingTargets)
+	if err != nil {
+		return nil, err
 	}
 
+	waiting := map[string][]string{}
 	// Reconcile roles, do create/update actions for roles.
 	for _, roleList := range sortedRoles {
 		var errs error
@@ -460,29 +682,87 @@ func (r *RoleBasedGroupReconciler) reconcileRoles(
 			roleCtx := log.IntoContext(ctx, logger.WithValues("role", role.Name))
 
 			// Check dependencies first
-			ready, err := dependencyManager.CheckDependencyReady(roleCtx, rbg, role)
+			unready, err := dependencyManager.UnreadyDependencies(roleCtx, rbg, role)
 			if err != nil {
 				r.recorder.Event(rbg, corev1.EventTypeWarning, FailedCheckRoleDependency, err.Error())
-				return err
+				return nil, err
+			}
+			targets := scalingTargets
+			if len(unready) > 0 || groupWaiting {
+				if len(unready) > 0 {
+					waiting[role.Name] = unready
+					r.recorder.Eventf(rbg, corev1.EventTypeWarning, DependencyNotMet,
+						"dependencies not met for role '%s', waiting for %s", role.Name, strings.Join(unready, ", "))
+				}
+				current, exists, err := r.currentReplicas(roleCtx, rbg, role)
+				if err != nil {
+					errs = stderrors.Join(errs, err)
+					continue
+				}
+				if !exists {
+					continue
+				}
+				targets = capScalingTarget(scalingTargets, role, current)
 			}
-			if !ready {
-				err := fmt.Errorf("dependencies not met for role '%s'", role.Name)
-				r.recorder.Event(rbg, corev1.EventTypeWarning, DependencyNotMet, err.Error())
-				return err
+			if current, ok := heldScaleIns[role.Name]; ok {
+				targets = maps.Clone(targets)
+				if targets == nil {
+					targets = map[string]int32{}
+				}
+				targets[role.Name] = current
+			}
+			// Pods of an admitted group run on the nodes of the resource flavors assigned by Kueue.
+			if nodeSelector := nodeSelectors[role.Name]; len(nodeSelector) > 0 {
+				if role, err = kueue.InjectNodeSelector(role, nodeSelector); err != nil {
+					errs = stderrors.Join(errs, err)
+					continue
+				}
 			}
 
-			if err := r.reconcileSingleRole(roleCtx, rbg, role, expectedRolesRevisionHash, scalingTargets, rollingUpdateStrategies); err != nil {
+			if err := r.reconcileSingleRole(roleCtx, rbg, role, expectedRolesRevisionHash, targets, rollingUpdateStrategies); err != nil {
 				errs = stderrors.Join(errs, err)
 				continue
 			}
 		}
 
 		if errs != nil {
-			return errs
+			return nil, errs
 		}
 	}
 
-	return nil
+	return waiting, nil
+}
+
+// currentReplicas returns the replicas of the workload of role and whether the workload exists.
+func (r *RoleBasedGroupReconciler) currentReplicas(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (int32, bool, error) {
+	reconciler, err := r.getOrCreateWorkloadReconciler(ctx, role.GetWorkloadSpec())
+	if err != nil {
+		return 0, false, err
+	}
+	status, err := reconciler.ConstructRoleStatus(ctx, rbg, role)
+	if apierrors.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return status.Replicas, true, nil
+}
+
+// capScalingTarget returns the scaling targets with the target of role limited to current, so a role
+// waiting for its dependencies keeps running but does not scale up.
+func capScalingTarget(scalingTargets map[string]int32, role *workloadsv1alpha2.RoleSpec, current int32) map[string]int32 {
+	if scalingTarget(scalingTargets, role) <= current {
+		return scalingTargets
+	}
+	capped := maps.Clone(scalingTargets)
+	if capped == nil {
+		capped = map[string]int32{}
+	}
+	capped[role.Name] = current
+	return capped
 }
 
 func (r *RoleBasedGroupReconciler) reconcileSingleRole(
@@ -496,7 +776,7 @@ func (r *RoleBasedGroupReconciler) reconcileSingleRole(
 	logger := log.FromContext(ctx)
 
 	// Get or create workload reconciler
-	reconciler, err := r.getOrCreateWorkloadReconciler(ctx, role.GetWorkloadSpec())
+	workloadReconciler, err := r.getOrCreateWorkloadReconciler(ctx, role.GetWorkloadSpec())
 	if err != nil {
 		logger.Error(err, "Failed to get workload reconciler")
 		r.recorder.Eventf(
@@ -525,14 +805,30 @@ func (r *RoleBasedGroupReconciler) reconcileSingleRole(
 	}
 
 	// Reconcile workload
-	if err := reconciler.Reconciler(ctx, rbg, roleToReconcile, rollingUpdateStrategy, expectedRolesRevisionHash[role.Name]); err != nil {
+	result, err := workloadReconciler.Reconciler(ctx, rbg, roleToReconcile, rollingUpdateStrategy, expectedRolesRevisionHash[role.Name])
+	if err != nil {
 		logger.Error(err, "Failed to reconcile workload")
+		reason := FailedReconcileWorkload
+		switch {
+		case stderrors.Is(err, reconciler.ErrRenderWorkload):
+			reason = FailedRenderWorkload
+		case stderrors.Is(err, reconciler.ErrAdoptWorkload):
+			reason = FailedAdoptWorkload
+		}
 		r.recorder.Eventf(
-			rbg, corev1.EventTypeWarning, FailedReconcileWorkload,
+			rbg, corev1.EventTypeWarning, reason,
 			"Failed to reconcile role %s: %v", role.Name, err,
 		)
 		return err
 	}
+	switch {
+	case result.Created:
+		r.recorder.Eventf(rbg, corev1.EventTypeNormal, RoleCreated,
+			"Created role %s with %d replicas", role.Name, ptr.Deref(roleToReconcile.Replicas, 1))
+	case result.Scaled():
+		r.recorder.Eventf(rbg, corev1.EventTypeNormal, RoleScaled,
+			"Scaled role %s from %d to %d replicas", role.Name, result.PreviousReplicas, result.Replicas)
+	}
 
 	// Reconcile scaling adapter
 	if err := r.ReconcileScalingAdapter(ctx, rbg, role); err != nil {
@@ -551,24 +847,51 @@ func (r *RoleBasedGroupReconciler) cleanup(ctx context.Context, rbg *workloadsv1
 	// Delete orphan roles
 	if err := r.deleteOrphanRoles(ctx, rbg); err != nil {
 		r.recorder.Eventf(
-			rbg, corev1.EventTypeWarning, "delete role error",
+			rbg, corev1.EventTypeWarning, FailedDeleteOrphanRoles,
 			"Failed to delete orphan roles for %s: %v", rbg.Name, err,
 		)
 		return err
 	}
 
 	// Delete expired controllerRevision
-	if _, err := utils.CleanExpiredRevision(ctx, r.client, rbg); err != nil {
+	selector := labels.SelectorFromSet(labels.Set{constants.GroupNameLabelKey: rbg.Name})
+	revisions, err := utils.ListRevisions(ctx, r.client, rbg, selector)
+	if err != nil {
+		return err
+	}
+	remaining, err := utils.CleanExpiredRevision(ctx, r.client, rbg)
+	if err != nil {
 		r.recorder.Eventf(
-			rbg, corev1.EventTypeWarning, "delete expired revision error",
+			rbg, corev1.EventTypeWarning, FailedDeleteExpiredRevision,
 			"Failed to delete expired revision for %s: %v", rbg.Name, err,
 		)
 		return err
 	}
+	if deleted := expiredRevisionNames(revisions, remaining); len(deleted) > 0 {
+		metrics.RecordRevisionsDeleted(rbg.Namespace, rbg.Name, len(deleted))
+		r.recorder.Eventf(rbg, corev1.EventTypeNormal, DeletedExpiredRevision,
+			"Deleted expired revisions %s", strings.Join(deleted, ", "))
+	}
 
 	return nil
 }
 
+// expiredRevisionNames returns the names of the revisions that are no longer remaining.
+func expiredRevisionNames(revisions, remaining []*appsv1.ControllerRevision) []string {
+	kept := sets.New[string]()
+	for _, revision := range remaining {
+		kept.Insert(revision.Name)
+	}
+	var deleted []string
+	for _, revision := range revisions {
+		if !kept.Has(revision.Name) {
+			deleted = append(deleted, revision.Name)
+		}
+	}
+	sort.Strings(deleted)
+	return deleted
+}
+
 func (r *RoleBasedGroupReconciler) getOrCreateWorkloadReconciler(
 	ctx context.Context,
 	workloadSpec workloadsv1alpha2.WorkloadSpec,
@@ -593,7 +916,7 @@ func (r *RoleBasedGroupReconciler) getOrCreateWorkloadReconciler(
 	}
 
 	// first check whether watch lws cr
-	dynamicWatchCustomCRD(ctx, workloadSpec.Kind)
+	dynamicWatchCustomCRD(ctx, workloadType)
 
 	// Create new reconciler
 	rec, err := reconciler.NewWorkloadReconciler(workloadSpec, r.scheme, r.client)
@@ -611,9 +934,13 @@ func (r *RoleBasedGroupReconciler) getOrCreateWorkloadReconciler(
 	return rec, nil
 }
 
+// constructAndUpdateRoleStatuses builds the status of every role and updates the group status.
+// rolesRevisionHash holds the per-role hashes of the expected revision, when nil the revisions
+// previously reported are kept.
 func (r *RoleBasedGroupReconciler) constructAndUpdateRoleStatuses(
 	ctx context.Context,
 	rbg *workloadsv1alpha2.RoleBasedGroup,
+	rolesRevisionHash map[string]string,
 ) ([]workloadsv1alpha2.RoleStatus, error) {
 	roleStatuses := make([]workloadsv1alpha2.RoleStatus, 0, len(rbg.Spec.Roles))
 
@@ -621,7 +948,7 @@ func (r *RoleBasedGroupReconciler) constructAndUpdateRoleStatuses(
 		logger := log.FromContext(ctx)
 		roleCtx := log.IntoContext(ctx, logger.WithValues("role", role.Name))
 
-		reconciler, err := r.getOrCreateWorkloadReconciler(ctx, role.GetWorkloadSpec())
+		workloadReconciler, err := r.getOrCreateWorkloadReconciler(ctx, role.GetWorkloadSpec())
 		if err != nil {
 			logger.Error(err, "Failed to get workload reconciler")
 			r.recorder.Eventf(
@@ -631,7 +958,13 @@ func (r *RoleBasedGroupReconciler) constructAndUpdateRoleStatuses(
 			return nil, err
 		}
 
-		roleStatus, err := reconciler.ConstructRoleStatus(roleCtx, rbg, &role)
+		roleStatus, err := workloadReconciler.ConstructRoleStatus(roleCtx, rbg, &role)
+		if apierrors.IsNotFound(err) {
+			// A one-shot role keeps the outcome of its Job once the Job was deleted after its TTL.
+			if completed, ok := reconciler.CompletedJobRoleStatus(rbg, &role, rolesRevisionHash[role.Name]); ok {
+				roleStatus, err = completed, nil
+			}
+		}
 		if err != nil {
 			if !apierrors.IsNotFound(err) {
 				r.recorder.Eventf(
@@ -641,6 +974,8 @@ func (r *RoleBasedGroupReconciler) constructAndUpdateRoleStatuses(
 				return nil, err
 			}
 		}
+		roleStatus.Conditions = constructRoleConditions(rbg, &role, roleStatus, err == nil)
+		setRoleRevisions(rbg, &role, &roleStatus, rolesRevisionHash)
 		roleStatuses = append(roleStatuses, roleStatus)
 	}
 
@@ -660,6 +995,35 @@ func (r *RoleBasedGroupReconciler) constructAndUpdateRoleStatuses(
 	return roleStatuses, nil
 }
 
+// setRoleRevisions reports the revision the role is updating to and the revision its replicas
+// last converged to. The current revision catches up with the update revision once all desired
+// replicas are updated and ready, so the roles still running the old template stand out.
+func setRoleRevisions(
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+	roleStatus *workloadsv1alpha2.RoleStatus,
+	rolesRevisionHash map[string]string,
+) {
+	if old, found := rbg.GetRoleStatus(role.Name); found {
+		roleStatus.CurrentRevision = old.CurrentRevision
+		roleStatus.UpdateRevision = old.UpdateRevision
+	}
+	if hash, ok := rolesRevisionHash[role.Name]; ok {
+		roleStatus.UpdateRevision = hash
+	}
+	if roleStatus.UpdateRevision == "" {
+		return
+	}
+	desired := int32(1)
+	if role.Replicas != nil {
+		desired = *role.Replicas
+	}
+	if roleStatus.CurrentRevision == "" ||
+		(roleStatus.UpdatedReplicas >= desired && roleStatus.ReadyReplicas >= desired) {
+		roleStatus.CurrentRevision = roleStatus.UpdateRevision
+	}
+}
+
 func (r *RoleBasedGroupReconciler) deleteOrphanRoles(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) error {
 	errs := make([]error, 0)
 	deployRecon := reconciler.NewDeploymentReconciler(r.scheme, r.client)
@@ -682,6 +1046,22 @@ func (r *RoleBasedGroupReconciler) deleteOrphanRoles(ctx context.Context, rbg *w
 		errs = append(errs, err)
 	}
 
+	jobRecon := reconciler.NewJobReconciler(r.scheme, r.client)
+	if err := jobRecon.CleanupOrphanedWorkloads(ctx, rbg); err != nil {
+		errs = append(errs, err)
+	}
+
+	cloneSetRecon := reconciler.NewCloneSetReconciler(r.scheme, r.client)
+	if err := cloneSetRecon.CleanupOrphanedWorkloads(ctx, rbg); err != nil {
+		errs = append(errs, err)
+	}
+
+	advancedStsRecon := reconciler.NewAdvancedStatefulSetReconciler(r.scheme, r.client)
+	if err := advancedStsRecon.CleanupOrphanedWorkloads(ctx, rbg); err != nil {
+		errs = append(errs, err)
+	}
+	reconciler.ForgetOrphanedWorkloads(rbg)
+
 	if err := r.CleanupOrphanedScalingAdapters(ctx, rbg); err != nil {
 		errs = append(errs, err)
 	}
@@ -698,7 +1078,7 @@ func (r *RoleBasedGroupReconciler) updateRBGStatus(
 	oldStatus := *rbg.Status.DeepCopy()
 
 	// update ready condition
-	var rbgReady = true
+	var notReadyRoles []string
 	statusMap := make(map[string]workloadsv1alpha2.RoleStatus, len(roleStatuses))
 	for _, rs := range roleStatuses {
 		statusMap[rs.Name] = rs
@@ -708,13 +1088,12 @@ func (r *RoleBasedGroupReconciler) updateRBGStatus(
 			role.Replicas == nil ||
 			*role.Replicas != rs.Replicas ||
 			rs.Replicas != rs.ReadyReplicas {
-			rbgReady = false
-			break
+			notReadyRoles = append(notReadyRoles, role.Name)
 		}
 	}
 
 	var readyCondition metav1.Condition
-	if rbgReady {
+	if len(notReadyRoles) == 0 {
 		readyCondition = metav1.Condition{
 			Type:               string(workloadsv1alpha2.RoleBasedGroupReady),
 			Status:             metav1.ConditionTrue,
@@ -728,13 +1107,17 @@ func (r *RoleBasedGroupReconciler) updateRBGStatus(
 			Status:             metav1.ConditionFalse,
 			LastTransitionTime: metav1.Now(),
 			Reason:             "RoleNotReady",
-			Message:            "Not all role ready",
+			Message:            fmt.Sprintf("Roles not ready: %s", strings.Join(notReadyRoles, ", ")),
 		}
 	}
 	readyCondition.ObservedGeneration = rbg.Generation
 
 	setCondition(rbg, readyCondition)
-	rbg.Status.ObservedGeneration = rbg.Generation
+	// Spec changes made while paused are not acted upon, so they are not observed either.
+	if !rbg.IsPaused() {
+		rbg.Status.ObservedGeneration = rbg.Generation
+	}
+	setPausedCondition(rbg)
 
 	// update role status
 	for i := range roleStatuses {
@@ -743,7 +1126,7 @@ func (r *RoleBasedGroupReconciler) updateRBGStatus(
 			// if found, update
 			if roleStatuses[i].Name == oldStatus.Name {
 				found = true
-				if roleStatuses[i].Replicas != oldStatus.Replicas || roleStatuses[i].ReadyReplicas != oldStatus.ReadyReplicas {
+				if !reflect.DeepEqual(roleStatuses[i], oldStatus) {
 					rbg.Status.RoleStatuses[j] = roleStatuses[i]
 				}
 				break
@@ -797,6 +1180,291 @@ func (r *RoleBasedGroupReconciler) updateRBGStatus(
 
 }
 
+// updateDependencyCondition reports the groups in spec.dependsOn that are not ready and the roles waiting
+// for their dependencies. The condition is only added once the group or a role had to wait.
+func (r *RoleBasedGroupReconciler) updateDependencyCondition(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, unreadyGroups []string, waiting map[string][]string,
+) error {
+	conditionType := string(workloadsv1alpha2.RoleBasedGroupWaitingForDependencies)
+	existing := apimeta.FindStatusCondition(rbg.Status.Conditions, conditionType)
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "DependenciesReady",
+		Message:            "All role dependencies are ready",
+		ObservedGeneration: rbg.Generation,
+	}
+	if len(unreadyGroups) > 0 || len(waiting) > 0 {
+		roles := make([]string, 0, len(waiting))
+		for role := range waiting {
+			roles = append(roles, role)
+		}
+		sort.Strings(roles)
+		messages := make([]string, 0, len(roles)+1)
+		condition.Reason = "DependenciesNotReady"
+		if len(unreadyGroups) > 0 {
+			messages = append(messages, fmt.Sprintf("group waits for rolebasedgroups %s to be ready",
+				strings.Join(unreadyGroups, ", ")))
+			condition.Reason = "GroupDependenciesNotReady"
+		}
+		for _, role := range roles {
+			messages = append(messages, fmt.Sprintf("role %s waits for %s", role, strings.Join(waiting[role], ", ")))
+		}
+		condition.Status = metav1.ConditionTrue
+		condition.Message = strings.Join(messages, "; ")
+	} else if existing == nil {
+		return nil
+	}
+	if existing != nil && existing.Status == condition.Status && existing.Message == condition.Message &&
+		existing.ObservedGeneration == condition.ObservedGeneration {
+		return nil
+	}
+
+	setCondition(rbg, condition)
+	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, ToRBGApplyConfigurationForStatus(rbg), utils.PatchStatus); err != nil {
+		r.recorder.Eventf(
+			rbg, corev1.EventTypeWarning, FailedUpdateStatus,
+			"Failed to update status for %s: %v", rbg.Name, err,
+		)
+		return err
+	}
+	return nil
+}
+
+// updatePropagatedCondition reports whether the workloads of a multi-cluster group are applied to
+// their member clusters. The condition is removed once the group is no longer multi-cluster.
+func (r *RoleBasedGroupReconciler) updatePropagatedCondition(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, propagation *multicluster.Propagation,
+) error {
+	conditionType := string(workloadsv1alpha2.RoleBasedGroupPropagated)
+	existing := apimeta.FindStatusCondition(rbg.Status.Conditions, conditionType)
+	if propagation == nil {
+		if existing == nil {
+			return nil
+		}
+		apimeta.RemoveStatusCondition(&rbg.Status.Conditions, conditionType)
+	} else {
+		condition := metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "PropagationPending",
+			Message:            propagation.Message,
+			ObservedGeneration: rbg.Generation,
+		}
+		if propagation.Propagated {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "WorkloadsApplied"
+		}
+		if existing != nil && existing.Status == condition.Status && existing.Message == condition.Message &&
+			existing.ObservedGeneration == condition.ObservedGeneration {
+			return nil
+		}
+		setCondition(rbg, condition)
+	}
+
+	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, ToRBGApplyConfigurationForStatus(rbg), utils.PatchStatus); err != nil {
+		r.recorder.Eventf(
+			rbg, corev1.EventTypeWarning, FailedUpdateStatus,
+			"Failed to update status for %s: %v", rbg.Name, err,
+		)
+		return err
+	}
+	return nil
+}
+
+// updateSuspendedCondition reports whether the roles are kept at zero replicas. The condition is only
+// added once a group was suspended.
+func (r *RoleBasedGroupReconciler) updateSuspendedCondition(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, suspended bool, admission *kueue.Admission,
+) error {
+	conditionType := string(workloadsv1alpha2.RoleBasedGroupSuspended)
+	existing := apimeta.FindStatusCondition(rbg.Status.Conditions, conditionType)
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "Resumed",
+		Message:            "The roles are scaled to their desired replicas",
+		ObservedGeneration: rbg.Generation,
+	}
+	switch {
+	case rbg.IsSuspended():
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Suspended"
+		condition.Message = "The roles are kept at zero replicas by spec.suspend"
+	case suspended:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "WaitingForAdmission"
+		condition.Message = fmt.Sprintf("Waiting for the admission by kueue queue %s",
+			rbg.Labels[constants.KueueQueueNameLabelKey])
+	case admission != nil:
+		condition.Reason = "Admitted"
+		condition.Message = "The group is admitted by kueue"
+	}
+	if !suspended && existing == nil {
+		return nil
+	}
+	if existing != nil && existing.Status == condition.Status && existing.Reason == condition.Reason &&
+		existing.ObservedGeneration == condition.ObservedGeneration {
+		return nil
+	}
+
+	setCondition(rbg, condition)
+	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, ToRBGApplyConfigurationForStatus(rbg), utils.PatchStatus); err != nil {
+		r.recorder.Eventf(
+			rbg, corev1.EventTypeWarning, FailedUpdateStatus,
+			"Failed to update status for %s: %v", rbg.Name, err,
+		)
+		return err
+	}
+	return nil
+}
+
+// setPausedCondition reports the paused state, the condition is only added once a group was paused.
+func setPausedCondition(rbg *workloadsv1alpha2.RoleBasedGroup) {
+	existing := apimeta.FindStatusCondition(rbg.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupPaused))
+	if rbg.IsPaused() {
+		message := fmt.Sprintf("Reconciliation is paused by annotation %s", constants.PausedAnnotationKey)
+		if rbg.Spec.Paused {
+			message = "Reconciliation is paused by spec.paused"
+		}
+		setCondition(rbg, metav1.Condition{
+			Type:               string(workloadsv1alpha2.RoleBasedGroupPaused),
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "Paused",
+			Message:            message,
+			ObservedGeneration: rbg.Generation,
+		})
+	} else if existing != nil {
+		setCondition(rbg, metav1.Condition{
+			Type:               string(workloadsv1alpha2.RoleBasedGroupPaused),
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "Resumed",
+			Message:            "Reconciliation is resumed",
+			ObservedGeneration: rbg.Generation,
+		})
+	}
+}
+
+// constructRoleConditions derives the Ready, Progressing, Degraded and Suspended conditions of a role from the
+// status of its workload, along with the Complete and Failed conditions of one-shot roles. The transition
+// times of the conditions recorded so far are kept.
+func constructRoleConditions(
+	rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	status workloadsv1alpha2.RoleStatus, workloadFound bool,
+) []metav1.Condition {
+	var conditions []metav1.Condition
+	if existing, found := rbg.GetRoleStatus(role.Name); found {
+		conditions = slices.Clone(existing.Conditions)
+	}
+	newCondition := func(conditionType workloadsv1alpha2.RoleConditionType, status metav1.ConditionStatus,
+		reason, message string) metav1.Condition {
+		return metav1.Condition{
+			Type:               string(conditionType),
+			Status:             status,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: rbg.Generation,
+		}
+	}
+	desired := ptr.Deref(role.Replicas, 1)
+	// The Complete and Failed conditions are only reported by the workloads of one-shot roles.
+	complete := apimeta.FindStatusCondition(status.Conditions, string(workloadsv1alpha2.RoleComplete))
+	failed := apimeta.FindStatusCondition(status.Conditions, string(workloadsv1alpha2.RoleFailed))
+
+	var ready, progressing, degraded metav1.Condition
+	suspended := newCondition(workloadsv1alpha2.RoleSuspended, metav1.ConditionFalse, "Resumed",
+		"The role is scaled to its desired replicas")
+	groupSuspended := apimeta.FindStatusCondition(rbg.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupSuspended))
+	switch {
+	case rbg.IsSuspended() || apimeta.IsStatusConditionTrue(rbg.Status.Conditions,
+		string(workloadsv1alpha2.RoleBasedGroupSuspended)):
+		reason, message := "Suspended", "The role is kept at zero replicas by spec.suspend"
+		if !rbg.IsSuspended() {
+			reason, message = groupSuspended.Reason, groupSuspended.Message
+		}
+		suspended = newCondition(workloadsv1alpha2.RoleSuspended, metav1.ConditionTrue, reason, message)
+		ready = newCondition(workloadsv1alpha2.RoleReady, metav1.ConditionFalse, "Suspended", "The role is suspended")
+		progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionFalse, "Suspended",
+			"The role is suspended")
+		degraded = newCondition(workloadsv1alpha2.RoleDegraded, metav1.ConditionFalse, "Suspended",
+			"The role is suspended")
+	case !workloadFound:
+		ready = newCondition(workloadsv1alpha2.RoleReady, metav1.ConditionFalse, "WorkloadNotFound",
+			"The workload of the role is not created yet")
+		progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionTrue, "WorkloadNotFound",
+			"The workload of the role is not created yet")
+		degraded = newCondition(workloadsv1alpha2.RoleDegraded, metav1.ConditionFalse, "WorkloadNotFound",
+			"The workload of the role is not created yet")
+	case complete != nil:
+		// A one-shot role is ready once its Job completed, and degraded once it failed.
+		switch {
+		case complete.Status == metav1.ConditionTrue:
+			ready = newCondition(workloadsv1alpha2.RoleReady, metav1.ConditionTrue, "Completed", complete.Message)
+			progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionFalse, "Completed",
+				"The job completed")
+		case failed != nil && failed.Status == metav1.ConditionTrue:
+			ready = newCondition(workloadsv1alpha2.RoleReady, metav1.ConditionFalse, "Failed", complete.Message)
+			progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionFalse, "Failed",
+				"The job failed")
+		default:
+			ready = newCondition(workloadsv1alpha2.RoleReady, metav1.ConditionFalse, "NotCompleted", complete.Message)
+			progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionTrue, complete.Reason,
+				complete.Message)
+		}
+		if failed != nil && failed.Status == metav1.ConditionTrue {
+			degraded = newCondition(workloadsv1alpha2.RoleDegraded, metav1.ConditionTrue, failed.Reason, failed.Message)
+		} else {
+			degraded = newCondition(workloadsv1alpha2.RoleDegraded, metav1.ConditionFalse, "AsExpected",
+				"The role is not degraded")
+		}
+	default:
+		if status.ReadyReplicas >= desired && status.Replicas == desired {
+			ready = newCondition(workloadsv1alpha2.RoleReady, metav1.ConditionTrue, "AllReplicasReady",
+				fmt.Sprintf("%d/%d replicas are ready", status.ReadyReplicas, desired))
+		} else {
+			ready = newCondition(workloadsv1alpha2.RoleReady, metav1.ConditionFalse, "ReplicasNotReady",
+				fmt.Sprintf("%d/%d replicas are ready", status.ReadyReplicas, desired))
+		}
+
+		switch {
+		case status.Replicas != desired:
+			progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionTrue, "Scaling",
+				fmt.Sprintf("Scaling from %d to %d replicas", status.Replicas, desired))
+		case status.UpdatedReplicas < status.Replicas:
+			progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionTrue, "Updating",
+				fmt.Sprintf("%d/%d replicas are updated", status.UpdatedReplicas, status.Replicas))
+		default:
+			progressing = newCondition(workloadsv1alpha2.RoleProgressing, metav1.ConditionFalse, "Stable",
+				"All replicas are updated")
+		}
+
+		// Replicas being created or updated are expected to be unready, a role only degrades once it settled.
+		if progressing.Status == metav1.ConditionFalse && status.ReadyReplicas < status.Replicas {
+			degraded = newCondition(workloadsv1alpha2.RoleDegraded, metav1.ConditionTrue, "ReplicasNotReady",
+				fmt.Sprintf("%d/%d replicas are not ready", status.Replicas-status.ReadyReplicas, status.Replicas))
+		} else {
+			degraded = newCondition(workloadsv1alpha2.RoleDegraded, metav1.ConditionFalse, "AsExpected",
+				"The role is not degraded")
+		}
+	}
+
+	for _, condition := range []metav1.Condition{ready, progressing, degraded, suspended} {
+		apimeta.SetStatusCondition(&conditions, condition)
+	}
+	for _, condition := range []*metav1.Condition{complete, failed} {
+		if condition != nil {
+			apimeta.SetStatusCondition(&conditions, *condition)
+		}
+	}
+	return conditions
+}
+
 // buildScalingAdapterLabels merges user-specified labels from scalingAdapter.labels
 // with controller-managed labels. Controller labels take precedence.
 func buildScalingAdapterLabels(roleSpec *workloadsv1alpha2.RoleSpec, rbgName, roleName string) map[string]string {
@@ -867,6 +1535,65 @@ func (r *RoleBasedGroupReconciler) ReconcileScalingAdapter(
 
 	return r.client.Create(ctx, rbgScalingAdapter)
 }
+
+// handleRollback restores the spec of the revision requested by the rollback-to annotation and
+// clears the annotation. It reports whether the group was updated.
+func (r *RoleBasedGroupReconciler) handleRollback(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) (bool, error) {
+	value, ok := rbg.Annotations[constants.RollbackToAnnotationKey]
+	if !ok {
+		return false, nil
+	}
+	logger := log.FromContext(ctx)
+
+	restored, rollbackErr := r.restoreRevision(ctx, rbg, value)
+	if rollbackErr != nil {
+		// The request cannot succeed by retrying, drop it so the group keeps reconciling.
+		restored = rbg.DeepCopy()
+	}
+	delete(restored.Annotations, constants.RollbackToAnnotationKey)
+	if err := r.client.Update(ctx, restored); err != nil {
+		logger.Error(err, "Failed to update RoleBasedGroup for rollback")
+		return false, err
+	}
+
+	if rollbackErr != nil {
+		logger.Error(rollbackErr, "Failed to roll back", "revision", value)
+		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedRollback, rollbackErr.Error())
+		return true, nil
+	}
+	logger.Info("Rolled back", "revision", value)
+	r.recorder.Eventf(rbg, corev1.EventTypeNormal, RolledBack, "Rolled back to revision %s", value)
+	return true, nil
+}
+
+// restoreRevision returns the group with the spec of its revision numbered value.
+func (r *RoleBasedGroupReconciler) restoreRevision(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, value string,
+) (*workloadsv1alpha2.RoleBasedGroup, error) {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number <= 0 {
+		return nil, fmt.Errorf("invalid %s %q, must be a positive revision number", constants.RollbackToAnnotationKey, value)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			constants.GroupNameLabelKey: rbg.Name,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := utils.ListRevisions(ctx, r.client, rbg, selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		if revision.Revision == number {
+			return utils.ApplyRevision(rbg, revision)
+		}
+	}
+	return nil, fmt.Errorf("revision %d of RoleBasedGroup %s not found", number, rbg.Name)
+}
+
 func (r *RoleBasedGroupReconciler) getCurrentRevision(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) (*appsv1.ControllerRevision, error) {
 	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
 		MatchLabels: map[string]string{
@@ -935,8 +1662,14 @@ func (r *RoleBasedGroupReconciler) SetupWithManager(mgr ctrl.Manager, options co
 		Owns(&appsv1.StatefulSet{}, builder.WithPredicates(WorkloadPredicate())).
 		Owns(&appsv1.Deployment{}, builder.WithPredicates(WorkloadPredicate())).
 		Owns(&workloadsv1alpha2.RoleInstanceSet{}, builder.WithPredicates(WorkloadPredicate())).
+		Owns(&batchv1.Job{}, builder.WithPredicates(WorkloadPredicate())).
 		Owns(&corev1.Service{}).
 		Owns(&workloadsv1alpha2.RoleBasedGroupScalingAdapter{}, builder.MatchEveryOwner, builder.WithPredicates(RBGScalingAdapterPredicate())).
+		Watches(
+			&workloadsv1alpha2.ClusterRoleTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.mapClusterRoleTemplateToRBGs),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Named("workloads-rolebasedgroup")
 
 	err := utils.CheckCrdExists(r.apiReader, utils.LwsCrdName)
@@ -944,6 +1677,16 @@ func (r *RoleBasedGroupReconciler) SetupWithManager(mgr ctrl.Manager, options co
 		watchedWorkload.LoadOrStore(utils.LwsCrdName, struct{}{})
 		runtimeController.Owns(&lwsv1.LeaderWorkerSet{}, builder.WithPredicates(WorkloadPredicate()))
 	}
+	err = utils.CheckCrdExists(r.apiReader, utils.KruiseCloneSetCrdName)
+	if err == nil {
+		watchedWorkload.LoadOrStore(utils.KruiseCloneSetCrdName, struct{}{})
+		runtimeController.Owns(&kruiseappsv1alpha1.CloneSet{}, builder.WithPredicates(WorkloadPredicate()))
+	}
+	err = utils.CheckCrdExists(r.apiReader, utils.KruiseStatefulSetCrdName)
+	if err == nil {
+		watchedWorkload.LoadOrStore(utils.KruiseStatefulSetCrdName, struct{}{})
+		runtimeController.Owns(&kruiseappsv1beta1.StatefulSet{}, builder.WithPredicates(WorkloadPredicate()))
+	}
 	err = utils.CheckCrdExists(r.apiReader, scheduler.KubePodGroupCrdName)
 	if err == nil {
 		watchedWorkload.LoadOrStore(scheduler.KubePodGroupCrdName, struct{}{})
@@ -954,15 +1697,66 @@ func (r *RoleBasedGroupReconciler) SetupWithManager(mgr ctrl.Manager, options co
 		watchedWorkload.LoadOrStore(scheduler.VolcanoPodGroupCrdName, struct{}{})
 		runtimeController.Owns(&volcanoschedulingv1beta1.PodGroup{})
 	}
+	err = utils.CheckCrdExists(r.apiReader, kueue.CrdName)
+	if err == nil {
+		watchedWorkload.LoadOrStore(kueue.CrdName, struct{}{})
+		runtimeController.Owns(kueue.NewWorkload())
+	}
+	err = utils.CheckCrdExists(r.apiReader, multicluster.PropagationPolicyCrdName)
+	if err == nil {
+		watchedWorkload.LoadOrStore(multicluster.PropagationPolicyCrdName, struct{}{})
+		runtimeController.Owns(multicluster.NewPropagationPolicy())
+	}
+	err = utils.CheckCrdExists(r.apiReader, inferencepool.CrdName)
+	if err == nil {
+		watchedWorkload.LoadOrStore(inferencepool.CrdName, struct{}{})
+		runtimeController.Owns(inferencepool.NewInferencePool())
+	}
+	err = utils.CheckCrdExists(r.apiReader, monitoring.PodMonitorCrdName)
+	if err == nil {
+		watchedWorkload.LoadOrStore(monitoring.PodMonitorCrdName, struct{}{})
+		runtimeController.Owns(monitoring.NewPodMonitor())
+	}
+	err = utils.CheckCrdExists(r.apiReader, monitoring.ServiceMonitorCrdName)
+	if err == nil {
+		watchedWorkload.LoadOrStore(monitoring.ServiceMonitorCrdName, struct{}{})
+		runtimeController.Owns(monitoring.NewServiceMonitor())
+	}
 
 	return runtimeController.Complete(r)
 }
 
+// mapClusterRoleTemplateToRBGs enqueues the groups with roles referencing the ClusterRoleTemplate, so
+// that changing it rolls them.
+func (r *RoleBasedGroupReconciler) mapClusterRoleTemplateToRBGs(ctx context.Context, obj client.Object) []reconcile.Request {
+	rbgList := &workloadsv1alpha2.RoleBasedGroupList{}
+	if err := r.client.List(ctx, rbgList); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list RBGs for ClusterRoleTemplate", "clusterRoleTemplate", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range rbgList.Items {
+		rbg := &rbgList.Items[i]
+		for j := range rbg.Spec.Roles {
+			role := &rbg.Spec.Roles[j]
+			if role.UsesClusterRoleTemplate() && role.GetEffectiveTemplateName() == obj.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: rbg.Namespace, Name: rbg.Name},
+				})
+				break
+			}
+		}
+	}
+	return requests
+}
+
 // CheckCrdExists checks if the specified Custom Resource Definition (CRD) exists in the Kubernetes cluster.
 func (r *RoleBasedGroupReconciler) CheckCrdExists() error {
 	crds := []string{
 		"rolebasedgroups.workloads.x-k8s.io",
 		"clusterengineruntimeprofiles.workloads.x-k8s.io",
+		"clusterroletemplates.workloads.x-k8s.io",
 	}
 
 	for _, crd := range crds {
@@ -1412,6 +2206,23 @@ func RBGPredicate() predicate.Funcs {
 					ctrl.Log.Info("enqueue: rbg update event", "rbg", klog.KObj(e.ObjectOld))
 					return true
 				}
+				if newRbg.Annotations[constants.RollbackToAnnotationKey] != "" &&
+					oldRbg.Annotations[constants.RollbackToAnnotationKey] != newRbg.Annotations[constants.RollbackToAnnotationKey] {
+					ctrl.Log.Info("enqueue: rbg rollback requested", "rbg", klog.KObj(e.ObjectOld))
+					return true
+				}
+				if oldRbg.DeletionTimestamp.IsZero() && !newRbg.DeletionTimestamp.IsZero() {
+					ctrl.Log.Info("enqueue: rbg deletion started", "rbg", klog.KObj(e.ObjectOld))
+					return true
+				}
+				if oldRbg.IsPaused() != newRbg.IsPaused() {
+					ctrl.Log.Info("enqueue: rbg paused state changed", "rbg", klog.KObj(e.ObjectOld), "paused", newRbg.IsPaused())
+					return true
+				}
+				if oldRbg.Labels[constants.KueueQueueNameLabelKey] != newRbg.Labels[constants.KueueQueueNameLabelKey] {
+					ctrl.Log.Info("enqueue: rbg kueue queue changed", "rbg", klog.KObj(e.ObjectOld))
+					return true
+				}
 			}
 			return false
 		},
@@ -1491,26 +2302,40 @@ func hasValidOwnerRef(obj client.Object, targetGVK schema.GroupVersionKind) bool
 	return utils.CheckOwnerReference(refs, targetGVK)
 }
 
-func dynamicWatchCustomCRD(ctx context.Context, kind string) {
+func dynamicWatchCustomCRD(ctx context.Context, workloadType string) {
 	// Skip in unit tests when runtimeController is not initialized
 	if runtimeController == nil {
 		return
 	}
 	logger := log.FromContext(ctx)
-	switch kind {
-	case utils.GetLwsGVK().Kind:
+	switch workloadType {
+	case constants.LeaderWorkerSetWorkloadType:
 		_, lwsExist := watchedWorkload.Load(utils.LwsCrdName)
 		if !lwsExist {
 			watchedWorkload.LoadOrStore(utils.LwsCrdName, struct{}{})
 			runtimeController.Owns(&lwsv1.LeaderWorkerSet{}, builder.WithPredicates(WorkloadPredicate()))
 			logger.Info("rbgs controller watch LeaderWorkerSet CRD")
 		}
-	case utils.GetRoleInstanceSetGVK().Kind:
+	case constants.RoleInstanceSetWorkloadType:
 		_, roleInstanceSetExist := watchedWorkload.Load(utils.RoleInstanceSetCrdName)
 		if !roleInstanceSetExist {
 			watchedWorkload.LoadOrStore(utils.RoleInstanceSetCrdName, struct{}{})
 			runtimeController.Owns(&workloadsv1alpha2.RoleInstanceSet{}, builder.WithPredicates(WorkloadPredicate()))
 			logger.Info("rbgs controller watch RoleInstanceSet CRD")
 		}
+	case constants.KruiseCloneSetWorkloadType:
+		_, cloneSetExist := watchedWorkload.Load(utils.KruiseCloneSetCrdName)
+		if !cloneSetExist {
+			watchedWorkload.LoadOrStore(utils.KruiseCloneSetCrdName, struct{}{})
+			runtimeController.Owns(&kruiseappsv1alpha1.CloneSet{}, builder.WithPredicates(WorkloadPredicate()))
+			logger.Info("rbgs controller watch Kruise CloneSet CRD")
+		}
+	case constants.KruiseStatefulSetWorkloadType:
+		_, advancedStsExist := watchedWorkload.Load(utils.KruiseStatefulSetCrdName)
+		if !advancedStsExist {
+			watchedWorkload.LoadOrStore(utils.KruiseStatefulSetCrdName, struct{}{})
+			runtimeController.Owns(&kruiseappsv1beta1.StatefulSet{}, builder.WithPredicates(WorkloadPredicate()))
+			logger.Info("rbgs controller watch Kruise Advanced StatefulSet CRD")
+		}
 	}
 }
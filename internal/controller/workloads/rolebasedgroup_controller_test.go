@@ -22,12 +22,16 @@ import (
 	"math"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -39,8 +43,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/pkg/kueue"
 	"sigs.k8s.io/rbgs/pkg/reconciler"
 
 	"sigs.k8s.io/rbgs/api/workloads/constants"
@@ -697,6 +703,865 @@ func TestRoleBasedGroupReconciler_Reconcile(t *testing.T) {
 	}
 }
 
+func TestRoleBasedGroupReconciler_Reconcile_Paused(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithAnnotations(map[string]string{constants.PausedAnnotationKey: "true"}).Obj()
+	rbg.Generation = 2
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg).
+		WithStatusSubresource(rbg).
+		Build()
+
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           record.NewFakeRecorder(10),
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+
+	ris := &workloadsv1alpha2.RoleInstanceSetList{}
+	assert.NoError(t, fakeClient.List(ctx, ris, client.InNamespace("default")))
+	assert.Empty(t, ris.Items, "no workloads should be created while paused")
+
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	assert.True(t, apimeta.IsStatusConditionTrue(got.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupPaused)))
+	assert.NotEqual(t, got.Generation, got.Status.ObservedGeneration)
+
+	// Resuming flips the condition and lets the reconciliation create the workloads again.
+	delete(got.Annotations, constants.PausedAnnotationKey)
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	cond := apimeta.FindStatusCondition(got.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupPaused))
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	}
+	assert.NoError(t, fakeClient.List(ctx, ris, client.InNamespace("default")))
+	assert.NotEmpty(t, ris.Items)
+}
+
+func TestRoleBasedGroupReconciler_Reconcile_Rollback(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg).
+		WithStatusSubresource(rbg).
+		Build()
+
+	recorder := record.NewFakeRecorder(100)
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           recorder,
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+	image := func(rbg *workloadsv1alpha2.RoleBasedGroup) string {
+		return rbg.Spec.Roles[0].StandalonePattern.Template.Spec.Containers[0].Image
+	}
+	events := func() []string {
+		var got []string
+		for len(recorder.Events) > 0 {
+			got = append(got, <-recorder.Events)
+		}
+		return got
+	}
+
+	// Record revision 1 with the original image and revision 2 with a new one.
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	original := image(got)
+	got.Spec.Roles[0].StandalonePattern.Template.Spec.Containers[0].Image = "nginx:v2"
+	got.Spec.Roles[0].Replicas = ptr.To[int32](3)
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	events()
+
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	got.Annotations = map[string]string{constants.RollbackToAnnotationKey: "1"}
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	assert.Equal(t, original, image(got))
+	assert.Equal(t, int32(3), *got.Spec.Roles[0].Replicas, "rollback keeps the current replicas")
+	assert.NotContains(t, got.Annotations, constants.RollbackToAnnotationKey)
+	assert.Equal(t, []string{"Normal RolledBack Rolled back to revision 1"}, events())
+
+	// A revision that does not exist is reported and dropped without touching the spec.
+	got.Annotations = map[string]string{constants.RollbackToAnnotationKey: "9"}
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	assert.Equal(t, original, image(got))
+	assert.NotContains(t, got.Annotations, constants.RollbackToAnnotationKey)
+	assert.Equal(t, []string{"Warning FailedRollback revision 9 of RoleBasedGroup test-rbg not found"}, events())
+
+	got.Annotations = map[string]string{constants.RollbackToAnnotationKey: "latest"}
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`Warning FailedRollback invalid rbg.workloads.x-k8s.io/rollback-to "latest", must be a positive revision number`,
+	}, events())
+}
+
+func TestRoleBasedGroupReconciler_Reconcile_AutoRollback(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	rbg.Spec.AutoRollback = workloadsv1alpha2.AutoRollbackOnProgressDeadlineExceeded
+	rbg.Spec.Roles[0].ProgressDeadlineSeconds = ptr.To[int32](600)
+	// The fake client leaves the creation timestamp empty, the rollout starts when its revision is created.
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg).
+		WithStatusSubresource(rbg).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				obj.SetCreationTimestamp(metav1.Now())
+				return c.Create(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	recorder := record.NewFakeRecorder(100)
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           recorder,
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+	image := func(rbg *workloadsv1alpha2.RoleBasedGroup) string {
+		return rbg.Spec.Roles[0].StandalonePattern.Template.Spec.Containers[0].Image
+	}
+	events := func() []string {
+		var got []string
+		for len(recorder.Events) > 0 {
+			got = append(got, <-recorder.Events)
+		}
+		return got
+	}
+	// expire moves the creation of a revision past the progress deadline.
+	expire := func(number int64) {
+		revisions := &appsv1.ControllerRevisionList{}
+		assert.NoError(t, fakeClient.List(ctx, revisions, client.InNamespace("default")))
+		for i := range revisions.Items {
+			if revisions.Items[i].Revision == number {
+				revisions.Items[i].CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+				assert.NoError(t, fakeClient.Update(ctx, &revisions.Items[i]))
+			}
+		}
+	}
+	rolloutStatus := func(number int64) string {
+		revisions := &appsv1.ControllerRevisionList{}
+		assert.NoError(t, fakeClient.List(ctx, revisions, client.InNamespace("default")))
+		for i := range revisions.Items {
+			if revisions.Items[i].Revision == number {
+				return revisions.Items[i].Annotations[constants.RolloutStatusAnnotationKey]
+			}
+		}
+		return ""
+	}
+
+	// The first revision creates the roles, its deadline does not apply.
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	expire(1)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Empty(t, rolloutStatus(1))
+
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	original := image(got)
+	got.Spec.Roles[0].StandalonePattern.Template.Spec.Containers[0].Image = "nginx:broken"
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	result, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.True(t, result.RequeueAfter > 0 && result.RequeueAfter <= 600*time.Second, "requeued at the deadline")
+	events()
+
+	// The new revision never became ready, the spec of revision 1 is restored.
+	expire(2)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	assert.Equal(t, original, image(got))
+	assert.Equal(t, "Failed", rolloutStatus(2))
+	assert.Equal(t, []string{
+		"Warning ProgressDeadlineExceeded Roles test-role of revision 2 did not become ready within their progress deadline",
+		"Normal RolledBack Rolled back to revision 1, roles test-role missed their progress deadline",
+	}, events())
+
+	// A restored revision missing its deadline too is not rolled back to the failed one.
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	events()
+	expire(3)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	assert.Equal(t, original, image(got))
+	assert.Equal(t, "Failed", rolloutStatus(3))
+	assert.Contains(t, events(), "Warning FailedRollback Previous revision 2 failed its rollout too, not rolling back")
+}
+
+func TestRoleBasedGroupReconciler_Reconcile_Dependencies(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithWorkload("apps/v1", "StatefulSet").Obj(),
+			wrappersv2.BuildStandaloneRole("router").WithWorkload("apps/v1", "StatefulSet").
+				WithDependencies([]string{"prefill"}).Obj(),
+		}).Obj()
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg).
+		WithStatusSubresource(rbg, &appsv1.StatefulSet{}).
+		Build()
+
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           record.NewFakeRecorder(100),
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+	getSts := func(name string) (*appsv1.StatefulSet, error) {
+		sts := &appsv1.StatefulSet{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: "test-rbg-" + name, Namespace: "default"}, sts)
+		return sts, err
+	}
+	setReady := func(name string, ready int32) {
+		sts, err := getSts(name)
+		assert.NoError(t, err)
+		sts.Status.Replicas = *sts.Spec.Replicas
+		sts.Status.ReadyReplicas = ready
+		assert.NoError(t, fakeClient.Status().Update(ctx, sts))
+	}
+	condition := func() *metav1.Condition {
+		got := &workloadsv1alpha2.RoleBasedGroup{}
+		assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+		return apimeta.FindStatusCondition(got.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupWaitingForDependencies))
+	}
+
+	// The router is not created before prefill is ready.
+	result, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, dependencyRequeueInterval, result.RequeueAfter)
+	_, err = getSts("prefill")
+	assert.NoError(t, err)
+	_, err = getSts("router")
+	assert.True(t, apierrors.IsNotFound(err))
+	if cond := condition(); assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "role router waits for prefill", cond.Message)
+	}
+
+	setReady("prefill", 2)
+	result, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	_, err = getSts("router")
+	assert.NoError(t, err)
+	if cond := condition(); assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	}
+
+	// An existing router keeps running but is not scaled up while prefill is not ready.
+	setReady("prefill", 1)
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	got.Spec.Roles[1].Replicas = ptr.To[int32](3)
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	router, err := getSts("router")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), *router.Spec.Replicas)
+	assert.Equal(t, metav1.ConditionTrue, condition().Status)
+
+	setReady("prefill", 2)
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	router, err = getSts("router")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), *router.Spec.Replicas)
+}
+
+func TestRoleBasedGroupReconciler_Reconcile_GroupDependencies(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).WithWorkload("apps/v1", "StatefulSet").Obj(),
+		}).Obj()
+	rbg.Spec.DependsOn = []workloadsv1alpha2.GroupDependency{{Name: "embedding"}}
+	embedding := wrappersv2.BuildBasicRoleBasedGroup("embedding", "default").Obj()
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg, embedding).
+		WithStatusSubresource(rbg, embedding, &appsv1.StatefulSet{}).
+		Build()
+
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           record.NewFakeRecorder(100),
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+	getSts := func() (*appsv1.StatefulSet, error) {
+		sts := &appsv1.StatefulSet{}
+		err := fakeClient.Get(ctx, types.NamespacedName{Name: "test-rbg-decode", Namespace: "default"}, sts)
+		return sts, err
+	}
+	setEmbeddingReady := func(status metav1.ConditionStatus) {
+		got := &workloadsv1alpha2.RoleBasedGroup{}
+		assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "embedding", Namespace: "default"}, got))
+		apimeta.SetStatusCondition(&got.Status.Conditions, metav1.Condition{
+			Type:   string(workloadsv1alpha2.RoleBasedGroupReady),
+			Status: status,
+			Reason: "Test",
+		})
+		assert.NoError(t, fakeClient.Status().Update(ctx, got))
+	}
+	condition := func() *metav1.Condition {
+		got := &workloadsv1alpha2.RoleBasedGroup{}
+		assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+		return apimeta.FindStatusCondition(got.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupWaitingForDependencies))
+	}
+
+	// No role is created before the embedding group is ready.
+	result, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, dependencyRequeueInterval, result.RequeueAfter)
+	_, err = getSts()
+	assert.True(t, apierrors.IsNotFound(err))
+	if cond := condition(); assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "GroupDependenciesNotReady", cond.Reason)
+		assert.Equal(t, "group waits for rolebasedgroups embedding to be ready", cond.Message)
+	}
+
+	setEmbeddingReady(metav1.ConditionTrue)
+	result, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	_, err = getSts()
+	assert.NoError(t, err)
+	if cond := condition(); assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	}
+
+	// The roles keep running but are not scaled up once the embedding group is no longer ready.
+	setEmbeddingReady(metav1.ConditionFalse)
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	got.Spec.Roles[0].Replicas = ptr.To[int32](4)
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	sts, err := getSts()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), *sts.Spec.Replicas)
+	assert.Equal(t, metav1.ConditionTrue, condition().Status)
+}
+
+func TestRoleBasedGroupReconciler_Reconcile_Events(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithWorkload("apps/v1", "StatefulSet").Obj(),
+		}).Obj()
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg).
+		WithStatusSubresource(rbg, &appsv1.StatefulSet{}).
+		Build()
+
+	recorder := record.NewFakeRecorder(100)
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           recorder,
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+	events := func() []string {
+		var got []string
+		for {
+			select {
+			case event := <-recorder.Events:
+				got = append(got, event)
+			default:
+				return got
+			}
+		}
+	}
+	updateRole := func(update func(role *workloadsv1alpha2.RoleSpec)) {
+		got := &workloadsv1alpha2.RoleBasedGroup{}
+		assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+		update(&got.Spec.Roles[0])
+		assert.NoError(t, fakeClient.Update(ctx, got))
+	}
+
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	got := events()
+	assert.Contains(t, got, "Normal RoleCreated Created role prefill with 2 replicas")
+	assert.Contains(t, strings.Join(got, "\n"), "Normal SucceedCreateRevision Created revision test-rbg-")
+
+	// Reconciling again without changes tells nothing new.
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Normal Succeed ReconcileSucceed"}, events())
+
+	updateRole(func(role *workloadsv1alpha2.RoleSpec) { role.Replicas = ptr.To[int32](3) })
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Contains(t, events(), "Normal RoleScaled Scaled role prefill from 2 to 3 replicas")
+
+	updateRole(func(role *workloadsv1alpha2.RoleSpec) {
+		role.StandalonePattern.Template.Spec.Containers[0].Image = "nginx:next"
+	})
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Regexp(t, "Normal SucceedCreateRevision Created revision test-rbg-\\S+, rolling out roles prefill",
+		strings.Join(events(), "\n"))
+}
+
+func TestRoleBasedGroupReconciler_reconcileSingleRole_CompletedJob(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	role := wrappersv2.BuildStandaloneRole("download").WithReplicas(1).WithWorkload("batch/v1", "Job").Obj()
+	role.TTLSecondsAfterFinished = ptr.To[int32](60)
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{role}).Obj()
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{{
+		Name:              "download",
+		Replicas:          1,
+		ReadyReplicas:     1,
+		CompletedRevision: "rev-1",
+		Conditions: []metav1.Condition{{
+			Type: string(workloadsv1alpha2.RoleComplete), Status: metav1.ConditionTrue, Reason: "Completed",
+		}},
+	}}
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(rbg).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           recorder,
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+
+	// The Job deleted after its ttlSecondsAfterFinished is neither run again nor reported as created.
+	err := r.reconcileSingleRole(ctx, rbg, &rbg.Spec.Roles[0], map[string]string{"download": "rev-1"}, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, recorder.Events)
+
+	// A new revision runs the Job again.
+	err = r.reconcileSingleRole(ctx, rbg, &rbg.Spec.Roles[0], map[string]string{"download": "rev-2"}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Normal RoleCreated Created role download with 1 replicas", <-recorder.Events)
+}
+
+func TestRoleBasedGroupReconciler_Reconcile_ClusterRoleTemplate(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	role := wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).WithWorkload("apps/v1", "StatefulSet").Obj()
+	role.StandalonePattern.Template = nil
+	role.StandalonePattern.TemplateRef = &workloadsv1alpha2.TemplateRef{
+		Name:  "vllm",
+		Kind:  workloadsv1alpha2.ClusterRoleTemplateKind,
+		Patch: &runtime.RawExtension{Raw: []byte(`{"spec":{"containers":[{"name":"engine","args":["Qwen/Qwen3-8B"]}]}}`)},
+	}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{role}).Obj()
+	clusterTemplate := &workloadsv1alpha2.ClusterRoleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "vllm"},
+		Spec: workloadsv1alpha2.ClusterRoleTemplateSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "engine", Image: "vllm:v1"}}},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg).
+		WithStatusSubresource(rbg, &appsv1.StatefulSet{}).
+		Build()
+
+	recorder := record.NewFakeRecorder(100)
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           recorder,
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+
+	// The group waits for the ClusterRoleTemplate it references.
+	_, err := r.Reconcile(ctx, request)
+	assert.ErrorContains(t, err, `clusterRoleTemplate "vllm" not found`)
+	assert.Contains(t, <-recorder.Events, "Warning InvalidTemplateRef")
+
+	// Creating the template enqueues the group, whose role is rendered from it.
+	assert.NoError(t, fakeClient.Create(ctx, clusterTemplate))
+	assert.Equal(t, []reconcile.Request{request}, r.mapClusterRoleTemplateToRBGs(ctx, clusterTemplate))
+	assert.Empty(t, r.mapClusterRoleTemplateToRBGs(ctx, &workloadsv1alpha2.ClusterRoleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "sglang"},
+	}))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	sts := &appsv1.StatefulSet{}
+	assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-rbg-decode", Namespace: "default"}, sts))
+	assert.Equal(t, "vllm:v1", sts.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, []string{"Qwen/Qwen3-8B"}, sts.Spec.Template.Spec.Containers[0].Args)
+}
+
+func Test_expiredRevisionNames(t *testing.T) {
+	revision := func(name string) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	revisions := []*appsv1.ControllerRevision{revision("rbg-c"), revision("rbg-a"), revision("rbg-b"), revision("rbg-d")}
+	assert.Equal(t, []string{"rbg-a", "rbg-c"}, expiredRevisionNames(revisions, revisions[2:]))
+	assert.Empty(t, expiredRevisionNames(revisions, revisions))
+}
+
+func Test_setRoleRevisions(t *testing.T) {
+	role := &workloadsv1alpha2.RoleSpec{Name: "decode", Replicas: ptr.To[int32](2)}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+
+	// The first revision of a role is current right away.
+	status := workloadsv1alpha2.RoleStatus{Name: "decode"}
+	setRoleRevisions(rbg, role, &status, map[string]string{"decode": "rev-1"})
+	assert.Equal(t, "rev-1", status.CurrentRevision)
+	assert.Equal(t, "rev-1", status.UpdateRevision)
+
+	// A new revision stays pending until all desired replicas are updated and ready.
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{status}
+	status = workloadsv1alpha2.RoleStatus{Name: "decode", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 1}
+	setRoleRevisions(rbg, role, &status, map[string]string{"decode": "rev-2"})
+	assert.Equal(t, "rev-1", status.CurrentRevision)
+	assert.Equal(t, "rev-2", status.UpdateRevision)
+
+	// Without hashes, such as while paused, the reported revisions are kept.
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{status}
+	status = workloadsv1alpha2.RoleStatus{Name: "decode", Replicas: 2, ReadyReplicas: 1, UpdatedReplicas: 1}
+	setRoleRevisions(rbg, role, &status, nil)
+	assert.Equal(t, "rev-1", status.CurrentRevision)
+	assert.Equal(t, "rev-2", status.UpdateRevision)
+
+	status = workloadsv1alpha2.RoleStatus{Name: "decode", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 2}
+	setRoleRevisions(rbg, role, &status, map[string]string{"decode": "rev-2"})
+	assert.Equal(t, "rev-2", status.CurrentRevision)
+	assert.Equal(t, "rev-2", status.UpdateRevision)
+}
+
+func TestRoleBasedGroupReconciler_Reconcile_Kueue(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithWorkload("apps/v1", "StatefulSet").Obj(),
+		}).Obj()
+	rbg.Labels[constants.KueueQueueNameLabelKey] = "gpu-queue"
+	flavor := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"nodeLabels": map[string]interface{}{"gpu.product": "h100"}},
+	}}
+	flavor.SetGroupVersionKind(kueue.ResourceFlavorGVK)
+	flavor.SetName("h100")
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(rbg, flavor).
+		WithStatusSubresource(rbg, &appsv1.StatefulSet{}).
+		Build()
+	// The Workload watch is registered by SetupWithManager, which is not run here.
+	watchedWorkload.LoadOrStore(kueue.CrdName, struct{}{})
+
+	r := &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           record.NewFakeRecorder(100),
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+		kueueManager:       kueue.New(fakeClient),
+	}
+	ctx := ctrl.LoggerInto(context.TODO(), zap.New().WithValues("env", "unit-test"))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-rbg", Namespace: "default"}}
+	getSts := func() *appsv1.StatefulSet {
+		sts := &appsv1.StatefulSet{}
+		assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "test-rbg-prefill", Namespace: "default"}, sts))
+		return sts
+	}
+	condition := func() *metav1.Condition {
+		got := &workloadsv1alpha2.RoleBasedGroup{}
+		assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+		return apimeta.FindStatusCondition(got.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupSuspended))
+	}
+
+	// The role is created at zero replicas until the Workload is admitted.
+	_, err := r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), *getSts().Spec.Replicas)
+	if cond := condition(); assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "WaitingForAdmission", cond.Reason)
+	}
+
+	workload := kueue.NewWorkload()
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, workload))
+	workload.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": "Admitted", "status": "True"}},
+		"admission": map[string]interface{}{
+			"podSetAssignments": []interface{}{
+				map[string]interface{}{"name": "prefill", "flavors": map[string]interface{}{"nvidia.com/gpu": "h100"}},
+			},
+		},
+	}
+	assert.NoError(t, fakeClient.Update(ctx, workload))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	sts := getSts()
+	assert.Equal(t, int32(2), *sts.Spec.Replicas)
+	assert.Equal(t, map[string]string{"gpu.product": "h100"}, sts.Spec.Template.Spec.NodeSelector)
+	if cond := condition(); assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "Admitted", cond.Reason)
+	}
+
+	// spec.suspend scales the roles down regardless of the admission.
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, fakeClient.Get(ctx, request.NamespacedName, got))
+	got.Spec.Suspend = ptr.To(true)
+	assert.NoError(t, fakeClient.Update(ctx, got))
+	_, err = r.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), *getSts().Spec.Replicas)
+	assert.Equal(t, "Suspended", condition().Reason)
+}
+
+func TestCapScalingTarget(t *testing.T) {
+	role := &workloadsv1alpha2.RoleSpec{Name: "router", Replicas: ptr.To[int32](4)}
+	assert.Equal(t, map[string]int32{"router": 2}, capScalingTarget(nil, role, 2))
+	assert.Equal(t, map[string]int32{"router": 3}, capScalingTarget(map[string]int32{"router": 3}, role, 5))
+	assert.Nil(t, capScalingTarget(nil, role, 4))
+
+	targets := map[string]int32{"router": 6, "decode": 1}
+	assert.Equal(t, map[string]int32{"router": 5, "decode": 1}, capScalingTarget(targets, role, 5))
+	assert.Equal(t, int32(6), targets["router"], "the input targets are not modified")
+}
+
+func TestConstructRoleConditions(t *testing.T) {
+	role := &workloadsv1alpha2.RoleSpec{Name: "decode", Replicas: ptr.To[int32](3)}
+	reasons := func(conditions []metav1.Condition) map[string]string {
+		got := map[string]string{}
+		for _, c := range conditions {
+			got[c.Type] = fmt.Sprintf("%s/%s", c.Status, c.Reason)
+		}
+		return got
+	}
+
+	cases := []struct {
+		name          string
+		suspend       bool
+		status        workloadsv1alpha2.RoleStatus
+		workloadFound bool
+		want          map[string]string
+	}{
+		{
+			name:          "ready",
+			status:        workloadsv1alpha2.RoleStatus{Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 3},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "True/AllReplicasReady", "Progressing": "False/Stable",
+				"Degraded": "False/AsExpected", "Suspended": "False/Resumed",
+			},
+		},
+		{
+			name:          "rolling update",
+			status:        workloadsv1alpha2.RoleStatus{Replicas: 3, ReadyReplicas: 2, UpdatedReplicas: 1},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "False/ReplicasNotReady", "Progressing": "True/Updating",
+				"Degraded": "False/AsExpected", "Suspended": "False/Resumed",
+			},
+		},
+		{
+			name:          "scaling",
+			status:        workloadsv1alpha2.RoleStatus{Replicas: 1, ReadyReplicas: 1, UpdatedReplicas: 1},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "False/ReplicasNotReady", "Progressing": "True/Scaling",
+				"Degraded": "False/AsExpected", "Suspended": "False/Resumed",
+			},
+		},
+		{
+			name:          "degraded",
+			status:        workloadsv1alpha2.RoleStatus{Replicas: 3, ReadyReplicas: 1, UpdatedReplicas: 3},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "False/ReplicasNotReady", "Progressing": "False/Stable",
+				"Degraded": "True/ReplicasNotReady", "Suspended": "False/Resumed",
+			},
+		},
+		{
+			name:   "workload not found",
+			status: workloadsv1alpha2.RoleStatus{},
+			want: map[string]string{
+				"Ready": "False/WorkloadNotFound", "Progressing": "True/WorkloadNotFound",
+				"Degraded": "False/WorkloadNotFound", "Suspended": "False/Resumed",
+			},
+		},
+		{
+			name: "job running",
+			status: workloadsv1alpha2.RoleStatus{Replicas: 3, ReadyReplicas: 1, UpdatedReplicas: 3,
+				Conditions: []metav1.Condition{
+					{Type: "Complete", Status: metav1.ConditionFalse, Reason: "Running", Message: "1/3 pods succeeded"},
+					{Type: "Failed", Status: metav1.ConditionFalse, Reason: "AsExpected"},
+				}},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "False/NotCompleted", "Progressing": "True/Running",
+				"Degraded": "False/AsExpected", "Suspended": "False/Resumed",
+				"Complete": "False/Running", "Failed": "False/AsExpected",
+			},
+		},
+		{
+			name: "job complete",
+			status: workloadsv1alpha2.RoleStatus{Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 3,
+				Conditions: []metav1.Condition{
+					{Type: "Complete", Status: metav1.ConditionTrue, Reason: "Completed", Message: "3/3 pods succeeded"},
+					{Type: "Failed", Status: metav1.ConditionFalse, Reason: "AsExpected"},
+				}},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "True/Completed", "Progressing": "False/Completed",
+				"Degraded": "False/AsExpected", "Suspended": "False/Resumed",
+				"Complete": "True/Completed", "Failed": "False/AsExpected",
+			},
+		},
+		{
+			name: "job failed",
+			status: workloadsv1alpha2.RoleStatus{Replicas: 3, ReadyReplicas: 1, UpdatedReplicas: 3,
+				Conditions: []metav1.Condition{
+					{Type: "Complete", Status: metav1.ConditionFalse, Reason: "Failed", Message: "1/3 pods succeeded"},
+					{Type: "Failed", Status: metav1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+				}},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "False/Failed", "Progressing": "False/Failed",
+				"Degraded": "True/BackoffLimitExceeded", "Suspended": "False/Resumed",
+				"Complete": "False/Failed", "Failed": "True/BackoffLimitExceeded",
+			},
+		},
+		{
+			name:          "suspended",
+			suspend:       true,
+			status:        workloadsv1alpha2.RoleStatus{},
+			workloadFound: true,
+			want: map[string]string{
+				"Ready": "False/Suspended", "Progressing": "False/Suspended",
+				"Degraded": "False/Suspended", "Suspended": "True/Suspended",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+			rbg.Spec.Suspend = ptr.To(tc.suspend)
+			tc.status.Name = role.Name
+			assert.Equal(t, tc.want, reasons(constructRoleConditions(rbg, role, tc.status, tc.workloadFound)))
+		})
+	}
+
+	// The admission state of the group is reported on its roles.
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	rbg.Status.Conditions = []metav1.Condition{{
+		Type: string(workloadsv1alpha2.RoleBasedGroupSuspended), Status: metav1.ConditionTrue,
+		Reason: "WaitingForAdmission", Message: "Waiting for the admission by kueue queue main",
+	}}
+	conditions := constructRoleConditions(rbg, role, workloadsv1alpha2.RoleStatus{Name: role.Name}, true)
+	suspended := apimeta.FindStatusCondition(conditions, string(workloadsv1alpha2.RoleSuspended))
+	assert.Equal(t, "WaitingForAdmission", suspended.Reason)
+	assert.Equal(t, "Waiting for the admission by kueue queue main", suspended.Message)
+
+	// The transition time is kept while the status of a condition does not change.
+	since := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	rbg = wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{{
+		Name: role.Name,
+		Conditions: []metav1.Condition{{
+			Type: string(workloadsv1alpha2.RoleReady), Status: metav1.ConditionFalse,
+			Reason: "ReplicasNotReady", LastTransitionTime: since,
+		}},
+	}}
+	conditions = constructRoleConditions(rbg, role,
+		workloadsv1alpha2.RoleStatus{Name: role.Name, Replicas: 3, ReadyReplicas: 2, UpdatedReplicas: 3}, true)
+	ready := apimeta.FindStatusCondition(conditions, string(workloadsv1alpha2.RoleReady))
+	assert.Equal(t, since, ready.LastTransitionTime)
+	assert.Equal(t, "2/3 replicas are ready", ready.Message)
+	assert.Equal(t, "ReplicasNotReady",
+		apimeta.FindStatusCondition(rbg.Status.RoleStatuses[0].Conditions, string(workloadsv1alpha2.RoleReady)).Reason,
+		"the recorded conditions are not modified")
+}
+
 func TestRoleBasedGroupReconciler_ReconcileScalingAdapter(t *testing.T) {
 	testScheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(testScheme)
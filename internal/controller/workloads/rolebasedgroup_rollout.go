@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+// Values of the rollout status annotation of a ControllerRevision.
+const (
+	rolloutComplete = "Complete"
+	rolloutFailed   = "Failed"
+)
+
+// handleProgressDeadline checks the roles rolled out by the current revision against their
+// progressDeadlineSeconds. A revision whose roles all became ready is marked Complete. Once a role missed
+// its deadline the revision is marked Failed and, with the OnProgressDeadlineExceeded policy, the spec of
+// the previous revision is restored. It reports whether the group was rolled back, and how long until the
+// next deadline expires.
+func (r *RoleBasedGroupReconciler) handleProgressDeadline(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, roleStatuses []workloadsv1alpha2.RoleStatus,
+) (bool, time.Duration, error) {
+	deadlines := map[string]time.Duration{}
+	for _, role := range rbg.Spec.Roles {
+		if role.ProgressDeadlineSeconds != nil {
+			deadlines[role.Name] = time.Duration(*role.ProgressDeadlineSeconds) * time.Second
+		}
+	}
+	if len(deadlines) == 0 {
+		return false, 0, nil
+	}
+
+	current, previous, err := r.rolloutRevisions(ctx, rbg)
+	if err != nil || current == nil || previous == nil || current.Annotations[constants.RolloutStatusAnnotationKey] != "" {
+		// The first revision creates the roles, there is nothing to roll back to.
+		return false, 0, err
+	}
+	currentHashes, err := utils.GetRolesRevisionHash(current)
+	if err != nil {
+		return false, 0, err
+	}
+	previousHashes, err := utils.GetRolesRevisionHash(previous)
+	if err != nil {
+		return false, 0, err
+	}
+	statuses := map[string]workloadsv1alpha2.RoleStatus{}
+	for _, status := range roleStatuses {
+		statuses[status.Name] = status
+	}
+
+	elapsed := time.Since(current.CreationTimestamp.Time)
+	var requeueAfter time.Duration
+	var pending, failed []string
+	for _, role := range rbg.Spec.Roles {
+		deadline, ok := deadlines[role.Name]
+		if !ok || currentHashes[role.Name] == previousHashes[role.Name] {
+			continue
+		}
+		desired := ptr.Deref(role.Replicas, 1)
+		status := statuses[role.Name]
+		if status.Replicas == desired && status.ReadyReplicas >= desired && status.UpdatedReplicas >= desired {
+			continue
+		}
+		if elapsed < deadline {
+			pending = append(pending, role.Name)
+			if remaining := deadline - elapsed; requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+		failed = append(failed, role.Name)
+	}
+	switch {
+	case len(failed) > 0:
+		return r.failRollout(ctx, rbg, current, previous, failed)
+	case len(pending) > 0:
+		return false, requeueAfter, nil
+	}
+	return false, 0, r.setRolloutStatus(ctx, current, rolloutComplete)
+}
+
+// rolloutRevisions returns the current revision of the group, and the latest revision before it with a
+// different spec.
+func (r *RoleBasedGroupReconciler) rolloutRevisions(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+) (current, previous *appsv1.ControllerRevision, err error) {
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			constants.GroupNameLabelKey: rbg.Name,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	revisions, err := utils.ListRevisions(ctx, r.client, rbg, selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	current = utils.GetHighestRevision(revisions)
+	if current == nil {
+		return nil, nil, nil
+	}
+	for _, revision := range revisions {
+		if revision.Revision >= current.Revision ||
+			revision.Labels[constants.GroupRevisionLabelKey] == current.Labels[constants.GroupRevisionLabelKey] {
+			continue
+		}
+		if previous == nil || revision.Revision > previous.Revision {
+			previous = revision
+		}
+	}
+	return current, previous, nil
+}
+
+// failRollout marks the current revision Failed and restores the previous one when the policy asks for it.
+// A previous revision that failed itself is not restored, so two broken revisions never alternate.
+func (r *RoleBasedGroupReconciler) failRollout(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+	current, previous *appsv1.ControllerRevision, failed []string,
+) (bool, time.Duration, error) {
+	logger := log.FromContext(ctx)
+	sort.Strings(failed)
+	roles := strings.Join(failed, ", ")
+	if err := r.setRolloutStatus(ctx, current, rolloutFailed); err != nil {
+		return false, 0, err
+	}
+	logger.Info("Rollout missed the progress deadline", "revision", current.Revision, "roles", roles)
+	r.recorder.Eventf(rbg, corev1.EventTypeWarning, ProgressDeadlineExceeded,
+		"Roles %s of revision %d did not become ready within their progress deadline", roles, current.Revision)
+	if rbg.Spec.AutoRollback != workloadsv1alpha2.AutoRollbackOnProgressDeadlineExceeded {
+		return false, 0, nil
+	}
+
+	if previous.Annotations[constants.RolloutStatusAnnotationKey] == rolloutFailed {
+		r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedRollback,
+			"Previous revision %d failed its rollout too, not rolling back", previous.Revision)
+		return false, 0, nil
+	}
+	restored, err := utils.ApplyRevision(rbg, previous)
+	if err != nil {
+		r.recorder.Event(rbg, corev1.EventTypeWarning, FailedRollback, err.Error())
+		return false, 0, err
+	}
+	if err := r.client.Update(ctx, restored); err != nil {
+		logger.Error(err, "Failed to update RoleBasedGroup for rollback")
+		return false, 0, err
+	}
+	logger.Info("Rolled back", "revision", previous.Revision)
+	r.recorder.Eventf(rbg, corev1.EventTypeNormal, RolledBack,
+		"Rolled back to revision %d, roles %s missed their progress deadline", previous.Revision, roles)
+	return true, 0, nil
+}
+
+func (r *RoleBasedGroupReconciler) setRolloutStatus(
+	ctx context.Context, revision *appsv1.ControllerRevision, status string,
+) error {
+	patch := client.MergeFrom(revision.DeepCopy())
+	if revision.Annotations == nil {
+		revision.Annotations = map[string]string{}
+	}
+	revision.Annotations[constants.RolloutStatusAnnotationKey] = status
+	if err := r.client.Patch(ctx, revision, patch); err != nil {
+		return fmt.Errorf("failed to mark revision %s %s: %w", revision.Name, status, err)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// terminationRequeueInterval is how often a group being torn down in order checks the pods of the
+// current stage, pod deletions are not watched by the group controller.
+const terminationRequeueInterval = 5 * time.Second
+
+// terminationStages groups the roles in the order they are terminated in: every role listed in the
+// termination policy is a stage of its own, the roles not listed share the last stage.
+func terminationStages(rbg *workloadsv1alpha2.RoleBasedGroup) [][]*workloadsv1alpha2.RoleSpec {
+	if rbg.Spec.TerminationPolicy == nil {
+		return nil
+	}
+	listed := make(map[string]bool, len(rbg.Spec.TerminationPolicy.Order))
+	var stages [][]*workloadsv1alpha2.RoleSpec
+	for _, name := range rbg.Spec.TerminationPolicy.Order {
+		if role, err := rbg.GetRole(name); err == nil && !listed[name] {
+			stages = append(stages, []*workloadsv1alpha2.RoleSpec{role})
+		}
+		listed[name] = true
+	}
+	var rest []*workloadsv1alpha2.RoleSpec
+	for i := range rbg.Spec.Roles {
+		if !listed[rbg.Spec.Roles[i].Name] {
+			rest = append(rest, &rbg.Spec.Roles[i])
+		}
+	}
+	if len(rest) > 0 {
+		stages = append(stages, rest)
+	}
+	return stages
+}
+
+// ensureTerminationFinalizer keeps the ordered termination finalizer on the groups with a termination
+// policy only, the other groups are left to the garbage collector.
+func (r *RoleBasedGroupReconciler) ensureTerminationFinalizer(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+) error {
+	old := rbg.DeepCopy()
+	var changed bool
+	if rbg.Spec.TerminationPolicy != nil {
+		changed = controllerutil.AddFinalizer(rbg, constants.OrderedTerminationFinalizer)
+	} else {
+		changed = controllerutil.RemoveFinalizer(rbg, constants.OrderedTerminationFinalizer)
+	}
+	if !changed {
+		return nil
+	}
+	// The finalizers are replaced as a whole, the optimistic lock keeps the ones set meanwhile.
+	return r.client.Patch(ctx, rbg, client.MergeFromWithOptions(old, client.MergeFromWithOptimisticLock{}))
+}
+
+// reconcileTermination deletes the workloads of a deleted group stage by stage and releases the
+// finalizer once the pods of every role are gone, each pod terminating within its own grace period.
+func (r *RoleBasedGroupReconciler) reconcileTermination(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(rbg, constants.OrderedTerminationFinalizer) {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+
+	for _, stage := range terminationStages(rbg) {
+		var remaining []string
+		for _, role := range stage {
+			if err := r.deleteRoleWorkload(ctx, rbg, role); err != nil {
+				return ctrl.Result{}, err
+			}
+			pods, err := r.listRolePods(ctx, rbg, role)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if len(pods) > 0 {
+				remaining = append(remaining, role.Name)
+			}
+		}
+		if len(remaining) > 0 {
+			logger.Info("Waiting for the pods of roles to terminate", "roles", remaining)
+			return ctrl.Result{RequeueAfter: terminationRequeueInterval}, nil
+		}
+	}
+
+	old := rbg.DeepCopy()
+	controllerutil.RemoveFinalizer(rbg, constants.OrderedTerminationFinalizer)
+	err := r.client.Patch(ctx, rbg, client.MergeFromWithOptions(old, client.MergeFromWithOptimisticLock{}))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *RoleBasedGroupReconciler) deleteRoleWorkload(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) error {
+	workload := &unstructured.Unstructured{}
+	workload.SetAPIVersion(role.GetWorkloadSpec().APIVersion)
+	workload.SetKind(role.GetWorkloadSpec().Kind)
+	workload.SetName(rbg.GetWorkloadName(role))
+	workload.SetNamespace(rbg.Namespace)
+	err := r.client.Delete(ctx, workload, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *RoleBasedGroupReconciler) listRolePods(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, client.InNamespace(rbg.Namespace), client.MatchingLabels{
+		constants.GroupNameLabelKey: rbg.Name,
+		constants.RoleNameLabelKey:  role.Name,
+	}); err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// heldScaleIns returns the current replicas of the roles whose scale-in waits for the roles terminated
+// before them. A stage is draining while one of its roles scales in, has terminating pods, or has more
+// pods than its replicas account for because the workload did not start deleting them yet. Nothing is
+// read unless a role after the first stage scales in below the replicas recorded in the group status,
+// and the pods are only listed for the stages before it.
+func (r *RoleBasedGroupReconciler) heldScaleIns(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, scalingTargets map[string]int32,
+) (map[string]int32, error) {
+	stages := terminationStages(rbg)
+	last := 0
+	for i, stage := range stages {
+		for _, role := range stage {
+			if status, found := rbg.GetRoleStatus(role.Name); found && scalingTarget(scalingTargets, role) < status.Replicas {
+				last = i
+			}
+		}
+	}
+	held := map[string]int32{}
+	if last == 0 {
+		return held, nil
+	}
+
+	draining := false
+	for i, stage := range stages[:last+1] {
+		stageDraining := false
+		for _, role := range stage {
+			current, exists, err := r.currentReplicas(ctx, rbg, role)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				continue
+			}
+			scalingIn := scalingTarget(scalingTargets, role) < current
+			if draining {
+				if scalingIn {
+					held[role.Name] = current
+				}
+				continue
+			}
+			if scalingIn {
+				stageDraining = true
+				continue
+			}
+			if i == last {
+				continue
+			}
+			pods, err := r.listRolePods(ctx, rbg, role)
+			if err != nil {
+				return nil, err
+			}
+			var active int32
+			for j := range pods {
+				if pods[j].DeletionTimestamp != nil {
+					stageDraining = true
+					break
+				}
+				if pods[j].Status.Phase != corev1.PodSucceeded && pods[j].Status.Phase != corev1.PodFailed {
+					active++
+				}
+			}
+			if active > current*podsPerReplica(role) {
+				stageDraining = true
+			}
+		}
+		draining = draining || stageDraining
+	}
+	return held, nil
+}
+
+// scalingTarget returns the replicas role is scaled to.
+func scalingTarget(scalingTargets map[string]int32, role *workloadsv1alpha2.RoleSpec) int32 {
+	if t, ok := scalingTargets[role.Name]; ok {
+		return t
+	}
+	return ptr.Deref(role.Replicas, 1)
+}
+
+// podsPerReplica returns the number of pods making up one replica of role.
+func podsPerReplica(role *workloadsv1alpha2.RoleSpec) int32 {
+	if lwp := role.GetLeaderWorkerPattern(); role.IsLeaderWorkerPattern() && lwp != nil {
+		return ptr.Deref(lwp.Size, 1)
+	}
+	if role.CustomComponentsPattern != nil {
+		var size int32
+		for _, component := range role.CustomComponentsPattern.Components {
+			size += ptr.Deref(component.Size, 1)
+		}
+		return size
+	}
+	return 1
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/reconciler"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newTerminationRBG(roles ...string) *workloadsv1alpha2.RoleBasedGroup {
+	specs := make([]workloadsv1alpha2.RoleSpec, 0, len(roles))
+	for _, name := range roles {
+		specs = append(specs, wrappersv2.BuildStandaloneRole(name).WithWorkload("apps/v1", "Deployment").Obj())
+	}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithRoles(specs).Obj()
+	rbg.Spec.TerminationPolicy = &workloadsv1alpha2.TerminationPolicy{Order: []string{"router", "prefill"}}
+	return rbg
+}
+
+func newTerminationReconciler(objs ...client.Object) *RoleBasedGroupReconciler {
+	testScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(testScheme)
+	_ = workloadsv1alpha2.AddToScheme(testScheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objs...).Build()
+	return &RoleBasedGroupReconciler{
+		client:             fakeClient,
+		apiReader:          fakeClient,
+		scheme:             testScheme,
+		recorder:           record.NewFakeRecorder(10),
+		workloadReconciler: make(map[string]reconciler.WorkloadReconciler),
+	}
+}
+
+func newRolePod(rbg *workloadsv1alpha2.RoleBasedGroup, name, role string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: rbg.Namespace,
+		Labels: map[string]string{
+			constants.GroupNameLabelKey: rbg.Name,
+			constants.RoleNameLabelKey:  role,
+		},
+	}}
+}
+
+func newRoleDeployment(rbg *workloadsv1alpha2.RoleBasedGroup, role string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: rbg.Name + "-" + role, Namespace: rbg.Namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+	}
+}
+
+func TestTerminationStages(t *testing.T) {
+	rbg := newTerminationRBG("decode", "prefill", "router", "cache")
+	rbg.Spec.TerminationPolicy.Order = []string{"router", "unknown", "prefill", "router"}
+
+	var names [][]string
+	for _, stage := range terminationStages(rbg) {
+		var stageNames []string
+		for _, role := range stage {
+			stageNames = append(stageNames, role.Name)
+		}
+		names = append(names, stageNames)
+	}
+	assert.Equal(t, [][]string{{"router"}, {"prefill"}, {"decode", "cache"}}, names)
+
+	rbg.Spec.TerminationPolicy = nil
+	assert.Empty(t, terminationStages(rbg))
+}
+
+func TestEnsureTerminationFinalizer(t *testing.T) {
+	rbg := newTerminationRBG("router", "decode")
+	r := newTerminationReconciler(rbg)
+
+	require.NoError(t, r.ensureTerminationFinalizer(context.TODO(), rbg))
+	persisted := &workloadsv1alpha2.RoleBasedGroup{}
+	require.NoError(t, r.client.Get(context.TODO(), client.ObjectKeyFromObject(rbg), persisted))
+	assert.Contains(t, persisted.Finalizers, constants.OrderedTerminationFinalizer)
+
+	persisted.Spec.TerminationPolicy = nil
+	require.NoError(t, r.ensureTerminationFinalizer(context.TODO(), persisted))
+	require.NoError(t, r.client.Get(context.TODO(), client.ObjectKeyFromObject(rbg), persisted))
+	assert.NotContains(t, persisted.Finalizers, constants.OrderedTerminationFinalizer)
+}
+
+func TestReconcileTermination(t *testing.T) {
+	rbg := newTerminationRBG("router", "decode")
+	rbg.Finalizers = []string{constants.OrderedTerminationFinalizer}
+	rbg.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+	routerPod := newRolePod(rbg, "test-rbg-router-0", "router")
+	decodePod := newRolePod(rbg, "test-rbg-decode-0", "decode")
+	r := newTerminationReconciler(rbg, newRoleDeployment(rbg, "router", 1), newRoleDeployment(rbg, "decode", 1),
+		routerPod, decodePod)
+	ctx := context.TODO()
+	deploymentExists := func(role string) bool {
+		err := r.client.Get(ctx, types.NamespacedName{Name: "test-rbg-" + role, Namespace: "default"}, &appsv1.Deployment{})
+		return !apierrors.IsNotFound(err)
+	}
+
+	// The router is deleted first, the decode role waits for its pods to terminate.
+	result, err := r.reconcileTermination(ctx, rbg)
+	require.NoError(t, err)
+	assert.Equal(t, terminationRequeueInterval, result.RequeueAfter)
+	assert.False(t, deploymentExists("router"))
+	assert.True(t, deploymentExists("decode"))
+
+	require.NoError(t, r.client.Delete(ctx, routerPod))
+	result, err = r.reconcileTermination(ctx, rbg)
+	require.NoError(t, err)
+	assert.Equal(t, terminationRequeueInterval, result.RequeueAfter)
+	assert.False(t, deploymentExists("decode"))
+
+	require.NoError(t, r.client.Delete(ctx, decodePod))
+	result, err = r.reconcileTermination(ctx, rbg)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	err = r.client.Get(ctx, client.ObjectKeyFromObject(rbg), &workloadsv1alpha2.RoleBasedGroup{})
+	assert.True(t, apierrors.IsNotFound(err), "the group should be released, got %v", err)
+}
+
+func TestHeldScaleIns(t *testing.T) {
+	rbg := newTerminationRBG("router", "decode")
+	targets := map[string]int32{"router": 1, "decode": 1}
+	ctx := context.TODO()
+
+	// Nothing is read while the roles after the first stage do not scale in.
+	r := newTerminationReconciler()
+	held, err := r.heldScaleIns(ctx, rbg, targets)
+	require.NoError(t, err)
+	assert.Empty(t, held)
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{{Name: "router", Replicas: 2}, {Name: "decode", Replicas: 2}}
+
+	// The router scales in first, the decode role keeps its replicas.
+	r = newTerminationReconciler(newRoleDeployment(rbg, "router", 2), newRoleDeployment(rbg, "decode", 2))
+	held, err = r.heldScaleIns(ctx, rbg, targets)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"decode": 2}, held)
+
+	// The router is scaled in but its pod is still draining.
+	terminating := newRolePod(rbg, "test-rbg-router-1", "router")
+	terminating.Finalizers = []string{"test/drain"}
+	terminating.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+	r = newTerminationReconciler(newRoleDeployment(rbg, "router", 1), newRoleDeployment(rbg, "decode", 2),
+		newRolePod(rbg, "test-rbg-router-0", "router"), terminating)
+	held, err = r.heldScaleIns(ctx, rbg, targets)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"decode": 2}, held)
+
+	// The router is scaled in but the Deployment did not start deleting its pods yet.
+	r = newTerminationReconciler(newRoleDeployment(rbg, "router", 1), newRoleDeployment(rbg, "decode", 2),
+		newRolePod(rbg, "test-rbg-router-0", "router"), newRolePod(rbg, "test-rbg-router-1", "router"))
+	held, err = r.heldScaleIns(ctx, rbg, targets)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"decode": 2}, held)
+
+	// The router is drained, the decode role scales in.
+	r = newTerminationReconciler(newRoleDeployment(rbg, "router", 1), newRoleDeployment(rbg, "decode", 2),
+		newRolePod(rbg, "test-rbg-router-0", "router"))
+	held, err = r.heldScaleIns(ctx, rbg, targets)
+	require.NoError(t, err)
+	assert.Empty(t, held)
+}
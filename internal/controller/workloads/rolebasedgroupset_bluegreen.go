@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	coreapplyv1 "k8s.io/client-go/applyconfigurations/core/v1"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+// reconcileBlueGreen stands up the groups of the current template next to the serving ones. Once all of
+// them are ready and the verification Job succeeded, the Service is switched to them and the previous
+// groups are deleted.
+func (r *RoleBasedGroupSetReconciler) reconcileBlueGreen(
+	ctx context.Context, rbgset *workloadsv1alpha2.RoleBasedGroupSet, rbglist *workloadsv1alpha2.RoleBasedGroupList,
+) error {
+	logger := log.FromContext(ctx)
+	updateRevision, err := utils.HashObject(rbgset.Spec.GroupTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to hash the group template: %w", err)
+	}
+
+	// Split the groups between the ones of the current template and the serving ones. The groups of an
+	// intermediate template that never served are deleted right away.
+	desiredReplicas := int(*rbgset.Spec.Replicas)
+	currentRevision := rbgset.Status.CurrentRevision
+	updated := make(map[int]*workloadsv1alpha2.RoleBasedGroup)
+	var previous, rbgsToDelete, rbgsToCreate []*workloadsv1alpha2.RoleBasedGroup
+	for i := range rbglist.Items {
+		rbg := &rbglist.Items[i]
+		switch rbg.Labels[constants.GroupSetRevisionLabelKey] {
+		case updateRevision:
+		case currentRevision:
+			previous = append(previous, rbg)
+			continue
+		default:
+			rbgsToDelete = append(rbgsToDelete, rbg)
+			continue
+		}
+		index, err := strconv.Atoi(rbg.Labels[constants.GroupSetIndexLabelKey])
+		if err != nil || index >= desiredReplicas {
+			rbgsToDelete = append(rbgsToDelete, rbg)
+			continue
+		}
+		updated[index] = rbg
+	}
+	for i := 0; i < desiredReplicas; i++ {
+		if _, exists := updated[i]; !exists {
+			rbgsToCreate = append(rbgsToCreate, newBlueGreenRBGForSet(rbgset, updateRevision, i))
+		}
+	}
+	if err := r.scaleDown(ctx, rbgsToDelete); err != nil {
+		return err
+	}
+	if err := r.scaleUp(ctx, rbgset, rbgsToCreate); err != nil {
+		return err
+	}
+
+	readyReplicas := 0
+	updatedList := &workloadsv1alpha2.RoleBasedGroupList{}
+	for _, rbg := range updated {
+		updatedList.Items = append(updatedList.Items, *rbg)
+		if meta.IsStatusConditionTrue(rbg.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupReady)) {
+			readyReplicas++
+		}
+	}
+
+	if currentRevision != updateRevision && len(rbgsToCreate) == 0 && readyReplicas >= desiredReplicas {
+		verified, err := r.verifyBlueGreen(ctx, rbgset, updateRevision)
+		if err != nil {
+			return err
+		}
+		if verified {
+			logger.Info("Switching to the new groups", "from", currentRevision, "to", updateRevision)
+			r.recorder.Eventf(rbgset, corev1.EventTypeNormal, SwitchedBlueGreen,
+				"Switched from revision %q to revision %s", currentRevision, updateRevision)
+			currentRevision = updateRevision
+		}
+	}
+
+	// The traffic only leaves the previous groups once the new ones serve it, the previous groups are
+	// kept until then.
+	if currentRevision != "" {
+		if err := r.applyBlueGreenService(ctx, rbgset, currentRevision); err != nil {
+			return err
+		}
+	}
+	if currentRevision == updateRevision {
+		if err := r.scaleDown(ctx, previous); err != nil {
+			return err
+		}
+	}
+
+	// Report the serving groups, the new groups until the switch only show up in the update revision.
+	if currentRevision != updateRevision {
+		updatedList.Items = nil
+		for _, rbg := range previous {
+			updatedList.Items = append(updatedList.Items, *rbg)
+		}
+	}
+	return r.updateStatus(ctx, rbgset, updatedList, currentRevision, updateRevision)
+}
+
+// verifyBlueGreen runs the verification Job of the new groups and reports whether it succeeded, the new
+// groups are verified when the strategy has no verification Job.
+func (r *RoleBasedGroupSetReconciler) verifyBlueGreen(
+	ctx context.Context, rbgset *workloadsv1alpha2.RoleBasedGroupSet, revision string,
+) (bool, error) {
+	blueGreen := rbgset.Spec.UpdateStrategy.BlueGreen
+	if blueGreen == nil || blueGreen.Verification == nil {
+		return true, nil
+	}
+	template := blueGreen.Verification
+	job := &batchv1.Job{}
+	name := fmt.Sprintf("%s-verify-%s", rbgset.Name, revision)
+	err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: rbgset.Namespace}, job)
+	if apierrors.IsNotFound(err) {
+		job = newBlueGreenVerificationJob(rbgset, template, name, revision)
+		if err := controllerutil.SetControllerReference(rbgset, job, r.scheme); err != nil {
+			return false, err
+		}
+		if err := r.client.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+			return false, fmt.Errorf("failed to create verification job %s: %w", name, err)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			// Stay on the serving groups, a new template starts a new verification.
+			r.recorder.Eventf(rbgset, corev1.EventTypeWarning, FailedBlueGreenVerification,
+				"Verification job %s of revision %s failed, keeping revision %q: %s",
+				name, revision, rbgset.Status.CurrentRevision, condition.Message)
+			return false, nil
+		}
+	}
+	return false, nil
+}
+
+// applyBlueGreenService points the Service of the set at the pods of the groups of revision.
+func (r *RoleBasedGroupSetReconciler) applyBlueGreenService(
+	ctx context.Context, rbgset *workloadsv1alpha2.RoleBasedGroupSet, revision string,
+) error {
+	blueGreen := rbgset.Spec.UpdateStrategy.BlueGreen
+	if blueGreen == nil || blueGreen.Service == nil {
+		return nil
+	}
+	service := blueGreen.Service
+	name := service.Name
+	if name == "" {
+		name = rbgset.Name
+	}
+	ports := make([]*coreapplyv1.ServicePortApplyConfiguration, 0, len(service.Ports))
+	for _, port := range service.Ports {
+		portConfig := coreapplyv1.ServicePort().
+			WithName(port.Name).
+			WithPort(port.Port)
+		if port.TargetPort != (intstr.IntOrString{}) {
+			portConfig.WithTargetPort(port.TargetPort)
+		}
+		if port.Protocol != "" {
+			portConfig.WithProtocol(port.Protocol)
+		}
+		if port.AppProtocol != nil {
+			portConfig.WithAppProtocol(*port.AppProtocol)
+		}
+		ports = append(ports, portConfig)
+	}
+	serviceConfig := coreapplyv1.Service(name, rbgset.Namespace).
+		WithLabels(map[string]string{constants.GroupSetNameLabelKey: rbgset.Name}).
+		WithOwnerReferences(
+			metaapplyv1.OwnerReference().
+				WithAPIVersion(workloadsv1alpha2.GroupVersion.String()).
+				WithKind("RoleBasedGroupSet").
+				WithName(rbgset.Name).
+				WithUID(rbgset.UID).
+				WithBlockOwnerDeletion(true).
+				WithController(true),
+		).
+		WithSpec(coreapplyv1.ServiceSpec().
+			WithSelector(map[string]string{
+				constants.GroupSetNameLabelKey:     rbgset.Name,
+				constants.GroupSetRevisionLabelKey: revision,
+				constants.RoleNameLabelKey:         service.Role,
+			}).
+			WithPorts(ports...))
+	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, serviceConfig, utils.PatchSpec); err != nil {
+		return fmt.Errorf("failed to apply service %s: %w", name, err)
+	}
+	return nil
+}
+
+// newBlueGreenRBGForSet creates the RoleBasedGroup of index for the revision of the set's template. The
+// revision is part of the name, so that the groups of two revisions exist side by side.
+func newBlueGreenRBGForSet(
+	rbgset *workloadsv1alpha2.RoleBasedGroupSet, revision string, index int,
+) *workloadsv1alpha2.RoleBasedGroup {
+	rbg := newRBGForSet(rbgset, index)
+	rbg.Name = fmt.Sprintf("%s-%s-%d", rbgset.Name, revision, index)
+	rbg.Labels[constants.GroupSetRevisionLabelKey] = revision
+	return rbg
+}
+
+func newBlueGreenVerificationJob(
+	rbgset *workloadsv1alpha2.RoleBasedGroupSet, template *batchv1.JobTemplateSpec, name, revision string,
+) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: *template.ObjectMeta.DeepCopy(),
+		Spec:       *template.Spec.DeepCopy(),
+	}
+	job.Name = name
+	job.Namespace = rbgset.Namespace
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[constants.GroupSetNameLabelKey] = rbgset.Name
+	job.Labels[constants.GroupSetRevisionLabelKey] = revision
+	env := []corev1.EnvVar{
+		{Name: constants.EnvRBGGroupSetName, Value: rbgset.Name},
+		{Name: constants.EnvRBGGroupSetRevision, Value: revision},
+	}
+	for i := range job.Spec.Template.Spec.Containers {
+		container := &job.Spec.Template.Spec.Containers[i]
+		container.Env = append(container.Env, env...)
+	}
+	return job
+}
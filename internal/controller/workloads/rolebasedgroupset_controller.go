@@ -23,6 +23,7 @@ import (
 	"sort"
 	"strconv"
 
+	batchv1 "k8s.io/api/batch/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -93,6 +94,15 @@ func (r *RoleBasedGroupSetReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
+	if rbgset.IsBlueGreen() {
+		if err := r.reconcileBlueGreen(ctx, rbgset, &rbglist); err != nil {
+			logger.Error(err, "Failed to reconcile the BlueGreen update")
+			return ctrl.Result{}, err
+		}
+		logger.Info("Successfully reconciled rbgset")
+		return ctrl.Result{}, nil
+	}
+
 	// 3. Calculate the difference between the desired state and the current state to determine which RBGs to create or delete.
 	// Map existing RBGs by their index label for efficient lookup.
 	existingRBGs := make(map[int]*workloadsv1alpha2.RoleBasedGroup)
@@ -195,7 +205,7 @@ func (r *RoleBasedGroupSetReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		logger.Error(err, "Failed to re-list child RoleBasedGroups for status update")
 		return ctrl.Result{}, err
 	}
-	if err := r.updateStatus(ctx, rbgset, &rbglist); err != nil {
+	if err := r.updateStatus(ctx, rbgset, &rbglist, "", ""); err != nil {
 		logger.Error(err, "Failed to update RoleBasedGroupSet status")
 		return ctrl.Result{}, err
 	}
@@ -265,15 +275,19 @@ func (r *RoleBasedGroupSetReconciler) scaleDown(
 	return utilerrors.NewAggregate(allErrs)
 }
 
-// updateStatus updates the status of the RoleBasedGroupSet.
+// updateStatus updates the status of the RoleBasedGroupSet. The revisions are only reported by the
+// BlueGreen update strategy.
 func (r *RoleBasedGroupSetReconciler) updateStatus(
 	ctx context.Context, rbgset *workloadsv1alpha2.RoleBasedGroupSet, rbglist *workloadsv1alpha2.RoleBasedGroupList,
+	currentRevision, updateRevision string,
 ) error {
 	logger := log.FromContext(ctx)
 
 	// Create a deep copy of the status to modify.
 	newStatus := *rbgset.Status.DeepCopy()
 	newStatus.Replicas = int32(len(rbglist.Items))
+	newStatus.CurrentRevision = currentRevision
+	newStatus.UpdateRevision = updateRevision
 
 	// Calculate the number of ready replicas.
 	readyReplicas := 0
@@ -374,10 +388,17 @@ func (r *RoleBasedGroupSetReconciler) rolesEqual(
 func (r *RoleBasedGroupSetReconciler) needsUpdate(
 	rbgset *workloadsv1alpha2.RoleBasedGroupSet, rbg *workloadsv1alpha2.RoleBasedGroup,
 ) bool {
-	// Check if the template spec has changed using order-insensitive comparison
+	// Check if the roles have changed using order-insensitive comparison
 	if !r.rolesEqual(rbg.Spec.Roles, rbgset.Spec.GroupTemplate.Spec.Roles) {
 		return true
 	}
+	// The rest of the spec is copied as a whole on update, so any other field of the template counts
+	spec := rbg.Spec.DeepCopy()
+	templateSpec := rbgset.Spec.GroupTemplate.Spec.DeepCopy()
+	spec.Roles, templateSpec.Roles = nil, nil
+	if !reflect.DeepEqual(spec, templateSpec) {
+		return true
+	}
 
 	// Check if labels from the template need to be propagated
 	if r.needsTemplateLabelUpdate(rbgset, rbg) {
@@ -455,7 +476,7 @@ func (r *RoleBasedGroupSetReconciler) updateExistingRBGs(
 				}
 
 				// Update the spec from template
-				latestRBG.Spec.Roles = rbgset.Spec.GroupTemplate.Spec.Roles
+				latestRBG.Spec = *rbgset.Spec.GroupTemplate.Spec.DeepCopy()
 
 				// Sync labels and annotations from the template
 				r.syncRBGMetadata(rbgset, latestRBG)
@@ -533,9 +554,7 @@ func newRBGForSet(rbgset *workloadsv1alpha2.RoleBasedGroupSet, index int) *workl
 			Annotations: rbgAnnotations,
 			// The OwnerReference will be set in the scaleUp function.
 		},
-		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
-			Roles: rbgset.Spec.GroupTemplate.Spec.Roles,
-		},
+		Spec: *rbgset.Spec.GroupTemplate.Spec.DeepCopy(),
 	}
 }
 
@@ -545,6 +564,7 @@ func (r *RoleBasedGroupSetReconciler) SetupWithManager(mgr ctrl.Manager, options
 		WithOptions(options).
 		For(&workloadsv1alpha2.RoleBasedGroupSet{}).
 		Owns(&workloadsv1alpha2.RoleBasedGroup{}).
+		Owns(&batchv1.Job{}).
 		Named("rbgset-controller").
 		Complete(r)
 }
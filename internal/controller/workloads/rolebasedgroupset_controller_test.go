@@ -22,11 +22,18 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -275,6 +282,64 @@ func TestRoleBasedGroupSetReconciler_needsUpdate(t *testing.T) {
 			},
 			expectedUpdate: true,
 		},
+		{
+			name: "RBG needs update - role templates changed",
+			rbgset: &workloadsv1alpha2.RoleBasedGroupSet{
+				Spec: workloadsv1alpha2.RoleBasedGroupSetSpec{
+					GroupTemplate: workloadsv1alpha2.RoleBasedGroupTemplateSpec{
+						Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+							Roles:         []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+							RoleTemplates: []workloadsv1alpha2.RoleTemplate{{Name: "base"}},
+						},
+					},
+				},
+			},
+			rbg: &workloadsv1alpha2.RoleBasedGroup{
+				Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+					Roles: []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+				},
+			},
+			expectedUpdate: true,
+		},
+		{
+			name: "RBG needs update - suspend changed",
+			rbgset: &workloadsv1alpha2.RoleBasedGroupSet{
+				Spec: workloadsv1alpha2.RoleBasedGroupSetSpec{
+					GroupTemplate: workloadsv1alpha2.RoleBasedGroupTemplateSpec{
+						Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+							Roles:   []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+							Suspend: ptr.To(true),
+						},
+					},
+				},
+			},
+			rbg: &workloadsv1alpha2.RoleBasedGroup{
+				Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+					Roles: []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+				},
+			},
+			expectedUpdate: true,
+		},
+		{
+			name: "RBG needs update - other spec field changed",
+			rbgset: &workloadsv1alpha2.RoleBasedGroupSet{
+				Spec: workloadsv1alpha2.RoleBasedGroupSetSpec{
+					GroupTemplate: workloadsv1alpha2.RoleBasedGroupTemplateSpec{
+						Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+							Roles:      []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+							Activation: &workloadsv1alpha2.ActivationPolicy{RetryAfterSeconds: 30},
+						},
+					},
+				},
+			},
+			rbg: &workloadsv1alpha2.RoleBasedGroup{
+				Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+					Roles:      []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+					Activation: &workloadsv1alpha2.ActivationPolicy{RetryAfterSeconds: 10},
+				},
+			},
+			expectedUpdate: true,
+		},
 		{
 			name: "RBG needs update - template annotation added",
 			rbgset: &workloadsv1alpha2.RoleBasedGroupSet{
@@ -835,6 +900,29 @@ func TestSyncRBGMetadata(t *testing.T) {
 	}
 }
 
+// TestNewRBGForSet_SpecPropagation tests that newRBGForSet copies the spec of the group template.
+func TestNewRBGForSet_SpecPropagation(t *testing.T) {
+	rbgset := &workloadsv1alpha2.RoleBasedGroupSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbgset", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSetSpec{
+			GroupTemplate: workloadsv1alpha2.RoleBasedGroupTemplateSpec{
+				Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+					Roles:         []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+					RoleTemplates: []workloadsv1alpha2.RoleTemplate{{Name: "base"}},
+					Suspend:       ptr.To(true),
+				},
+			},
+		},
+	}
+
+	rbg := newRBGForSet(rbgset, 0)
+	assert.Equal(t, rbgset.Spec.GroupTemplate.Spec, rbg.Spec)
+
+	// The child owns a copy of the template spec.
+	rbg.Spec.Roles[0].Name = "changed"
+	assert.Equal(t, "role-1", rbgset.Spec.GroupTemplate.Spec.Roles[0].Name)
+}
+
 // TestRoleBasedGroupSetReconciler_Reconcile_OptimizedOrder tests the optimized operation order.
 // This test verifies that when both role changes and replica reduction occur,
 // the controller deletes excess RBGs first, then updates remaining ones.
@@ -924,6 +1012,56 @@ func TestRoleBasedGroupSetReconciler_Reconcile_OptimizedOrder(t *testing.T) {
 	}
 }
 
+// TestRoleBasedGroupSetReconciler_Reconcile_SpecUpdate tests that a change of a group template field other
+// than the roles reaches the existing RBGs.
+func TestRoleBasedGroupSetReconciler_Reconcile_SpecUpdate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	rbgset := &workloadsv1alpha2.RoleBasedGroupSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbgset", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSetSpec{
+			Replicas: ptr.To(int32(1)),
+			GroupTemplate: workloadsv1alpha2.RoleBasedGroupTemplateSpec{
+				Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+					Roles:        []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+					Paused:       true,
+					AutoRollback: workloadsv1alpha2.AutoRollbackOnProgressDeadlineExceeded,
+				},
+			},
+		},
+	}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rbgset-0",
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.GroupSetNameLabelKey:  "test-rbgset",
+				constants.GroupSetIndexLabelKey: "0",
+			},
+		},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{{Name: "role-1"}},
+		},
+	}
+
+	r := &RoleBasedGroupSetReconciler{
+		client: fake.NewClientBuilder().WithScheme(scheme).
+			WithRuntimeObjects(rbgset, rbg).
+			WithStatusSubresource(&workloadsv1alpha2.RoleBasedGroupSet{}).Build(),
+		scheme: scheme,
+	}
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-rbgset"},
+	})
+	assert.NoError(t, err)
+
+	updated := &workloadsv1alpha2.RoleBasedGroup{}
+	assert.NoError(t, r.client.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "test-rbgset-0"}, updated))
+	assert.True(t, updated.Spec.Paused)
+	assert.Equal(t, workloadsv1alpha2.AutoRollbackOnProgressDeadlineExceeded, updated.Spec.AutoRollback)
+}
+
 // TestRoleBasedGroupSetReconciler_Reconcile_StatusUpdate tests the status update logic within the Reconcile loop.
 func TestRoleBasedGroupSetReconciler_Reconcile_StatusUpdate(t *testing.T) {
 	// Setup test scheme
@@ -1147,3 +1285,111 @@ func TestRoleBasedGroupSetReconciler_Reconcile_StatusUpdate(t *testing.T) {
 		)
 	}
 }
+
+// TestRoleBasedGroupSetReconciler_Reconcile_BlueGreen tests that the Service only switches to the new
+// groups once they are ready and verified, and that the previous groups are deleted afterwards.
+func TestRoleBasedGroupSetReconciler_Reconcile_BlueGreen(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	rbgset := &workloadsv1alpha2.RoleBasedGroupSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbgset", Namespace: "default", UID: "rbgset-uid"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSetSpec{
+			Replicas: ptr.To(int32(1)),
+			GroupTemplate: workloadsv1alpha2.RoleBasedGroupTemplateSpec{
+				Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+					Roles: []workloadsv1alpha2.RoleSpec{{Name: "new-role"}},
+				},
+			},
+			UpdateStrategy: &workloadsv1alpha2.RoleBasedGroupSetUpdateStrategy{
+				Type: workloadsv1alpha2.GroupSetBlueGreenUpdateStrategyType,
+				BlueGreen: &workloadsv1alpha2.BlueGreenUpdateStrategy{
+					Service: &workloadsv1alpha2.BlueGreenService{
+						Role:  "new-role",
+						Ports: []corev1.ServicePort{{Name: "http", Port: 8000}},
+					},
+					Verification: &batchv1.JobTemplateSpec{
+						Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "verify", Image: "curl"}},
+						}}},
+					},
+				},
+			},
+		},
+		Status: workloadsv1alpha2.RoleBasedGroupSetStatus{CurrentRevision: "old"},
+	}
+	oldRBG := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rbgset-old-0",
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.GroupSetNameLabelKey:     "test-rbgset",
+				constants.GroupSetIndexLabelKey:    "0",
+				constants.GroupSetRevisionLabelKey: "old",
+			},
+		},
+	}
+	revision, err := utils.HashObject(rbgset.Spec.GroupTemplate)
+	assert.NoError(t, err)
+
+	r := &RoleBasedGroupSetReconciler{
+		client: fake.NewClientBuilder().WithScheme(scheme).
+			WithRuntimeObjects(rbgset, oldRBG).
+			WithStatusSubresource(&workloadsv1alpha2.RoleBasedGroupSet{}, &workloadsv1alpha2.RoleBasedGroup{}).Build(),
+		scheme:   scheme,
+		recorder: record.NewFakeRecorder(10),
+	}
+	ctx := context.TODO()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-rbgset"}}
+	reconcile := func() {
+		_, err := r.Reconcile(ctx, req)
+		assert.NoError(t, err)
+	}
+	getService := func() *corev1.Service {
+		svc := &corev1.Service{}
+		assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test-rbgset"}, svc))
+		return svc
+	}
+
+	// The new group is created next to the serving one, the Service keeps pointing at the old revision.
+	reconcile()
+	newRBG := &workloadsv1alpha2.RoleBasedGroup{}
+	newKey := types.NamespacedName{Namespace: "default", Name: "test-rbgset-" + revision + "-0"}
+	assert.NoError(t, r.client.Get(ctx, newKey, newRBG))
+	assert.Equal(t, revision, newRBG.Labels[constants.GroupSetRevisionLabelKey])
+	assert.Equal(t, "old", getService().Spec.Selector[constants.GroupSetRevisionLabelKey])
+
+	// Once the new group is ready the verification Job runs before the switch.
+	meta.SetStatusCondition(&newRBG.Status.Conditions, metav1.Condition{
+		Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: metav1.ConditionTrue, Reason: "AllRolesReady",
+	})
+	assert.NoError(t, r.client.Status().Update(ctx, newRBG))
+	reconcile()
+	job := &batchv1.Job{}
+	jobKey := types.NamespacedName{Namespace: "default", Name: "test-rbgset-verify-" + revision}
+	assert.NoError(t, r.client.Get(ctx, jobKey, job))
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Env,
+		corev1.EnvVar{Name: constants.EnvRBGGroupSetRevision, Value: revision})
+	assert.Equal(t, "old", getService().Spec.Selector[constants.GroupSetRevisionLabelKey])
+	assert.NoError(t, r.client.Get(ctx, types.NamespacedName{Namespace: "default", Name: oldRBG.Name}, oldRBG))
+
+	// The succeeded verification switches the Service and deletes the previous group.
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	assert.NoError(t, r.client.Status().Update(ctx, job))
+	reconcile()
+	svc := getService()
+	assert.Equal(t, map[string]string{
+		constants.GroupSetNameLabelKey:     "test-rbgset",
+		constants.GroupSetRevisionLabelKey: revision,
+		constants.RoleNameLabelKey:         "new-role",
+	}, svc.Spec.Selector)
+	err = r.client.Get(ctx, types.NamespacedName{Namespace: "default", Name: oldRBG.Name}, oldRBG)
+	assert.True(t, apierrors.IsNotFound(err))
+
+	updatedSet := &workloadsv1alpha2.RoleBasedGroupSet{}
+	assert.NoError(t, r.client.Get(ctx, req.NamespacedName, updatedSet))
+	assert.Equal(t, revision, updatedSet.Status.CurrentRevision)
+	assert.Equal(t, revision, updatedSet.Status.UpdateRevision)
+	assert.Equal(t, int32(1), updatedSet.Status.ReadyReplicas)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/schedulinggate"
+)
+
+// schedulingGateRequeueInterval is how often a group with gated pods is checked again, as the readiness
+// of the dependencies of its roles is not watched.
+const schedulingGateRequeueInterval = 10 * time.Second
+
+// SchedulingGateReconciler releases the pods of RoleBasedGroups with staged admission, which hold a
+// scheduling gate until all pods of the roles able to start are created and the group is admitted.
+type SchedulingGateReconciler struct {
+	client   client.Client
+	recorder record.EventRecorder
+	manager  *schedulinggate.Manager
+}
+
+func NewSchedulingGateReconciler(mgr ctrl.Manager) *SchedulingGateReconciler {
+	return &SchedulingGateReconciler{
+		client:   mgr.GetClient(),
+		recorder: mgr.GetEventRecorderFor("SchedulingGate"),
+		manager:  schedulinggate.New(mgr.GetScheme(), mgr.GetClient()),
+	}
+}
+
+func (r *SchedulingGateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	if err := r.client.Get(ctx, req.NamespacedName, rbg); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !rbg.DeletionTimestamp.IsZero() || rbg.IsPaused() {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx).WithValues("rbg", klog.KObj(rbg))
+	ctx = ctrl.LoggerInto(ctx, logger)
+
+	result, err := r.manager.Release(ctx, rbg)
+	if result != nil && result.Released > 0 {
+		logger.Info("Released gated pods", "pods", result.Released)
+		r.recorder.Eventf(rbg, corev1.EventTypeNormal, ReleasedSchedulingGates,
+			"Released the scheduling gate of %d pods", result.Released)
+	}
+	if err != nil {
+		r.recorder.Eventf(rbg, corev1.EventTypeWarning, FailedReleaseSchedulingGates,
+			"Failed to release the scheduling gate of pods: %v", err)
+		return ctrl.Result{}, err
+	}
+	if result.Gated > 0 {
+		logger.V(1).Info("Pods are held by the scheduling gate", "pods", result.Gated, "reason", result.Reason)
+		return ctrl.Result{RequeueAfter: schedulingGateRequeueInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// podToRBG enqueues the group of a pod.
+func (r *SchedulingGateReconciler) podToRBG(ctx context.Context, obj client.Object) []reconcile.Request {
+	rbgName := obj.GetLabels()[constants.GroupNameLabelKey]
+	if rbgName == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: rbgName}}}
+}
+
+// SetupWithManager sets up the controller with the Manager. Only the groups with staged admission, or
+// which just had it turned off, and the creation of gated pods start the loop.
+func (r *SchedulingGateReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	usesStagedAdmission := func(obj client.Object) bool {
+		rbg, ok := obj.(*workloadsv1alpha2.RoleBasedGroup)
+		return ok && rbg.UsesStagedAdmission()
+	}
+	rbgPredicate := predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return usesStagedAdmission(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return usesStagedAdmission(e.ObjectOld) || usesStagedAdmission(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+	podPredicate := predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			pod, ok := e.Object.(*corev1.Pod)
+			return ok && schedulinggate.HasGate(pod)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		Named("workloads-rolebasedgroup-schedulinggate").
+		For(&workloadsv1alpha2.RoleBasedGroup{}, builder.WithPredicates(rbgPredicate)).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.podToRBG), builder.WithPredicates(podPredicate)).
+		Complete(r)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/schedulinggate"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestSchedulingGateReconciler_Reconcile(t *testing.T) {
+	schema := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(schema)
+	_ = workloadsv1alpha2.AddToScheme(schema)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithAnnotations(map[string]string{constants.StagedAdmissionAnnotationKey: "true"}).
+		WithRoles([]workloadsv1alpha2.RoleSpec{wrappersv2.BuildStandaloneRole("decode").WithReplicas(2).Obj()}).Obj()
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{{Name: "decode", Replicas: 2}}
+	pod := func(index int) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("test-rbg-decode-%d", index),
+				Namespace: "default",
+				Labels: map[string]string{
+					constants.GroupNameLabelKey: "test-rbg",
+					constants.RoleNameLabelKey:  "decode",
+				},
+			},
+			Spec: corev1.PodSpec{
+				SchedulingGates: []corev1.PodSchedulingGate{{Name: constants.StagedAdmissionSchedulingGate}},
+			},
+		}
+	}
+
+	fclient := fake.NewClientBuilder().WithScheme(schema).WithObjects(rbg, pod(0)).
+		WithStatusSubresource(&workloadsv1alpha2.RoleBasedGroup{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &SchedulingGateReconciler{
+		client:   fclient,
+		recorder: recorder,
+		manager:  schedulinggate.New(schema, fclient),
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-rbg"}}
+	gatedPods := func() int {
+		pods := &corev1.PodList{}
+		require.NoError(t, fclient.List(context.TODO(), pods, client.InNamespace("default")))
+		gated := 0
+		for i := range pods.Items {
+			if schedulinggate.HasGate(&pods.Items[i]) {
+				gated++
+			}
+		}
+		return gated
+	}
+
+	// The pod is held until the other pod of the role is created.
+	result, err := r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, schedulingGateRequeueInterval, result.RequeueAfter)
+	assert.Equal(t, 1, gatedPods())
+
+	require.NoError(t, fclient.Create(context.TODO(), pod(1)))
+	result, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, 0, gatedPods())
+	assert.Equal(t, "Normal ReleasedSchedulingGates Released the scheduling gate of 2 pods", <-recorder.Events)
+
+	// The pods of a paused group are left alone.
+	got := &workloadsv1alpha2.RoleBasedGroup{}
+	require.NoError(t, fclient.Get(context.TODO(), req.NamespacedName, got))
+	got.Spec.Paused = true
+	require.NoError(t, fclient.Update(context.TODO(), got))
+	require.NoError(t, fclient.Create(context.TODO(), pod(2)))
+	result, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, 1, gatedPods())
+}
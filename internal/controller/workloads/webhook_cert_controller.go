@@ -33,7 +33,8 @@ import (
 )
 
 // WebhookCertReconciler watches the conversion-webhook CRDs and keeps their
-// caBundle patched with the current CA certificate.
+// caBundle, and the ones of the admission webhook configurations, patched with
+// the current CA certificate.
 // It also re-patches on a fixed interval to recover from out-of-band changes.
 type WebhookCertReconciler struct {
 	client.Client
@@ -41,9 +42,16 @@ type WebhookCertReconciler struct {
 	CACert      []byte
 	// CRDNames is the list of CRD names whose caBundle should be kept in sync.
 	CRDNames []string
+	// ValidatingWebhookConfigurationName is the ValidatingWebhookConfiguration whose
+	// caBundle should be kept in sync, if any.
+	ValidatingWebhookConfigurationName string
+	// MutatingWebhookConfigurationName is the MutatingWebhookConfiguration whose
+	// caBundle should be kept in sync, if any.
+	MutatingWebhookConfigurationName string
 }
 
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations;mutatingwebhookconfigurations,verbs=get;list;watch;patch
 // Secret access is intentionally namespace-scoped (Role, not ClusterRole) and is managed
 // manually in config/rbac/secret_role.yaml rather than generated from markers below,
 // because kubebuilder markers do not support resourceNames scoping.
@@ -56,6 +64,18 @@ func (r *WebhookCertReconciler) Reconcile(ctx context.Context, req reconcile.Req
 		log.Error(err, "failed to patch caBundle on CRDs")
 		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
 	}
+	if r.ValidatingWebhookConfigurationName != "" {
+		if err := r.CertManager.PatchValidatingWebhookCABundle(ctx, r.ValidatingWebhookConfigurationName, r.CACert); err != nil {
+			log.Error(err, "failed to patch caBundle on ValidatingWebhookConfiguration")
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+	}
+	if r.MutatingWebhookConfigurationName != "" {
+		if err := r.CertManager.PatchMutatingWebhookCABundle(ctx, r.MutatingWebhookConfigurationName, r.CACert); err != nil {
+			log.Error(err, "failed to patch caBundle on MutatingWebhookConfiguration")
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+	}
 
 	// Re-check periodically in case the CRD is replaced or the caBundle is removed.
 	return reconcile.Result{RequeueAfter: 10 * time.Minute}, nil
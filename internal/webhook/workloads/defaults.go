@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+const (
+	// defaultEngineTerminationGracePeriodSeconds leaves engines time to finish in-flight
+	// requests, which often take longer than the 30s pod default.
+	defaultEngineTerminationGracePeriodSeconds int64 = 60
+	// engineHealthPath is the health endpoint served by SGLang and vLLM.
+	engineHealthPath = "/health"
+	// engineStartupFailureThreshold lets the startup probe wait up to 30 minutes
+	// for the model to be loaded.
+	engineStartupFailureThreshold int32 = 180
+)
+
+// engine describes an inference engine recognized from the container image.
+type engine struct {
+	// imageName is matched against the last path element of the image repository.
+	imageName string
+	// port is the port the engine serves HTTP on by default.
+	port int32
+}
+
+var knownEngines = []engine{
+	{imageName: "sglang", port: 30000},
+	{imageName: "vllm", port: 8000},
+}
+
+// engineOf returns the engine the container runs, if it is a known one.
+func engineOf(container *corev1.Container) (engine, bool) {
+	repository := container.Image
+	if i := strings.Index(repository, "@"); i >= 0 {
+		repository = repository[:i]
+	}
+	name := repository[strings.LastIndex(repository, "/")+1:]
+	if i := strings.Index(name, ":"); i >= 0 {
+		name = name[:i]
+	}
+	for _, e := range knownEngines {
+		if strings.Contains(name, e.imageName) {
+			return e, true
+		}
+	}
+	return engine{}, false
+}
+
+// defaultRoleBasedGroupSpec fills in the defaults of every pod template of the spec.
+func defaultRoleBasedGroupSpec(spec *workloadsv1alpha2.RoleBasedGroupSpec) {
+	for i := range spec.RoleTemplates {
+		defaultPodTemplate(&spec.RoleTemplates[i].Template, false)
+	}
+	for i := range spec.Roles {
+		role := &spec.Roles[i]
+		role.Labels = normalizeLabelKeys(role.Labels)
+		if role.StandalonePattern != nil && role.StandalonePattern.Template != nil {
+			defaultPodTemplate(role.StandalonePattern.Template, true)
+		}
+		// Only the leader of a multi-node engine serves HTTP, so the shared
+		// leader and worker template gets no probes.
+		if role.LeaderWorkerPattern != nil && role.LeaderWorkerPattern.Template != nil {
+			defaultPodTemplate(role.LeaderWorkerPattern.Template, false)
+		}
+		if role.CustomComponentsPattern != nil {
+			for j := range role.CustomComponentsPattern.Components {
+				defaultPodTemplate(&role.CustomComponentsPattern.Components[j].Template, false)
+			}
+		}
+	}
+}
+
+// defaultPodTemplate normalizes the template label keys and, for pods running a known
+// engine, sets the termination grace period and, when withProbes is set, engine probes.
+// Values the user set are never overwritten.
+func defaultPodTemplate(template *corev1.PodTemplateSpec, withProbes bool) {
+	template.Labels = normalizeLabelKeys(template.Labels)
+
+	runsEngine := false
+	for i := range template.Spec.Containers {
+		container := &template.Spec.Containers[i]
+		e, ok := engineOf(container)
+		if !ok {
+			continue
+		}
+		runsEngine = true
+		if withProbes {
+			defaultEngineProbes(container, e)
+		}
+	}
+	if runsEngine && template.Spec.TerminationGracePeriodSeconds == nil {
+		template.Spec.TerminationGracePeriodSeconds = ptr.To(defaultEngineTerminationGracePeriodSeconds)
+	}
+}
+
+// defaultEngineProbes adds readiness and startup probes on the engine health endpoint
+// to containers that declare no probes at all.
+func defaultEngineProbes(container *corev1.Container, e engine) {
+	if container.ReadinessProbe != nil || container.LivenessProbe != nil || container.StartupProbe != nil {
+		return
+	}
+	port := intstr.FromInt32(e.port)
+	if len(container.Ports) > 0 {
+		port = intstr.FromInt32(container.Ports[0].ContainerPort)
+	}
+	handler := corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{Path: engineHealthPath, Port: port},
+	}
+	container.ReadinessProbe = &corev1.Probe{
+		ProbeHandler:     handler,
+		PeriodSeconds:    10,
+		FailureThreshold: 3,
+	}
+	container.StartupProbe = &corev1.Probe{
+		ProbeHandler:     handler,
+		PeriodSeconds:    10,
+		FailureThreshold: engineStartupFailureThreshold,
+	}
+}
+
+// normalizeLabelKeys trims the label keys and lower-cases their prefix, which must be a
+// DNS subdomain. When two keys normalize to the same one, the key already in normal form wins.
+func normalizeLabelKeys(labels map[string]string) map[string]string {
+	var normalized map[string]string
+	for key := range labels {
+		if normalizeLabelKey(key) != key {
+			normalized = make(map[string]string, len(labels))
+			break
+		}
+	}
+	if normalized == nil {
+		return labels
+	}
+	for key, value := range labels {
+		if normalizeLabelKey(key) == key {
+			normalized[key] = value
+		}
+	}
+	// Sorted so that colliding keys resolve the same way on every request.
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		if n := normalizeLabelKey(key); n != key {
+			if _, exists := normalized[n]; !exists {
+				normalized[n] = labels[key]
+			}
+		}
+	}
+	return normalized
+}
+
+func normalizeLabelKey(key string) string {
+	key = strings.TrimSpace(key)
+	if i := strings.Index(key, "/"); i >= 0 {
+		return strings.ToLower(strings.TrimSpace(key[:i])) + "/" + strings.TrimSpace(key[i+1:])
+	}
+	return key
+}
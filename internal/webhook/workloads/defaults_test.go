@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func engineTemplate(image string, ports ...int32) *corev1.PodTemplateSpec {
+	container := corev1.Container{Name: "engine", Image: image}
+	for _, port := range ports {
+		container.Ports = append(container.Ports, corev1.ContainerPort{ContainerPort: port})
+	}
+	return &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{container}}}
+}
+
+func TestEngineOf(t *testing.T) {
+	cases := map[string]string{
+		"lmsysorg/sglang:v0.4.9":                 "sglang",
+		"registry.local:5000/vllm/vllm-openai":   "vllm",
+		"vllm/vllm-openai@sha256:0123456789abcd": "vllm",
+		"nginx:1.27":                             "",
+		"registry.sglang.io/nginx":               "",
+	}
+	for image, want := range cases {
+		e, ok := engineOf(&corev1.Container{Image: image})
+		assert.Equal(t, want != "", ok, image)
+		assert.Equal(t, want, e.imageName, image)
+	}
+}
+
+func TestRoleBasedGroupCustomDefaulter_Default(t *testing.T) {
+	standalone := wrappersv2.BuildStandaloneRole("decode").WithTemplate(engineTemplate("lmsysorg/sglang:v0.4.9")).Obj()
+	withPort := wrappersv2.BuildStandaloneRole("prefill").WithTemplate(engineTemplate("vllm/vllm-openai:v0.9.0", 9000)).Obj()
+	probed := wrappersv2.BuildStandaloneRole("router").WithTemplate(engineTemplate("lmsysorg/sglang:v0.4.9")).Obj()
+	probed.StandalonePattern.Template.Spec.Containers[0].LivenessProbe = &corev1.Probe{}
+	probed.StandalonePattern.Template.Spec.TerminationGracePeriodSeconds = ptr.To[int64](10)
+	leaderWorker := wrappersv2.BuildLeaderWorkerRole("multi-node").Obj()
+	leaderWorker.LeaderWorkerPattern.Template = engineTemplate("lmsysorg/sglang:v0.4.9")
+	plain := wrappersv2.BuildStandaloneRole("proxy").Obj()
+	plain.Labels = map[string]string{" Example.COM/Team ": "infra", "example.com/Team": "serving"}
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{standalone, withPort, probed, leaderWorker, plain}).Obj()
+	rbg.Labels["RBG.Example.com/owner"] = "me"
+	require.NoError(t, (&RoleBasedGroupCustomDefaulter{}).Default(context.TODO(), rbg))
+
+	decode := rbg.Spec.Roles[0].StandalonePattern.Template
+	assert.Equal(t, ptr.To(defaultEngineTerminationGracePeriodSeconds), decode.Spec.TerminationGracePeriodSeconds)
+	engine := decode.Spec.Containers[0]
+	require.NotNil(t, engine.ReadinessProbe)
+	require.NotNil(t, engine.StartupProbe)
+	assert.Nil(t, engine.LivenessProbe)
+	assert.Equal(t, &corev1.HTTPGetAction{Path: "/health", Port: intstr.FromInt32(30000)}, engine.ReadinessProbe.HTTPGet)
+	assert.Equal(t, engineStartupFailureThreshold, engine.StartupProbe.FailureThreshold)
+
+	prefill := rbg.Spec.Roles[1].StandalonePattern.Template.Spec.Containers[0]
+	assert.Equal(t, intstr.FromInt32(9000), prefill.ReadinessProbe.HTTPGet.Port)
+
+	router := rbg.Spec.Roles[2].StandalonePattern.Template
+	assert.Nil(t, router.Spec.Containers[0].ReadinessProbe)
+	assert.Equal(t, ptr.To[int64](10), router.Spec.TerminationGracePeriodSeconds)
+
+	multiNode := rbg.Spec.Roles[3].LeaderWorkerPattern.Template
+	assert.Nil(t, multiNode.Spec.Containers[0].ReadinessProbe)
+	assert.Equal(t, ptr.To(defaultEngineTerminationGracePeriodSeconds), multiNode.Spec.TerminationGracePeriodSeconds)
+
+	proxy := rbg.Spec.Roles[4]
+	assert.Nil(t, proxy.StandalonePattern.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, map[string]string{"example.com/Team": "serving"}, proxy.Labels)
+
+	assert.Equal(t, "me", rbg.Labels["rbg.example.com/owner"])
+	assert.NotContains(t, rbg.Labels, "RBG.Example.com/owner")
+}
+
+func TestRoleBasedGroupSetCustomDefaulter_Default(t *testing.T) {
+	rbgset := wrappersv2.BuildBasicRoleBasedGroupSet("test-rbgset", "default").Obj()
+	rbgset.Spec.GroupTemplate.Labels = map[string]string{"Example.com/app": "llm"}
+	rbgset.Spec.GroupTemplate.Spec.Roles = []workloadsv1alpha2.RoleSpec{
+		wrappersv2.BuildStandaloneRole("decode").WithTemplate(engineTemplate("lmsysorg/sglang:v0.4.9")).Obj(),
+	}
+	require.NoError(t, (&RoleBasedGroupSetCustomDefaulter{}).Default(context.TODO(), rbgset))
+
+	assert.Equal(t, map[string]string{"example.com/app": "llm"}, rbgset.Spec.GroupTemplate.Labels)
+	template := rbgset.Spec.GroupTemplate.Spec.Roles[0].StandalonePattern.Template
+	assert.NotNil(t, template.Spec.Containers[0].ReadinessProbe)
+
+	// Defaulting the groups created from the template must be a no-op, or the set
+	// controller would keep reverting them.
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbgset-0", "default").Obj()
+	rbg.Spec = *rbgset.Spec.GroupTemplate.Spec.DeepCopy()
+	require.NoError(t, (&RoleBasedGroupCustomDefaulter{}).Default(context.TODO(), rbg))
+	assert.Equal(t, rbgset.Spec.GroupTemplate.Spec, rbg.Spec)
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+// GPUCapacityCheck defines how RoleBasedGroups requesting more GPUs than the cluster has are admitted.
+type GPUCapacityCheck string
+
+const (
+	// GPUCapacityCheckNone admits groups without looking at the GPUs of the nodes.
+	GPUCapacityCheckNone GPUCapacityCheck = "none"
+	// GPUCapacityCheckWarn admits groups exceeding the GPU capacity with a warning.
+	GPUCapacityCheckWarn GPUCapacityCheck = "warn"
+	// GPUCapacityCheckDeny rejects groups exceeding the GPU capacity.
+	GPUCapacityCheckDeny GPUCapacityCheck = "deny"
+)
+
+// ParseGPUCapacityCheck validates the value of the GPU capacity check flag.
+func ParseGPUCapacityCheck(value string) (GPUCapacityCheck, error) {
+	switch check := GPUCapacityCheck(value); check {
+	case GPUCapacityCheckNone, GPUCapacityCheckWarn, GPUCapacityCheckDeny:
+		return check, nil
+	default:
+		return "", fmt.Errorf("invalid GPU capacity check %q: supported values are %q, %q and %q",
+			value, GPUCapacityCheckNone, GPUCapacityCheckWarn, GPUCapacityCheckDeny)
+	}
+}
+
+// gpuDemand is the GPUs a group requests, per pod of every role and in total.
+type gpuDemand struct {
+	perPod []map[corev1.ResourceName]int64
+	total  map[corev1.ResourceName]int64
+}
+
+func newGPUDemand(ctx context.Context, reader client.Reader, rbg *workloadsv1alpha2.RoleBasedGroup) gpuDemand {
+	demand := gpuDemand{
+		perPod: make([]map[corev1.ResourceName]int64, len(rbg.Spec.Roles)),
+		total:  map[corev1.ResourceName]int64{},
+	}
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		// Custom components have one template per component, only the pod templates of the
+		// standalone and leader-worker patterns are checked.
+		if role.GetCustomComponentsPattern() != nil {
+			continue
+		}
+		template, err := utils.ResolveRoleTemplate(ctx, reader, rbg, role)
+		if err != nil {
+			continue
+		}
+		pods := int64(ptr.Deref(role.Replicas, 1))
+		if size := role.GetLeaderWorkerSize(); size != nil {
+			pods *= int64(*size)
+		}
+		demand.perPod[i] = utils.PodSpecGPUs(&template.Spec)
+		for name, count := range demand.perPod[i] {
+			demand.total[name] += count * pods
+		}
+	}
+	return demand
+}
+
+// exceeds reports whether the demand asks for more GPUs than old, per pod of a role or in total.
+// A nil old demand is exceeded by any GPU request.
+func (d gpuDemand) exceeds(old *gpuDemand, roles, oldRoles []workloadsv1alpha2.RoleSpec) bool {
+	if old == nil {
+		return len(d.total) > 0
+	}
+	for name, count := range d.total {
+		if count > old.total[name] {
+			return true
+		}
+	}
+	oldPerPod := make(map[string]map[corev1.ResourceName]int64, len(oldRoles))
+	for i := range oldRoles {
+		oldPerPod[oldRoles[i].Name] = old.perPod[i]
+	}
+	for i := range roles {
+		for name, count := range d.perPod[i] {
+			if count > oldPerPod[roles[i].Name][name] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validateGPUCapacity compares the GPU requests of rbg with the GPUs the schedulable nodes allocate:
+// a pod of a role must fit the largest node and the whole group the cluster. On updates, only groups
+// requesting more GPUs than before are checked, so that a group can still be scaled down. The
+// problems are returned as warnings or errors depending on the check, and the check is skipped with
+// a warning when the nodes cannot be listed.
+func validateGPUCapacity(
+	ctx context.Context,
+	reader client.Reader,
+	check GPUCapacityCheck,
+	rbg, oldRBG *workloadsv1alpha2.RoleBasedGroup,
+) (admission.Warnings, field.ErrorList) {
+	if reader == nil || check == "" || check == GPUCapacityCheckNone || rbg.Spec.MultiCluster != nil {
+		return nil, nil
+	}
+	demand := newGPUDemand(ctx, reader, rbg)
+	var oldDemand *gpuDemand
+	var oldRoles []workloadsv1alpha2.RoleSpec
+	if oldRBG != nil {
+		d := newGPUDemand(ctx, reader, oldRBG)
+		oldDemand, oldRoles = &d, oldRBG.Spec.Roles
+	}
+	if !demand.exceeds(oldDemand, rbg.Spec.Roles, oldRoles) {
+		return nil, nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := reader.List(ctx, nodes); err != nil {
+		return admission.Warnings{fmt.Sprintf("GPU capacity check skipped, failed to list nodes: %v", err)}, nil
+	}
+	largest := map[corev1.ResourceName]int64{}
+	capacity := map[corev1.ResourceName]int64{}
+	for i := range nodes.Items {
+		if nodes.Items[i].Spec.Unschedulable {
+			continue
+		}
+		for name, count := range utils.NodeGPUs(&nodes.Items[i]) {
+			capacity[name] += count
+			largest[name] = max(largest[name], count)
+		}
+	}
+
+	var allErrs field.ErrorList
+	rolesPath := field.NewPath("spec", "roles")
+	for i := range rbg.Spec.Roles {
+		for _, name := range sortedResourceNames(demand.perPod[i]) {
+			if count := demand.perPod[i][name]; count > largest[name] {
+				allErrs = append(allErrs, field.Forbidden(rolesPath.Index(i), fmt.Sprintf(
+					"role %s requests %d %s per pod, the largest schedulable node allocates %d",
+					rbg.Spec.Roles[i].Name, count, name, largest[name])))
+			}
+		}
+	}
+	for _, name := range sortedResourceNames(demand.total) {
+		if count := demand.total[name]; count > capacity[name] {
+			allErrs = append(allErrs, field.Forbidden(rolesPath, fmt.Sprintf(
+				"group requests %d %s in total, the schedulable nodes allocate %d", count, name, capacity[name])))
+		}
+	}
+
+	if check == GPUCapacityCheckDeny {
+		return nil, allErrs
+	}
+	var warnings admission.Warnings
+	for _, err := range allErrs {
+		warnings = append(warnings, err.Error())
+	}
+	return warnings, nil
+}
+
+func sortedResourceNames(resources map[corev1.ResourceName]int64) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func gpuNode(name string, gpus int64, unschedulable bool) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+		Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+			"nvidia.com/gpu": *resource.NewQuantity(gpus, resource.DecimalSI),
+		}},
+	}
+}
+
+func gpuRole(name string, replicas int32, gpus int64) workloadsv1alpha2.RoleSpec {
+	template := wrappersv2.BuildBasicPodTemplateSpec()
+	template.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+		"nvidia.com/gpu": *resource.NewQuantity(gpus, resource.DecimalSI),
+	}
+	return wrappersv2.BuildStandaloneRole(name).WithReplicas(replicas).WithTemplate(&template).Obj()
+}
+
+func TestValidateGPUCapacity(t *testing.T) {
+	nodes := []*corev1.Node{
+		gpuNode("node-a", 8, false),
+		gpuNode("node-b", 4, false),
+		gpuNode("node-c", 16, true),
+	}
+	tests := []struct {
+		name         string
+		check        GPUCapacityCheck
+		roles        []workloadsv1alpha2.RoleSpec
+		oldRoles     []workloadsv1alpha2.RoleSpec
+		wantFields   []string
+		wantWarnings int
+	}{
+		{
+			name:  "fits the cluster",
+			check: GPUCapacityCheckDeny,
+			roles: []workloadsv1alpha2.RoleSpec{gpuRole("decode", 1, 8), gpuRole("prefill", 1, 4)},
+		},
+		{
+			name:  "check disabled",
+			check: GPUCapacityCheckNone,
+			roles: []workloadsv1alpha2.RoleSpec{gpuRole("decode", 1, 16)},
+		},
+		{
+			name:       "pod larger than every schedulable node",
+			check:      GPUCapacityCheckDeny,
+			roles:      []workloadsv1alpha2.RoleSpec{gpuRole("decode", 1, 16)},
+			wantFields: []string{"spec.roles[0]", "spec.roles"},
+		},
+		{
+			name:       "group larger than the cluster",
+			check:      GPUCapacityCheckDeny,
+			roles:      []workloadsv1alpha2.RoleSpec{gpuRole("decode", 2, 8)},
+			wantFields: []string{"spec.roles"},
+		},
+		{
+			name:         "warn only",
+			check:        GPUCapacityCheckWarn,
+			roles:        []workloadsv1alpha2.RoleSpec{gpuRole("decode", 1, 16)},
+			wantWarnings: 2,
+		},
+		{
+			name:     "scaling down an oversized group",
+			check:    GPUCapacityCheckDeny,
+			roles:    []workloadsv1alpha2.RoleSpec{gpuRole("decode", 2, 8)},
+			oldRoles: []workloadsv1alpha2.RoleSpec{gpuRole("decode", 3, 8)},
+		},
+		{
+			name:       "scaling up an oversized group",
+			check:      GPUCapacityCheckDeny,
+			roles:      []workloadsv1alpha2.RoleSpec{gpuRole("decode", 3, 8)},
+			oldRoles:   []workloadsv1alpha2.RoleSpec{gpuRole("decode", 2, 8)},
+			wantFields: []string{"spec.roles"},
+		},
+	}
+
+	reader := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+		WithObjects(nodes[0], nodes[1], nodes[2]).Build()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := &RoleBasedGroupCustomValidator{reader: reader, gpuCapacityCheck: tt.check}
+			rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithRoles(tt.roles).Obj()
+			var warnings []string
+			var err error
+			if tt.oldRoles == nil {
+				warnings, err = validator.ValidateCreate(context.TODO(), rbg)
+			} else {
+				oldRBG := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithRoles(tt.oldRoles).Obj()
+				warnings, err = validator.ValidateUpdate(context.TODO(), oldRBG, rbg)
+			}
+			assert.Len(t, warnings, tt.wantWarnings)
+			if len(tt.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.True(t, apierrors.IsInvalid(err), "expected an Invalid error, got %v", err)
+			var fields []string
+			for _, cause := range err.(*apierrors.StatusError).Status().Details.Causes {
+				fields = append(fields, cause.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestParseGPUCapacityCheck(t *testing.T) {
+	check, err := ParseGPUCapacityCheck("warn")
+	require.NoError(t, err)
+	assert.Equal(t, GPUCapacityCheckWarn, check)
+
+	_, err = ParseGPUCapacityCheck("reject")
+	assert.Error(t, err)
+}
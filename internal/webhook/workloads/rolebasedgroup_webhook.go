@@ -0,0 +1,623 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// supportedWorkloadTypes lists the role workload types the controller can reconcile.
+var supportedWorkloadTypes = []string{
+	constants.RoleInstanceSetWorkloadType,
+	constants.StatefulSetWorkloadType,
+	constants.DeploymentWorkloadType,
+	constants.LeaderWorkerSetWorkloadType,
+	constants.JobWorkloadType,
+	constants.KruiseCloneSetWorkloadType,
+	constants.KruiseStatefulSetWorkloadType,
+}
+
+// inPlaceWorkloadTypes lists the role workload types that can update pods in place.
+var inPlaceWorkloadTypes = []string{
+	constants.RoleInstanceSetWorkloadType,
+	constants.KruiseCloneSetWorkloadType,
+	constants.KruiseStatefulSetWorkloadType,
+}
+
+// SetupRoleBasedGroupWebhookWithManager registers the RoleBasedGroup defaulting and validating webhooks.
+// The nodes are read from the cache of the manager when gpuCapacityCheck is not none.
+func SetupRoleBasedGroupWebhookWithManager(mgr ctrl.Manager, gpuCapacityCheck GPUCapacityCheck) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&workloadsv1alpha2.RoleBasedGroup{}).
+		WithDefaulter(&RoleBasedGroupCustomDefaulter{}).
+		WithValidator(&RoleBasedGroupCustomValidator{reader: mgr.GetClient(), gpuCapacityCheck: gpuCapacityCheck}).
+		Complete()
+}
+
+// Defaults are only applied on creation: applying them to the templates of existing
+// groups would roll out their pods on the next unrelated update.
+// +kubebuilder:webhook:path=/mutate-workloads-x-k8s-io-v1alpha2-rolebasedgroup,mutating=true,failurePolicy=fail,sideEffects=None,groups=workloads.x-k8s.io,resources=rolebasedgroups,verbs=create,versions=v1alpha2,name=mrolebasedgroup.workloads.x-k8s.io,admissionReviewVersions=v1
+
+// RoleBasedGroupCustomDefaulter normalizes label keys and fills in engine defaults in
+// the pod templates of a RoleBasedGroup.
+type RoleBasedGroupCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &RoleBasedGroupCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *RoleBasedGroupCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	rbg, ok := obj.(*workloadsv1alpha2.RoleBasedGroup)
+	if !ok {
+		return fmt.Errorf("expected a RoleBasedGroup object but got %T", obj)
+	}
+	rbg.Labels = normalizeLabelKeys(rbg.Labels)
+	defaultRoleBasedGroupSpec(&rbg.Spec)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-workloads-x-k8s-io-v1alpha2-rolebasedgroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=workloads.x-k8s.io,resources=rolebasedgroups,verbs=create;update,versions=v1alpha2,name=vrolebasedgroup.workloads.x-k8s.io,admissionReviewVersions=v1
+
+// RoleBasedGroupCustomValidator rejects RoleBasedGroups the controller would fail to
+// reconcile, reporting every problem with the field path it was found at. Groups requesting more
+// GPUs than the nodes allocate are reported according to gpuCapacityCheck.
+type RoleBasedGroupCustomValidator struct {
+	reader           client.Reader
+	gpuCapacityCheck GPUCapacityCheck
+}
+
+var _ admission.CustomValidator = &RoleBasedGroupCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *RoleBasedGroupCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	rbg, ok := obj.(*workloadsv1alpha2.RoleBasedGroup)
+	if !ok {
+		return nil, fmt.Errorf("expected a RoleBasedGroup object but got %T", obj)
+	}
+	return v.validateRoleBasedGroup(ctx, rbg, nil)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *RoleBasedGroupCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	rbg, ok := newObj.(*workloadsv1alpha2.RoleBasedGroup)
+	if !ok {
+		return nil, fmt.Errorf("expected a RoleBasedGroup object but got %T", newObj)
+	}
+	oldRBG, ok := oldObj.(*workloadsv1alpha2.RoleBasedGroup)
+	if !ok {
+		return nil, fmt.Errorf("expected a RoleBasedGroup object but got %T", oldObj)
+	}
+	// Let finalizer removal go through on objects that are already being deleted.
+	if rbg.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	return v.validateRoleBasedGroup(ctx, rbg, oldRBG)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *RoleBasedGroupCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateRoleBasedGroup validates rbg, oldRBG is the group being updated and is nil on creation.
+// An update leaving the spec unchanged, like the finalizers and annotations set by the controller,
+// does not validate the spec again, and only the errors the old group did not have are reported: the
+// groups created before a rule was added can still be updated.
+func (v *RoleBasedGroupCustomValidator) validateRoleBasedGroup(
+	ctx context.Context, rbg, oldRBG *workloadsv1alpha2.RoleBasedGroup,
+) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	rolesPath := field.NewPath("spec", "roles")
+	specChanged := oldRBG == nil || !equality.Semantic.DeepEqual(oldRBG.Spec, rbg.Spec)
+	allErrs := validateFields(rbg, specChanged)
+	if oldRBG != nil {
+		allErrs = newErrors(rbg, oldRBG, allErrs, validateFields(oldRBG, specChanged))
+		allErrs = append(allErrs, validateWorkloadTypeUnchanged(oldRBG.Spec.Roles, rbg.Spec.Roles, rolesPath)...)
+	}
+
+	if len(allErrs) == 0 && rbg.Annotations[constants.GangSchedulingAnnotationKey] == "true" {
+		warnings = append(warnings, gangSchedulingWarnings(rbg)...)
+	}
+	if len(allErrs) == 0 && specChanged {
+		capacityWarnings, capacityErrs := validateGPUCapacity(ctx, v.reader, v.gpuCapacityCheck, rbg, oldRBG)
+		warnings = append(warnings, capacityWarnings...)
+		allErrs = append(allErrs, capacityErrs...)
+	}
+	if len(allErrs) > 0 {
+		return warnings, apierrors.NewInvalid(
+			workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroup").GroupKind(), rbg.Name, allErrs)
+	}
+	return warnings, nil
+}
+
+// validateFields validates the annotations of rbg and, when validateSpec is set, its spec.
+func validateFields(rbg *workloadsv1alpha2.RoleBasedGroup, validateSpec bool) field.ErrorList {
+	rolesPath := field.NewPath("spec", "roles")
+	allErrs := validateGangAnnotations(rbg)
+	allErrs = append(allErrs, validateStagedAdmission(rbg, rolesPath)...)
+	if !validateSpec {
+		return allErrs
+	}
+
+	names := make(map[string]bool, len(rbg.Spec.Roles))
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		rolePath := rolesPath.Index(i)
+		if names[role.Name] {
+			allErrs = append(allErrs, field.Duplicate(rolePath.Child("name"), role.Name))
+		}
+		names[role.Name] = true
+		allErrs = append(allErrs, validateRole(role, rolePath)...)
+	}
+	allErrs = append(allErrs, validateDependencies(rbg.Spec.Roles, names, rolesPath)...)
+	allErrs = append(allErrs, validateGroupDependencies(rbg)...)
+	allErrs = append(allErrs, validateTerminationPolicy(rbg.Spec.TerminationPolicy, names)...)
+	allErrs = append(allErrs, validatePlacementPolicy(rbg.Spec.PlacementPolicy, names)...)
+	allErrs = append(allErrs, validateMultiCluster(rbg, rolesPath)...)
+	allErrs = append(allErrs, validateNetworking(rbg.Spec.Networking, names)...)
+	allErrs = append(allErrs, validateMonitoring(rbg.Spec.Monitoring, names)...)
+	allErrs = append(allErrs, validateGroupAutoscaling(rbg, rolesPath)...)
+	return allErrs
+}
+
+// newErrors returns the errors of rbg the old group did not have, and the errors of its roles that changed.
+func newErrors(rbg, oldRBG *workloadsv1alpha2.RoleBasedGroup, allErrs, oldErrs field.ErrorList) field.ErrorList {
+	var errs field.ErrorList
+	for _, err := range allErrs {
+		old, ok := oldError(rbg, oldRBG, err)
+		if !ok || !slices.ContainsFunc(oldErrs, func(e *field.Error) bool { return equality.Semantic.DeepEqual(e, old) }) {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// oldError returns err as it is reported for the old group, at the index its role had there. The errors
+// of a role that was added or changed have none.
+func oldError(rbg, oldRBG *workloadsv1alpha2.RoleBasedGroup, err *field.Error) (*field.Error, bool) {
+	var i int
+	if _, scanErr := fmt.Sscanf(err.Field, "spec.roles[%d]", &i); scanErr != nil || i >= len(rbg.Spec.Roles) {
+		return err, true
+	}
+	role := &rbg.Spec.Roles[i]
+	j := slices.IndexFunc(oldRBG.Spec.Roles, func(old workloadsv1alpha2.RoleSpec) bool { return old.Name == role.Name })
+	if j < 0 || !equality.Semantic.DeepEqual(&oldRBG.Spec.Roles[j], role) {
+		return nil, false
+	}
+	old := *err
+	old.Field = fmt.Sprintf("spec.roles[%d]", j) + strings.TrimPrefix(err.Field, fmt.Sprintf("spec.roles[%d]", i))
+	return &old, true
+}
+
+func validateGangAnnotations(rbg *workloadsv1alpha2.RoleBasedGroup) field.ErrorList {
+	if rbg.Annotations[constants.GangSchedulingAnnotationKey] != "true" ||
+		rbg.Annotations[constants.RoleInstanceGangSchedulingAnnotationKey] != "true" {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(
+		field.NewPath("metadata", "annotations").Key(constants.RoleInstanceGangSchedulingAnnotationKey), "true",
+		fmt.Sprintf("cannot be set together with %q; set it per role via role annotations instead",
+			constants.GangSchedulingAnnotationKey))}
+}
+
+// validateStagedAdmission rejects roles creating their pods one by one in groups with staged admission,
+// as the pods are only released once all of them are created and would never be.
+func validateStagedAdmission(rbg *workloadsv1alpha2.RoleBasedGroup, rolesPath *field.Path) field.ErrorList {
+	if !rbg.UsesStagedAdmission() {
+		return nil
+	}
+	var allErrs field.ErrorList
+	for i := range rbg.Spec.Roles {
+		if rbg.Spec.Roles[i].PodManagementPolicy == constants.OrderedReadyPodManagement {
+			allErrs = append(allErrs, field.Invalid(rolesPath.Index(i).Child("podManagementPolicy"),
+				rbg.Spec.Roles[i].PodManagementPolicy,
+				fmt.Sprintf("cannot be used together with the %q annotation", constants.StagedAdmissionAnnotationKey)))
+		}
+	}
+	return allErrs
+}
+
+func validateRole(role *workloadsv1alpha2.RoleSpec, rolePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, msg := range validation.IsDNS1123Label(role.Name) {
+		allErrs = append(allErrs, field.Invalid(rolePath.Child("name"), role.Name, msg))
+	}
+
+	if role.Replicas == nil {
+		allErrs = append(allErrs, field.Required(rolePath.Child("replicas"), ""))
+	} else if *role.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(rolePath.Child("replicas"), *role.Replicas, "must be greater than or equal to 0"))
+	}
+
+	workloadType := role.Annotations[constants.RoleWorkloadTypeAnnotationKey]
+	if workloadType == "" {
+		workloadType = constants.RoleInstanceSetWorkloadType
+	} else if workloadType == constants.JobSetWorkloadType {
+		allErrs = append(allErrs, field.Forbidden(
+			rolePath.Child("annotations").Key(constants.RoleWorkloadTypeAnnotationKey),
+			fmt.Sprintf("JobSet is not supported yet, use %s for one-shot roles", constants.JobWorkloadType)))
+		return allErrs
+	} else if !isSupportedWorkloadType(workloadType) {
+		allErrs = append(allErrs, field.NotSupported(
+			rolePath.Child("annotations").Key(constants.RoleWorkloadTypeAnnotationKey), workloadType, supportedWorkloadTypes))
+		return allErrs
+	}
+	allErrs = append(allErrs, validatePattern(role, workloadType, rolePath)...)
+	if role.TTLSecondsAfterFinished != nil && workloadType != constants.JobWorkloadType {
+		allErrs = append(allErrs, field.Forbidden(rolePath.Child("ttlSecondsAfterFinished"),
+			fmt.Sprintf("is only supported by workload type %s", constants.JobWorkloadType)))
+	}
+	allErrs = append(allErrs, validateAutoscaling(role, rolePath)...)
+	return append(allErrs, validateRolloutStrategy(role, workloadType, rolePath)...)
+}
+
+// validateAutoscaling rejects autoscaled roles that are also scaled through the scaling adapter, as
+// both would update the replicas of the role, and targets the metric cannot be divided by.
+func validateAutoscaling(role *workloadsv1alpha2.RoleSpec, rolePath *field.Path) field.ErrorList {
+	if role.Autoscaling == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	autoscalingPath := rolePath.Child("autoscaling")
+	if role.ScalingAdapter != nil && role.ScalingAdapter.Enable {
+		allErrs = append(allErrs, field.Forbidden(autoscalingPath, "may not be set together with scalingAdapter.enable"))
+	}
+	if role.Autoscaling.Target.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(autoscalingPath.Child("target"),
+			role.Autoscaling.Target.String(), "must be greater than 0"))
+	}
+	return allErrs
+}
+
+func isSupportedWorkloadType(workloadType string) bool {
+	for _, t := range supportedWorkloadTypes {
+		if t == workloadType {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePattern(role *workloadsv1alpha2.RoleSpec, workloadType string, rolePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	var set []string
+	if role.StandalonePattern != nil {
+		set = append(set, "standalonePattern")
+	}
+	if role.LeaderWorkerPattern != nil {
+		set = append(set, "leaderWorkerPattern")
+	}
+	if role.CustomComponentsPattern != nil {
+		set = append(set, "customComponentsPattern")
+	}
+	switch len(set) {
+	case 0:
+		return field.ErrorList{field.Required(rolePath,
+			"one of standalonePattern, leaderWorkerPattern or customComponentsPattern must be set")}
+	case 1:
+	default:
+		return field.ErrorList{field.Forbidden(rolePath.Child(set[1]),
+			fmt.Sprintf("may not be set together with %s", set[0]))}
+	}
+
+	if role.LeaderWorkerPattern != nil {
+		lwpPath := rolePath.Child("leaderWorkerPattern")
+		if workloadType != constants.RoleInstanceSetWorkloadType && workloadType != constants.LeaderWorkerSetWorkloadType {
+			allErrs = append(allErrs, field.Forbidden(lwpPath,
+				fmt.Sprintf("is not supported by workload type %s", workloadType)))
+		}
+		if size := role.LeaderWorkerPattern.Size; size != nil && *size < 1 {
+			allErrs = append(allErrs, field.Invalid(lwpPath.Child("size"), *size, "must be greater than or equal to 1"))
+		}
+	}
+	if role.CustomComponentsPattern != nil && workloadType != constants.RoleInstanceSetWorkloadType {
+		allErrs = append(allErrs, field.Forbidden(rolePath.Child("customComponentsPattern"),
+			fmt.Sprintf("is only supported by workload type %s", constants.RoleInstanceSetWorkloadType)))
+	}
+	return allErrs
+}
+
+// validateRolloutStrategy rejects in-place only updates on workloads that can only roll out by
+// recreating pods. InPlaceIfPossible is accepted everywhere as it falls back to recreation.
+func validateRolloutStrategy(role *workloadsv1alpha2.RoleSpec, workloadType string, rolePath *field.Path) field.ErrorList {
+	if role.RolloutStrategy == nil || role.RolloutStrategy.RollingUpdate == nil {
+		return nil
+	}
+	if role.RolloutStrategy.RollingUpdate.Type == workloadsv1alpha2.InPlaceOnlyUpdateStrategyType &&
+		!slices.Contains(inPlaceWorkloadTypes, workloadType) {
+		return field.ErrorList{field.Forbidden(rolePath.Child("rolloutStrategy", "rollingUpdate", "type"),
+			fmt.Sprintf("%s is only supported by workload types %s",
+				workloadsv1alpha2.InPlaceOnlyUpdateStrategyType, strings.Join(inPlaceWorkloadTypes, ", ")))}
+	}
+	return nil
+}
+
+// validateTerminationPolicy reports unknown and repeated roles in the termination order.
+func validateTerminationPolicy(policy *workloadsv1alpha2.TerminationPolicy, names map[string]bool) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	orderPath := field.NewPath("spec", "terminationPolicy", "order")
+	seen := make(map[string]bool, len(policy.Order))
+	for i, name := range policy.Order {
+		switch {
+		case !names[name]:
+			allErrs = append(allErrs, field.NotFound(orderPath.Index(i), name))
+		case seen[name]:
+			allErrs = append(allErrs, field.Duplicate(orderPath.Index(i), name))
+		}
+		seen[name] = true
+	}
+	return allErrs
+}
+
+func validatePlacementPolicy(policy *workloadsv1alpha2.PlacementPolicy, names map[string]bool) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	rolesPath := field.NewPath("spec", "placementPolicy", "coLocateRoles")
+	for i, name := range policy.CoLocateRoles {
+		if !names[name] {
+			allErrs = append(allErrs, field.NotFound(rolesPath.Index(i), name))
+		}
+	}
+	return allErrs
+}
+
+// validateNetworking rejects inference pools over unknown roles, and roles served by more than one pool,
+// as the pods of a role are labeled with the name of their pool.
+func validateNetworking(networking *workloadsv1alpha2.NetworkingPolicy, names map[string]bool) field.ErrorList {
+	if networking == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	poolsPath := field.NewPath("spec", "networking", "inferencePools")
+	pools := make(map[string]bool, len(networking.InferencePools))
+	poolOfRole := make(map[string]string)
+	for i, pool := range networking.InferencePools {
+		poolPath := poolsPath.Index(i)
+		if pools[pool.Name] {
+			allErrs = append(allErrs, field.Duplicate(poolPath.Child("name"), pool.Name))
+		}
+		pools[pool.Name] = true
+		for j, name := range pool.Roles {
+			rolePath := poolPath.Child("roles").Index(j)
+			if !names[name] {
+				allErrs = append(allErrs, field.NotFound(rolePath, name))
+				continue
+			}
+			if other, found := poolOfRole[name]; found && other != pool.Name {
+				allErrs = append(allErrs, field.Invalid(rolePath, name,
+					fmt.Sprintf("role is already served by inference pool %s", other)))
+				continue
+			}
+			poolOfRole[name] = pool.Name
+		}
+	}
+	return allErrs
+}
+
+func validateMonitoring(policy *workloadsv1alpha2.MonitoringPolicy, names map[string]bool) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	rolesPath := field.NewPath("spec", "monitoring", "roles")
+	for i, role := range policy.Roles {
+		if !names[role.Name] {
+			allErrs = append(allErrs, field.NotFound(rolesPath.Index(i).Child("name"), role.Name))
+		}
+	}
+	return allErrs
+}
+
+// validateGroupAutoscaling rejects roles of spec.autoscaling that are not in the group or are also scaled
+// by their own autoscaling or the scaling adapter, and objectives the value cannot be divided by.
+func validateGroupAutoscaling(rbg *workloadsv1alpha2.RoleBasedGroup, rolesPath *field.Path) field.ErrorList {
+	autoscaling := rbg.Spec.Autoscaling
+	if autoscaling == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	autoscalingPath := field.NewPath("spec", "autoscaling")
+	roleIndex := make(map[string]int, len(rbg.Spec.Roles))
+	for i := range rbg.Spec.Roles {
+		roleIndex[rbg.Spec.Roles[i].Name] = i
+	}
+	for i, scaled := range autoscaling.Roles {
+		index, found := roleIndex[scaled.Name]
+		if !found {
+			allErrs = append(allErrs, field.NotFound(autoscalingPath.Child("roles").Index(i).Child("name"), scaled.Name))
+			continue
+		}
+		role := &rbg.Spec.Roles[index]
+		if role.Autoscaling != nil {
+			allErrs = append(allErrs, field.Forbidden(rolesPath.Index(index).Child("autoscaling"),
+				"may not be set on a role scaled by spec.autoscaling"))
+		}
+		if role.ScalingAdapter != nil && role.ScalingAdapter.Enable {
+			allErrs = append(allErrs, field.Forbidden(rolesPath.Index(index).Child("scalingAdapter", "enable"),
+				"may not be set on a role scaled by spec.autoscaling"))
+		}
+	}
+	for _, slo := range []struct {
+		name      string
+		objective *workloadsv1alpha2.ServiceLevelObjective
+	}{
+		{name: "ttft", objective: autoscaling.TTFT},
+		{name: "tpot", objective: autoscaling.TPOT},
+		{name: "queueLength", objective: autoscaling.QueueLength},
+	} {
+		if slo.objective != nil && slo.objective.Target.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(autoscalingPath.Child(slo.name, "target"),
+				slo.objective.Target.String(), "must be greater than 0"))
+		}
+	}
+	return allErrs
+}
+
+// validateWorkloadTypeUnchanged rejects changing the workload type of an existing role: the
+// controller looks up the children of a role by its workload type, a child of the former type
+// would be left behind. The role has to be removed first, which deletes its workload, and then
+// added back with the new type.
+func validateWorkloadTypeUnchanged(oldRoles, roles []workloadsv1alpha2.RoleSpec, rolesPath *field.Path) field.ErrorList {
+	oldTypes := make(map[string]string, len(oldRoles))
+	for i := range oldRoles {
+		oldTypes[oldRoles[i].Name] = oldRoles[i].GetWorkloadType()
+	}
+	var allErrs field.ErrorList
+	for i := range roles {
+		oldType, ok := oldTypes[roles[i].Name]
+		if !ok || oldType == roles[i].GetWorkloadType() {
+			continue
+		}
+		allErrs = append(allErrs, field.Forbidden(
+			rolesPath.Index(i).Child("annotations").Key(constants.RoleWorkloadTypeAnnotationKey),
+			fmt.Sprintf("workload type of role %s cannot be changed from %s, remove the role and add it back instead",
+				roles[i].Name, oldType)))
+	}
+	return allErrs
+}
+
+// validateDependencies reports dependencies on unknown roles and every dependency
+// cycle once, at the dependencies of the role the cycle was first entered from.
+func validateDependencies(roles []workloadsv1alpha2.RoleSpec, names map[string]bool, rolesPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	index := make(map[string]int, len(roles))
+	for i := range roles {
+		if _, ok := index[roles[i].Name]; !ok {
+			index[roles[i].Name] = i
+		}
+		for j, dep := range roles[i].Dependencies {
+			if !names[dep] {
+				allErrs = append(allErrs, field.NotFound(rolesPath.Index(i).Child("dependencies").Index(j), dep))
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(roles))
+	var stack []string
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = visiting
+		stack = append(stack, roles[i].Name)
+		for _, dep := range roles[i].Dependencies {
+			j, ok := index[dep]
+			if !ok {
+				continue
+			}
+			switch state[j] {
+			case unvisited:
+				visit(j)
+			case visiting:
+				start := 0
+				for k := range stack {
+					if stack[k] == dep {
+						start = k
+					}
+				}
+				cycle := append(append([]string{}, stack[start:]...), dep)
+				allErrs = append(allErrs, field.Invalid(rolesPath.Index(j).Child("dependencies"), roles[j].Dependencies,
+					"dependency cycle "+strings.Join(cycle, " -> ")))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[i] = visited
+	}
+	for i := range roles {
+		if state[i] == unvisited {
+			visit(i)
+		}
+	}
+	return allErrs
+}
+
+// multiClusterWorkloadTypes lists the role workload types Karmada aggregates the status of.
+var multiClusterWorkloadTypes = []string{
+	constants.StatefulSetWorkloadType,
+	constants.DeploymentWorkloadType,
+}
+
+// validateMultiCluster rejects multi-cluster groups whose roles run a workload type the status of
+// which would not be aggregated back from the member clusters.
+func validateMultiCluster(rbg *workloadsv1alpha2.RoleBasedGroup, rolesPath *field.Path) field.ErrorList {
+	if rbg.Spec.MultiCluster == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	for i := range rbg.Spec.Roles {
+		workloadType := rbg.Spec.Roles[i].GetWorkloadType()
+		if !slices.Contains(multiClusterWorkloadTypes, workloadType) {
+			allErrs = append(allErrs, field.Forbidden(
+				rolesPath.Index(i).Child("annotations").Key(constants.RoleWorkloadTypeAnnotationKey),
+				fmt.Sprintf("workload type %s is not supported with spec.multiCluster, use %s",
+					workloadType, strings.Join(multiClusterWorkloadTypes, " or "))))
+		}
+	}
+	return allErrs
+}
+
+// validateGroupDependencies rejects a group depending on itself, which would never start.
+func validateGroupDependencies(rbg *workloadsv1alpha2.RoleBasedGroup) field.ErrorList {
+	var allErrs field.ErrorList
+	dependsOnPath := field.NewPath("spec", "dependsOn")
+	for i, dep := range rbg.Spec.DependsOn {
+		if dep.Name == rbg.Name {
+			allErrs = append(allErrs, field.Invalid(dependsOnPath.Index(i).Child("name"), dep.Name,
+				"a group cannot depend on itself"))
+		}
+	}
+	return allErrs
+}
+
+// gangSchedulingWarnings flags roles that add no pods to the gang. The PodGroup
+// minMember is the group size, so an empty group would never gate scheduling.
+func gangSchedulingWarnings(rbg *workloadsv1alpha2.RoleBasedGroup) admission.Warnings {
+	var warnings admission.Warnings
+	for i := range rbg.Spec.Roles {
+		if *rbg.Spec.Roles[i].Replicas == 0 {
+			warnings = append(warnings, fmt.Sprintf(
+				"spec.roles[%d].replicas: role %s has 0 replicas and does not count towards the PodGroup minMember",
+				i, rbg.Spec.Roles[i].Name))
+		}
+	}
+	if rbg.GetGroupSize() == 0 {
+		warnings = append(warnings, "gang scheduling is enabled but the group has no pods, the PodGroup minMember would be 0")
+	}
+	return warnings
+}
@@ -0,0 +1,427 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestRoleBasedGroupCustomValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		roles        []workloadsv1alpha2.RoleSpec
+		dependsOn    []workloadsv1alpha2.GroupDependency
+		termination  *workloadsv1alpha2.TerminationPolicy
+		placement    *workloadsv1alpha2.PlacementPolicy
+		multiCluster *workloadsv1alpha2.MultiClusterPolicy
+		networking   *workloadsv1alpha2.NetworkingPolicy
+		monitoring   *workloadsv1alpha2.MonitoringPolicy
+		autoscaling  *workloadsv1alpha2.GroupAutoscaling
+		wantFields   []string
+		wantWarnings int
+	}{
+		{
+			name: "valid group",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").Obj(),
+				wrappersv2.BuildStandaloneRole("decode").WithDependencies([]string{"prefill"}).Obj(),
+				wrappersv2.BuildLeaderWorkerRole("router").WithWorkload("leaderworkerset.x-k8s.io/v1", "LeaderWorkerSet").Obj(),
+			},
+		},
+		{
+			name:       "group depending on itself",
+			roles:      []workloadsv1alpha2.RoleSpec{wrappersv2.BuildStandaloneRole("decode").Obj()},
+			dependsOn:  []workloadsv1alpha2.GroupDependency{{Name: "embedding"}, {Name: "test-rbg"}},
+			wantFields: []string{"spec.dependsOn[1].name"},
+		},
+		{
+			name: "duplicate role names",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+			},
+			wantFields: []string{"spec.roles[1].name"},
+		},
+		{
+			name: "invalid role name",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("Decode").Obj(),
+			},
+			wantFields: []string{"spec.roles[0].name"},
+		},
+		{
+			name: "negative and missing replicas",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").WithReplicas(-1).Obj(),
+				func() workloadsv1alpha2.RoleSpec {
+					role := wrappersv2.BuildStandaloneRole("decode").Obj()
+					role.Replicas = nil
+					return role
+				}(),
+			},
+			wantFields: []string{"spec.roles[0].replicas", "spec.roles[1].replicas"},
+		},
+		{
+			name: "unsupported workload type",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "DaemonSet").Obj(),
+			},
+			wantFields: []string{"spec.roles[0].annotations[rbg.workloads.x-k8s.io/role-workload-type]"},
+		},
+		{
+			name: "leader worker pattern on a StatefulSet",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildLeaderWorkerRole("decode").WithWorkload("apps/v1", "StatefulSet").Obj(),
+			},
+			wantFields: []string{"spec.roles[0].leaderWorkerPattern"},
+		},
+		{
+			name: "JobSet workload type",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("eval").WithWorkload("jobset.x-k8s.io/v1alpha2", "JobSet").Obj(),
+			},
+			wantFields: []string{"spec.roles[0].annotations[rbg.workloads.x-k8s.io/role-workload-type]"},
+		},
+		{
+			name: "leader worker pattern on a Job",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("download").WithWorkload("batch/v1", "Job").Obj(),
+				wrappersv2.BuildLeaderWorkerRole("decode").WithWorkload("batch/v1", "Job").Obj(),
+			},
+			wantFields: []string{"spec.roles[1].leaderWorkerPattern"},
+		},
+		{
+			name: "ttl after finished on a non-Job role",
+			roles: []workloadsv1alpha2.RoleSpec{
+				withTTLAfterFinished(wrappersv2.BuildStandaloneRole("download").WithWorkload("batch/v1", "Job").Obj()),
+				withTTLAfterFinished(wrappersv2.BuildStandaloneRole("decode").Obj()),
+			},
+			wantFields: []string{"spec.roles[1].ttlSecondsAfterFinished"},
+		},
+		{
+			name: "autoscaling with the scaling adapter and a zero target",
+			roles: []workloadsv1alpha2.RoleSpec{
+				withAutoscaling(wrappersv2.BuildStandaloneRole("prefill").Obj(), "4"),
+				withAutoscaling(wrappersv2.BuildStandaloneRole("decode").WithScalingAdapter(true).Obj(), "4"),
+				withAutoscaling(wrappersv2.BuildStandaloneRole("router").Obj(), "0"),
+			},
+			wantFields: []string{"spec.roles[1].autoscaling", "spec.roles[2].autoscaling.target"},
+		},
+		{
+			name: "invalid leader worker size",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildLeaderWorkerRole("decode").WithSize(0).Obj(),
+			},
+			wantFields: []string{"spec.roles[0].leaderWorkerPattern.size"},
+		},
+		{
+			name: "in-place only update",
+			roles: []workloadsv1alpha2.RoleSpec{
+				inPlaceOnly(wrappersv2.BuildStandaloneRole("decode").Obj()),
+				inPlaceOnly(wrappersv2.BuildStandaloneRole("prefill").WithWorkload("apps/v1", "StatefulSet").Obj()),
+				inPlaceOnly(wrappersv2.BuildStandaloneRole("router").WithWorkload("apps.kruise.io/v1alpha1", "CloneSet").Obj()),
+			},
+			wantFields: []string{"spec.roles[1].rolloutStrategy.rollingUpdate.type"},
+		},
+		{
+			name: "leader worker pattern on a CloneSet",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildLeaderWorkerRole("decode").WithWorkload("apps.kruise.io/v1alpha1", "CloneSet").Obj(),
+			},
+			wantFields: []string{"spec.roles[0].leaderWorkerPattern"},
+		},
+		{
+			name: "missing pattern",
+			roles: []workloadsv1alpha2.RoleSpec{
+				{Name: "decode", Replicas: ptr.To[int32](1)},
+			},
+			wantFields: []string{"spec.roles[0]"},
+		},
+		{
+			name: "unknown dependency",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("decode").WithDependencies([]string{"prefill"}).Obj(),
+			},
+			wantFields: []string{"spec.roles[0].dependencies[0]"},
+		},
+		{
+			name: "dependency cycle",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("router").WithDependencies([]string{"decode"}).Obj(),
+				wrappersv2.BuildStandaloneRole("prefill").WithDependencies([]string{"decode"}).Obj(),
+				wrappersv2.BuildStandaloneRole("decode").WithDependencies([]string{"prefill"}).Obj(),
+			},
+			wantFields: []string{"spec.roles[2].dependencies"},
+		},
+		{
+			name: "ordered termination",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("router").Obj(),
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+			},
+			termination: &workloadsv1alpha2.TerminationPolicy{Order: []string{"router", "decode"}},
+		},
+		{
+			name: "termination order with unknown and repeated roles",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("router").Obj(),
+			},
+			termination: &workloadsv1alpha2.TerminationPolicy{Order: []string{"router", "prefill", "router"}},
+			wantFields:  []string{"spec.terminationPolicy.order[1]", "spec.terminationPolicy.order[2]"},
+		},
+		{
+			name: "placement co-locating an unknown role",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").Obj(),
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+			},
+			placement:  &workloadsv1alpha2.PlacementPolicy{CoLocateRoles: []string{"prefill", "decode", "router"}},
+			wantFields: []string{"spec.placementPolicy.coLocateRoles[2]"},
+		},
+		{
+			name: "multi-cluster group with a RoleInstanceSet role",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").WithWorkload("apps/v1", "Deployment").Obj(),
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+			},
+			multiCluster: &workloadsv1alpha2.MultiClusterPolicy{Clusters: []string{"member1", "member2"}},
+			wantFields:   []string{"spec.roles[1].annotations[rbg.workloads.x-k8s.io/role-workload-type]"},
+		},
+		{
+			name: "inference pools over unknown and shared roles",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").Obj(),
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+			},
+			networking: &workloadsv1alpha2.NetworkingPolicy{InferencePools: []workloadsv1alpha2.InferencePoolSpec{
+				{Name: "pd", Roles: []string{"prefill", "decode"}, TargetPort: 8000},
+				{Name: "decode", Roles: []string{"decode", "router"}, TargetPort: 8000},
+			}},
+			wantFields: []string{"spec.networking.inferencePools[1].roles[0]", "spec.networking.inferencePools[1].roles[1]"},
+		},
+		{
+			name: "monitoring an unknown role",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+			},
+			monitoring: &workloadsv1alpha2.MonitoringPolicy{Roles: []workloadsv1alpha2.RoleMonitoring{
+				{Name: "decode", Port: "metrics"},
+				{Name: "router", Port: "metrics"},
+			}},
+			wantFields: []string{"spec.monitoring.roles[1].name"},
+		},
+		{
+			name:        "conflicting gang scheduling annotations",
+			annotations: map[string]string{constants.GangSchedulingAnnotationKey: "true", constants.RoleInstanceGangSchedulingAnnotationKey: "true"},
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("decode").Obj(),
+			},
+			wantFields: []string{"metadata.annotations[rbg.workloads.x-k8s.io/role-instance-gang-scheduling]"},
+		},
+		{
+			name:        "gang scheduling with an empty role",
+			annotations: map[string]string{constants.GangSchedulingAnnotationKey: "true"},
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").Obj(),
+				wrappersv2.BuildStandaloneRole("decode").WithReplicas(0).Obj(),
+			},
+			wantWarnings: 1,
+		},
+		{
+			name:        "gang scheduling with no pods",
+			annotations: map[string]string{constants.GangSchedulingAnnotationKey: "true"},
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("decode").WithReplicas(0).Obj(),
+			},
+			wantWarnings: 2,
+		},
+		{
+			name: "group autoscaling of missing and otherwise scaled roles",
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").Obj(),
+				func() workloadsv1alpha2.RoleSpec {
+					role := wrappersv2.BuildStandaloneRole("decode").Obj()
+					role.ScalingAdapter = &workloadsv1alpha2.ScalingAdapter{Enable: true}
+					return role
+				}(),
+			},
+			autoscaling: &workloadsv1alpha2.GroupAutoscaling{
+				Roles: []workloadsv1alpha2.GroupAutoscalingRole{
+					{Name: "prefill", Replicas: 2}, {Name: "decode", Replicas: 1}, {Name: "router", Replicas: 1},
+				},
+				MaxGPUs:       16,
+				ServerAddress: "http://prometheus:9090",
+				TTFT:          &workloadsv1alpha2.ServiceLevelObjective{Query: "ttft", Target: resource.MustParse("0")},
+			},
+			wantFields: []string{
+				"spec.roles[1].scalingAdapter.enable", "spec.autoscaling.roles[2].name", "spec.autoscaling.ttft.target",
+			},
+		},
+		{
+			name:        "staged admission with ordered pod creation",
+			annotations: map[string]string{constants.StagedAdmissionAnnotationKey: "true"},
+			roles: []workloadsv1alpha2.RoleSpec{
+				wrappersv2.BuildStandaloneRole("prefill").Obj(),
+				func() workloadsv1alpha2.RoleSpec {
+					role := wrappersv2.BuildStandaloneRole("decode").Obj()
+					role.PodManagementPolicy = constants.OrderedReadyPodManagement
+					return role
+				}(),
+			},
+			wantFields: []string{"spec.roles[1].podManagementPolicy"},
+		},
+	}
+
+	validator := &RoleBasedGroupCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+				WithAnnotations(tt.annotations).WithRoles(tt.roles).Obj()
+			rbg.Spec.DependsOn = tt.dependsOn
+			rbg.Spec.TerminationPolicy = tt.termination
+			rbg.Spec.PlacementPolicy = tt.placement
+			rbg.Spec.MultiCluster = tt.multiCluster
+			rbg.Spec.Networking = tt.networking
+			rbg.Spec.Monitoring = tt.monitoring
+			rbg.Spec.Autoscaling = tt.autoscaling
+			warnings, err := validator.ValidateCreate(context.TODO(), rbg)
+			assert.Len(t, warnings, tt.wantWarnings)
+			if len(tt.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.True(t, apierrors.IsInvalid(err), "expected an Invalid error, got %v", err)
+			var fields []string
+			for _, cause := range err.(*apierrors.StatusError).Status().Details.Causes {
+				fields = append(fields, cause.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func withTTLAfterFinished(role workloadsv1alpha2.RoleSpec) workloadsv1alpha2.RoleSpec {
+	role.TTLSecondsAfterFinished = ptr.To(int32(300))
+	return role
+}
+
+func withAutoscaling(role workloadsv1alpha2.RoleSpec, target string) workloadsv1alpha2.RoleSpec {
+	role.Autoscaling = &workloadsv1alpha2.RoleAutoscaling{
+		MaxReplicas:  4,
+		EngineMetric: &workloadsv1alpha2.EngineLoadMetric{Port: 8000, Metric: "vllm:num_requests_waiting"},
+		Target:       resource.MustParse(target),
+	}
+	return role
+}
+
+func inPlaceOnly(role workloadsv1alpha2.RoleSpec) workloadsv1alpha2.RoleSpec {
+	role.RolloutStrategy = &workloadsv1alpha2.RolloutStrategy{
+		Type:          workloadsv1alpha2.RollingUpdateStrategyType,
+		RollingUpdate: &workloadsv1alpha2.RollingUpdate{Type: workloadsv1alpha2.InPlaceOnlyUpdateStrategyType},
+	}
+	return role
+}
+
+func TestRoleBasedGroupCustomValidator_DependencyCycleMessage(t *testing.T) {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithRoles([]workloadsv1alpha2.RoleSpec{
+		wrappersv2.BuildStandaloneRole("prefill").WithDependencies([]string{"decode"}).Obj(),
+		wrappersv2.BuildStandaloneRole("decode").WithDependencies([]string{"prefill"}).Obj(),
+	}).Obj()
+	_, err := (&RoleBasedGroupCustomValidator{}).ValidateCreate(context.TODO(), rbg)
+	assert.ErrorContains(t, err, "dependency cycle prefill -> decode -> prefill")
+}
+
+func TestRoleBasedGroupCustomValidator_ValidateUpdate(t *testing.T) {
+	validator := &RoleBasedGroupCustomValidator{}
+	oldObj := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	newObj := oldObj.DeepCopy()
+	newObj.Spec.Roles[0].Replicas = ptr.To[int32](-1)
+
+	_, err := validator.ValidateUpdate(context.TODO(), oldObj, newObj)
+	assert.True(t, apierrors.IsInvalid(err))
+
+	now := metav1.Now()
+	newObj.DeletionTimestamp = &now
+	_, err = validator.ValidateUpdate(context.TODO(), oldObj, newObj)
+	assert.NoError(t, err)
+}
+
+func TestRoleBasedGroupCustomValidator_ValidateUpdate_ExistingErrors(t *testing.T) {
+	validator := &RoleBasedGroupCustomValidator{}
+	// The group was created before ttlSecondsAfterFinished was restricted to Job roles.
+	oldObj := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithRoles([]workloadsv1alpha2.RoleSpec{
+		wrappersv2.BuildStandaloneRole("prefill").WithReplicas(1).WithWorkload("apps/v1", "StatefulSet").Obj(),
+		wrappersv2.BuildStandaloneRole("decode").WithReplicas(1).WithWorkload("apps/v1", "StatefulSet").Obj(),
+	}).Obj()
+	oldObj.Spec.Roles[0].TTLSecondsAfterFinished = ptr.To[int32](60)
+	_, err := validator.ValidateCreate(context.TODO(), oldObj)
+	assert.True(t, apierrors.IsInvalid(err))
+
+	// Metadata updates, like the finalizers and annotations set by the controller, go through.
+	newObj := oldObj.DeepCopy()
+	newObj.Finalizers = append(newObj.Finalizers, constants.OrderedTerminationFinalizer)
+	newObj.Annotations = map[string]string{"example.com/note": "updated"}
+	_, err = validator.ValidateUpdate(context.TODO(), oldObj, newObj)
+	assert.NoError(t, err)
+
+	// So do spec updates leaving the invalid role alone, even when the roles are reordered.
+	newObj.Spec.Roles[1].Replicas = ptr.To[int32](2)
+	newObj.Spec.Roles[0], newObj.Spec.Roles[1] = newObj.Spec.Roles[1], newObj.Spec.Roles[0]
+	_, err = validator.ValidateUpdate(context.TODO(), oldObj, newObj)
+	assert.NoError(t, err)
+	newObj.Spec.Roles[0], newObj.Spec.Roles[1] = newObj.Spec.Roles[1], newObj.Spec.Roles[0]
+
+	// Changing the invalid field reports it.
+	newObj.Spec.Roles[0].TTLSecondsAfterFinished = ptr.To[int32](120)
+	_, err = validator.ValidateUpdate(context.TODO(), oldObj, newObj)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.ErrorContains(t, err, "spec.roles[0].ttlSecondsAfterFinished")
+}
+
+func TestRoleBasedGroupCustomValidator_ValidateUpdate_WorkloadType(t *testing.T) {
+	validator := &RoleBasedGroupCustomValidator{}
+	oldObj := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").WithRoles([]workloadsv1alpha2.RoleSpec{
+		wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "StatefulSet").Obj(),
+	}).Obj()
+
+	newObj := oldObj.DeepCopy()
+	newObj.Spec.Roles[0] = wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "Deployment").Obj()
+	_, err := validator.ValidateUpdate(context.TODO(), oldObj, newObj)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.ErrorContains(t, err, "workload type of role decode cannot be changed from apps/v1/StatefulSet")
+
+	// Removing the role and adding it back with another type goes through.
+	removed := oldObj.DeepCopy()
+	removed.Spec.Roles = append(removed.Spec.Roles[:0], wrappersv2.BuildStandaloneRole("prefill").Obj())
+	_, err = validator.ValidateUpdate(context.TODO(), oldObj, removed)
+	assert.NoError(t, err)
+	_, err = validator.ValidateUpdate(context.TODO(), removed, newObj)
+	assert.NoError(t, err)
+}
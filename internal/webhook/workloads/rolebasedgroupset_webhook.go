@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// SetupRoleBasedGroupSetWebhookWithManager registers the RoleBasedGroupSet defaulting webhook.
+func SetupRoleBasedGroupSetWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&workloadsv1alpha2.RoleBasedGroupSet{}).
+		WithDefaulter(&RoleBasedGroupSetCustomDefaulter{}).
+		Complete()
+}
+
+// The group template is defaulted like a RoleBasedGroup, otherwise the set controller
+// would revert the defaults of the groups it creates to match its template.
+// +kubebuilder:webhook:path=/mutate-workloads-x-k8s-io-v1alpha2-rolebasedgroupset,mutating=true,failurePolicy=fail,sideEffects=None,groups=workloads.x-k8s.io,resources=rolebasedgroupsets,verbs=create,versions=v1alpha2,name=mrolebasedgroupset.workloads.x-k8s.io,admissionReviewVersions=v1
+
+// RoleBasedGroupSetCustomDefaulter applies the RoleBasedGroup defaults to the group template.
+type RoleBasedGroupSetCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &RoleBasedGroupSetCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *RoleBasedGroupSetCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	rbgset, ok := obj.(*workloadsv1alpha2.RoleBasedGroupSet)
+	if !ok {
+		return fmt.Errorf("expected a RoleBasedGroupSet object but got %T", obj)
+	}
+	rbgset.Labels = normalizeLabelKeys(rbgset.Labels)
+	rbgset.Spec.GroupTemplate.Labels = normalizeLabelKeys(rbgset.Spec.GroupTemplate.Labels)
+	defaultRoleBasedGroupSpec(&rbgset.Spec.GroupTemplate.Spec)
+	return nil
+}
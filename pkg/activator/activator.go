@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package activator resumes suspended RoleBasedGroups on demand.
+//
+// The activator is an HTTP endpoint served by every replica of the controller. Requests for a group
+// scaled to zero, e.g. routed there as the fallback backend of a gateway, or sent by an alert on a
+// custom metric, call /activate/<namespace>/<name>. A suspended group with spec.activation is resumed
+// by removing spec.suspend, and the callers are asked to retry until the roles of the group are ready.
+package activator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+const (
+	// PathPrefix is the path the activation requests are served under, followed by <namespace>/<name>.
+	PathPrefix = "/activate/"
+
+	// Activated is the reason of the event recorded when a group is resumed by the activator.
+	Activated = "Activated"
+
+	defaultRetryAfterSeconds = 10
+)
+
+// Server serves the activation requests of suspended RoleBasedGroups.
+type Server struct {
+	addr     string
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+var _ manager.LeaderElectionRunnable = &Server{}
+
+func NewServer(addr string, client client.Client, recorder record.EventRecorder) *Server {
+	return &Server{addr: addr, client: client, recorder: recorder}
+}
+
+// Start serves the activation requests until ctx is done.
+func (s *Server) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(PathPrefix, s)
+	server := &http.Server{Addr: s.addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.FromContext(ctx).Info("Starting activator", "address", s.addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, every replica serves the activator.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// ServeHTTP resumes the group named by the request path. It answers 200 once the group is ready, and
+// 503 with a Retry-After header while its roles are starting.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, PathPrefix), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.Error(w, fmt.Sprintf("expected a path of the form %s<namespace>/<name>", PathPrefix), http.StatusNotFound)
+		return
+	}
+	key := types.NamespacedName{Namespace: parts[0], Name: parts[1]}
+	logger := log.FromContext(ctx).WithValues("rbg", key)
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	if err := s.client.Get(ctx, key, rbg); err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("rbg %s not found", key), http.StatusNotFound)
+			return
+		}
+		logger.Error(err, "Failed to get rbg for activation")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if rbg.Spec.Activation == nil {
+		http.Error(w, fmt.Sprintf("rbg %s does not enable activation", key), http.StatusForbidden)
+		return
+	}
+
+	if rbg.IsSuspended() {
+		// A null value removes the field in a merge patch.
+		patch := client.RawPatch(types.MergePatchType, []byte(`{"spec":{"suspend":null}}`))
+		if err := s.client.Patch(ctx, rbg, patch); err != nil {
+			logger.Error(err, "Failed to resume rbg")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		logger.Info("Resumed rbg on activation request")
+		s.recorder.Eventf(rbg, corev1.EventTypeNormal, Activated, "Resumed by an activation request")
+	} else if apimeta.IsStatusConditionTrue(rbg.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupReady)) {
+		_, _ = fmt.Fprintf(w, "rbg %s is ready\n", key)
+		return
+	}
+
+	retryAfter := rbg.Spec.Activation.RetryAfterSeconds
+	if retryAfter <= 0 {
+		retryAfter = defaultRetryAfterSeconds
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter)))
+	http.Error(w, fmt.Sprintf("rbg %s is starting", key), http.StatusServiceUnavailable)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func TestServer_ServeHTTP(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, workloadsv1alpha2.AddToScheme(scheme))
+
+	newRBG := func(name string, activation *workloadsv1alpha2.ActivationPolicy, ready bool) *workloadsv1alpha2.RoleBasedGroup {
+		rbg := &workloadsv1alpha2.RoleBasedGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       workloadsv1alpha2.RoleBasedGroupSpec{Suspend: ptr.To(true), Activation: activation},
+		}
+		if ready {
+			rbg.Spec.Suspend = nil
+			rbg.Status.Conditions = []metav1.Condition{{
+				Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: metav1.ConditionTrue, Reason: "AllRolesReady",
+			}}
+		}
+		return rbg
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newRBG("on-demand", &workloadsv1alpha2.ActivationPolicy{RetryAfterSeconds: 5}, false),
+		newRBG("always-on", nil, false),
+		newRBG("ready", &workloadsv1alpha2.ActivationPolicy{}, true),
+	).Build()
+	recorder := record.NewFakeRecorder(10)
+	server := NewServer(":0", c, recorder)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+		return w
+	}
+
+	w := serve("/activate/default/on-demand")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "5", w.Header().Get("Retry-After"))
+	rbg := &workloadsv1alpha2.RoleBasedGroup{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "on-demand", Namespace: "default"}, rbg))
+	assert.False(t, rbg.IsSuspended())
+	assert.Equal(t, "Normal Activated Resumed by an activation request", <-recorder.Events)
+
+	// Requests keep being retried while the roles start.
+	w = serve("/activate/default/on-demand")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Empty(t, recorder.Events)
+
+	w = serve("/activate/default/ready")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serve("/activate/default/always-on")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "always-on", Namespace: "default"}, rbg))
+	assert.True(t, rbg.IsSuspended())
+
+	assert.Equal(t, http.StatusNotFound, serve("/activate/default/absent").Code)
+	assert.Equal(t, http.StatusNotFound, serve("/activate/default").Code)
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package autoscaler scales the roles of RoleBasedGroups by a metric of their engines, for clusters
+// without an external autoscaler such as KEDA.
+//
+// A role needs the value of its metric divided by the target of a single replica, bounded by its
+// minimum and maximum replicas. As with the HorizontalPodAutoscaler, deviations from the target within
+// a tolerance are ignored, and a role is only scaled down to the highest number of replicas
+// recommended within its stabilization window.
+//
+// Roles of a group can also be scaled together by the service level objectives of the group, such as
+// its time to first token, in units that keep the ratio of their replicas, within the GPU budget of the
+// group.
+package autoscaler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/reconciler"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+const (
+	// Tolerance is the relative deviation of the metric from the target of the current replicas
+	// within which a role is not scaled.
+	Tolerance = 0.1
+
+	defaultMinReplicas                   = 1
+	defaultScaleDownStabilizationSeconds = 300
+	prometheusTimeout                    = 5 * time.Second
+)
+
+// recommendation is a number of replicas computed for a role at some point in time.
+type recommendation struct {
+	replicas int32
+	time     time.Time
+}
+
+// Autoscaler reads the metrics of autoscaled roles and remembers the replicas recommended for them.
+type Autoscaler struct {
+	client     client.Client
+	httpClient *http.Client
+
+	mu sync.Mutex
+	// recommendations are the recent recommendations of the roles of every group, by role name.
+	recommendations map[types.NamespacedName]map[string][]recommendation
+	// groupRecommendations are the recent recommendations of the units of every group.
+	groupRecommendations map[types.NamespacedName][]recommendation
+}
+
+// New returns a new Autoscaler.
+func New(c client.Client) *Autoscaler {
+	return &Autoscaler{
+		client:               c,
+		httpClient:           &http.Client{Timeout: prometheusTimeout},
+		recommendations:      map[types.NamespacedName]map[string][]recommendation{},
+		groupRecommendations: map[types.NamespacedName][]recommendation{},
+	}
+}
+
+// ReadMetric returns the value of the metric of an autoscaled role over all of its replicas.
+func (a *Autoscaler) ReadMetric(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (float64, error) {
+	autoscaling := role.Autoscaling
+	switch {
+	case autoscaling.Prometheus != nil:
+		return a.queryPrometheus(ctx, autoscaling.Prometheus)
+	case autoscaling.EngineMetric != nil:
+		return a.scrapeEngines(ctx, rbg, role)
+	default:
+		return 0, fmt.Errorf("role %s has no autoscaling metric", role.Name)
+	}
+}
+
+// scrapeEngines sums the engine metric of the ready pods of the role. The pods that fail to report it,
+// such as the workers of a leader-worker role, are skipped.
+func (a *Autoscaler) scrapeEngines(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (float64, error) {
+	logger := log.FromContext(ctx)
+	pods := &corev1.PodList{}
+	if err := a.client.List(ctx, pods, client.InNamespace(rbg.Namespace), client.MatchingLabels{
+		constants.GroupNameLabelKey: rbg.Name,
+		constants.RoleNameLabelKey:  role.Name,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list pods of role %s: %w", role.Name, err)
+	}
+
+	var total float64
+	reported := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !pod.DeletionTimestamp.IsZero() || !utils.PodRunningAndReady(*pod) {
+			continue
+		}
+		value, err := reconciler.ScrapeEngineLoad(ctx, pod, role.Autoscaling.EngineMetric)
+		if err != nil {
+			logger.V(1).Info("Failed to read the engine metric", "pod", pod.Name, "error", err.Error())
+			continue
+		}
+		total += value
+		reported++
+	}
+	if reported == 0 {
+		return 0, fmt.Errorf("no ready pod of role %s reported metric %s", role.Name, role.Autoscaling.EngineMetric.Metric)
+	}
+	return total, nil
+}
+
+// promQueryResponse is the subset of the Prometheus instant query response used here.
+type promQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// queryPrometheus runs the instant query of the metric and sums the values of its series. A query
+// without any series, e.g. over a metric that was not reported yet, yields zero.
+func (a *Autoscaler) queryPrometheus(ctx context.Context, metric *workloadsv1alpha2.PrometheusMetric) (float64, error) {
+	endpoint, err := url.JoinPath(metric.ServerAddress, "/api/v1/query")
+	if err != nil {
+		return 0, fmt.Errorf("invalid prometheus server address: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?query="+url.QueryEscape(metric.Query), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query prometheus: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("prometheus returned %s: %s", resp.Status, string(body))
+	}
+
+	var result promQueryResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return 0, fmt.Errorf("failed to decode prometheus response: %w", err)
+	}
+	if result.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s", result.Error)
+	}
+	switch result.Data.ResultType {
+	case "scalar":
+		var sample []interface{}
+		if err := json.Unmarshal(result.Data.Result, &sample); err != nil {
+			return 0, fmt.Errorf("failed to decode prometheus scalar: %w", err)
+		}
+		return parseSampleValue(sample)
+	case "vector":
+		var series []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(result.Data.Result, &series); err != nil {
+			return 0, fmt.Errorf("failed to decode prometheus vector: %w", err)
+		}
+		var total float64
+		for _, s := range series {
+			value, err := parseSampleValue(s.Value)
+			if err != nil {
+				return 0, err
+			}
+			total += value
+		}
+		return total, nil
+	default:
+		return 0, fmt.Errorf("unsupported prometheus result type %q, the query has to return a vector or scalar",
+			result.Data.ResultType)
+	}
+}
+
+// parseSampleValue parses the value of a [timestamp, "value"] sample.
+func parseSampleValue(sample []interface{}) (float64, error) {
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("malformed prometheus sample %v", sample)
+	}
+	raw, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("malformed prometheus sample %v", sample)
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("malformed prometheus sample %v: %w", sample, err)
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("prometheus sample %v is not a number", sample)
+	}
+	return value, nil
+}
+
+// DesiredReplicas returns the replicas a role with current replicas needs for the value of its metric,
+// bounded by its minimum and maximum replicas.
+func DesiredReplicas(autoscaling *workloadsv1alpha2.RoleAutoscaling, current int32, value float64) int32 {
+	minReplicas := int32(defaultMinReplicas)
+	if autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+	}
+	target := autoscaling.Target.AsApproximateFloat64()
+
+	desired := current
+	if target > 0 {
+		if current == 0 || math.Abs(value/(target*float64(current))-1) > Tolerance {
+			desired = int32(math.Min(math.Ceil(value/target), math.MaxInt32))
+		}
+	}
+	return max(minReplicas, min(desired, autoscaling.MaxReplicas))
+}
+
+// Stabilize records the replicas desired for a role of a group and returns the ones it is scaled to.
+// A role is scaled up right away, but only scaled down to the highest number of replicas recommended
+// within its stabilization window.
+func (a *Autoscaler) Stabilize(
+	key types.NamespacedName, role *workloadsv1alpha2.RoleSpec, current, desired int32, now time.Time,
+) int32 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	roles, ok := a.recommendations[key]
+	if !ok {
+		roles = map[string][]recommendation{}
+		a.recommendations[key] = roles
+	}
+	var replicas int32
+	replicas, roles[role.Name] = stabilize(roles[role.Name],
+		stabilizationWindow(role.Autoscaling.ScaleDownStabilizationSeconds), current, desired, now)
+	return replicas
+}
+
+// stabilizationWindow returns the scale-down stabilization window of the given seconds, or the default one.
+func stabilizationWindow(seconds *int32) time.Duration {
+	if seconds != nil {
+		return time.Duration(*seconds) * time.Second
+	}
+	return time.Duration(defaultScaleDownStabilizationSeconds) * time.Second
+}
+
+// stabilize adds the desired replicas to the recommendations and returns the replicas to scale to, along
+// with the recommendations still within the window.
+func stabilize(
+	recommendations []recommendation, window time.Duration, current, desired int32, now time.Time,
+) (int32, []recommendation) {
+	recent := []recommendation{{replicas: desired, time: now}}
+	highest := desired
+	for _, r := range recommendations {
+		if now.Sub(r.time) >= window {
+			continue
+		}
+		recent = append(recent, r)
+		highest = max(highest, r.replicas)
+	}
+
+	if desired >= current {
+		return desired, recent
+	}
+	return min(highest, current), recent
+}
+
+// Forget drops the recommendations of the roles of a group that is deleted or no longer autoscaled,
+// except for the roles in keep.
+func (a *Autoscaler) Forget(key types.NamespacedName, keep map[string]bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	roles, ok := a.recommendations[key]
+	if !ok {
+		return
+	}
+	for name := range roles {
+		if !keep[name] {
+			delete(roles, name)
+		}
+	}
+	if len(roles) == 0 {
+		delete(a.recommendations, key)
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestDesiredReplicas(t *testing.T) {
+	autoscaling := &workloadsv1alpha2.RoleAutoscaling{
+		MinReplicas: ptr.To[int32](1),
+		MaxReplicas: 8,
+		Target:      resource.MustParse("10"),
+	}
+	tests := []struct {
+		name    string
+		current int32
+		value   float64
+		want    int32
+	}{
+		{name: "within tolerance", current: 4, value: 43, want: 4},
+		{name: "scale up", current: 4, value: 61, want: 7},
+		{name: "scale down", current: 4, value: 15, want: 2},
+		{name: "bounded by max replicas", current: 4, value: 500, want: 8},
+		{name: "bounded by min replicas", current: 4, value: 0, want: 1},
+		{name: "scale from zero", current: 0, value: 5, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DesiredReplicas(autoscaling, tt.current, tt.value))
+		})
+	}
+}
+
+func TestAutoscaler_Stabilize(t *testing.T) {
+	a := New(fake.NewClientBuilder().Build())
+	key := types.NamespacedName{Namespace: "default", Name: "test-rbg"}
+	role := &workloadsv1alpha2.RoleSpec{
+		Name:        "decode",
+		Autoscaling: &workloadsv1alpha2.RoleAutoscaling{ScaleDownStabilizationSeconds: ptr.To[int32](60)},
+	}
+	now := time.Now()
+
+	// A scale-up is applied right away.
+	assert.Equal(t, int32(6), a.Stabilize(key, role, 4, 6, now))
+	// A scale-down keeps the highest recommendation of the window.
+	assert.Equal(t, int32(6), a.Stabilize(key, role, 6, 2, now.Add(30*time.Second)))
+	assert.Equal(t, int32(6), a.Stabilize(key, role, 6, 3, now.Add(45*time.Second)))
+	// Recommendations older than the window are dropped.
+	assert.Equal(t, int32(3), a.Stabilize(key, role, 6, 2, now.Add(75*time.Second)))
+	assert.Equal(t, int32(2), a.Stabilize(key, role, 3, 2, now.Add(140*time.Second)))
+
+	a.Forget(key, nil)
+	assert.Empty(t, a.recommendations)
+}
+
+func TestAutoscaler_QueryPrometheus(t *testing.T) {
+	responses := map[string]string{
+		"vector": `{"status":"success","data":{"resultType":"vector","result":[` +
+			`{"metric":{"pod":"a"},"value":[1,"3"]},{"metric":{"pod":"b"},"value":[1,"4.5"]}]}}`,
+		"scalar": `{"status":"success","data":{"resultType":"scalar","result":[1,"12"]}}`,
+		"empty":  `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		"matrix": `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		response, ok := responses[r.URL.Query().Get("query")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `{"status":"error","error":"parse error"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, response)
+	}))
+	defer server.Close()
+
+	a := New(fake.NewClientBuilder().Build())
+	query := func(q string) (float64, error) {
+		return a.queryPrometheus(context.TODO(), &workloadsv1alpha2.PrometheusMetric{ServerAddress: server.URL, Query: q})
+	}
+	value, err := query("vector")
+	require.NoError(t, err)
+	assert.Equal(t, 7.5, value)
+	value, err = query("scalar")
+	require.NoError(t, err)
+	assert.Equal(t, float64(12), value)
+	value, err = query("empty")
+	require.NoError(t, err)
+	assert.Zero(t, value)
+	_, err = query("matrix")
+	assert.ErrorContains(t, err, "unsupported prometheus result type")
+	_, err = query("invalid")
+	assert.ErrorContains(t, err, "400 Bad Request")
+}
+
+func TestAutoscaler_ScrapeEngines(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "vllm:num_requests_waiting{model=\"a\"} 3\nvllm:num_requests_waiting{model=\"b\"} 2\n")
+	}))
+	listener, err := net.Listen("tcp", "0.0.0.0:0")
+	if err != nil {
+		t.Skipf("cannot listen on all interfaces: %v", err)
+	}
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+	_, portValue, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portValue)
+	require.NoError(t, err)
+
+	pod := func(name, ip string, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					constants.GroupNameLabelKey: "test-rbg",
+					constants.RoleNameLabelKey:  "decode",
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				PodIP:      ip,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			},
+		}
+	}
+	role := wrappersv2.BuildStandaloneRole("decode").Obj()
+	role.Autoscaling = &workloadsv1alpha2.RoleAutoscaling{
+		MaxReplicas:  4,
+		EngineMetric: &workloadsv1alpha2.EngineLoadMetric{Port: int32(port), Metric: "vllm:num_requests_waiting"},
+		Target:       resource.MustParse("2"),
+	}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{role}).Obj()
+
+	// The unready pod and the pod whose engine cannot be reached are skipped.
+	c := fake.NewClientBuilder().WithObjects(
+		pod("decode-0", "127.0.0.1", corev1.ConditionTrue),
+		pod("decode-1", "127.0.0.2", corev1.ConditionTrue),
+		pod("decode-2", "", corev1.ConditionTrue),
+		pod("decode-3", "127.0.0.3", corev1.ConditionFalse),
+	).Build()
+	value, err := New(c).ReadMetric(context.TODO(), rbg, &rbg.Spec.Roles[0])
+	require.NoError(t, err)
+	assert.Equal(t, float64(10), value)
+
+	// A role without a reporting pod is not scaled.
+	_, err = New(fake.NewClientBuilder().Build()).ReadMetric(context.TODO(), rbg, &rbg.Spec.Roles[0])
+	assert.EqualError(t, err, "no ready pod of role decode reported metric vllm:num_requests_waiting")
+}
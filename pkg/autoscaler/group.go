@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+const defaultMinUnits = 1
+
+// Objective is the value read for a service level objective of a group and the target it is kept at.
+type Objective struct {
+	Name   string
+	Value  float64
+	Target float64
+}
+
+// ReadObjectives queries the service level objectives of an autoscaled group.
+func (a *Autoscaler) ReadObjectives(ctx context.Context, autoscaling *workloadsv1alpha2.GroupAutoscaling) ([]Objective, error) {
+	var objectives []Objective
+	for _, slo := range []struct {
+		name      string
+		objective *workloadsv1alpha2.ServiceLevelObjective
+	}{
+		{name: "ttft", objective: autoscaling.TTFT},
+		{name: "tpot", objective: autoscaling.TPOT},
+		{name: "queueLength", objective: autoscaling.QueueLength},
+	} {
+		if slo.objective == nil {
+			continue
+		}
+		value, err := a.queryPrometheus(ctx, &workloadsv1alpha2.PrometheusMetric{
+			ServerAddress: autoscaling.ServerAddress,
+			Query:         slo.objective.Query,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read objective %s: %w", slo.name, err)
+		}
+		objectives = append(objectives, Objective{
+			Name:   slo.name,
+			Value:  value,
+			Target: slo.objective.Target.AsApproximateFloat64(),
+		})
+	}
+	return objectives, nil
+}
+
+// CurrentUnits returns the number of units the autoscaled roles of the group have, rounded up for roles
+// that are out of ratio.
+func CurrentUnits(rbg *workloadsv1alpha2.RoleBasedGroup) int32 {
+	var units int32
+	for _, scaled := range rbg.Spec.Autoscaling.Roles {
+		role, err := rbg.GetRole(scaled.Name)
+		if err != nil {
+			continue
+		}
+		replicas := ptr.Deref(role.Replicas, 1)
+		units = max(units, (replicas+scaled.Replicas-1)/scaled.Replicas)
+	}
+	return units
+}
+
+// MaxUnits returns the highest number of units of the autoscaled roles that fit in the GPU budget of the
+// group, besides the GPUs of its other roles.
+func MaxUnits(ctx context.Context, reader client.Reader, rbg *workloadsv1alpha2.RoleBasedGroup) (int32, error) {
+	autoscaling := rbg.Spec.Autoscaling
+	perUnit := make(map[string]int32, len(autoscaling.Roles))
+	for _, scaled := range autoscaling.Roles {
+		perUnit[scaled.Name] = scaled.Replicas
+	}
+
+	var unitGPUs, otherGPUs int64
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		gpus, err := replicaGPUs(ctx, reader, rbg, role)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count the GPUs of role %s: %w", role.Name, err)
+		}
+		if replicas, ok := perUnit[role.Name]; ok {
+			unitGPUs += int64(replicas) * gpus
+		} else {
+			otherGPUs += int64(ptr.Deref(role.Replicas, 1)) * gpus
+		}
+	}
+	if unitGPUs == 0 {
+		return 0, fmt.Errorf("the autoscaled roles request no GPUs")
+	}
+	units := (autoscaling.MaxGPUs - otherGPUs) / unitGPUs
+	if units < 1 {
+		return 0, fmt.Errorf("the budget of %d GPUs does not fit a unit of %d GPUs besides the %d GPUs of the other roles",
+			autoscaling.MaxGPUs, unitGPUs, otherGPUs)
+	}
+	return int32(min(units, math.MaxInt32)), nil
+}
+
+// replicaGPUs counts the GPUs of one replica of a role, which is a group of pods for the leader-worker and
+// custom components patterns.
+func replicaGPUs(
+	ctx context.Context, reader client.Reader, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (int64, error) {
+	var total int64
+	if pattern := role.GetCustomComponentsPattern(); pattern != nil {
+		for i := range pattern.Components {
+			size := int64(ptr.Deref(pattern.Components[i].Size, 1))
+			for _, count := range utils.PodSpecGPUs(&pattern.Components[i].Template.Spec) {
+				total += size * count
+			}
+		}
+		return total, nil
+	}
+	template, err := utils.ResolveRoleTemplate(ctx, reader, rbg, role)
+	if err != nil {
+		return 0, err
+	}
+	size := int64(1)
+	if lwSize := role.GetLeaderWorkerSize(); lwSize != nil {
+		size = int64(*lwSize)
+	}
+	for _, count := range utils.PodSpecGPUs(&template.Spec) {
+		total += size * count
+	}
+	return total, nil
+}
+
+// DesiredUnits returns the units the autoscaled roles of a group need for the values of its objectives.
+// The roles are scaled up right away in proportion to the objective missed the most. Latency does not
+// shrink in proportion to the replicas, so they are only scaled down by a single unit, once every
+// objective is below its target. The units are bounded by the minimum units, then by the GPU budget.
+func DesiredUnits(autoscaling *workloadsv1alpha2.GroupAutoscaling, current, maxUnits int32, objectives []Objective) int32 {
+	desired := current
+	scaleDown := len(objectives) > 0
+	for _, objective := range objectives {
+		if objective.Target <= 0 {
+			scaleDown = false
+			continue
+		}
+		ratio := objective.Value / objective.Target
+		if ratio > 1+Tolerance {
+			desired = max(desired, int32(math.Min(math.Ceil(float64(max(current, 1))*ratio), math.MaxInt32)))
+		}
+		if ratio >= 1-Tolerance {
+			scaleDown = false
+		}
+	}
+	if scaleDown {
+		desired = current - 1
+	}
+	return min(max(ptr.Deref(autoscaling.MinUnits, defaultMinUnits), desired), maxUnits)
+}
+
+// UnitReplicas returns the replicas of the autoscaled roles of the group for the given units, keeping
+// only the roles whose replicas change.
+func UnitReplicas(rbg *workloadsv1alpha2.RoleBasedGroup, units int32) map[string]int32 {
+	replicas := map[string]int32{}
+	for _, scaled := range rbg.Spec.Autoscaling.Roles {
+		role, err := rbg.GetRole(scaled.Name)
+		if err != nil {
+			continue
+		}
+		if desired := units * scaled.Replicas; ptr.Deref(role.Replicas, 1) != desired {
+			replicas[scaled.Name] = desired
+		}
+	}
+	return replicas
+}
+
+// StabilizeGroup records the units desired for the autoscaled roles of a group and returns the ones they
+// are scaled to, as Stabilize does for a role.
+func (a *Autoscaler) StabilizeGroup(
+	key types.NamespacedName, autoscaling *workloadsv1alpha2.GroupAutoscaling, current, desired int32, now time.Time,
+) int32 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var units int32
+	units, a.groupRecommendations[key] = stabilize(a.groupRecommendations[key],
+		stabilizationWindow(autoscaling.ScaleDownStabilizationSeconds), current, desired, now)
+	return units
+}
+
+// ForgetGroup drops the recommendations of the units of a group that is deleted or no longer autoscaled.
+func (a *Autoscaler) ForgetGroup(key types.NamespacedName) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.groupRecommendations, key)
+}
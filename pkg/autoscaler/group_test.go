@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func TestDesiredUnits(t *testing.T) {
+	autoscaling := &workloadsv1alpha2.GroupAutoscaling{MinUnits: ptr.To[int32](2)}
+	tests := []struct {
+		name       string
+		current    int32
+		objectives []Objective
+		want       int32
+	}{
+		{
+			name:       "within tolerance",
+			current:    4,
+			objectives: []Objective{{Name: "ttft", Value: 1.05, Target: 1}, {Name: "tpot", Value: 0.01, Target: 0.05}},
+			want:       4,
+		},
+		{
+			name:       "scale up for the objective missed the most",
+			current:    2,
+			objectives: []Objective{{Name: "ttft", Value: 1.5, Target: 1}, {Name: "queueLength", Value: 40, Target: 20}},
+			want:       4,
+		},
+		{
+			name:       "scale down by a unit once every objective is met",
+			current:    4,
+			objectives: []Objective{{Name: "ttft", Value: 0.2, Target: 1}, {Name: "tpot", Value: 0.01, Target: 0.05}},
+			want:       3,
+		},
+		{
+			name:       "bounded by min units",
+			current:    2,
+			objectives: []Objective{{Name: "ttft", Value: 0.2, Target: 1}},
+			want:       2,
+		},
+		{
+			name:       "bounded by the GPU budget",
+			current:    4,
+			objectives: []Objective{{Name: "ttft", Value: 5, Target: 1}},
+			want:       6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DesiredUnits(autoscaling, tt.current, 6, tt.objectives))
+		})
+	}
+}
+
+func TestMaxUnits(t *testing.T) {
+	withGPUs := func(role workloadsv1alpha2.RoleSpec, gpus string) workloadsv1alpha2.RoleSpec {
+		role.StandalonePattern.Template.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+			"nvidia.com/gpu": resource.MustParse(gpus),
+		}
+		return role
+	}
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			withGPUs(wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj(), "1"),
+			withGPUs(wrappersv2.BuildStandaloneRole("decode").WithReplicas(1).Obj(), "2"),
+			withGPUs(wrappersv2.BuildStandaloneRole("embedding").WithReplicas(2).Obj(), "1"),
+			wrappersv2.BuildStandaloneRole("router").WithReplicas(1).Obj(),
+		}).Obj()
+	rbg.Spec.Autoscaling = &workloadsv1alpha2.GroupAutoscaling{
+		Roles:   []workloadsv1alpha2.GroupAutoscalingRole{{Name: "prefill", Replicas: 2}, {Name: "decode", Replicas: 1}},
+		MaxGPUs: 20,
+	}
+	c := fake.NewClientBuilder().Build()
+
+	// A unit takes 4 GPUs, the embedding role keeps 2 of the budget.
+	units, err := MaxUnits(context.TODO(), c, rbg)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), units)
+	assert.Equal(t, int32(1), CurrentUnits(rbg))
+	assert.Equal(t, map[string]int32{"prefill": 8, "decode": 4}, UnitReplicas(rbg, 4))
+
+	rbg.Spec.Autoscaling.MaxGPUs = 5
+	_, err = MaxUnits(context.TODO(), c, rbg)
+	assert.EqualError(t, err, "the budget of 5 GPUs does not fit a unit of 4 GPUs besides the 2 GPUs of the other roles")
+
+	rbg.Spec.Autoscaling.Roles = []workloadsv1alpha2.GroupAutoscalingRole{{Name: "router", Replicas: 1}}
+	_, err = MaxUnits(context.TODO(), c, rbg)
+	assert.EqualError(t, err, "the autoscaled roles request no GPUs")
+}
+
+func TestAutoscaler_StabilizeGroup(t *testing.T) {
+	a := New(fake.NewClientBuilder().Build())
+	key := types.NamespacedName{Namespace: "default", Name: "test-rbg"}
+	autoscaling := &workloadsv1alpha2.GroupAutoscaling{ScaleDownStabilizationSeconds: ptr.To[int32](60)}
+	now := time.Now()
+
+	assert.Equal(t, int32(4), a.StabilizeGroup(key, autoscaling, 2, 4, now))
+	assert.Equal(t, int32(4), a.StabilizeGroup(key, autoscaling, 4, 3, now.Add(30*time.Second)))
+	assert.Equal(t, int32(3), a.StabilizeGroup(key, autoscaling, 4, 3, now.Add(90*time.Second)))
+
+	a.ForgetGroup(key)
+	assert.Empty(t, a.groupRecommendations)
+}
@@ -21,7 +21,10 @@ import (
 	"fmt"
 	"sort"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
@@ -94,26 +97,57 @@ func (m *DefaultDependencyManager) SortRoles(
 func (m *DefaultDependencyManager) CheckDependencyReady(
 	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
 ) (bool, error) {
+	unready, err := m.UnreadyDependencies(ctx, rbg, role)
+	if err != nil {
+		return false, err
+	}
+	return len(unready) == 0, nil
+}
 
+// UnreadyDependencies returns the dependencies of role whose workloads are not ready,
+// dependencies without a workload yet are not ready either.
+func (m *DefaultDependencyManager) UnreadyDependencies(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) ([]string, error) {
+	var unready []string
 	for _, dep := range role.Dependencies {
 		depRole, err := rbg.GetRole(dep)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 		r, err := reconciler.NewWorkloadReconciler(depRole.GetWorkloadSpec(), m.scheme, m.client)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 		ready, err := r.CheckWorkloadReady(ctx, rbg, depRole)
-		if err != nil {
-			return false, err
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
 		}
 		if !ready {
-			return false, nil
+			unready = append(unready, dep)
 		}
 	}
+	return unready, nil
+}
 
-	return true, nil
+// UnreadyGroupDependencies returns the RoleBasedGroups in spec.dependsOn of rbg that do not report Ready,
+// groups that do not exist or are being deleted are not ready either.
+func (m *DefaultDependencyManager) UnreadyGroupDependencies(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+) ([]string, error) {
+	var unready []string
+	for _, dep := range rbg.Spec.DependsOn {
+		upstream := &workloadsv1alpha2.RoleBasedGroup{}
+		err := m.client.Get(ctx, types.NamespacedName{Name: dep.Name, Namespace: rbg.Namespace}, upstream)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err != nil || !upstream.DeletionTimestamp.IsZero() ||
+			!apimeta.IsStatusConditionTrue(upstream.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupReady)) {
+			unready = append(unready, dep.Name)
+		}
+	}
+	return unready, nil
 }
 
 type roleWithOrder struct {
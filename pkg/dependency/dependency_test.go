@@ -289,6 +289,11 @@ func TestDefaultDependencyManager_CheckDependencyReady(t *testing.T) {
 			wantErr:     false,
 			expectReady: false,
 		},
+		{
+			name:        "dependency not created",
+			wantErr:     false,
+			expectReady: false,
+		},
 	}
 
 	rbg := &workloadsv1alpha2.RoleBasedGroup{
@@ -316,14 +321,55 @@ func TestDefaultDependencyManager_CheckDependencyReady(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				client := fake.NewClientBuilder().WithObjects(tt.sts).Build()
-				dependencyManager := NewDefaultDependencyManager(scheme, client)
+				builder := fake.NewClientBuilder()
+				if tt.sts != nil {
+					builder = builder.WithObjects(tt.sts)
+				}
+				dependencyManager := NewDefaultDependencyManager(scheme, builder.Build())
 
 				ctx := log.IntoContext(context.TODO(), zap.New().WithValues("env", "test"))
 				ready, err := dependencyManager.CheckDependencyReady(ctx, rbg, &rbg.Spec.Roles[0])
 				assert.Equal(t, err != nil, tt.wantErr)
 				assert.Equal(t, ready, tt.expectReady)
+
+				unready, err := dependencyManager.UnreadyDependencies(ctx, rbg, &rbg.Spec.Roles[0])
+				assert.NoError(t, err)
+				if tt.expectReady {
+					assert.Empty(t, unready)
+				} else {
+					assert.Equal(t, []string{"role2"}, unready)
+				}
 			},
 		)
 	}
 }
+
+func TestDefaultDependencyManager_UnreadyGroupDependencies(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	group := func(name string, ready metav1.ConditionStatus) *workloadsv1alpha2.RoleBasedGroup {
+		return &workloadsv1alpha2.RoleBasedGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status: workloadsv1alpha2.RoleBasedGroupStatus{
+				Conditions: []metav1.Condition{{Type: string(workloadsv1alpha2.RoleBasedGroupReady), Status: ready}},
+			},
+		}
+	}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			DependsOn: []workloadsv1alpha2.GroupDependency{{Name: "embedding"}, {Name: "rerank"}, {Name: "cache"}},
+		},
+	}
+	// A group of the same name in another namespace is not a dependency.
+	other := group("cache", metav1.ConditionTrue)
+	other.Namespace = "other"
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		group("embedding", metav1.ConditionTrue), group("rerank", metav1.ConditionFalse), other,
+	).Build()
+
+	unready, err := NewDefaultDependencyManager(scheme, client).UnreadyGroupDependencies(context.TODO(), rbg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rerank", "cache"}, unready)
+}
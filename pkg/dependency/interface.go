@@ -27,4 +27,8 @@ type DependencyManager interface {
 	CheckDependencyReady(
 		ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
 	) (bool, error)
+	UnreadyDependencies(
+		ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	) ([]string, error)
+	UnreadyGroupDependencies(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) ([]string, error)
 }
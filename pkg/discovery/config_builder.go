@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 )
 
@@ -65,7 +66,10 @@ type GroupInfo struct {
 type RolesInfo map[string]RoleInstances
 
 type RoleInstances struct {
-	Size      int        `json:"size"`
+	Size int `json:"size"`
+	// Service is the headless service of the role, empty for roles without one such as Jobs.
+	Service string `json:"service,omitempty"`
+	// Instances lists the stable addresses of the role, only stateful roles have them.
 	Instances []Instance `json:"instances"`
 }
 
@@ -102,25 +106,27 @@ func (b *ConfigBuilder) getRoleNames() []string {
 func (b *ConfigBuilder) buildRolesInfo() (RolesInfo, error) {
 	roles := make(RolesInfo)
 	for _, role := range b.rbg.Spec.Roles {
-		instances, err := b.buildInstances(&role)
-		if err != nil {
-			return nil, err
-		}
-		roles[role.Name] = RoleInstances{
+		info := RoleInstances{
 			Size:      int(*role.Replicas),
-			Instances: instances,
+			Instances: []Instance{},
+		}
+		if role.GetWorkloadType() != constants.JobWorkloadType {
+			serviceName, err := utils.GetCompatibleHeadlessServiceName(context.TODO(), b.client, b.rbg, &role)
+			if err != nil {
+				return nil, fmt.Errorf("GetCompatibleHeadlessServiceName error: %s", err.Error())
+			}
+			info.Service = serviceName
+			if workloadsv1alpha2.IsStatefulRole(&role) {
+				info.Instances = b.buildInstances(&role, serviceName)
+			}
 		}
+		roles[role.Name] = info
 	}
 	return roles, nil
 }
 
-func (b *ConfigBuilder) buildInstances(role *workloadsv1alpha2.RoleSpec) ([]Instance, error) {
+func (b *ConfigBuilder) buildInstances(role *workloadsv1alpha2.RoleSpec, serviceName string) []Instance {
 	instances := make([]Instance, 0, *role.Replicas)
-	serviceName, err := utils.GetCompatibleHeadlessServiceName(context.TODO(), b.client, b.rbg, role)
-	if err != nil {
-		return nil, fmt.Errorf("GetCompatibleHeadlessServiceName error: %s", err.Error())
-	}
-
 	for i := 0; i < int(*role.Replicas); i++ {
 		instance := Instance{
 			Address: fmt.Sprintf("%s-%d.%s", b.rbg.GetWorkloadName(role), i, serviceName),
@@ -134,7 +140,7 @@ func (b *ConfigBuilder) buildInstances(role *workloadsv1alpha2.RoleSpec) ([]Inst
 
 		instances = append(instances, instance)
 	}
-	return instances, nil
+	return instances
 }
 
 func generatePortKey(port corev1.ServicePort) string {
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 )
 
@@ -91,6 +92,7 @@ roles:
     - address: test-cluster-leader-0.s-test-cluster-leader
       ports:
         api: 6443
+    service: s-test-cluster-leader
     size: 1
   worker:
     instances:
@@ -103,6 +105,7 @@ roles:
     - address: test-cluster-worker-2.s-test-cluster-worker
       ports:
         http: 8080
+    service: s-test-cluster-worker
     size: 3
 `,
 			wantErr: false,
@@ -168,6 +171,7 @@ roles:
     - address: test-cluster-leader-0.test-cluster-leader
       ports:
         api: 6443
+    service: test-cluster-leader
     size: 1
   worker:
     instances:
@@ -180,6 +184,7 @@ roles:
     - address: test-cluster-worker-2.test-cluster-worker
       ports:
         http: 8080
+    service: test-cluster-worker
     size: 3
 `,
 			wantErr: false,
@@ -225,6 +230,7 @@ roles:
       ports:
         port80: 80
         port443: 443
+    service: s-test-cluster-web
     size: 1
 `,
 			wantErr: false,
@@ -277,6 +283,7 @@ roles:
     - address: 1-test-cluster-leader-0.s-1-test-cluster-leader
       ports:
         api: 6443
+    service: s-1-test-cluster-leader
     size: 1
   worker:
     instances:
@@ -289,6 +296,51 @@ roles:
     - address: 1-test-cluster-worker-2.s-1-test-cluster-worker
       ports:
         http: 8080
+    service: s-1-test-cluster-worker
+    size: 3
+`,
+			wantErr: false,
+		},
+		{
+			name:   "stateless and job roles",
+			client: fake.NewClientBuilder().WithScheme(schema).Build(),
+			rbg: &workloadsv1alpha2.RoleBasedGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "default",
+				},
+				Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+					Roles: []workloadsv1alpha2.RoleSpec{
+						{
+							Name:     "router",
+							Replicas: &replicas3,
+							Annotations: map[string]string{
+								constants.RoleWorkloadTypeAnnotationKey: constants.DeploymentWorkloadType,
+							},
+						},
+						{
+							Name:     "download",
+							Replicas: &replicas1,
+							Annotations: map[string]string{
+								constants.RoleWorkloadTypeAnnotationKey: constants.JobWorkloadType,
+							},
+						},
+					},
+				},
+			},
+			expected: `group:
+  name: test-cluster
+  roles:
+  - router
+  - download
+  size: 2
+roles:
+  download:
+    instances: []
+    size: 1
+  router:
+    instances: []
+    service: s-test-cluster-router
     size: 3
 `,
 			wantErr: false,
@@ -595,10 +647,7 @@ func TestConfigBuilder_buildInstances(t *testing.T) {
 		role:   role,
 	}
 
-	instances, err := b.buildInstances(role)
-	if err != nil {
-		t.Errorf("buildInstances() error = %v", err)
-	}
+	instances := b.buildInstances(role, "s-test-cluster-server")
 
 	// Verify number of instances
 	if len(instances) != int(replicas) {
@@ -17,14 +17,22 @@ limitations under the License.
 package discovery
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
 )
 
+// roleAddressesEnvPattern matches the references to the role address variables in a pod template.
+var roleAddressesEnvPattern = regexp.MustCompile(`RBG_ROLE_[A-Z0-9_]+_ADDRESSES`)
+
 type EnvBuilder struct {
 	rbg  *workloadsv1alpha2.RoleBasedGroup
 	role *workloadsv1alpha2.RoleSpec
@@ -73,6 +81,85 @@ func (b *EnvBuilder) BuildLwsEnv(svcName string) []corev1.EnvVar {
 	return envVars
 }
 
+// BuildRoleAddressVars returns the address lists of the roles referenced by the containers of podSpec,
+// e.g. a router running `--prefill $(RBG_ROLE_PREFILL_ADDRESSES)`. Unreferenced roles are left out so
+// that scaling a role only rolls the pods consuming its addresses.
+func (g *EnvBuilder) BuildRoleAddressVars(
+	ctx context.Context, c client.Client, podSpec *corev1.PodTemplateSpec,
+) ([]corev1.EnvVar, error) {
+	referenced := referencedRoleAddressVars(podSpec)
+	if len(referenced) == 0 {
+		return nil, nil
+	}
+
+	var envVars []corev1.EnvVar
+	for i := range g.rbg.Spec.Roles {
+		role := &g.rbg.Spec.Roles[i]
+		name := RoleAddressesEnvName(role.Name)
+		if _, ok := referenced[name]; !ok {
+			continue
+		}
+		addresses, err := roleAddresses(ctx, c, g.rbg, role)
+		if err != nil {
+			return nil, err
+		}
+		envVars = append(envVars, corev1.EnvVar{Name: name, Value: strings.Join(addresses, ",")})
+	}
+	return envVars, nil
+}
+
+// RoleAddressesEnvName returns the name of the variable listing the addresses of the role.
+func RoleAddressesEnvName(roleName string) string {
+	return fmt.Sprintf(constants.EnvRBGRoleAddressesFmt, strings.ToUpper(strings.ReplaceAll(roleName, "-", "_")))
+}
+
+func referencedRoleAddressVars(podSpec *corev1.PodTemplateSpec) map[string]struct{} {
+	referenced := map[string]struct{}{}
+	collect := func(values ...string) {
+		for _, value := range values {
+			for _, name := range roleAddressesEnvPattern.FindAllString(value, -1) {
+				referenced[name] = struct{}{}
+			}
+		}
+	}
+	containers := append(append([]corev1.Container{}, podSpec.Spec.InitContainers...), podSpec.Spec.Containers...)
+	for i := range containers {
+		collect(containers[i].Command...)
+		collect(containers[i].Args...)
+		for _, env := range containers[i].Env {
+			collect(env.Value)
+		}
+	}
+	return referenced
+}
+
+// roleAddresses lists the stable address of every replica of a stateful role, and the headless
+// service load balancing a stateless role. Job roles have no address.
+func roleAddresses(
+	ctx context.Context, c client.Client, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) ([]string, error) {
+	if role.GetWorkloadType() == constants.JobWorkloadType {
+		return nil, nil
+	}
+	serviceName, err := utils.GetCompatibleHeadlessServiceName(ctx, c, rbg, role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the service of role %s: %w", role.Name, err)
+	}
+	if !workloadsv1alpha2.IsStatefulRole(role) {
+		return []string{serviceName}, nil
+	}
+
+	replicas := int32(1)
+	if role.Replicas != nil {
+		replicas = *role.Replicas
+	}
+	addresses := make([]string, 0, replicas)
+	for i := int32(0); i < replicas; i++ {
+		addresses = append(addresses, fmt.Sprintf("%s-%d.%s", rbg.GetWorkloadName(role), i, serviceName))
+	}
+	return addresses, nil
+}
+
 func (g *EnvBuilder) buildLocalRoleVars() []corev1.EnvVar {
 
 	// Inject environment variables for service discovery
@@ -17,12 +17,16 @@ limitations under the License.
 package discovery
 
 import (
+	"context"
 	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 )
@@ -336,3 +340,53 @@ func TestEnvBuilder_buildLocalRoleVars(t *testing.T) {
 		)
 	}
 }
+
+func TestEnvBuilder_BuildRoleAddressVars(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{
+			Roles: []workloadsv1alpha2.RoleSpec{
+				{Name: "router", Replicas: ptr.To(int32(1))},
+				{Name: "prefill", Replicas: ptr.To(int32(2))},
+				{
+					Name:     "decode-large",
+					Replicas: ptr.To(int32(3)),
+					Annotations: map[string]string{
+						constants.RoleWorkloadTypeAnnotationKey: constants.DeploymentWorkloadType,
+					},
+				},
+				{Name: "large", Replicas: ptr.To(int32(1))},
+			},
+		},
+	}
+	builder := &EnvBuilder{rbg: rbg, role: &rbg.Spec.Roles[0]}
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	podSpec := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "router",
+				Command: []string{"sh", "-c", "launch --prefill ${RBG_ROLE_PREFILL_ADDRESSES}"},
+				Env:     []corev1.EnvVar{{Name: "DECODE", Value: "$(RBG_ROLE_DECODE_LARGE_ADDRESSES)"}},
+			}},
+		},
+	}
+	envVars, err := builder.BuildRoleAddressVars(context.TODO(), c, podSpec)
+	if err != nil {
+		t.Fatalf("BuildRoleAddressVars() error = %v", err)
+	}
+	expected := []corev1.EnvVar{
+		{Name: "RBG_ROLE_PREFILL_ADDRESSES", Value: "test-rbg-prefill-0.s-test-rbg-prefill,test-rbg-prefill-1.s-test-rbg-prefill"},
+		{Name: "RBG_ROLE_DECODE_LARGE_ADDRESSES", Value: "s-test-rbg-decode-large"},
+	}
+	if diff := cmp.Diff(expected, envVars); diff != "" {
+		t.Errorf("BuildRoleAddressVars() mismatch (-want +got):\n%s", diff)
+	}
+
+	envVars, err = builder.BuildRoleAddressVars(context.TODO(), c, &corev1.PodTemplateSpec{})
+	if err != nil || envVars != nil {
+		t.Errorf("BuildRoleAddressVars() = %v, %v, want no variables for an unreferencing template", envVars, err)
+	}
+}
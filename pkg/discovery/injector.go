@@ -67,10 +67,11 @@ func (i *DefaultInjector) InjectConfig(
 		configKey  = "config.yaml"
 	)
 
-	// Only stateful roles have a discovery ConfigMap.
-	// The controller (reconcileDiscoveryConfigMap) always creates a single RBG-level
-	// ConfigMap named after the RBG itself, regardless of discovery config mode.
-	if !workloadsv1alpha2.IsStatefulRole(role) {
+	// The controller (reconcileRefinedDiscoveryConfigMap) creates a single RBG-level
+	// ConfigMap named after the RBG itself. In refine mode it describes every role and is
+	// mounted into all pods, legacy groups keep mounting it into stateful roles only.
+	if !workloadsv1alpha2.IsStatefulRole(role) &&
+		rbg.GetDiscoveryConfigMode() != constants.RefineDiscoveryConfigMode {
 		return nil
 	}
 	configMapName := rbg.Name
@@ -132,6 +133,11 @@ func (i *DefaultInjector) InjectEnv(
 	}
 
 	envVars := builder.Build()
+	addressVars, err := builder.BuildRoleAddressVars(ctx, i.client, podSpec)
+	if err != nil {
+		return err
+	}
+	envVars = append(envVars, addressVars...)
 
 	for idx := range podSpec.Spec.Containers {
 		container := &podSpec.Spec.Containers[idx]
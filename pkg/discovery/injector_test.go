@@ -401,7 +401,7 @@ func TestDefaultInjector_InjectConfig(t *testing.T) {
 			},
 		},
 		{
-			name: "Refine mode skips config injection for stateless role",
+			name: "Refine mode mounts shared configmap for stateless role",
 			rbg: func() *workloadsv1alpha2.RoleBasedGroup {
 				rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
 				rbg.SetDiscoveryConfigMode(constants.RefineDiscoveryConfigMode)
@@ -421,6 +421,53 @@ func TestDefaultInjector_InjectConfig(t *testing.T) {
 					},
 				},
 			},
+			expectedVolumes: []corev1.Volume{
+				{
+					Name: "rbg-cluster-config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-rbg",
+							},
+							Items: []corev1.KeyToPath{
+								{
+									Key:  "config.yaml",
+									Path: "config.yaml",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedMounts: []corev1.VolumeMount{
+				{
+					Name:      "rbg-cluster-config",
+					MountPath: "/etc/rbg",
+					ReadOnly:  true,
+				},
+			},
+		},
+		{
+			name: "Legacy mode skips config injection for stateless role",
+			rbg: func() *workloadsv1alpha2.RoleBasedGroup {
+				rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+				rbg.SetDiscoveryConfigMode(constants.LegacyDiscoveryConfigMode)
+				if rbg.Spec.Roles[0].Annotations == nil {
+					rbg.Spec.Roles[0].Annotations = make(map[string]string)
+				}
+				rbg.Spec.Roles[0].Annotations[constants.RoleWorkloadTypeAnnotationKey] = "apps/v1/Deployment"
+				return rbg
+			}(),
+			initialPodSpec: &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "main",
+							Image: "test-image",
+						},
+					},
+				},
+			},
 			expectedVolumes: nil,
 			expectedMounts:  nil,
 		},
@@ -128,6 +128,19 @@ func (b *SidecarBuilder) injectRuntime(
 		}
 	}
 
+	// inject env and volume mounts into the engine containers
+	for i := range podSpec.Spec.Containers {
+		c := &podSpec.Spec.Containers[i]
+		if utils.ContainsString(engineRuntimeContainerNames, c.Name) {
+			continue
+		}
+		if len(runtime.InjectContainers) > 0 && !utils.ContainsString(runtime.InjectContainers, c.Name) {
+			continue
+		}
+		injectEnv(c, engineRuntime.Spec.Env)
+		injectVolumeMounts(c, engineRuntime.Spec.VolumeMounts)
+	}
+
 	// override container based on rbg cr
 	for _, container := range runtime.Containers {
 
@@ -156,3 +169,35 @@ func (b *SidecarBuilder) injectRuntime(
 
 	return nil
 }
+
+// injectEnv appends the variables the container does not set yet, the container keeps precedence.
+func injectEnv(c *v1.Container, env []v1.EnvVar) {
+	for _, e := range env {
+		found := false
+		for _, existing := range c.Env {
+			if existing.Name == e.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.Env = append(c.Env, e)
+		}
+	}
+}
+
+// injectVolumeMounts appends the mounts whose path is not mounted by the container yet.
+func injectVolumeMounts(c *v1.Container, mounts []v1.VolumeMount) {
+	for _, m := range mounts {
+		found := false
+		for _, existing := range c.VolumeMounts {
+			if existing.MountPath == m.MountPath {
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.VolumeMounts = append(c.VolumeMounts, m)
+		}
+	}
+}
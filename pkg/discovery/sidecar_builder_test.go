@@ -369,3 +369,60 @@ func TestNewSidecarBuilder(t *testing.T) {
 	assert.Equal(t, rbg, builder.rbg)
 	assert.Equal(t, role, builder.role)
 }
+
+func TestSidecarBuilder_injectRuntimeEnvAndVolumeMounts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	_ = v1.AddToScheme(scheme)
+
+	profile := &workloadsv1alpha2.ClusterEngineRuntimeProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "kv-cache"},
+		Spec: workloadsv1alpha2.ClusterEngineRuntimeProfileSpec{
+			Containers: []v1.Container{{Name: "kv-cache-manager", Image: "kv-cache:latest"}},
+			Volumes:    []v1.Volume{{Name: "kv-socket", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}},
+			Env: []v1.EnvVar{
+				{Name: "KV_CACHE_SOCKET", Value: "/var/run/kv/kv.sock"},
+				{Name: "LOG_LEVEL", Value: "info"},
+			},
+			VolumeMounts: []v1.VolumeMount{{Name: "kv-socket", MountPath: "/var/run/kv"}},
+		},
+	}
+	b := NewSidecarBuilder(fake.NewClientBuilder().WithScheme(scheme).WithObjects(profile).Build(),
+		&workloadsv1alpha2.RoleBasedGroup{}, &workloadsv1alpha2.RoleSpec{})
+	podSpec := func() *v1.PodTemplateSpec {
+		return &v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{
+			{Name: "engine", Env: []v1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}}},
+			{Name: "proxy"},
+		}}}
+	}
+	container := func(pod *v1.PodTemplateSpec, name string) v1.Container {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == name {
+				return c
+			}
+		}
+		t.Fatalf("container %s not found", name)
+		return v1.Container{}
+	}
+
+	// Without injectContainers every container of the template gets the env, the sidecar does not.
+	pod := podSpec()
+	assert.NoError(t, b.injectRuntime(context.TODO(), pod, workloadsv1alpha2.EngineRuntime{ProfileName: "kv-cache"}))
+	engine := container(pod, "engine")
+	assert.Equal(t, []v1.EnvVar{
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{Name: "KV_CACHE_SOCKET", Value: "/var/run/kv/kv.sock"},
+	}, engine.Env)
+	assert.Equal(t, profile.Spec.VolumeMounts, engine.VolumeMounts)
+	assert.Len(t, container(pod, "proxy").Env, 2)
+	assert.Empty(t, container(pod, "kv-cache-manager").Env)
+
+	// injectContainers restricts the injection, which is idempotent.
+	pod = podSpec()
+	runtime := workloadsv1alpha2.EngineRuntime{ProfileName: "kv-cache", InjectContainers: []string{"engine"}}
+	assert.NoError(t, b.injectRuntime(context.TODO(), pod, runtime))
+	assert.NoError(t, b.injectRuntime(context.TODO(), pod, runtime))
+	assert.Len(t, container(pod, "engine").Env, 2)
+	assert.Len(t, container(pod, "engine").VolumeMounts, 1)
+	assert.Empty(t, container(pod, "proxy").Env)
+}
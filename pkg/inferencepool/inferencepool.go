@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inferencepool exposes the roles of RoleBasedGroups to the Gateway API inference extension
+// (inference.networking.k8s.io).
+//
+// Every pool of spec.networking.inferencePools is represented by an InferencePool owned by the group.
+// The pods of the roles of a pool are labeled with the name of the pool, which the InferencePool
+// selects them by, so that its members follow the replicas of the roles. The gateway asks the
+// endpoint picker of the pool which of them a request is routed to.
+package inferencepool
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+const (
+	// CrdName is the CRD name for the InferencePool.
+	CrdName = "inferencepools.inference.networking.k8s.io"
+
+	// SpecHashAnnotationKey records the hash of the spec an InferencePool was last written with, so
+	// that the fields defaulted by the API server do not trigger an update on every reconcile.
+	SpecHashAnnotationKey = constants.RBGPrefix + "inference-pool-spec-hash"
+
+	defaultEndpointPickerPort = 9002
+)
+
+// InferencePoolGVK is the GroupVersionKind of the InferencePool.
+var InferencePoolGVK = schema.GroupVersionKind{Group: "inference.networking.k8s.io", Version: "v1", Kind: "InferencePool"}
+
+// Manager manages the InferencePools of RoleBasedGroups.
+type Manager struct {
+	client client.Client
+}
+
+// New returns a new Manager.
+func New(c client.Client) *Manager {
+	return &Manager{client: c}
+}
+
+// NewInferencePool returns an empty InferencePool object.
+func NewInferencePool() *unstructured.Unstructured {
+	pool := &unstructured.Unstructured{}
+	pool.SetGroupVersionKind(InferencePoolGVK)
+	return pool
+}
+
+// ReconcileInferencePools creates or updates the InferencePools of the pools of a group and deletes
+// the InferencePools of the pools it no longer has.
+func (m *Manager) ReconcileInferencePools(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	runtimeController *builder.TypedBuilder[reconcile.Request],
+	watchedWorkload *sync.Map,
+	apiReader client.Reader,
+) error {
+	var pools []workloadsv1alpha2.InferencePoolSpec
+	if rbg.Spec.Networking != nil {
+		pools = rbg.Spec.Networking.InferencePools
+	}
+	if _, loaded := watchedWorkload.Load(CrdName); !loaded {
+		if len(pools) == 0 {
+			return nil
+		}
+		if err := utils.CheckCrdExists(apiReader, CrdName); err != nil {
+			return fmt.Errorf("gateway api inference extension %s not ready", CrdName)
+		}
+		watchedWorkload.LoadOrStore(CrdName, struct{}{})
+		runtimeController.Owns(NewInferencePool())
+	}
+
+	expected := make(map[string]bool, len(pools))
+	for i := range pools {
+		name := rbg.GetInferencePoolName(&pools[i])
+		expected[name] = true
+		if err := m.reconcileInferencePool(ctx, rbg, name, buildPoolSpec(rbg, &pools[i])); err != nil {
+			return err
+		}
+	}
+	return m.deleteStalePools(ctx, rbg, expected)
+}
+
+func (m *Manager) reconcileInferencePool(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	name string,
+	spec map[string]interface{},
+) error {
+	hash, err := hashSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	pool := NewInferencePool()
+	err = m.client.Get(ctx, types.NamespacedName{Name: name, Namespace: rbg.Namespace}, pool)
+	if apierrors.IsNotFound(err) {
+		return m.client.Create(ctx, newGroupPool(rbg, name, spec, hash))
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(pool, rbg) {
+		return fmt.Errorf("inference pool %s/%s exists and is not owned by the group", rbg.Namespace, name)
+	}
+	if pool.GetAnnotations()[SpecHashAnnotationKey] == hash {
+		return nil
+	}
+	pool.Object["spec"] = spec
+	annotations := pool.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[SpecHashAnnotationKey] = hash
+	pool.SetAnnotations(annotations)
+	return m.client.Update(ctx, pool)
+}
+
+// deleteStalePools deletes the InferencePools owned by the group that are not in expected.
+func (m *Manager) deleteStalePools(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, expected map[string]bool) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(InferencePoolGVK.GroupVersion().WithKind(InferencePoolGVK.Kind + "List"))
+	if err := m.client.List(ctx, list, client.InNamespace(rbg.Namespace),
+		client.MatchingLabels{constants.GroupNameLabelKey: rbg.Name}); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		pool := &list.Items[i]
+		if expected[pool.GetName()] || !metav1.IsControlledBy(pool, rbg) {
+			continue
+		}
+		if err := m.client.Delete(ctx, pool); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func newGroupPool(rbg *workloadsv1alpha2.RoleBasedGroup, name string, spec map[string]interface{}, hash string) *unstructured.Unstructured {
+	pool := NewInferencePool()
+	pool.SetName(name)
+	pool.SetNamespace(rbg.Namespace)
+	pool.SetLabels(map[string]string{constants.GroupNameLabelKey: rbg.Name})
+	pool.SetAnnotations(map[string]string{SpecHashAnnotationKey: hash})
+	pool.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(rbg, utils.GetRbgGVK())})
+	pool.Object["spec"] = spec
+	return pool
+}
+
+// buildPoolSpec selects the pods of the group labeled with the name of the pool and references the
+// Service of its endpoint picker.
+func buildPoolSpec(rbg *workloadsv1alpha2.RoleBasedGroup, pool *workloadsv1alpha2.InferencePoolSpec) map[string]interface{} {
+	picker := pool.EndpointPicker
+	port := picker.Port
+	if port == 0 {
+		port = defaultEndpointPickerPort
+	}
+	failureMode := picker.FailureMode
+	if failureMode == "" {
+		failureMode = workloadsv1alpha2.EndpointPickerFailClose
+	}
+	return map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{
+				constants.GroupNameLabelKey:     rbg.Name,
+				constants.InferencePoolLabelKey: pool.Name,
+			},
+		},
+		"targetPorts": []interface{}{
+			map[string]interface{}{"number": int64(pool.TargetPort)},
+		},
+		"endpointPickerRef": map[string]interface{}{
+			"name":        picker.Name,
+			"port":        map[string]interface{}{"number": int64(port)},
+			"failureMode": string(failureMode),
+		},
+	}
+}
+
+func hashSpec(spec map[string]interface{}) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferencepool
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newCrdReader(scheme *runtime.Scheme) client.Reader {
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: CrdName},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+					{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+				},
+			},
+		},
+	).Build()
+}
+
+func getPool(t *testing.T, c client.Client, name string) (*unstructured.Unstructured, error) {
+	t.Helper()
+	pool := NewInferencePool()
+	err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "default"}, pool)
+	return pool, err
+}
+
+func TestManager_ReconcileInferencePools(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").Obj(),
+		}).Obj()
+	rbg.Spec.Networking = &workloadsv1alpha2.NetworkingPolicy{
+		InferencePools: []workloadsv1alpha2.InferencePoolSpec{{
+			Name:           "pd",
+			Roles:          []string{"prefill", "decode"},
+			TargetPort:     8000,
+			EndpointPicker: workloadsv1alpha2.EndpointPickerRef{Name: "pd-epp"},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	manager := New(c)
+	runtimeController := builder.TypedBuilder[reconcile.Request]{}
+	watchedWorkload := sync.Map{}
+
+	// The InferencePool is not created until the inference extension is installed.
+	err := manager.ReconcileInferencePools(context.TODO(), rbg, &runtimeController, &watchedWorkload,
+		fake.NewClientBuilder().WithScheme(scheme).Build())
+	assert.EqualError(t, err, "gateway api inference extension inferencepools.inference.networking.k8s.io not ready")
+
+	// A pool selects the pods labeled with its name and references its endpoint picker.
+	reader := newCrdReader(scheme)
+	require.NoError(t, manager.ReconcileInferencePools(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	pool, err := getPool(t, c, "test-rbg-pd")
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(pool, rbg))
+	selector, _, _ := unstructured.NestedStringMap(pool.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{
+		constants.GroupNameLabelKey:     "test-rbg",
+		constants.InferencePoolLabelKey: "pd",
+	}, selector)
+	ports, _, _ := unstructured.NestedSlice(pool.Object, "spec", "targetPorts")
+	assert.Equal(t, []interface{}{map[string]interface{}{"number": int64(8000)}}, ports)
+	picker, _, _ := unstructured.NestedMap(pool.Object, "spec", "endpointPickerRef")
+	assert.Equal(t, map[string]interface{}{
+		"name":        "pd-epp",
+		"port":        map[string]interface{}{"number": int64(9002)},
+		"failureMode": "FailClose",
+	}, picker)
+
+	// Changing the pool updates the InferencePool.
+	rbg.Spec.Networking.InferencePools[0].TargetPort = 8080
+	require.NoError(t, manager.ReconcileInferencePools(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	pool, err = getPool(t, c, "test-rbg-pd")
+	require.NoError(t, err)
+	ports, _, _ = unstructured.NestedSlice(pool.Object, "spec", "targetPorts")
+	assert.Equal(t, []interface{}{map[string]interface{}{"number": int64(8080)}}, ports)
+
+	// Renaming the pool replaces the InferencePool.
+	rbg.Spec.Networking.InferencePools[0].Name = "llm"
+	require.NoError(t, manager.ReconcileInferencePools(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	_, err = getPool(t, c, "test-rbg-llm")
+	require.NoError(t, err)
+	_, err = getPool(t, c, "test-rbg-pd")
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// Removing spec.networking deletes the InferencePools.
+	rbg.Spec.Networking = nil
+	require.NoError(t, manager.ReconcileInferencePools(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	_, err = getPool(t, c, "test-rbg-llm")
+	assert.True(t, apierrors.IsNotFound(err))
+}
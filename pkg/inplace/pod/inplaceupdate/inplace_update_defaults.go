@@ -30,6 +30,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	inplaceapi "sigs.k8s.io/rbgs/api/workloads/pub/inplace_update"
 )
 
@@ -97,6 +98,10 @@ func defaultCalculateInPlaceUpdateSpec(oldRevision, newRevision *apps.Controller
 	if err != nil {
 		return nil
 	}
+	// A restart request must recreate the pods, patching the annotation in place would be a no-op.
+	if oldTemp.Annotations[constants.RoleRestartedAtAnnotationKey] != newTemp.Annotations[constants.RoleRestartedAtAnnotationKey] {
+		return nil
+	}
 
 	updateSpec := &UpdateSpec{
 		Revision:        newRevision.Name,
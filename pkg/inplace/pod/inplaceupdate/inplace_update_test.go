@@ -119,6 +119,17 @@ func TestCalculateInPlaceUpdateSpec(t *testing.T) {
 			},
 			expectedSpec: nil,
 		},
+		{
+			oldRevision: &apps.ControllerRevision{
+				ObjectMeta: metav1.ObjectMeta{Name: "old-revision"},
+				Data:       runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"$patch":"replace","spec":{"containers":[{"name":"c1","image":"foo1"}]}}}}`)},
+			},
+			newRevision: &apps.ControllerRevision{
+				ObjectMeta: metav1.ObjectMeta{Name: "new-revision"},
+				Data:       runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"$patch":"replace","metadata":{"annotations":{"rbg.workloads.x-k8s.io/role-restarted-at":"2026-01-02T15:04:05Z"}},"spec":{"containers":[{"name":"c1","image":"foo1"}]}}}}`)},
+			},
+			expectedSpec: nil,
+		},
 	}
 
 	for i, tc := range cases {
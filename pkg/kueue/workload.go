@@ -0,0 +1,353 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kueue submits RoleBasedGroups to Kueue (kueue.x-k8s.io) for quota management.
+//
+// A RoleBasedGroup labeled with kueue.x-k8s.io/queue-name is represented by a Kueue Workload
+// owned by the group, carrying one pod set per role. The workloads of the roles are kept at
+// zero replicas until Kueue admits the Workload, then the node labels of the resource flavors
+// assigned to each pod set are added to the node selector of the role.
+//
+// Kueue has to list RoleBasedGroup in its integrations.externalFrameworks so that it admits
+// Workloads it did not create itself.
+package kueue
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+const (
+	// CrdName is the CRD name for the Kueue Workload.
+	CrdName = "workloads.kueue.x-k8s.io"
+
+	// SpecHashAnnotationKey records the hash of the spec a Workload was created with. Kueue does not
+	// allow the pod sets of a Workload to change, so the Workload is recreated when the hash differs.
+	SpecHashAnnotationKey = constants.RBGPrefix + "kueue-spec-hash"
+
+	admittedConditionType = "Admitted"
+)
+
+var (
+	// WorkloadGVK is the GroupVersionKind of the Kueue Workload.
+	WorkloadGVK = schema.GroupVersionKind{Group: "kueue.x-k8s.io", Version: "v1beta1", Kind: "Workload"}
+
+	// ResourceFlavorGVK is the GroupVersionKind of the Kueue ResourceFlavor.
+	ResourceFlavorGVK = schema.GroupVersionKind{Group: "kueue.x-k8s.io", Version: "v1beta1", Kind: "ResourceFlavor"}
+)
+
+// Admission is the admission of a group by Kueue.
+type Admission struct {
+	// Admitted reports whether Kueue admitted the Workload of the group.
+	Admitted bool
+
+	// NodeSelectors holds per role the node labels of the resource flavors assigned to it.
+	NodeSelectors map[string]map[string]string
+}
+
+// Manager manages the Kueue Workloads of RoleBasedGroups.
+type Manager struct {
+	client client.Client
+}
+
+// New returns a new Manager.
+func New(c client.Client) *Manager {
+	return &Manager{client: c}
+}
+
+// IsQueued returns true if the group is submitted to a Kueue queue.
+func IsQueued(rbg *workloadsv1alpha2.RoleBasedGroup) bool {
+	return rbg.Labels[constants.KueueQueueNameLabelKey] != ""
+}
+
+// NewWorkload returns an empty Kueue Workload object.
+func NewWorkload() *unstructured.Unstructured {
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(WorkloadGVK)
+	return workload
+}
+
+// ReconcileWorkload creates or recreates the Workload of a queued group and returns its admission.
+// The Workload of a group that is no longer queued is deleted and nil is returned.
+func (m *Manager) ReconcileWorkload(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	runtimeController *builder.TypedBuilder[reconcile.Request],
+	watchedWorkload *sync.Map,
+	apiReader client.Reader,
+) (*Admission, error) {
+	if !IsQueued(rbg) {
+		return nil, m.deleteWorkload(ctx, rbg, watchedWorkload)
+	}
+
+	if _, loaded := watchedWorkload.Load(CrdName); !loaded {
+		if err := utils.CheckCrdExists(apiReader, CrdName); err != nil {
+			return nil, fmt.Errorf("kueue %s not ready", CrdName)
+		}
+		watchedWorkload.LoadOrStore(CrdName, struct{}{})
+		runtimeController.Owns(NewWorkload())
+	}
+
+	podSets, err := buildPodSets(ctx, m.client, rbg)
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]interface{}{
+		"queueName": rbg.Labels[constants.KueueQueueNameLabelKey],
+		"podSets":   podSets,
+	}
+	hash, err := hashSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	workload := NewWorkload()
+	err = m.client.Get(ctx, types.NamespacedName{Name: rbg.Name, Namespace: rbg.Namespace}, workload)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && !metav1.IsControlledBy(workload, rbg) {
+		return nil, fmt.Errorf("workload %s/%s exists and is not owned by the group", rbg.Namespace, rbg.Name)
+	}
+	if err == nil && workload.GetAnnotations()[SpecHashAnnotationKey] != hash {
+		// The pod sets of a Workload are immutable, a changed group is queued again.
+		log.FromContext(ctx).Info("Group changed, recreating kueue workload", "workload", workload.GetName())
+		if err := m.client.Delete(ctx, workload); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		return &Admission{}, nil
+	}
+	if apierrors.IsNotFound(err) {
+		if err := m.client.Create(ctx, newGroupWorkload(rbg, spec, hash)); err != nil {
+			return nil, err
+		}
+		return &Admission{}, nil
+	}
+
+	return m.admission(ctx, workload)
+}
+
+func (m *Manager) deleteWorkload(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	watchedWorkload *sync.Map,
+) error {
+	if _, loaded := watchedWorkload.Load(CrdName); !loaded {
+		return nil
+	}
+
+	workload := NewWorkload()
+	err := m.client.Get(ctx, types.NamespacedName{Name: rbg.Name, Namespace: rbg.Namespace}, workload)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if metav1.IsControlledBy(workload, rbg) {
+		if err := m.client.Delete(ctx, workload); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// admission reads the admission of the Workload and resolves the node labels of the assigned flavors.
+func (m *Manager) admission(ctx context.Context, workload *unstructured.Unstructured) (*Admission, error) {
+	conditions, _, _ := unstructured.NestedSlice(workload.Object, "status", "conditions")
+	if !conditionTrue(conditions, admittedConditionType) {
+		return &Admission{}, nil
+	}
+
+	result := &Admission{Admitted: true, NodeSelectors: map[string]map[string]string{}}
+	assignments, _, _ := unstructured.NestedSlice(workload.Object, "status", "admission", "podSetAssignments")
+	flavorLabels := map[string]map[string]string{}
+	for _, item := range assignments {
+		assignment, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		role, _, _ := unstructured.NestedString(assignment, "name")
+		flavors, _, _ := unstructured.NestedStringMap(assignment, "flavors")
+		for _, flavor := range flavors {
+			labels, ok := flavorLabels[flavor]
+			if !ok {
+				var err error
+				if labels, err = m.flavorNodeLabels(ctx, flavor); err != nil {
+					return nil, err
+				}
+				flavorLabels[flavor] = labels
+			}
+			if len(labels) == 0 {
+				continue
+			}
+			if result.NodeSelectors[role] == nil {
+				result.NodeSelectors[role] = map[string]string{}
+			}
+			maps.Copy(result.NodeSelectors[role], labels)
+		}
+	}
+	return result, nil
+}
+
+func (m *Manager) flavorNodeLabels(ctx context.Context, name string) (map[string]string, error) {
+	flavor := &unstructured.Unstructured{}
+	flavor.SetGroupVersionKind(ResourceFlavorGVK)
+	if err := m.client.Get(ctx, types.NamespacedName{Name: name}, flavor); err != nil {
+		return nil, fmt.Errorf("failed to get resource flavor %s: %w", name, err)
+	}
+	labels, _, err := unstructured.NestedStringMap(flavor.Object, "spec", "nodeLabels")
+	if err != nil {
+		return nil, fmt.Errorf("invalid node labels of resource flavor %s: %w", name, err)
+	}
+	return labels, nil
+}
+
+func conditionTrue(conditions []interface{}, conditionType string) bool {
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == conditionType {
+			return condition["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+func newGroupWorkload(rbg *workloadsv1alpha2.RoleBasedGroup, spec map[string]interface{}, hash string) *unstructured.Unstructured {
+	workload := NewWorkload()
+	workload.SetName(rbg.Name)
+	workload.SetNamespace(rbg.Namespace)
+	workload.SetLabels(map[string]string{constants.GroupNameLabelKey: rbg.Name})
+	workload.SetAnnotations(map[string]string{SpecHashAnnotationKey: hash})
+	workload.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(rbg, utils.GetRbgGVK())})
+	workload.Object["spec"] = spec
+	return workload
+}
+
+// buildPodSets returns one pod set per role, counting every pod of every replica of the role so
+// that Kueue reserves quota for the whole group.
+func buildPodSets(ctx context.Context, c client.Reader, rbg *workloadsv1alpha2.RoleBasedGroup) ([]interface{}, error) {
+	podSets := make([]interface{}, 0, len(rbg.Spec.Roles))
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		if role.GetCustomComponentsPattern() != nil {
+			return nil, fmt.Errorf("role %s: custom components are not supported with kueue", role.Name)
+		}
+		template, err := utils.ResolveRoleTemplate(ctx, c, rbg, role)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+		if err != nil {
+			return nil, err
+		}
+		podsPerReplica := int64(1)
+		if size := role.GetLeaderWorkerSize(); size != nil {
+			podsPerReplica = int64(*size)
+		}
+		podSets = append(podSets, map[string]interface{}{
+			"name":     role.Name,
+			"count":    int64(ptr.Deref(role.Replicas, 1)) * podsPerReplica,
+			"template": obj,
+		})
+	}
+	return podSets, nil
+}
+
+func hashSpec(spec map[string]interface{}) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
+
+// InjectNodeSelector returns a copy of role whose pod template selects the given node labels.
+func InjectNodeSelector(role *workloadsv1alpha2.RoleSpec, nodeSelector map[string]string) (*workloadsv1alpha2.RoleSpec, error) {
+	injected := role.DeepCopy()
+	if len(nodeSelector) == 0 {
+		return injected, nil
+	}
+	var source *workloadsv1alpha2.TemplateSource
+	switch {
+	case injected.StandalonePattern != nil:
+		source = &injected.StandalonePattern.TemplateSource
+	case injected.LeaderWorkerPattern != nil:
+		source = &injected.LeaderWorkerPattern.TemplateSource
+	default:
+		return nil, fmt.Errorf("role %s has no template to inject the node selector into", role.Name)
+	}
+
+	if source.Template != nil {
+		if source.Template.Spec.NodeSelector == nil {
+			source.Template.Spec.NodeSelector = map[string]string{}
+		}
+		maps.Copy(source.Template.Spec.NodeSelector, nodeSelector)
+		return injected, nil
+	}
+	if source.TemplateRef == nil {
+		return nil, fmt.Errorf("role %s has no template or templateRef set", role.Name)
+	}
+
+	// The referenced template is shared, the node selector goes into the patch of the role.
+	patch := map[string]interface{}{}
+	if source.TemplateRef.Patch != nil && len(source.TemplateRef.Patch.Raw) > 0 {
+		if err := json.Unmarshal(source.TemplateRef.Patch.Raw, &patch); err != nil {
+			return nil, fmt.Errorf("role %s: invalid templateRef patch: %w", role.Name, err)
+		}
+	}
+	selector, _, _ := unstructured.NestedMap(patch, "spec", "nodeSelector")
+	if selector == nil {
+		selector = map[string]interface{}{}
+	}
+	for key, value := range nodeSelector {
+		selector[key] = value
+	}
+	if err := unstructured.SetNestedField(patch, selector, "spec", "nodeSelector"); err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	source.TemplateRef.Patch = &runtime.RawExtension{Raw: raw}
+	return injected, nil
+}
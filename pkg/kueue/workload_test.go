@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kueue
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newQueuedRBG() *workloadsv1alpha2.RoleBasedGroup {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode").WithReplicas(2).WithSize(4).Obj(),
+		}).Obj()
+	rbg.Labels[constants.KueueQueueNameLabelKey] = "gpu-queue"
+	return rbg
+}
+
+func newFlavor(name string, nodeLabels map[string]interface{}) *unstructured.Unstructured {
+	flavor := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"nodeLabels": nodeLabels},
+	}}
+	flavor.SetGroupVersionKind(ResourceFlavorGVK)
+	flavor.SetName(name)
+	return flavor
+}
+
+func newCrdReader(scheme *runtime.Scheme) client.Reader {
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: CrdName},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+					{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+				},
+			},
+		},
+	).Build()
+}
+
+func getWorkload(t *testing.T, c client.Client) (*unstructured.Unstructured, error) {
+	t.Helper()
+	workload := NewWorkload()
+	err := c.Get(context.TODO(), types.NamespacedName{Name: "test-rbg", Namespace: "default"}, workload)
+	return workload, err
+}
+
+func TestManager_ReconcileWorkload(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+
+	rbg := newQueuedRBG()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newFlavor("h100", map[string]interface{}{"gpu.product": "h100", "zone": "a"}),
+		newFlavor("default-cpu", map[string]interface{}{}),
+	).Build()
+	manager := New(c)
+	runtimeController := builder.TypedBuilder[reconcile.Request]{}
+	watchedWorkload := sync.Map{}
+
+	// The Workload is not found until the CRD is installed.
+	_, err := manager.ReconcileWorkload(context.TODO(), rbg, &runtimeController, &watchedWorkload,
+		fake.NewClientBuilder().WithScheme(scheme).Build())
+	assert.EqualError(t, err, "kueue workloads.kueue.x-k8s.io not ready")
+
+	// A queued group gets a Workload with one pod set per role.
+	reader := newCrdReader(scheme)
+	admission, err := manager.ReconcileWorkload(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	assert.False(t, admission.Admitted)
+	workload, err := getWorkload(t, c)
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(workload, rbg))
+	queueName, _, _ := unstructured.NestedString(workload.Object, "spec", "queueName")
+	assert.Equal(t, "gpu-queue", queueName)
+	podSets, _, _ := unstructured.NestedSlice(workload.Object, "spec", "podSets")
+	require.Len(t, podSets, 2)
+	assert.Equal(t, "prefill", podSets[0].(map[string]interface{})["name"])
+	assert.EqualValues(t, 2, podSets[0].(map[string]interface{})["count"])
+	assert.Equal(t, "decode", podSets[1].(map[string]interface{})["name"])
+	assert.EqualValues(t, 8, podSets[1].(map[string]interface{})["count"])
+
+	// An admitted Workload resolves the node labels of the assigned flavors per role.
+	workload.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "QuotaReserved", "status": "True"},
+			map[string]interface{}{"type": "Admitted", "status": "True"},
+		},
+		"admission": map[string]interface{}{
+			"clusterQueue": "cluster-queue",
+			"podSetAssignments": []interface{}{
+				map[string]interface{}{"name": "prefill", "flavors": map[string]interface{}{"cpu": "default-cpu"}},
+				map[string]interface{}{"name": "decode", "flavors": map[string]interface{}{
+					"cpu": "default-cpu", "nvidia.com/gpu": "h100",
+				}},
+			},
+		},
+	}
+	require.NoError(t, c.Update(context.TODO(), workload))
+	admission, err = manager.ReconcileWorkload(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	assert.True(t, admission.Admitted)
+	assert.Equal(t, map[string]map[string]string{"decode": {"gpu.product": "h100", "zone": "a"}}, admission.NodeSelectors)
+
+	// Changing the group queues it again.
+	rbg.Spec.Roles[0].Replicas = ptr.To[int32](3)
+	admission, err = manager.ReconcileWorkload(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	assert.False(t, admission.Admitted)
+	_, err = getWorkload(t, c)
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = manager.ReconcileWorkload(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	workload, err = getWorkload(t, c)
+	require.NoError(t, err)
+	podSets, _, _ = unstructured.NestedSlice(workload.Object, "spec", "podSets")
+	assert.EqualValues(t, 3, podSets[0].(map[string]interface{})["count"])
+
+	// Removing the queue name deletes the Workload.
+	delete(rbg.Labels, constants.KueueQueueNameLabelKey)
+	admission, err = manager.ReconcileWorkload(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	assert.Nil(t, admission)
+	_, err = getWorkload(t, c)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestInjectNodeSelector(t *testing.T) {
+	selector := map[string]string{"gpu.product": "h100"}
+
+	role := wrappersv2.BuildStandaloneRole("prefill").Obj()
+	role.StandalonePattern.Template.Spec.NodeSelector = map[string]string{"zone": "a"}
+	injected, err := InjectNodeSelector(&role, selector)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"zone": "a", "gpu.product": "h100"}, injected.GetTemplate().Spec.NodeSelector)
+	assert.Equal(t, map[string]string{"zone": "a"}, role.GetTemplate().Spec.NodeSelector)
+
+	role = wrappersv2.BuildStandaloneRole("prefill").
+		WithPatchRef("base", &runtime.RawExtension{Raw: []byte(`{"metadata":{"labels":{"a":"b"}},"spec":{"nodeSelector":{"zone":"a"}}}`)}).
+		Obj()
+	injected, err = InjectNodeSelector(&role, selector)
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"metadata":{"labels":{"a":"b"}},"spec":{"nodeSelector":{"zone":"a","gpu.product":"h100"}}}`,
+		string(injected.GetTemplatePatch().Raw))
+
+	role = wrappersv2.BuildLeaderWorkerRole("decode").WithTemplateRef("base").Obj()
+	injected, err = InjectNodeSelector(&role, selector)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"spec":{"nodeSelector":{"gpu.product":"h100"}}}`, string(injected.GetTemplatePatch().Raw))
+
+	_, err = InjectNodeSelector(&workloadsv1alpha2.RoleSpec{Name: "empty"}, selector)
+	assert.EqualError(t, err, "role empty has no template to inject the node selector into")
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exports the Prometheus metrics of the RoleBasedGroup controller, served by the
+// metrics endpoint of the controller manager.
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+const namespace = "rbg"
+
+var (
+	roleLabels  = []string{"namespace", "rbg", "role"}
+	groupLabels = []string{"namespace", "rbg"}
+
+	roleDesiredReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "role_desired_replicas",
+		Help:      "Number of replicas desired by a role.",
+	}, roleLabels)
+	roleReadyReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "role_ready_replicas",
+		Help:      "Number of ready replicas of a role.",
+	}, roleLabels)
+	roleUpdatedReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "role_updated_replicas",
+		Help:      "Number of replicas of a role running its latest revision.",
+	}, roleLabels)
+	roleRolloutDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "role_rollout_duration_seconds",
+		Help:      "Time from a new revision of a role until all its replicas are updated and ready.",
+		Buckets:   prometheus.ExponentialBuckets(10, 2, 12),
+	}, roleLabels)
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of the reconciliations of RoleBasedGroups.",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+	}, []string{"result"})
+	reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconcile_errors_total",
+		Help:      "Number of failed reconciliations of a RoleBasedGroup.",
+	}, groupLabels)
+	revisionsDeleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "revisions_deleted_total",
+		Help:      "Number of expired ControllerRevisions of a RoleBasedGroup garbage collected.",
+	}, groupLabels)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		roleDesiredReplicas, roleReadyReplicas, roleUpdatedReplicas, roleRolloutDuration,
+		reconcileDuration, reconcileErrors, revisionsDeleted,
+	)
+}
+
+// rollout tracks the latest revision of a role.
+type rollout struct {
+	revision string
+	start    time.Time
+	done     bool
+}
+
+var (
+	mu sync.Mutex
+	// groupRoles are the roles metrics were recorded for, per group.
+	groupRoles = map[string]sets.Set[string]{}
+	// rollouts are keyed by group and role.
+	rollouts = map[string]*rollout{}
+)
+
+func groupKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// RecordRoles records the replicas of the roles of a group from their statuses, along with the
+// progress of their rollouts to the given revisions. The series of the roles removed from the
+// group are dropped.
+func RecordRoles(rbg *workloadsv1alpha2.RoleBasedGroup, statuses []workloadsv1alpha2.RoleStatus,
+	revisions map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := groupKey(rbg.Namespace, rbg.Name)
+	current := sets.New[string]()
+	for _, status := range statuses {
+		role, err := rbg.GetRole(status.Name)
+		if err != nil {
+			continue
+		}
+		current.Insert(role.Name)
+		desired := ptr.Deref(role.Replicas, 1)
+		roleDesiredReplicas.WithLabelValues(rbg.Namespace, rbg.Name, role.Name).Set(float64(desired))
+		roleReadyReplicas.WithLabelValues(rbg.Namespace, rbg.Name, role.Name).Set(float64(status.ReadyReplicas))
+		roleUpdatedReplicas.WithLabelValues(rbg.Namespace, rbg.Name, role.Name).Set(float64(status.UpdatedReplicas))
+
+		if revision, ok := revisions[role.Name]; ok {
+			recordRollout(rbg, role.Name, revision, status.Replicas == desired &&
+				status.ReadyReplicas >= desired && status.UpdatedReplicas >= desired)
+		}
+	}
+	for _, removed := range sets.List(groupRoles[key].Difference(current)) {
+		deleteRole(rbg.Namespace, rbg.Name, removed)
+	}
+	groupRoles[key] = current
+}
+
+// recordRollout observes the duration of the rollout of a role once it completes. The statuses
+// passed along with a new revision predate it, the rollout can only complete in a later call.
+// Rollouts already running when the controller starts are not observed, their start is unknown.
+func recordRollout(rbg *workloadsv1alpha2.RoleBasedGroup, role, revision string, done bool) {
+	key := groupKey(rbg.Namespace, rbg.Name) + "/" + role
+	current, ok := rollouts[key]
+	switch {
+	case !ok:
+		rollouts[key] = &rollout{revision: revision, start: time.Now(), done: true}
+	case current.revision != revision:
+		rollouts[key] = &rollout{revision: revision, start: time.Now()}
+	case !current.done && done:
+		current.done = true
+		roleRolloutDuration.WithLabelValues(rbg.Namespace, rbg.Name, role).Observe(time.Since(current.start).Seconds())
+	}
+}
+
+// ObserveReconcile records the duration and the result of a reconciliation of a group.
+func ObserveReconcile(namespace, name string, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+		reconcileErrors.WithLabelValues(namespace, name).Inc()
+	}
+	reconcileDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// RecordRevisionsDeleted counts the expired revisions of a group garbage collected.
+func RecordRevisionsDeleted(namespace, name string, count int) {
+	revisionsDeleted.WithLabelValues(namespace, name).Add(float64(count))
+}
+
+// ForgetGroup drops the series of a deleted group.
+func ForgetGroup(namespace, name string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := groupKey(namespace, name)
+	for role := range groupRoles[key] {
+		deleteRole(namespace, name, role)
+	}
+	delete(groupRoles, key)
+	labels := prometheus.Labels{"namespace": namespace, "rbg": name}
+	reconcileErrors.DeletePartialMatch(labels)
+	revisionsDeleted.DeletePartialMatch(labels)
+}
+
+func deleteRole(namespace, name, role string) {
+	labels := prometheus.Labels{"namespace": namespace, "rbg": name, "role": role}
+	roleDesiredReplicas.Delete(labels)
+	roleReadyReplicas.Delete(labels)
+	roleUpdatedReplicas.Delete(labels)
+	roleRolloutDuration.Delete(labels)
+	delete(rollouts, groupKey(namespace, name)+"/"+role)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func gaugeValue(t *testing.T, vec *prometheus.GaugeVec, labels ...string) float64 {
+	m := &dto.Metric{}
+	require.NoError(t, vec.WithLabelValues(labels...).Write(m))
+	return m.GetGauge().GetValue()
+}
+
+func sampleCount(t *testing.T, vec *prometheus.HistogramVec, labels ...string) uint64 {
+	m := &dto.Metric{}
+	require.NoError(t, vec.WithLabelValues(labels...).(prometheus.Metric).Write(m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestRecordRoles(t *testing.T) {
+	defer ForgetGroup("default", "test-rbg")
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{Roles: []workloadsv1alpha2.RoleSpec{
+			{Name: "prefill", Replicas: ptr.To[int32](2)},
+			{Name: "decode", Replicas: ptr.To[int32](3)},
+		}},
+	}
+	statuses := []workloadsv1alpha2.RoleStatus{
+		{Name: "prefill", Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 2},
+		{Name: "decode", Replicas: 3, ReadyReplicas: 1, UpdatedReplicas: 3},
+	}
+	RecordRoles(rbg, statuses, map[string]string{"prefill": "rev-1", "decode": "rev-1"})
+	assert.Equal(t, 3.0, gaugeValue(t, roleDesiredReplicas, "default", "test-rbg", "decode"))
+	assert.Equal(t, 1.0, gaugeValue(t, roleReadyReplicas, "default", "test-rbg", "decode"))
+	assert.Equal(t, 3.0, gaugeValue(t, roleUpdatedReplicas, "default", "test-rbg", "decode"))
+
+	// A new revision of prefill is rolled out, its statuses predate the revision.
+	RecordRoles(rbg, statuses, map[string]string{"prefill": "rev-2", "decode": "rev-1"})
+	assert.Equal(t, uint64(0), sampleCount(t, roleRolloutDuration, "default", "test-rbg", "prefill"))
+	statuses[0].UpdatedReplicas = 1
+	RecordRoles(rbg, statuses, map[string]string{"prefill": "rev-2", "decode": "rev-1"})
+	assert.Equal(t, uint64(0), sampleCount(t, roleRolloutDuration, "default", "test-rbg", "prefill"))
+	statuses[0].UpdatedReplicas = 2
+	RecordRoles(rbg, statuses, map[string]string{"prefill": "rev-2", "decode": "rev-1"})
+	RecordRoles(rbg, statuses, map[string]string{"prefill": "rev-2", "decode": "rev-1"})
+	assert.Equal(t, uint64(1), sampleCount(t, roleRolloutDuration, "default", "test-rbg", "prefill"))
+	assert.Equal(t, uint64(0), sampleCount(t, roleRolloutDuration, "default", "test-rbg", "decode"))
+
+	// The series of a removed role are dropped.
+	rbg.Spec.Roles = rbg.Spec.Roles[:1]
+	RecordRoles(rbg, statuses[:1], nil)
+	assert.False(t, roleDesiredReplicas.Delete(prometheus.Labels{"namespace": "default", "rbg": "test-rbg", "role": "decode"}))
+	assert.True(t, roleDesiredReplicas.Delete(prometheus.Labels{"namespace": "default", "rbg": "test-rbg", "role": "prefill"}))
+}
+
+func TestObserveReconcile(t *testing.T) {
+	defer ForgetGroup("default", "test-rbg")
+	errorsBefore := &dto.Metric{}
+	require.NoError(t, reconcileErrors.WithLabelValues("default", "test-rbg").Write(errorsBefore))
+
+	ObserveReconcile("default", "test-rbg", time.Second, nil)
+	ObserveReconcile("default", "test-rbg", time.Second, errors.New("conflict"))
+	RecordRevisionsDeleted("default", "test-rbg", 2)
+
+	m := &dto.Metric{}
+	require.NoError(t, reconcileErrors.WithLabelValues("default", "test-rbg").Write(m))
+	assert.Equal(t, errorsBefore.GetCounter().GetValue()+1, m.GetCounter().GetValue())
+	require.NoError(t, revisionsDeleted.WithLabelValues("default", "test-rbg").Write(m))
+	assert.Equal(t, 2.0, m.GetCounter().GetValue())
+
+	ForgetGroup("default", "test-rbg")
+	assert.False(t, revisionsDeleted.Delete(prometheus.Labels{"namespace": "default", "rbg": "test-rbg"}))
+}
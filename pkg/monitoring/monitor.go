@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package monitoring scrapes the metrics of the roles of RoleBasedGroups with the Prometheus Operator
+// (monitoring.coreos.com).
+//
+// Every role of spec.monitoring is represented by a PodMonitor, or a ServiceMonitor over the headless
+// Service of the role, owned by the group and named after the workload of the role. The monitors
+// select the pods of the role by their labels, so the scraped targets follow its replicas.
+package monitoring
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+const (
+	// PodMonitorCrdName is the CRD name for the Prometheus Operator PodMonitor.
+	PodMonitorCrdName = "podmonitors.monitoring.coreos.com"
+
+	// ServiceMonitorCrdName is the CRD name for the Prometheus Operator ServiceMonitor.
+	ServiceMonitorCrdName = "servicemonitors.monitoring.coreos.com"
+
+	// SpecHashAnnotationKey records the hash of the spec a monitor was last written with, so that the
+	// fields defaulted by the API server do not trigger an update on every reconcile.
+	SpecHashAnnotationKey = constants.RBGPrefix + "monitor-spec-hash"
+
+	defaultMetricsPath = "/metrics"
+)
+
+var (
+	// PodMonitorGVK is the GroupVersionKind of the Prometheus Operator PodMonitor.
+	PodMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"}
+
+	// ServiceMonitorGVK is the GroupVersionKind of the Prometheus Operator ServiceMonitor.
+	ServiceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+)
+
+// monitorKinds maps the kinds of monitors to their CRD names and GroupVersionKinds.
+var monitorKinds = map[workloadsv1alpha2.MonitorKind]struct {
+	crdName string
+	gvk     schema.GroupVersionKind
+}{
+	workloadsv1alpha2.PodMonitorKind:     {crdName: PodMonitorCrdName, gvk: PodMonitorGVK},
+	workloadsv1alpha2.ServiceMonitorKind: {crdName: ServiceMonitorCrdName, gvk: ServiceMonitorGVK},
+}
+
+// Manager manages the monitors of RoleBasedGroups.
+type Manager struct {
+	client client.Client
+}
+
+// New returns a new Manager.
+func New(c client.Client) *Manager {
+	return &Manager{client: c}
+}
+
+// NewPodMonitor returns an empty PodMonitor object.
+func NewPodMonitor() *unstructured.Unstructured {
+	return newMonitor(PodMonitorGVK)
+}
+
+// NewServiceMonitor returns an empty ServiceMonitor object.
+func NewServiceMonitor() *unstructured.Unstructured {
+	return newMonitor(ServiceMonitorGVK)
+}
+
+func newMonitor(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(gvk)
+	return monitor
+}
+
+// monitorKind returns the kind of the monitors of a monitoring policy.
+func monitorKind(policy *workloadsv1alpha2.MonitoringPolicy) workloadsv1alpha2.MonitorKind {
+	if policy.Kind == "" {
+		return workloadsv1alpha2.PodMonitorKind
+	}
+	return policy.Kind
+}
+
+// ReconcileMonitors creates or updates the monitors of the roles in spec.monitoring of a group and
+// deletes the monitors of the roles, or of the kind, it no longer has.
+func (m *Manager) ReconcileMonitors(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	runtimeController *builder.TypedBuilder[reconcile.Request],
+	watchedWorkload *sync.Map,
+	apiReader client.Reader,
+) error {
+	expected := map[string]bool{}
+	policy := rbg.Spec.Monitoring
+	var kind workloadsv1alpha2.MonitorKind
+	if policy != nil {
+		kind = monitorKind(policy)
+		target, ok := monitorKinds[kind]
+		if !ok {
+			return fmt.Errorf("unsupported monitor kind %s", kind)
+		}
+		if _, loaded := watchedWorkload.Load(target.crdName); !loaded {
+			if err := utils.CheckCrdExists(apiReader, target.crdName); err != nil {
+				return fmt.Errorf("prometheus operator %s not ready", target.crdName)
+			}
+			watchedWorkload.LoadOrStore(target.crdName, struct{}{})
+			runtimeController.Owns(newMonitor(target.gvk))
+		}
+
+		for i := range policy.Roles {
+			roleMonitoring := &policy.Roles[i]
+			role, err := rbg.GetRole(roleMonitoring.Name)
+			if err != nil {
+				return err
+			}
+			name := rbg.GetWorkloadName(role)
+			expected[name] = true
+			spec := buildMonitorSpec(rbg, policy, kind, roleMonitoring)
+			if err := m.reconcileMonitor(ctx, rbg, target.gvk, name, policy.Labels, spec); err != nil {
+				return err
+			}
+		}
+	}
+
+	for otherKind, target := range monitorKinds {
+		if _, loaded := watchedWorkload.Load(target.crdName); !loaded {
+			continue
+		}
+		keep := expected
+		if otherKind != kind {
+			keep = nil
+		}
+		if err := m.deleteStaleMonitors(ctx, rbg, target.gvk, keep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) reconcileMonitor(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	gvk schema.GroupVersionKind,
+	name string,
+	labels map[string]string,
+	spec map[string]interface{},
+) error {
+	hash, err := hashSpec(map[string]interface{}{"labels": labels, "spec": spec})
+	if err != nil {
+		return err
+	}
+
+	monitor := newMonitor(gvk)
+	err = m.client.Get(ctx, types.NamespacedName{Name: name, Namespace: rbg.Namespace}, monitor)
+	if apierrors.IsNotFound(err) {
+		return m.client.Create(ctx, newGroupMonitor(rbg, gvk, name, labels, spec, hash))
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(monitor, rbg) {
+		return fmt.Errorf("%s %s/%s exists and is not owned by the group", gvk.Kind, rbg.Namespace, name)
+	}
+	if monitor.GetAnnotations()[SpecHashAnnotationKey] == hash {
+		return nil
+	}
+	monitor.Object["spec"] = spec
+	monitor.SetLabels(monitorLabels(rbg, labels))
+	annotations := monitor.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[SpecHashAnnotationKey] = hash
+	monitor.SetAnnotations(annotations)
+	return m.client.Update(ctx, monitor)
+}
+
+// deleteStaleMonitors deletes the monitors of the given kind owned by the group that are not in keep.
+func (m *Manager) deleteStaleMonitors(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	gvk schema.GroupVersionKind,
+	keep map[string]bool,
+) error {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := m.client.List(ctx, list, client.InNamespace(rbg.Namespace),
+		client.MatchingLabels{constants.GroupNameLabelKey: rbg.Name}); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		monitor := &list.Items[i]
+		if keep[monitor.GetName()] || !metav1.IsControlledBy(monitor, rbg) {
+			continue
+		}
+		if err := m.client.Delete(ctx, monitor); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// monitorLabels returns the labels of the policy with the name of the group the monitors are listed by.
+func monitorLabels(rbg *workloadsv1alpha2.RoleBasedGroup, labels map[string]string) map[string]string {
+	result := make(map[string]string, len(labels)+1)
+	maps.Copy(result, labels)
+	result[constants.GroupNameLabelKey] = rbg.Name
+	return result
+}
+
+func newGroupMonitor(
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	gvk schema.GroupVersionKind,
+	name string,
+	labels map[string]string,
+	spec map[string]interface{},
+	hash string,
+) *unstructured.Unstructured {
+	monitor := newMonitor(gvk)
+	monitor.SetName(name)
+	monitor.SetNamespace(rbg.Namespace)
+	monitor.SetLabels(monitorLabels(rbg, labels))
+	monitor.SetAnnotations(map[string]string{SpecHashAnnotationKey: hash})
+	monitor.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(rbg, utils.GetRbgGVK())})
+	monitor.Object["spec"] = spec
+	return monitor
+}
+
+// buildMonitorSpec selects the pods of the role, or its headless Service, and scrapes the named
+// container port. The group and role labels of the pods are added to the scraped series.
+func buildMonitorSpec(
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	policy *workloadsv1alpha2.MonitoringPolicy,
+	kind workloadsv1alpha2.MonitorKind,
+	role *workloadsv1alpha2.RoleMonitoring,
+) map[string]interface{} {
+	path := role.Path
+	if path == "" {
+		path = defaultMetricsPath
+	}
+	endpoint := map[string]interface{}{"path": path}
+	if policy.Interval != "" {
+		endpoint["interval"] = policy.Interval
+	}
+	spec := map[string]interface{}{
+		"selector": map[string]interface{}{
+			"matchLabels": map[string]interface{}{
+				constants.GroupNameLabelKey: rbg.Name,
+				constants.RoleNameLabelKey:  role.Name,
+			},
+		},
+		"podTargetLabels": []interface{}{constants.GroupNameLabelKey, constants.RoleNameLabelKey},
+	}
+	if kind == workloadsv1alpha2.ServiceMonitorKind {
+		// The headless Service of the role declares no ports, the container port is scraped directly.
+		endpoint["targetPort"] = role.Port
+		spec["endpoints"] = []interface{}{endpoint}
+	} else {
+		endpoint["port"] = role.Port
+		spec["podMetricsEndpoints"] = []interface{}{endpoint}
+	}
+	return spec
+}
+
+func hashSpec(spec map[string]interface{}) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
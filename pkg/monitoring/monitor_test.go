@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newCrdReader(scheme *runtime.Scheme, names ...string) client.Reader {
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, name := range names {
+		builder = builder.WithObjects(&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+					{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+				},
+			},
+		})
+	}
+	return builder.Build()
+}
+
+func getMonitor(t *testing.T, c client.Client, monitor *unstructured.Unstructured, name string) error {
+	t.Helper()
+	return c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "default"}, monitor)
+}
+
+func TestManager_ReconcileMonitors(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("router").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").Obj(),
+		}).Obj()
+	rbg.Spec.Monitoring = &workloadsv1alpha2.MonitoringPolicy{
+		Interval: "30s",
+		Labels:   map[string]string{"release": "prometheus"},
+		Roles:    []workloadsv1alpha2.RoleMonitoring{{Name: "decode", Port: "metrics"}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	manager := New(c)
+	runtimeController := builder.TypedBuilder[reconcile.Request]{}
+	watchedWorkload := sync.Map{}
+
+	// The monitors are not created until the Prometheus Operator is installed.
+	err := manager.ReconcileMonitors(context.TODO(), rbg, &runtimeController, &watchedWorkload,
+		fake.NewClientBuilder().WithScheme(scheme).Build())
+	assert.EqualError(t, err, "prometheus operator podmonitors.monitoring.coreos.com not ready")
+
+	// A PodMonitor selects the pods of the role and scrapes the named port.
+	reader := newCrdReader(scheme, PodMonitorCrdName, ServiceMonitorCrdName)
+	require.NoError(t, manager.ReconcileMonitors(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	podMonitor := NewPodMonitor()
+	require.NoError(t, getMonitor(t, c, podMonitor, "test-rbg-decode"))
+	assert.True(t, metav1.IsControlledBy(podMonitor, rbg))
+	assert.Equal(t, map[string]string{"release": "prometheus", constants.GroupNameLabelKey: "test-rbg"}, podMonitor.GetLabels())
+	selector, _, _ := unstructured.NestedStringMap(podMonitor.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{constants.GroupNameLabelKey: "test-rbg", constants.RoleNameLabelKey: "decode"}, selector)
+	endpoints, _, _ := unstructured.NestedSlice(podMonitor.Object, "spec", "podMetricsEndpoints")
+	assert.Equal(t, []interface{}{map[string]interface{}{"port": "metrics", "path": "/metrics", "interval": "30s"}}, endpoints)
+
+	// Monitoring another role adds its monitor.
+	rbg.Spec.Monitoring.Roles = append(rbg.Spec.Monitoring.Roles,
+		workloadsv1alpha2.RoleMonitoring{Name: "router", Port: "http", Path: "/stats"})
+	require.NoError(t, manager.ReconcileMonitors(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	require.NoError(t, getMonitor(t, c, NewPodMonitor(), "test-rbg-router"))
+
+	// Switching the kind replaces the PodMonitors with ServiceMonitors.
+	rbg.Spec.Monitoring.Kind = workloadsv1alpha2.ServiceMonitorKind
+	rbg.Spec.Monitoring.Roles = rbg.Spec.Monitoring.Roles[:1]
+	require.NoError(t, manager.ReconcileMonitors(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	serviceMonitor := NewServiceMonitor()
+	require.NoError(t, getMonitor(t, c, serviceMonitor, "test-rbg-decode"))
+	endpoints, _, _ = unstructured.NestedSlice(serviceMonitor.Object, "spec", "endpoints")
+	assert.Equal(t, []interface{}{map[string]interface{}{"targetPort": "metrics", "path": "/metrics", "interval": "30s"}}, endpoints)
+	assert.True(t, apierrors.IsNotFound(getMonitor(t, c, NewPodMonitor(), "test-rbg-decode")))
+	assert.True(t, apierrors.IsNotFound(getMonitor(t, c, NewPodMonitor(), "test-rbg-router")))
+
+	// Removing spec.monitoring deletes the monitors.
+	rbg.Spec.Monitoring = nil
+	require.NoError(t, manager.ReconcileMonitors(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader))
+	assert.True(t, apierrors.IsNotFound(getMonitor(t, c, NewServiceMonitor(), "test-rbg-decode")))
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package multicluster propagates RoleBasedGroups to the member clusters of a Karmada control plane.
+//
+// The controller runs against the Karmada API server, where the workloads of the roles are created
+// as resource templates. A group with spec.multiCluster owns a PropagationPolicy selecting its
+// workloads, Services and discovery ConfigMap, which Karmada applies to the member clusters. Karmada
+// aggregates the status of Deployments and StatefulSets back into the templates, so the role
+// statuses of the group cover every cluster. Whether the workloads are applied is read from their
+// ResourceBindings.
+package multicluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+const (
+	// PropagationPolicyCrdName is the CRD name for the Karmada PropagationPolicy.
+	PropagationPolicyCrdName = "propagationpolicies.policy.karmada.io"
+
+	// SpecHashAnnotationKey records the hash of the spec a PropagationPolicy was last written with,
+	// so that the fields defaulted by Karmada do not trigger an update on every reconcile.
+	SpecHashAnnotationKey = constants.RBGPrefix + "propagation-spec-hash"
+
+	scheduledConditionType = "Scheduled"
+)
+
+var (
+	// PropagationPolicyGVK is the GroupVersionKind of the Karmada PropagationPolicy.
+	PropagationPolicyGVK = schema.GroupVersionKind{Group: "policy.karmada.io", Version: "v1alpha1", Kind: "PropagationPolicy"}
+
+	// ResourceBindingGVK is the GroupVersionKind of the Karmada ResourceBinding.
+	ResourceBindingGVK = schema.GroupVersionKind{Group: "work.karmada.io", Version: "v1alpha2", Kind: "ResourceBinding"}
+)
+
+// Propagation is the propagation of a group to its member clusters.
+type Propagation struct {
+	// Propagated reports whether the workloads of every role are applied to their member clusters.
+	Propagated bool
+
+	// Message describes the roles that are not propagated yet.
+	Message string
+}
+
+// Manager manages the Karmada PropagationPolicies of RoleBasedGroups.
+type Manager struct {
+	client client.Client
+}
+
+// New returns a new Manager.
+func New(c client.Client) *Manager {
+	return &Manager{client: c}
+}
+
+// IsMultiCluster returns true if the group is propagated to member clusters.
+func IsMultiCluster(rbg *workloadsv1alpha2.RoleBasedGroup) bool {
+	return rbg.Spec.MultiCluster != nil
+}
+
+// NewPropagationPolicy returns an empty Karmada PropagationPolicy object.
+func NewPropagationPolicy() *unstructured.Unstructured {
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(PropagationPolicyGVK)
+	return policy
+}
+
+// ReconcilePropagationPolicy creates or updates the PropagationPolicy of a multi-cluster group and
+// returns its propagation. The PropagationPolicy of a group that is no longer multi-cluster is deleted
+// and nil is returned.
+func (m *Manager) ReconcilePropagationPolicy(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	runtimeController *builder.TypedBuilder[reconcile.Request],
+	watchedWorkload *sync.Map,
+	apiReader client.Reader,
+) (*Propagation, error) {
+	if !IsMultiCluster(rbg) {
+		return nil, m.deletePropagationPolicy(ctx, rbg, watchedWorkload)
+	}
+
+	if _, loaded := watchedWorkload.Load(PropagationPolicyCrdName); !loaded {
+		if err := utils.CheckCrdExists(apiReader, PropagationPolicyCrdName); err != nil {
+			return nil, fmt.Errorf("karmada %s not ready", PropagationPolicyCrdName)
+		}
+		watchedWorkload.LoadOrStore(PropagationPolicyCrdName, struct{}{})
+		runtimeController.Owns(NewPropagationPolicy())
+	}
+
+	spec := buildPolicySpec(rbg)
+	hash, err := hashSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := NewPropagationPolicy()
+	err = m.client.Get(ctx, types.NamespacedName{Name: rbg.Name, Namespace: rbg.Namespace}, policy)
+	if apierrors.IsNotFound(err) {
+		if err := m.client.Create(ctx, newGroupPolicy(rbg, spec, hash)); err != nil {
+			return nil, err
+		}
+		return m.propagation(ctx, rbg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(policy, rbg) {
+		return nil, fmt.Errorf("propagation policy %s/%s exists and is not owned by the group", rbg.Namespace, rbg.Name)
+	}
+	if policy.GetAnnotations()[SpecHashAnnotationKey] != hash {
+		policy.Object["spec"] = spec
+		annotations := policy.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[SpecHashAnnotationKey] = hash
+		policy.SetAnnotations(annotations)
+		if err := m.client.Update(ctx, policy); err != nil {
+			return nil, err
+		}
+	}
+	return m.propagation(ctx, rbg)
+}
+
+func (m *Manager) deletePropagationPolicy(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	watchedWorkload *sync.Map,
+) error {
+	if _, loaded := watchedWorkload.Load(PropagationPolicyCrdName); !loaded {
+		return nil
+	}
+
+	policy := NewPropagationPolicy()
+	err := m.client.Get(ctx, types.NamespacedName{Name: rbg.Name, Namespace: rbg.Namespace}, policy)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if metav1.IsControlledBy(policy, rbg) {
+		if err := m.client.Delete(ctx, policy); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// propagation reads the ResourceBindings Karmada creates for the workloads of the roles.
+func (m *Manager) propagation(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) (*Propagation, error) {
+	var pending []string
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		binding := &unstructured.Unstructured{}
+		binding.SetGroupVersionKind(ResourceBindingGVK)
+		name := bindingName(role.GetWorkloadSpec().Kind, rbg.GetWorkloadName(role))
+		err := m.client.Get(ctx, types.NamespacedName{Name: name, Namespace: rbg.Namespace}, binding)
+		if apierrors.IsNotFound(err) {
+			pending = append(pending, fmt.Sprintf("role %s is not scheduled yet", role.Name))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resource binding %s: %w", name, err)
+		}
+
+		conditions, _, _ := unstructured.NestedSlice(binding.Object, "status", "conditions")
+		if !conditionTrue(conditions, scheduledConditionType) {
+			pending = append(pending, fmt.Sprintf("role %s is not scheduled yet", role.Name))
+			continue
+		}
+		items, _, _ := unstructured.NestedSlice(binding.Object, "status", "aggregatedStatus")
+		for _, item := range items {
+			status, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if applied, _, _ := unstructured.NestedBool(status, "applied"); applied {
+				continue
+			}
+			cluster, _, _ := unstructured.NestedString(status, "clusterName")
+			message, _, _ := unstructured.NestedString(status, "appliedMessage")
+			pending = append(pending, fmt.Sprintf("role %s is not applied to cluster %s: %s", role.Name, cluster, message))
+		}
+	}
+	if len(pending) > 0 {
+		return &Propagation{Message: strings.Join(pending, "; ")}, nil
+	}
+	return &Propagation{
+		Propagated: true,
+		Message:    fmt.Sprintf("Workloads are applied to clusters %s", strings.Join(rbg.Spec.MultiCluster.Clusters, ", ")),
+	}, nil
+}
+
+// bindingName returns the name of the ResourceBinding Karmada creates for a resource template.
+func bindingName(kind, name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, ":", ".") + "-" + kind)
+}
+
+func conditionTrue(conditions []interface{}, conditionType string) bool {
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == conditionType {
+			return condition["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+func newGroupPolicy(rbg *workloadsv1alpha2.RoleBasedGroup, spec map[string]interface{}, hash string) *unstructured.Unstructured {
+	policy := NewPropagationPolicy()
+	policy.SetName(rbg.Name)
+	policy.SetNamespace(rbg.Namespace)
+	policy.SetLabels(map[string]string{constants.GroupNameLabelKey: rbg.Name})
+	policy.SetAnnotations(map[string]string{SpecHashAnnotationKey: hash})
+	policy.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(rbg, utils.GetRbgGVK())})
+	policy.Object["spec"] = spec
+	return policy
+}
+
+// buildPolicySpec selects the workload of every role, the Services of the group and its discovery
+// ConfigMap, and places them in the clusters of the group.
+func buildPolicySpec(rbg *workloadsv1alpha2.RoleBasedGroup) map[string]interface{} {
+	selectors := make([]interface{}, 0, len(rbg.Spec.Roles)+2)
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		workload := role.GetWorkloadSpec()
+		selectors = append(selectors, map[string]interface{}{
+			"apiVersion": workload.APIVersion,
+			"kind":       workload.Kind,
+			"name":       rbg.GetWorkloadName(role),
+		})
+	}
+	selectors = append(selectors,
+		map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"labelSelector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{constants.GroupNameLabelKey: rbg.Name},
+			},
+		},
+		map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"name":       rbg.Name,
+		},
+	)
+
+	policy := rbg.Spec.MultiCluster
+	clusters := make([]interface{}, 0, len(policy.Clusters))
+	for _, cluster := range policy.Clusters {
+		clusters = append(clusters, cluster)
+	}
+	replicaScheduling := map[string]interface{}{"replicaSchedulingType": string(workloadsv1alpha2.MultiClusterReplicaDuplicated)}
+	if policy.ReplicaScheduling != workloadsv1alpha2.MultiClusterReplicaDuplicated {
+		// Without a weight preference, a weighted division gives every cluster the same weight.
+		replicaScheduling = map[string]interface{}{
+			"replicaSchedulingType":     string(workloadsv1alpha2.MultiClusterReplicaDivided),
+			"replicaDivisionPreference": "Weighted",
+		}
+	}
+	return map[string]interface{}{
+		"resourceSelectors": selectors,
+		"placement": map[string]interface{}{
+			"clusterAffinity":   map[string]interface{}{"clusterNames": clusters},
+			"replicaScheduling": replicaScheduling,
+		},
+	}
+}
+
+func hashSpec(spec map[string]interface{}) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func newMultiClusterRBG() *workloadsv1alpha2.RoleBasedGroup {
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithWorkload("apps/v1", "Deployment").Obj(),
+			wrappersv2.BuildStandaloneRole("decode").WithWorkload("apps/v1", "StatefulSet").Obj(),
+		}).Obj()
+	rbg.Spec.MultiCluster = &workloadsv1alpha2.MultiClusterPolicy{
+		Clusters:          []string{"member1", "member2"},
+		ReplicaScheduling: workloadsv1alpha2.MultiClusterReplicaDivided,
+	}
+	return rbg
+}
+
+func newBinding(name string, scheduled bool, aggregated ...interface{}) *unstructured.Unstructured {
+	status := "False"
+	if scheduled {
+		status = "True"
+	}
+	binding := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions":       []interface{}{map[string]interface{}{"type": "Scheduled", "status": status}},
+			"aggregatedStatus": aggregated,
+		},
+	}}
+	binding.SetGroupVersionKind(ResourceBindingGVK)
+	binding.SetName(name)
+	binding.SetNamespace("default")
+	return binding
+}
+
+func newCrdReader(scheme *runtime.Scheme) client.Reader {
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: PropagationPolicyCrdName},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+					{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+				},
+			},
+		},
+	).Build()
+}
+
+func getPolicy(t *testing.T, c client.Client) (*unstructured.Unstructured, error) {
+	t.Helper()
+	policy := NewPropagationPolicy()
+	err := c.Get(context.TODO(), types.NamespacedName{Name: "test-rbg", Namespace: "default"}, policy)
+	return policy, err
+}
+
+func TestManager_ReconcilePropagationPolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+
+	rbg := newMultiClusterRBG()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newBinding("test-rbg-prefill-deployment", true,
+			map[string]interface{}{"clusterName": "member1", "applied": true},
+			map[string]interface{}{"clusterName": "member2", "applied": false, "appliedMessage": "quota exceeded"},
+		),
+	).Build()
+	manager := New(c)
+	runtimeController := builder.TypedBuilder[reconcile.Request]{}
+	watchedWorkload := sync.Map{}
+
+	// The PropagationPolicy is not created until Karmada is installed.
+	_, err := manager.ReconcilePropagationPolicy(context.TODO(), rbg, &runtimeController, &watchedWorkload,
+		fake.NewClientBuilder().WithScheme(scheme).Build())
+	assert.EqualError(t, err, "karmada propagationpolicies.policy.karmada.io not ready")
+
+	// A multi-cluster group gets a PropagationPolicy selecting its workloads, Services and ConfigMap.
+	reader := newCrdReader(scheme)
+	propagation, err := manager.ReconcilePropagationPolicy(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	assert.False(t, propagation.Propagated)
+	assert.Equal(t, "role prefill is not applied to cluster member2: quota exceeded; role decode is not scheduled yet",
+		propagation.Message)
+	policy, err := getPolicy(t, c)
+	require.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(policy, rbg))
+	selectors, _, _ := unstructured.NestedSlice(policy.Object, "spec", "resourceSelectors")
+	require.Len(t, selectors, 4)
+	assert.Equal(t, map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "test-rbg-prefill"}, selectors[0])
+	assert.Equal(t, map[string]interface{}{"apiVersion": "apps/v1", "kind": "StatefulSet", "name": "test-rbg-decode"}, selectors[1])
+	clusters, _, _ := unstructured.NestedStringSlice(policy.Object, "spec", "placement", "clusterAffinity", "clusterNames")
+	assert.Equal(t, []string{"member1", "member2"}, clusters)
+	scheduling, _, _ := unstructured.NestedString(policy.Object, "spec", "placement", "replicaScheduling", "replicaSchedulingType")
+	assert.Equal(t, "Divided", scheduling)
+
+	// Once every binding is applied, the group is propagated.
+	require.NoError(t, c.Delete(context.TODO(), newBinding("test-rbg-prefill-deployment", true)))
+	require.NoError(t, c.Create(context.TODO(), newBinding("test-rbg-prefill-deployment", true,
+		map[string]interface{}{"clusterName": "member1", "applied": true})))
+	require.NoError(t, c.Create(context.TODO(), newBinding("test-rbg-decode-statefulset", true,
+		map[string]interface{}{"clusterName": "member2", "applied": true})))
+	propagation, err = manager.ReconcilePropagationPolicy(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	assert.True(t, propagation.Propagated)
+	assert.Equal(t, "Workloads are applied to clusters member1, member2", propagation.Message)
+
+	// Changing the policy of the group updates the PropagationPolicy.
+	rbg.Spec.MultiCluster.ReplicaScheduling = workloadsv1alpha2.MultiClusterReplicaDuplicated
+	_, err = manager.ReconcilePropagationPolicy(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	policy, err = getPolicy(t, c)
+	require.NoError(t, err)
+	scheduling, _, _ = unstructured.NestedString(policy.Object, "spec", "placement", "replicaScheduling", "replicaSchedulingType")
+	assert.Equal(t, "Duplicated", scheduling)
+
+	// Removing spec.multiCluster deletes the PropagationPolicy.
+	rbg.Spec.MultiCluster = nil
+	propagation, err = manager.ReconcilePropagationPolicy(context.TODO(), rbg, &runtimeController, &watchedWorkload, reader)
+	require.NoError(t, err)
+	assert.Nil(t, propagation)
+	_, err = getPolicy(t, c)
+	assert.True(t, apierrors.IsNotFound(err))
+}
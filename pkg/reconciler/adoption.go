@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// ErrAdoptWorkload wraps the refusals to take over an existing workload that is not owned by the group,
+// either because it has another controller or because the adoption policy forbids it.
+var ErrAdoptWorkload = errors.New("failed to adopt workload")
+
+// adoptWorkload makes sure the existing object the patch is applied to is owned as the patch says, by
+// the group for role workloads and by the role workload for headless services, so that server side
+// apply never silently takes over an object of someone else. The objects created out of the controller
+// lack the labels of the group and are missing from the cache, they are read from the API server
+// before being created. Orphans are adopted when the adoption policy of the group allows it, the
+// objects controlled by another owner are never taken over.
+func adoptWorkload(
+	ctx context.Context, k8sClient client.Client, rbg *workloadsv1alpha2.RoleBasedGroup,
+	current client.Object, patch *unstructured.Unstructured,
+) error {
+	existing := current
+	if current.GetResourceVersion() == "" {
+		// Unstructured objects are not cached, the read goes to the API server.
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(patch.GroupVersionKind())
+		if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(patch), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		existing = live
+	}
+	if ownedBy(existing, patch.GetOwnerReferences()) {
+		return nil
+	}
+
+	kind, name := patch.GetKind(), patch.GetName()
+	if ref := metav1.GetControllerOf(existing); ref != nil {
+		return fmt.Errorf("%w: %s %s is controlled by %s %s", ErrAdoptWorkload, kind, name, ref.Kind, ref.Name)
+	}
+	// The headless service of a workload deleted without its dependents lost its owner, but was
+	// rendered for the group, it is taken over whatever the policy.
+	if patch.GetKind() == "Service" && existing.GetLabels()[constants.GroupNameLabelKey] == rbg.Name {
+		return nil
+	}
+	if rbg.Spec.AdoptionPolicy != workloadsv1alpha2.AdoptionPolicyOrphans {
+		return fmt.Errorf("%w: %s %s already exists, set spec.adoptionPolicy to %s to adopt it",
+			ErrAdoptWorkload, kind, name, workloadsv1alpha2.AdoptionPolicyOrphans)
+	}
+
+	ownerRefs := patch.GetOwnerReferences()
+	if len(ownerRefs) == 0 {
+		return fmt.Errorf("%w: %s %s has no owner reference to set", ErrAdoptWorkload, kind, name)
+	}
+	for _, ref := range existing.GetOwnerReferences() {
+		if !hasOwnerUID(ownerRefs, ref.UID) {
+			ownerRefs = append(ownerRefs, ref)
+		}
+	}
+	// The resource version fails the patch if the workload was adopted by someone else meanwhile.
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"ownerReferences": ownerRefs,
+			"resourceVersion": existing.GetResourceVersion(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	target := &unstructured.Unstructured{}
+	target.SetGroupVersionKind(patch.GroupVersionKind())
+	target.SetNamespace(patch.GetNamespace())
+	target.SetName(name)
+	if err := k8sClient.Patch(ctx, target, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return fmt.Errorf("%w: %s %s: %w", ErrAdoptWorkload, kind, name, err)
+	}
+	log.FromContext(ctx).Info("Adopted orphan workload", "kind", kind, "name", name)
+	return nil
+}
+
+// ownedBy reports whether the object already carries one of the owner references.
+func ownedBy(obj metav1.Object, refs []metav1.OwnerReference) bool {
+	for _, ref := range refs {
+		if hasOwnerUID(obj.GetOwnerReferences(), ref.UID) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasOwnerUID(refs []metav1.OwnerReference, uid types.UID) bool {
+	for _, ref := range refs {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
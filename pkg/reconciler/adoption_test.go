@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func TestAdoptWorkload(t *testing.T) {
+	ctx := context.TODO()
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default", UID: "rbg-uid"},
+	}
+	controllerRef := metav1.OwnerReference{
+		APIVersion: rbg.APIVersion, Kind: rbg.Kind, Name: rbg.Name, UID: rbg.UID, Controller: ptr.To(true),
+	}
+	patch := &unstructured.Unstructured{}
+	patch.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("StatefulSet"))
+	patch.SetNamespace("default")
+	patch.SetName("test-rbg-worker")
+	patch.SetOwnerReferences([]metav1.OwnerReference{controllerRef})
+
+	sts := func(owners ...metav1.OwnerReference) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{
+			Name: "test-rbg-worker", Namespace: "default", OwnerReferences: owners,
+		}}
+	}
+	otherRef := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "keep", UID: "cm-uid"}
+
+	cases := []struct {
+		name     string
+		existing *appsv1.StatefulSet
+		policy   workloadsv1alpha2.AdoptionPolicy
+		wantErr  string
+		adopted  bool
+	}{
+		{name: "absent workload"},
+		{name: "workload controlled by the group", existing: sts(controllerRef)},
+		{
+			name:     "orphan kept by default",
+			existing: sts(),
+			wantErr:  "failed to adopt workload: StatefulSet test-rbg-worker already exists, set spec.adoptionPolicy to Orphans to adopt it",
+		},
+		{name: "orphan adopted", existing: sts(otherRef), policy: workloadsv1alpha2.AdoptionPolicyOrphans, adopted: true},
+		{
+			name: "workload of another controller",
+			existing: sts(metav1.OwnerReference{
+				APIVersion: "apps/v1", Kind: "Deployment", Name: "other", UID: "other-uid", Controller: ptr.To(true),
+			}),
+			policy:  workloadsv1alpha2.AdoptionPolicyOrphans,
+			wantErr: "failed to adopt workload: StatefulSet test-rbg-worker is controlled by Deployment other",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.existing != nil {
+				builder = builder.WithObjects(tc.existing)
+			}
+			c := builder.Build()
+			group := rbg.DeepCopy()
+			group.Spec.AdoptionPolicy = tc.policy
+
+			// The workload is missing from the cache, it is looked up on the API server.
+			err := adoptWorkload(ctx, c, group, &appsv1.StatefulSet{}, patch)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.adopted {
+				got := &appsv1.StatefulSet{}
+				require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "test-rbg-worker", Namespace: "default"}, got))
+				assert.True(t, metav1.IsControlledBy(got, group))
+				assert.Len(t, got.OwnerReferences, 2)
+			}
+		})
+	}
+}
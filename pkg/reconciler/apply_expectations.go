@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils"
+	"sigs.k8s.io/rbgs/pkg/utils/expectations"
+)
+
+// appliedSpec is what the controller last applied to a workload of a role of a group.
+type appliedSpec struct {
+	hash            string
+	uid             types.UID
+	resourceVersion string
+	generation      int64
+	replicas        *int64
+	appliedAt       time.Time
+	group           types.NamespacedName
+	role            string
+}
+
+// applyExpectations remembers the workloads applied by the controller. The reconcilers are built
+// per reconcile, so they are shared by all of them.
+type applyExpectations struct {
+	sync.Mutex
+	applied map[string]appliedSpec
+}
+
+var workloadApplyExpectations = &applyExpectations{applied: map[string]appliedSpec{}}
+
+// satisfied reports whether applying the spec of the given hash to the current workload can be
+// skipped: either the current workload is the one returned by the last apply, the cache has not
+// caught up with the last apply yet, or nobody changed the spec of the workload since. Resource
+// versions are opaque and only compared for equality, the generation is the sequence number of
+// the spec. Expectations expire so that drift on the metadata is corrected eventually.
+func (e *applyExpectations) satisfied(key, hash string, current client.Object) bool {
+	e.Lock()
+	defer e.Unlock()
+	applied, ok := e.applied[key]
+	if !ok || applied.hash != hash || current.GetResourceVersion() == "" || current.GetUID() != applied.uid {
+		return false
+	}
+	if time.Since(applied.appliedAt) > expectations.ExpectationTimeout {
+		delete(e.applied, key)
+		return false
+	}
+	if current.GetResourceVersion() == applied.resourceVersion {
+		return true
+	}
+	// Workloads without a generation, like services, are applied again.
+	if applied.generation == 0 {
+		return false
+	}
+	// The generation only moves when the spec changes, status updates leave it untouched.
+	if current.GetGeneration() < applied.generation {
+		return true
+	}
+	return current.GetGeneration() == applied.generation &&
+		current.GetAnnotations()[constants.WorkloadSpecHashAnnotationKey] == hash
+}
+
+func (e *applyExpectations) expect(key string, applied appliedSpec) {
+	e.Lock()
+	defer e.Unlock()
+	e.applied[key] = applied
+}
+
+// appliedReplicas returns the replicas last applied to the workload of the given uid, the cache may not
+// have caught up with them yet.
+func (e *applyExpectations) appliedReplicas(key string, uid types.UID) (*int64, bool) {
+	e.Lock()
+	defer e.Unlock()
+	applied, ok := e.applied[key]
+	if !ok || applied.uid != uid {
+		return nil, false
+	}
+	return applied.replicas, true
+}
+
+func (e *applyExpectations) forget(key string) {
+	e.Lock()
+	defer e.Unlock()
+	delete(e.applied, key)
+}
+
+// forgetGroup drops the workloads applied for the group, except the ones of the roles to keep.
+func (e *applyExpectations) forgetGroup(group types.NamespacedName, keep map[string]bool) {
+	e.Lock()
+	defer e.Unlock()
+	for key, applied := range e.applied {
+		if applied.group == group && !keep[applied.role] {
+			delete(e.applied, key)
+		}
+	}
+}
+
+// ForgetAppliedWorkloads drops what was applied to the workloads of a deleted group.
+func ForgetAppliedWorkloads(namespace, name string) {
+	workloadApplyExpectations.forgetGroup(types.NamespacedName{Namespace: namespace, Name: name}, nil)
+}
+
+// ForgetOrphanedWorkloads drops what was applied to the workloads of the roles removed from a group.
+func ForgetOrphanedWorkloads(rbg *workloadsv1alpha2.RoleBasedGroup) {
+	keep := make(map[string]bool, len(rbg.Spec.Roles))
+	for _, role := range rbg.Spec.Roles {
+		keep[role.Name] = true
+	}
+	workloadApplyExpectations.forgetGroup(types.NamespacedName{Namespace: rbg.Namespace, Name: rbg.Name}, keep)
+}
+
+// applyWorkload server-side applies the configuration of a role workload or service, recording the
+// hash of the configuration on the object. The apply is skipped when the configuration is the one
+// last applied to the current object, which cuts the redundant updates issued by reconciles that
+// run against a stale cache or compare fields defaulted by the API server.
+// current is the object read from the cache, empty when it does not exist yet. Workloads not
+// controlled by rbg are adopted or refused before being applied, see adoptWorkload.
+func applyWorkload(
+	ctx context.Context, k8sClient client.Client, rbg *workloadsv1alpha2.RoleBasedGroup,
+	current client.Object, applyConfig interface{},
+) (ApplyResult, error) {
+	logger := log.FromContext(ctx)
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(applyConfig)
+	if err != nil {
+		return ApplyResult{}, err
+	}
+	patch := &unstructured.Unstructured{Object: obj}
+	hash, err := specHash(patch)
+	if err != nil {
+		return ApplyResult{}, err
+	}
+	replicas := specReplicas(patch.Object)
+	annotations := patch.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[constants.WorkloadSpecHashAnnotationKey] = hash
+	patch.SetAnnotations(annotations)
+
+	key := fmt.Sprintf("%s/%s/%s", patch.GroupVersionKind().GroupKind(), patch.GetNamespace(), patch.GetName())
+	if workloadApplyExpectations.satisfied(key, hash, current) {
+		logger.V(1).Info("configuration already applied, skip patch", "workload", key, "hash", hash)
+		return ApplyResult{}, nil
+	}
+
+	if err := adoptWorkload(ctx, k8sClient, rbg, current, patch); err != nil {
+		return ApplyResult{}, err
+	}
+	previous, err := currentReplicas(current)
+	if err != nil {
+		return ApplyResult{}, err
+	}
+
+	// The applied object is read back from the patch to learn its resource version.
+	err = k8sClient.Patch(ctx, patch, client.Apply, &client.PatchOptions{
+		FieldManager: utils.FieldManager,
+		Force:        ptr.To(true),
+	})
+	if err != nil {
+		workloadApplyExpectations.forget(key)
+		logger.Error(err, "Using server side apply to patch object")
+		return ApplyResult{}, err
+	}
+	// The cache may lag behind an earlier apply to the same workload, which tells what it was applied with.
+	result := ApplyResult{}
+	if applied, ok := workloadApplyExpectations.appliedReplicas(key, patch.GetUID()); ok {
+		previous = applied
+	} else if current.GetResourceVersion() == "" {
+		result.Created = true
+	}
+	if !result.Created && replicas != nil && previous != nil && *replicas != *previous {
+		result.PreviousReplicas, result.Replicas = int32(*previous), int32(*replicas)
+	}
+	workloadApplyExpectations.expect(key, appliedSpec{
+		hash:            hash,
+		uid:             patch.GetUID(),
+		resourceVersion: patch.GetResourceVersion(),
+		generation:      patch.GetGeneration(),
+		replicas:        replicas,
+		appliedAt:       time.Now(),
+		group:           types.NamespacedName{Namespace: rbg.Namespace, Name: rbg.Name},
+		role:            patch.GetLabels()[constants.RoleNameLabelKey],
+	})
+	return result, nil
+}
+
+// specReplicas returns the spec.replicas of a workload, nil for workloads without replicas like Jobs.
+func specReplicas(obj map[string]interface{}) *int64 {
+	replicas, found, err := unstructured.NestedInt64(obj, "spec", "replicas")
+	if !found || err != nil {
+		return nil
+	}
+	return &replicas
+}
+
+// currentReplicas returns the spec.replicas of the workload read from the cache.
+func currentReplicas(current client.Object) (*int64, error) {
+	if current.GetResourceVersion() == "" {
+		return nil, nil
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return nil, err
+	}
+	return specReplicas(obj), nil
+}
+
+// specHash hashes the configuration to apply, without the hash annotation itself.
+func specHash(obj *unstructured.Unstructured) (string, error) {
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	hf := fnv.New32a()
+	_, _ = hf.Write(data)
+	return rand.SafeEncodeString(fmt.Sprint(hf.Sum32())), nil
+}
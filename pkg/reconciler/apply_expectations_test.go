@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coreapplyv1 "k8s.io/client-go/applyconfigurations/core/v1"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/utils/expectations"
+)
+
+func TestApplyWorkload(t *testing.T) {
+	old := workloadApplyExpectations
+	defer func() { workloadApplyExpectations = old }()
+	workloadApplyExpectations = &applyExpectations{applied: map[string]appliedSpec{}}
+
+	ctx := context.TODO()
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	patches := 0
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			patches++
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	}).Build()
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default", UID: "rbg-uid"},
+	}
+	svcConfig := func(port int32) *coreapplyv1.ServiceApplyConfiguration {
+		return coreapplyv1.Service("s-test-rbg-worker", "default").
+			WithOwnerReferences(metaapplyv1.OwnerReference().WithAPIVersion(rbg.APIVersion).WithKind(rbg.Kind).
+				WithName(rbg.Name).WithUID(rbg.UID).WithController(true)).
+			WithSpec(coreapplyv1.ServiceSpec().WithClusterIP("None").
+				WithPorts(coreapplyv1.ServicePort().WithName("http").WithPort(port)))
+	}
+	get := func() *corev1.Service {
+		svc := &corev1.Service{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "s-test-rbg-worker", Namespace: "default"}, svc))
+		return svc
+	}
+
+	result, err := applyWorkload(ctx, c, rbg, &corev1.Service{}, svcConfig(8080))
+	require.NoError(t, err)
+	assert.Equal(t, ApplyResult{Created: true}, result)
+	assert.Equal(t, 1, patches)
+	applied := get()
+	hash := applied.Annotations[constants.WorkloadSpecHashAnnotationKey]
+	assert.NotEmpty(t, hash)
+	key := "Service/default/s-test-rbg-worker"
+	assert.Equal(t, hash, workloadApplyExpectations.applied[key].hash)
+
+	// The fake client does not return the resource version of applied objects, record the one
+	// the API server would have returned.
+	workloadApplyExpectations.expect(key, appliedSpec{
+		hash: hash, uid: applied.UID, resourceVersion: "5", generation: 2, appliedAt: time.Now(),
+	})
+	applied.ResourceVersion = "5"
+	applied.Generation = 2
+
+	// The current object is the one applied last.
+	require.NoError(t, applyErr(applyWorkload(ctx, c, rbg, applied, svcConfig(8080))))
+	assert.Equal(t, 1, patches)
+
+	// The cache lags behind the last apply.
+	stale := applied.DeepCopy()
+	stale.ResourceVersion = "3"
+	stale.Generation = 1
+	stale.Annotations = nil
+	require.NoError(t, applyErr(applyWorkload(ctx, c, rbg, stale, svcConfig(8080))))
+	assert.Equal(t, 1, patches)
+
+	// Only the status changed since the last apply.
+	updated := applied.DeepCopy()
+	updated.ResourceVersion = "7"
+	require.NoError(t, applyErr(applyWorkload(ctx, c, rbg, updated, svcConfig(8080))))
+	assert.Equal(t, 1, patches)
+
+	// Somebody else changed the spec.
+	updated.Generation = 3
+	require.NoError(t, applyErr(applyWorkload(ctx, c, rbg, updated, svcConfig(8080))))
+	assert.Equal(t, 2, patches)
+
+	// The spec to apply changed.
+	require.NoError(t, applyErr(applyWorkload(ctx, c, rbg, applied, svcConfig(9090))))
+	assert.Equal(t, 3, patches)
+	assert.NotEqual(t, hash, get().Annotations[constants.WorkloadSpecHashAnnotationKey])
+
+	// The workload was deleted, it is applied again.
+	require.NoError(t, applyErr(applyWorkload(ctx, c, rbg, &corev1.Service{}, svcConfig(9090))))
+	assert.Equal(t, 4, patches)
+}
+
+func TestApplyExpectationsSatisfied(t *testing.T) {
+	e := &applyExpectations{applied: map[string]appliedSpec{}}
+	current := &corev1.Service{}
+	current.ResourceVersion = "10"
+	current.Generation = 2
+	current.Annotations = map[string]string{constants.WorkloadSpecHashAnnotationKey: "abc"}
+	assert.False(t, e.satisfied("svc", "abc", current))
+
+	e.expect("svc", appliedSpec{hash: "abc", resourceVersion: "8", generation: 2, appliedAt: time.Now()})
+	assert.True(t, e.satisfied("svc", "abc", current), "only the status changed since the apply")
+	assert.False(t, e.satisfied("svc", "def", current), "another spec is applied")
+
+	current.Generation = 3
+	assert.False(t, e.satisfied("svc", "abc", current), "the spec was changed by somebody else")
+
+	e.expect("svc", appliedSpec{hash: "abc", resourceVersion: "12", generation: 4, appliedAt: time.Now()})
+	assert.True(t, e.satisfied("svc", "abc", current), "the cache lags behind the apply")
+
+	current.UID = "recreated"
+	assert.False(t, e.satisfied("svc", "abc", current), "the workload was recreated by somebody else")
+	current.UID = ""
+
+	e.expect("svc", appliedSpec{hash: "abc", resourceVersion: "8", appliedAt: time.Now()})
+	assert.False(t, e.satisfied("svc", "abc", current), "the workload has no generation")
+	current.ResourceVersion = "8"
+	assert.True(t, e.satisfied("svc", "abc", current), "the workload is the one applied")
+
+	e.expect("svc", appliedSpec{
+		hash: "abc", resourceVersion: "12", generation: 4,
+		appliedAt: time.Now().Add(-expectations.ExpectationTimeout - time.Second),
+	})
+	assert.False(t, e.satisfied("svc", "abc", current), "the expectation expired")
+	assert.Empty(t, e.applied)
+}
+
+func TestApplyExpectationsForgetGroup(t *testing.T) {
+	old := workloadApplyExpectations
+	defer func() { workloadApplyExpectations = old }()
+	workloadApplyExpectations = &applyExpectations{applied: map[string]appliedSpec{}}
+
+	group := types.NamespacedName{Namespace: "default", Name: "test-rbg"}
+	other := types.NamespacedName{Namespace: "default", Name: "other-rbg"}
+	workloadApplyExpectations.expect("prefill", appliedSpec{hash: "abc", group: group, role: "prefill"})
+	workloadApplyExpectations.expect("decode", appliedSpec{hash: "abc", group: group, role: "decode"})
+	workloadApplyExpectations.expect("other", appliedSpec{hash: "abc", group: other, role: "prefill"})
+
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec:       workloadsv1alpha2.RoleBasedGroupSpec{Roles: []workloadsv1alpha2.RoleSpec{{Name: "decode"}}},
+	}
+	ForgetOrphanedWorkloads(rbg)
+	assert.ElementsMatch(t, []string{"decode", "other"}, slices.Collect(maps.Keys(workloadApplyExpectations.applied)))
+
+	ForgetAppliedWorkloads("default", "test-rbg")
+	assert.ElementsMatch(t, []string{"other"}, slices.Collect(maps.Keys(workloadApplyExpectations.applied)))
+}
+
+// applyErr drops the result of an apply for the assertions on its error.
+func applyErr(_ ApplyResult, err error) error {
+	return err
+}
@@ -18,6 +18,7 @@ package reconciler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -33,6 +34,10 @@ import (
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 )
 
+// ErrRenderWorkload wraps the failures to render the workload of a role from its spec, such as an invalid
+// template patch, as opposed to the failures to apply the rendered workload.
+var ErrRenderWorkload = errors.New("failed to render workload")
+
 func ConstructRoleStatue(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, currentReplicas, currentReady, updatedReplicas int32) workloadsv1alpha2.RoleStatus {
 	status, found := rbg.GetRoleStatus(role.Name)
 	if !found || status.Replicas != currentReplicas ||
@@ -50,10 +55,10 @@ func ConstructRoleStatue(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv
 
 // ConstructWorkloadRoleStatus handles the common pattern of constructing a role status
 // from a workload that may not have observed the latest generation yet. If the
-// workload's controller hasn't observed the latest generation, it returns an empty
-// status (don't treat this as an error, otherwise constructAndUpdateRoleStatuses
-// would bail out before calling updateRBGStatus). Otherwise it delegates to
-// ConstructRoleStatue.
+// workload's controller hasn't observed the latest generation, it returns the last
+// known status with the desired replicas of the workload (don't treat this as an
+// error, otherwise constructAndUpdateRoleStatuses would bail out before calling
+// updateRBGStatus). Otherwise it delegates to ConstructRoleStatue.
 func ConstructWorkloadRoleStatus(
 	ctx context.Context,
 	rbg *workloadsv1alpha2.RoleBasedGroup,
@@ -72,10 +77,12 @@ func ConstructWorkloadRoleStatus(
 			"generation", generation,
 			"observedGeneration", observedGeneration,
 		)
+		// The desired replicas are read from the spec of the workload and are known regardless.
 		if status, found := rbg.GetRoleStatus(role.Name); found {
+			status.Replicas = replicas
 			return status
 		}
-		return workloadsv1alpha2.RoleStatus{Name: role.Name}
+		return workloadsv1alpha2.RoleStatus{Name: role.Name, Replicas: replicas}
 	}
 	return ConstructRoleStatue(rbg, role, replicas, readyReplicas, updatedReplicas)
 }
@@ -103,7 +110,9 @@ func CleanupOrphanedObjs(ctx context.Context, c client.Client, rbg *workloadsv1a
 		}
 		found := false
 		for _, role := range rbg.Spec.Roles {
-			if role.GetWorkloadSpec().Kind == obj.GetObjectKind().GroupVersionKind().Kind && rbg.GetWorkloadName(&role) == obj.GetName() {
+			// Compare the group as well, apps and OpenKruise both have a StatefulSet kind.
+			workloadGK := schema.FromAPIVersionAndKind(role.GetWorkloadSpec().APIVersion, role.GetWorkloadSpec().Kind).GroupKind()
+			if workloadGK == obj.GroupVersionKind().GroupKind() && rbg.GetWorkloadName(&role) == obj.GetName() {
 				found = true
 				break
 			}
@@ -29,6 +29,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -69,29 +70,40 @@ func (r *DeploymentReconciler) Validate(
 
 func (r *DeploymentReconciler) Reconciler(
 	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
-	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) error {
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
+	result, err := r.reconcileDeployment(ctx, rbg, role, rollingUpdateStrategy, revisionKey)
+	if err != nil {
+		return ApplyResult{}, err
+	}
+
+	return result, NewServiceReconciler(r.client).reconcileHeadlessService(ctx, rbg, role)
+}
+
+func (r *DeploymentReconciler) reconcileDeployment(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
 	logger := log.FromContext(ctx)
 	logger.V(1).Info("start to reconciling deployment workload")
 
 	oldDeploy := &appsv1.Deployment{}
 	err := r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, oldDeploy)
 	if err != nil && !apierrors.IsNotFound(err) {
-		return err
+		return ApplyResult{}, err
 	}
 
 	deployApplyConfig, err := r.constructDeployApplyConfiguration(ctx, rbg, role, oldDeploy, rollingUpdateStrategy, revisionKey)
 	if err != nil {
 		logger.Error(err, "Failed to construct deployment apply configuration")
-		return err
+		return ApplyResult{}, fmt.Errorf("%w: %w", ErrRenderWorkload, err)
 	}
 	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployApplyConfig)
 	if err != nil {
 		logger.Error(err, "Converting obj apply configuration to json.")
-		return err
+		return ApplyResult{}, err
 	}
 	newDeploy := &appsv1.Deployment{}
 	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj, newDeploy); err != nil {
-		return fmt.Errorf("convert deployApplyConfig to deployment error: %s", err.Error())
+		return ApplyResult{}, fmt.Errorf("convert deployApplyConfig to deployment error: %s", err.Error())
 	}
 
 	// the err value was used to pass the differences between the old and new objects,
@@ -110,14 +122,39 @@ func (r *DeploymentReconciler) Reconciler(
 	}
 	if semanticallyEqual && revisionHashEqual {
 		logger.Info("deployment equal, skip reconcile")
-		return nil
+		return ApplyResult{}, nil
 	}
 
-	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, deployApplyConfig, utils.PatchSpec); err != nil {
+	if needsPodDeletionCosts(role, oldDeploy.Spec.Replicas) {
+		if err := setPodDeletionCosts(ctx, r.client, role, oldDeploy.Namespace, oldDeploy.Spec.Selector.MatchLabels); err != nil {
+			return ApplyResult{}, err
+		}
+	}
+	result, err := applyWorkload(ctx, r.client, rbg, oldDeploy, deployApplyConfig)
+	if err != nil {
 		logger.Error(err, "Failed to patch deployment apply configuration")
-		return err
+		return ApplyResult{}, err
 	}
-	return nil
+	return result, nil
+}
+
+// Render implements WorkloadRenderer.
+func (r *DeploymentReconciler) Render(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) ([]*unstructured.Unstructured, error) {
+	deployApplyConfig, err := r.constructDeployApplyConfiguration(ctx, rbg, role, &appsv1.Deployment{}, nil, revisionKey)
+	if err != nil {
+		return nil, err
+	}
+	deploy, err := applyConfigurationToUnstructured(deployApplyConfig)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := renderHeadlessService(ctx, r.client, rbg, role, deploy)
+	if err != nil {
+		return nil, err
+	}
+	return []*unstructured.Unstructured{deploy, svc}, nil
 }
 
 func (r *DeploymentReconciler) constructDeployApplyConfiguration(
@@ -150,6 +187,7 @@ func (r *DeploymentReconciler) constructDeployApplyConfiguration(
 		WithSpec(
 			appsapplyv1.DeploymentSpec().
 				WithReplicas(*role.Replicas).
+				WithMinReadySeconds(role.MinReadySeconds).
 				WithTemplate(podTemplateApplyConfiguration).
 				WithSelector(
 					metaapplyv1.LabelSelector().
@@ -374,6 +412,10 @@ func deploymentSpecEqual(spec1, spec2 appsv1.DeploymentSpec) (bool, error) {
 		}
 	}
 
+	if spec1.MinReadySeconds != spec2.MinReadySeconds {
+		return false, fmt.Errorf("minReadySeconds not equal, old: %d, new: %d", spec1.MinReadySeconds, spec2.MinReadySeconds)
+	}
+
 	if !reflect.DeepEqual(spec1.Selector, spec2.Selector) {
 		return false, fmt.Errorf("selector not equal, old: %v, new: %v", spec1.Selector, spec2.Selector)
 	}
@@ -110,8 +110,9 @@ func TestDeploymentReconciler_Reconciler(t *testing.T) {
 			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
 				&appsv1.Deployment{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-rbg-test-role",
-						Namespace: "default",
+						Name:            "test-rbg-test-role",
+						Namespace:       "default",
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rbg, workloadsv1alpha2.GroupVersion.WithKind("RoleBasedGroup"))},
 					},
 					Spec: appsv1.DeploymentSpec{
 						Replicas: ptr.To[int32](1),
@@ -154,7 +155,7 @@ func TestDeploymentReconciler_Reconciler(t *testing.T) {
 				}
 
 				ctx := context.Background()
-				err := r.Reconciler(ctx, tt.rbg, tt.role, nil, expectedRevisionHash)
+				_, err := r.Reconciler(ctx, tt.rbg, tt.role, nil, expectedRevisionHash)
 
 				if (err != nil) != tt.expectError {
 					t.Errorf("DeploymentReconciler.Reconciler() error = %v, expectError %v", err, tt.expectError)
@@ -190,6 +191,19 @@ func TestDeploymentReconciler_Reconciler(t *testing.T) {
 						t.Errorf("Expected revision hash %s, got %s",
 							expectedRevisionHash, deploy.Labels[roleHashKey])
 					}
+
+					// Check the headless service of the role, owned by the deployment
+					svc := &corev1.Service{}
+					err = tt.client.Get(
+						ctx, types.NamespacedName{
+							Name:      tt.rbg.GetServiceName(tt.role),
+							Namespace: tt.rbg.Namespace,
+						}, svc,
+					)
+					if assert.NoError(t, err) {
+						assert.Equal(t, corev1.ClusterIPNone, svc.Spec.ClusterIP)
+						assert.Equal(t, deploy.Name, svc.OwnerReferences[0].Name)
+					}
 				}
 			},
 		)
@@ -870,3 +884,33 @@ func TestConstructDeploymentApplyConfiguration_LabelsAndAnnotations(t *testing.T
 		})
 	}
 }
+
+func TestDeploymentReconciler_MinReadySeconds(t *testing.T) {
+	role := workloadsv1alpha2.RoleSpec{
+		Name:            "test-role",
+		Replicas:        ptr.To[int32](1),
+		MinReadySeconds: 120,
+		Pattern: workloadsv1alpha2.Pattern{
+			StandalonePattern: &workloadsv1alpha2.StandalonePattern{
+				TemplateSource: workloadsv1alpha2.TemplateSource{
+					Template: &corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "test-container", Image: "test-image:v1"}}},
+					},
+				},
+			},
+		},
+	}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+		Spec:       workloadsv1alpha2.RoleBasedGroupSpec{Roles: []workloadsv1alpha2.RoleSpec{role}},
+	}
+	r := &DeploymentReconciler{scheme: runtime.NewScheme(), client: fake.NewClientBuilder().Build()}
+
+	deployConfig, err := r.constructDeployApplyConfiguration(context.Background(), rbg, &role, &appsv1.Deployment{}, nil, "revision-key")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(120), *deployConfig.Spec.MinReadySeconds)
+
+	equal, err := deploymentSpecEqual(appsv1.DeploymentSpec{MinReadySeconds: 120}, appsv1.DeploymentSpec{})
+	assert.False(t, equal)
+	assert.EqualError(t, err, "minReadySeconds not equal, old: 120, new: 0")
+}
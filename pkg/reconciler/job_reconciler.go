@@ -0,0 +1,439 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	batchapplyv1 "k8s.io/client-go/applyconfigurations/batch/v1"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/scheduler"
+)
+
+// JobReconciler reconciles the one-shot roles of a rbg, such as model downloads or warmups, as a Job running
+// to completion. The replicas of the role are the number of pods to complete. The pod template and the
+// completions of a Job are immutable, so the Job is recreated, and runs again, when either changes.
+type JobReconciler struct {
+	scheme          *runtime.Scheme
+	client          client.Client
+	podGroupManager scheduler.PodGroupManager
+}
+
+var _ WorkloadReconciler = &JobReconciler{}
+
+func NewJobReconciler(scheme *runtime.Scheme, client client.Client) *JobReconciler {
+	return &JobReconciler{scheme: scheme, client: client}
+}
+
+// SetPodGroupManager implements PodGroupManagerSetter.
+func (r *JobReconciler) SetPodGroupManager(m scheduler.PodGroupManager) {
+	r.podGroupManager = m
+}
+
+func (r *JobReconciler) Validate(
+	ctx context.Context, role *workloadsv1alpha2.RoleSpec) error {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("start to validate role declaration")
+
+	if role.StandalonePattern == nil {
+		return fmt.Errorf("role %s: Job workloads only support the standalonePattern", role.Name)
+	}
+	return nil
+}
+
+func (r *JobReconciler) Reconciler(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	_ *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("start to reconciling job workload")
+
+	oldJob := &batchv1.Job{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, oldJob)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ApplyResult{}, err
+	}
+	found := err == nil
+	if oldJob.DeletionTimestamp != nil {
+		// The Job is recreated once the deletion of the previous run completes.
+		return ApplyResult{}, nil
+	}
+
+	replicas := ptr.Deref(role.Replicas, 1)
+	if replicas == 0 {
+		// A Job cannot be scaled to zero without completing, it is suspended instead.
+		if !found || ptr.Deref(oldJob.Spec.Suspend, false) {
+			return ApplyResult{}, nil
+		}
+		logger.Info("suspend job", "job", oldJob.Name)
+		patch := client.MergeFrom(oldJob.DeepCopy())
+		oldJob.Spec.Suspend = ptr.To(true)
+		if err := r.client.Patch(ctx, oldJob, patch); err != nil {
+			return ApplyResult{}, err
+		}
+		return ApplyResult{PreviousReplicas: ptr.Deref(oldJob.Spec.Completions, 1)}, nil
+	}
+	if _, completed := CompletedJobRoleStatus(rbg, role, revisionKey); completed && !found {
+		logger.V(1).Info("job completed and was deleted after its ttl, skip reconcile")
+		return ApplyResult{}, nil
+	}
+
+	if found {
+		roleHashKey := fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)
+		if oldJob.Labels[roleHashKey] != revisionKey || ptr.Deref(oldJob.Spec.Completions, 1) != replicas {
+			logger.Info(fmt.Sprintf("job revision or completions changed, delete job %s to run it again", oldJob.Name),
+				"oldRevision", oldJob.Labels[roleHashKey], "newRevision", revisionKey)
+			if err := r.deleteJob(ctx, oldJob); err != nil {
+				return ApplyResult{}, err
+			}
+			return ApplyResult{PreviousReplicas: ptr.Deref(oldJob.Spec.Completions, 1), Replicas: replicas}, nil
+		}
+		if err := r.syncJobTTL(ctx, rbg, role, oldJob, revisionKey); err != nil {
+			return ApplyResult{}, err
+		}
+		if !ptr.Deref(oldJob.Spec.Suspend, false) {
+			logger.V(1).Info("job equal, skip reconcile")
+			return ApplyResult{}, nil
+		}
+	}
+
+	jobApplyConfig, err := r.constructJobApplyConfiguration(ctx, rbg, role, revisionKey)
+	if err != nil {
+		logger.Error(err, "Failed to construct job apply configuration")
+		return ApplyResult{}, fmt.Errorf("%w: %w", ErrRenderWorkload, err)
+	}
+	result, err := applyWorkload(ctx, r.client, rbg, oldJob, jobApplyConfig)
+	if err != nil {
+		logger.Error(err, "Failed to patch job apply configuration")
+		return ApplyResult{}, err
+	}
+	return result, nil
+}
+
+// Render implements WorkloadRenderer.
+func (r *JobReconciler) Render(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) ([]*unstructured.Unstructured, error) {
+	jobApplyConfig, err := r.constructJobApplyConfiguration(ctx, rbg, role, revisionKey)
+	if err != nil {
+		return nil, err
+	}
+	job, err := applyConfigurationToUnstructured(jobApplyConfig)
+	if err != nil {
+		return nil, err
+	}
+	return []*unstructured.Unstructured{job}, nil
+}
+
+func (r *JobReconciler) constructJobApplyConfiguration(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+	revisionKey string,
+) (*batchapplyv1.JobApplyConfiguration, error) {
+	podLabels := rbg.GetCommonLabelsFromRole(role)
+
+	podReconciler := NewPodReconciler(r.scheme, r.client)
+	podReconciler.SetPodGroupManager(r.podGroupManager)
+	podTemplateApplyConfiguration, err := podReconciler.ConstructPodTemplateSpecApplyConfiguration(
+		ctx, rbg, role, maps.Clone(podLabels),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Job pods may not restart always, let the kubelet retry failed containers unless the role opted for Never.
+	if spec := podTemplateApplyConfiguration.Spec; spec != nil &&
+		ptr.Deref(spec.RestartPolicy, corev1.RestartPolicyAlways) == corev1.RestartPolicyAlways {
+		spec.WithRestartPolicy(corev1.RestartPolicyOnFailure)
+	}
+
+	jobLabel := maps.Clone(podLabels)
+	jobLabel[fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)] = revisionKey
+	replicas := ptr.Deref(role.Replicas, 1)
+
+	// The Job controller generates the selector of the Job, so it is not set here.
+	jobConfig := batchapplyv1.Job(rbg.GetWorkloadName(role), rbg.Namespace).
+		WithSpec(
+			batchapplyv1.JobSpec().
+				WithParallelism(replicas).
+				WithCompletions(replicas).
+				WithSuspend(false).
+				WithTemplate(podTemplateApplyConfiguration),
+		).
+		WithAnnotations(labels.Merge(maps.Clone(role.Annotations), rbg.GetCommonAnnotationsFromRole(role))).
+		WithLabels(labels.Merge(maps.Clone(role.Labels), jobLabel)).
+		WithOwnerReferences(
+			metaapplyv1.OwnerReference().
+				WithAPIVersion(rbg.APIVersion).
+				WithKind(rbg.Kind).
+				WithName(rbg.Name).
+				WithUID(rbg.GetUID()).
+				WithBlockOwnerDeletion(true).
+				WithController(true),
+		)
+	return jobConfig, nil
+}
+
+// ConstructRoleStatus reports the succeeded pods of the Job as the ready replicas of the role, so a
+// one-shot role is ready once it completed. The Complete and Failed conditions of the Job are mirrored
+// in the conditions of the role.
+func (r *JobReconciler) ConstructRoleStatus(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+) (workloadsv1alpha2.RoleStatus, error) {
+	job := &batchv1.Job{}
+	if err := r.client.Get(
+		ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, job,
+	); err != nil {
+		return workloadsv1alpha2.RoleStatus{Name: role.Name}, err
+	}
+
+	completions := ptr.Deref(job.Spec.Completions, 1)
+	status := workloadsv1alpha2.RoleStatus{
+		Name:            role.Name,
+		Replicas:        completions,
+		ReadyReplicas:   job.Status.Succeeded,
+		UpdatedReplicas: completions,
+		Conditions:      constructJobRoleConditions(rbg, job),
+	}
+	if jobConditionTrue(job, batchv1.JobComplete) {
+		status.CompletedRevision = job.Labels[fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)]
+	}
+	return status, nil
+}
+
+// CompletedJobRoleStatus returns the status of a Job role with a ttlSecondsAfterFinished whose Job
+// completed at revisionKey and at the current replicas. The role keeps reporting this status once its
+// Job was deleted, and the Job is not run again.
+func CompletedJobRoleStatus(
+	rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) (workloadsv1alpha2.RoleStatus, bool) {
+	if role.TTLSecondsAfterFinished == nil || role.GetWorkloadType() != constants.JobWorkloadType {
+		return workloadsv1alpha2.RoleStatus{Name: role.Name}, false
+	}
+	status, found := rbg.GetRoleStatus(role.Name)
+	if !found || revisionKey == "" || status.CompletedRevision != revisionKey ||
+		status.Replicas != ptr.Deref(role.Replicas, 1) ||
+		!apimeta.IsStatusConditionTrue(status.Conditions, string(workloadsv1alpha2.RoleComplete)) {
+		return workloadsv1alpha2.RoleStatus{Name: role.Name}, false
+	}
+	return status, true
+}
+
+// syncJobTTL sets the ttlSecondsAfterFinished of the role on its Job once the role status recorded the
+// completion of the Job, so the Job is only deleted by the Job TTL controller after its outcome is kept.
+// Failed Jobs are kept for inspection.
+func (r *JobReconciler) syncJobTTL(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	job *batchv1.Job, revisionKey string,
+) error {
+	var ttl *int32
+	if _, completed := CompletedJobRoleStatus(rbg, role, revisionKey); completed &&
+		jobConditionTrue(job, batchv1.JobComplete) {
+		ttl = role.TTLSecondsAfterFinished
+	}
+	if ptr.Equal(job.Spec.TTLSecondsAfterFinished, ttl) {
+		return nil
+	}
+	log.FromContext(ctx).Info("set job ttl after finished", "job", job.Name, "ttlSecondsAfterFinished", ttl)
+	patch := client.MergeFrom(job.DeepCopy())
+	job.Spec.TTLSecondsAfterFinished = ttl
+	return r.client.Patch(ctx, job, patch)
+}
+
+// constructJobRoleConditions derives the Complete and Failed conditions of a role from those of its Job.
+func constructJobRoleConditions(rbg *workloadsv1alpha2.RoleBasedGroup, job *batchv1.Job) []metav1.Condition {
+	completions := ptr.Deref(job.Spec.Completions, 1)
+	complete := metav1.Condition{
+		Type:    string(workloadsv1alpha2.RoleComplete),
+		Status:  metav1.ConditionFalse,
+		Reason:  "Running",
+		Message: fmt.Sprintf("%d/%d pods succeeded", job.Status.Succeeded, completions),
+	}
+	failed := metav1.Condition{
+		Type:    string(workloadsv1alpha2.RoleFailed),
+		Status:  metav1.ConditionFalse,
+		Reason:  "AsExpected",
+		Message: "The job has not failed",
+	}
+	switch {
+	case jobConditionTrue(job, batchv1.JobComplete):
+		complete.Status, complete.Reason = metav1.ConditionTrue, "Completed"
+	case jobConditionTrue(job, batchv1.JobFailed):
+		complete.Reason = "Failed"
+		failed.Status, failed.Reason, failed.Message = metav1.ConditionTrue, "JobFailed", "The job failed"
+		for _, c := range job.Status.Conditions {
+			if c.Type == batchv1.JobFailed && c.Reason != "" {
+				failed.Reason, failed.Message = c.Reason, c.Message
+			}
+		}
+	case ptr.Deref(job.Spec.Suspend, false):
+		complete.Reason = "Suspended"
+	}
+
+	var conditions []metav1.Condition
+	for _, condition := range []metav1.Condition{complete, failed} {
+		condition.LastTransitionTime = metav1.Now()
+		condition.ObservedGeneration = rbg.Generation
+		apimeta.SetStatusCondition(&conditions, condition)
+	}
+	return conditions
+}
+
+func jobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// CheckWorkloadReady reports whether the Job completed, so the roles depending on a one-shot role
+// start after it finished. A Job deleted after its ttlSecondsAfterFinished stays completed.
+func (r *JobReconciler) CheckWorkloadReady(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (bool, error) {
+	job := &batchv1.Job{}
+	if err := r.client.Get(
+		ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, job,
+	); err != nil {
+		// The Job of a completed role may have been deleted after its ttlSecondsAfterFinished.
+		if status, found := rbg.GetRoleStatus(role.Name); found && apierrors.IsNotFound(err) {
+			if _, completed := CompletedJobRoleStatus(rbg, role, status.UpdateRevision); completed {
+				return true, nil
+			}
+		}
+		return false, err
+	}
+	return jobConditionTrue(job, batchv1.JobComplete), nil
+}
+
+func (r *JobReconciler) CleanupOrphanedWorkloads(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+) error {
+	logger := log.FromContext(ctx)
+	// list job managed by rbg
+	jobList := &batchv1.JobList{}
+	if err := r.client.List(
+		ctx, jobList, client.InNamespace(rbg.Namespace),
+		client.MatchingLabels(
+			map[string]string{
+				constants.GroupNameLabelKey: rbg.Name,
+			},
+		),
+	); err != nil {
+		return err
+	}
+
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if !metav1.IsControlledBy(job, rbg) {
+			continue
+		}
+		found := false
+		for _, role := range rbg.Spec.Roles {
+			if role.GetWorkloadSpec().Kind == "Job" && rbg.GetWorkloadName(&role) == job.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			logger.Info("delete job", "job", job.Name)
+			if err := r.deleteJob(ctx, job); err != nil {
+				return fmt.Errorf("delete job %s error: %s", job.Name, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func (r *JobReconciler) RecreateWorkload(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+) error {
+	logger := log.FromContext(ctx)
+	if rbg == nil || role == nil {
+		return nil
+	}
+
+	jobName := rbg.GetWorkloadName(role)
+	var job batchv1.Job
+	err := r.client.Get(ctx, types.NamespacedName{Name: jobName, Namespace: rbg.Namespace}, &job)
+	// if job is not found, skip delete job
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if job.UID == "" {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("Recreate job workload, delete job %s", jobName))
+	if err := r.deleteJob(ctx, &job); err != nil {
+		return err
+	}
+
+	// wait new job create
+	var retErr error
+	err = wait.PollUntilContextTimeout(
+		ctx, 5*time.Second, 5*time.Minute, true, func(ctx context.Context) (bool, error) {
+			var newJob batchv1.Job
+			retErr = r.client.Get(ctx, types.NamespacedName{Name: jobName, Namespace: rbg.Namespace}, &newJob)
+			if retErr != nil {
+				if apierrors.IsNotFound(retErr) {
+					return false, nil
+				}
+				return false, retErr
+			}
+			return true, nil
+		},
+	)
+
+	if err != nil {
+		logger.Error(retErr, "wait new job creating error")
+		return retErr
+	}
+
+	return nil
+}
+
+// deleteJob deletes a Job together with its pods, the Job API orphans the pods by default.
+func (r *JobReconciler) deleteJob(ctx context.Context, job *batchv1.Job) error {
+	err := r.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
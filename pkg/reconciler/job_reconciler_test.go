@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func newJobTestRBG() (*workloadsv1alpha2.RoleBasedGroup, *workloadsv1alpha2.RoleSpec) {
+	role := &workloadsv1alpha2.RoleSpec{
+		Name:     "download",
+		Replicas: ptr.To(int32(2)),
+		Annotations: map[string]string{
+			constants.RoleWorkloadTypeAnnotationKey: constants.JobWorkloadType,
+		},
+		Pattern: workloadsv1alpha2.Pattern{
+			StandalonePattern: &workloadsv1alpha2.StandalonePattern{
+				TemplateSource: workloadsv1alpha2.TemplateSource{
+					Template: &corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "download", Image: "downloader:v1"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		TypeMeta: metav1.TypeMeta{APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rbg",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{Roles: []workloadsv1alpha2.RoleSpec{*role}},
+	}
+	return rbg, role
+}
+
+func newJobTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = batchv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	return scheme
+}
+
+func TestJobReconciler_Validate(t *testing.T) {
+	r := NewJobReconciler(newJobTestScheme(), fake.NewClientBuilder().Build())
+	_, role := newJobTestRBG()
+	assert.NoError(t, r.Validate(context.TODO(), role))
+
+	role.StandalonePattern = nil
+	role.LeaderWorkerPattern = &workloadsv1alpha2.LeaderWorkerPattern{Size: ptr.To(int32(2))}
+	assert.EqualError(t, r.Validate(context.TODO(), role), "role download: Job workloads only support the standalonePattern")
+}
+
+func TestJobReconciler_Reconciler(t *testing.T) {
+	ctx := context.TODO()
+	scheme := newJobTestScheme()
+	rbg, role := newJobTestRBG()
+	key := types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}
+	revisionKey := fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := NewJobReconciler(scheme, c)
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, key, job))
+	assert.Equal(t, int32(2), *job.Spec.Parallelism)
+	assert.Equal(t, int32(2), *job.Spec.Completions)
+	assert.False(t, *job.Spec.Suspend)
+	assert.Equal(t, corev1.RestartPolicyOnFailure, job.Spec.Template.Spec.RestartPolicy)
+	assert.Equal(t, "rev-1", job.Labels[revisionKey])
+	assert.True(t, metav1.IsControlledBy(job, rbg))
+
+	// Scaling the role to zero suspends the job instead of completing it.
+	role.Replicas = ptr.To(int32(0))
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+	require.NoError(t, c.Get(ctx, key, job))
+	assert.True(t, *job.Spec.Suspend)
+	assert.Equal(t, int32(2), *job.Spec.Completions)
+
+	role.Replicas = ptr.To(int32(2))
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+	require.NoError(t, c.Get(ctx, key, job))
+	assert.False(t, *job.Spec.Suspend)
+
+	// A new revision runs the job again.
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-2")))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, job)))
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-2")))
+	require.NoError(t, c.Get(ctx, key, job))
+	assert.Equal(t, "rev-2", job.Labels[revisionKey])
+
+	// So do new completions.
+	role.Replicas = ptr.To(int32(3))
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-2")))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, job)))
+
+	// A role created at zero replicas does not run.
+	role.Replicas = ptr.To(int32(0))
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-2")))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, job)))
+}
+
+func TestJobReconciler_ConstructRoleStatus(t *testing.T) {
+	ctx := context.TODO()
+	rbg, role := newJobTestRBG()
+	newJob := func(succeeded int32, conditions ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace},
+			Spec:       batchv1.JobSpec{Completions: ptr.To(int32(2))},
+			Status:     batchv1.JobStatus{Succeeded: succeeded, Conditions: conditions},
+		}
+	}
+	reasons := func(conditions []metav1.Condition) map[string]string {
+		got := map[string]string{}
+		for _, c := range conditions {
+			got[c.Type] = string(c.Status) + "/" + c.Reason
+		}
+		return got
+	}
+
+	cases := []struct {
+		name      string
+		job       *batchv1.Job
+		wantReady int32
+		want      map[string]string
+		wantDone  bool
+	}{
+		{
+			name:      "running",
+			job:       newJob(1),
+			wantReady: 1,
+			want:      map[string]string{"Complete": "False/Running", "Failed": "False/AsExpected"},
+		},
+		{
+			name:      "complete",
+			job:       newJob(2, batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}),
+			wantReady: 2,
+			want:      map[string]string{"Complete": "True/Completed", "Failed": "False/AsExpected"},
+			wantDone:  true,
+		},
+		{
+			name: "failed",
+			job: newJob(0, batchv1.JobCondition{
+				Type: batchv1.JobFailed, Status: corev1.ConditionTrue,
+				Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit",
+			}),
+			want: map[string]string{"Complete": "False/Failed", "Failed": "True/BackoffLimitExceeded"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(newJobTestScheme()).WithObjects(tc.job).Build()
+			r := NewJobReconciler(newJobTestScheme(), c)
+
+			status, err := r.ConstructRoleStatus(ctx, rbg, role)
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), status.Replicas)
+			assert.Equal(t, tc.wantReady, status.ReadyReplicas)
+			assert.Equal(t, tc.want, reasons(status.Conditions))
+
+			done, err := r.CheckWorkloadReady(ctx, rbg, role)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDone, done)
+		})
+	}
+
+	failed := apimeta.FindStatusCondition(
+		constructJobRoleConditions(rbg, cases[2].job), string(workloadsv1alpha2.RoleFailed))
+	assert.Equal(t, "Job has reached the specified backoff limit", failed.Message)
+
+	r := NewJobReconciler(newJobTestScheme(), fake.NewClientBuilder().WithScheme(newJobTestScheme()).Build())
+	_, err := r.ConstructRoleStatus(ctx, rbg, role)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestJobReconciler_TTLSecondsAfterFinished(t *testing.T) {
+	ctx := context.TODO()
+	scheme := newJobTestScheme()
+	rbg, role := newJobTestRBG()
+	role.TTLSecondsAfterFinished = ptr.To(int32(60))
+	key := types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&batchv1.Job{}).Build()
+	r := NewJobReconciler(scheme, c)
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+	job := &batchv1.Job{}
+	require.NoError(t, c.Get(ctx, key, job))
+	job.Status.Succeeded = 2
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	require.NoError(t, c.Status().Update(ctx, job))
+
+	// The ttl is only set once the role status recorded the completion.
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+	require.NoError(t, c.Get(ctx, key, job))
+	assert.Nil(t, job.Spec.TTLSecondsAfterFinished)
+
+	status, err := r.ConstructRoleStatus(ctx, rbg, role)
+	require.NoError(t, err)
+	assert.Equal(t, "rev-1", status.CompletedRevision)
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{status}
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+	require.NoError(t, c.Get(ctx, key, job))
+	assert.Equal(t, int32(60), ptr.Deref(job.Spec.TTLSecondsAfterFinished, 0))
+
+	// Once the Job controller deleted the job, the role stays completed and does not run again.
+	require.NoError(t, c.Delete(ctx, job))
+	completed, ok := CompletedJobRoleStatus(rbg, role, "rev-1")
+	assert.True(t, ok)
+	assert.Equal(t, int32(2), completed.ReadyReplicas)
+	ready, err := r.CheckWorkloadReady(ctx, rbg, role)
+	assert.True(t, apierrors.IsNotFound(err), "the update revision of the role is not recorded yet")
+	assert.False(t, ready)
+	rbg.Status.RoleStatuses[0].UpdateRevision = "rev-1"
+	ready, err = r.CheckWorkloadReady(ctx, rbg, role)
+	require.NoError(t, err)
+	assert.True(t, ready)
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, job)))
+
+	// A new revision or new completions run the job again.
+	_, ok = CompletedJobRoleStatus(rbg, role, "rev-2")
+	assert.False(t, ok)
+	role.Replicas = ptr.To(int32(3))
+	_, ok = CompletedJobRoleStatus(rbg, role, "rev-1")
+	assert.False(t, ok)
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+	require.NoError(t, c.Get(ctx, key, job))
+	assert.Nil(t, job.Spec.TTLSecondsAfterFinished)
+}
+
+func TestJobReconciler_CleanupOrphanedWorkloads(t *testing.T) {
+	ctx := context.TODO()
+	rbg, role := newJobTestRBG()
+	newJob := func(name string) *batchv1.Job {
+		return &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: rbg.Namespace,
+			Labels:    map[string]string{constants.GroupNameLabelKey: rbg.Name},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup",
+				Name: rbg.Name, UID: rbg.UID, Controller: ptr.To(true),
+			}},
+		}}
+	}
+	c := fake.NewClientBuilder().WithScheme(newJobTestScheme()).
+		WithObjects(newJob(rbg.GetWorkloadName(role)), newJob("test-rbg-removed")).Build()
+	r := NewJobReconciler(newJobTestScheme(), c)
+	require.NoError(t, r.CleanupOrphanedWorkloads(ctx, rbg))
+
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(ctx, jobs, client.InNamespace(rbg.Namespace)))
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, rbg.GetWorkloadName(role), jobs.Items[0].Name)
+}
@@ -0,0 +1,385 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+
+	kruiseappspub "github.com/openkruise/kruise/apis/apps/pub"
+	kruiseappsv1alpha1 "github.com/openkruise/kruise/apis/apps/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/scheduler"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+// CloneSetReconciler reconciles roles backed by an OpenKruise CloneSet, which updates the
+// pods of stateless roles in place and supports partitioned rollouts.
+type CloneSetReconciler struct {
+	scheme          *runtime.Scheme
+	client          client.Client
+	podGroupManager scheduler.PodGroupManager
+}
+
+var _ WorkloadReconciler = &CloneSetReconciler{}
+
+func NewCloneSetReconciler(scheme *runtime.Scheme, client client.Client) *CloneSetReconciler {
+	return &CloneSetReconciler{scheme: scheme, client: client}
+}
+
+// SetPodGroupManager implements PodGroupManagerSetter.
+func (r *CloneSetReconciler) SetPodGroupManager(m scheduler.PodGroupManager) {
+	r.podGroupManager = m
+}
+
+func (r *CloneSetReconciler) Validate(
+	ctx context.Context, role *workloadsv1alpha2.RoleSpec) error {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("start to validate role declaration")
+
+	return nil
+}
+
+func (r *CloneSetReconciler) Reconciler(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
+	result, err := r.reconcileCloneSet(ctx, rbg, role, rollingUpdateStrategy, revisionKey)
+	if err != nil {
+		return ApplyResult{}, err
+	}
+
+	return result, NewServiceReconciler(r.client).reconcileHeadlessService(ctx, rbg, role)
+}
+
+func (r *CloneSetReconciler) reconcileCloneSet(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("start to reconciling cloneset workload")
+
+	oldCloneSet := &kruiseappsv1alpha1.CloneSet{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, oldCloneSet)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ApplyResult{}, err
+	}
+
+	cloneSetObj, err := r.constructCloneSet(ctx, rbg, role, oldCloneSet, rollingUpdateStrategy, revisionKey)
+	if err != nil {
+		logger.Error(err, "Failed to construct cloneset")
+		return ApplyResult{}, fmt.Errorf("%w: %w", ErrRenderWorkload, err)
+	}
+	newCloneSet := &kruiseappsv1alpha1.CloneSet{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(cloneSetObj.Object, newCloneSet); err != nil {
+		return ApplyResult{}, fmt.Errorf("convert cloneset error: %s", err.Error())
+	}
+
+	// the err value was used to pass the differences between the old and new objects,
+	// not to indicate an actual processing error.
+	semanticallyEqual, err := semanticallyEqualCloneSet(oldCloneSet, newCloneSet, false)
+	if err != nil {
+		logger.Info(fmt.Sprintf("cloneset not equal, diff: %s", err.Error()))
+	}
+
+	roleHashKey := fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)
+	revisionHashEqual := newCloneSet.Labels[roleHashKey] == oldCloneSet.Labels[roleHashKey]
+	if !revisionHashEqual {
+		logger.Info(fmt.Sprintf("cloneset hash not equal, old: %s, new: %s",
+			oldCloneSet.Labels[roleHashKey], newCloneSet.Labels[roleHashKey]))
+	}
+	if semanticallyEqual && revisionHashEqual {
+		logger.Info("cloneset equal, skip reconcile")
+		return ApplyResult{}, nil
+	}
+
+	if needsPodDeletionCosts(role, oldCloneSet.Spec.Replicas) && oldCloneSet.Spec.Selector != nil {
+		if err := setPodDeletionCosts(
+			ctx, r.client, role, oldCloneSet.Namespace, oldCloneSet.Spec.Selector.MatchLabels,
+		); err != nil {
+			return ApplyResult{}, err
+		}
+	}
+	result, err := applyWorkload(ctx, r.client, rbg, oldCloneSet, cloneSetObj)
+	if err != nil {
+		logger.Error(err, "Failed to patch cloneset")
+		return ApplyResult{}, err
+	}
+	return result, nil
+}
+
+// Render implements WorkloadRenderer.
+func (r *CloneSetReconciler) Render(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) ([]*unstructured.Unstructured, error) {
+	cloneSet, err := r.constructCloneSet(ctx, rbg, role, &kruiseappsv1alpha1.CloneSet{}, nil, revisionKey)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := renderHeadlessService(ctx, r.client, rbg, role, cloneSet)
+	if err != nil {
+		return nil, err
+	}
+	return []*unstructured.Unstructured{cloneSet, svc}, nil
+}
+
+// constructCloneSet renders the cloneset of the role as an unstructured object, as OpenKruise
+// ships no apply configurations. Only the fields owned by rbg are set, so that it can be
+// applied with server side apply.
+func (r *CloneSetReconciler) constructCloneSet(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+	oldCloneSet *kruiseappsv1alpha1.CloneSet,
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate,
+	revisionKey string,
+) (*unstructured.Unstructured, error) {
+	matchLabels := rbg.GetCommonLabelsFromRole(role)
+	if oldCloneSet.UID != "" {
+		// do not update selector when workload exists
+		matchLabels = oldCloneSet.Spec.Selector.MatchLabels
+	}
+
+	podReconciler := NewPodReconciler(r.scheme, r.client)
+	podReconciler.SetPodGroupManager(r.podGroupManager)
+	podTemplateApplyConfiguration, err := podReconciler.ConstructPodTemplateSpecApplyConfiguration(
+		ctx, rbg, role, maps.Clone(matchLabels),
+	)
+	if err != nil {
+		return nil, err
+	}
+	cloneSetLabel := maps.Clone(matchLabels)
+	cloneSetLabel[fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)] = revisionKey
+
+	// The partition is always set so that lifting it ends a partitioned rollout.
+	updateStrategy := kruiseappsv1alpha1.CloneSetUpdateStrategy{
+		Type:      kruiseappsv1alpha1.CloneSetUpdateStrategyType(kruisePodUpdatePolicy(role)),
+		Partition: ptr.To(intstr.FromInt32(0)),
+	}
+	if role.RolloutStrategy != nil && role.RolloutStrategy.RollingUpdate != nil {
+		rollingUpdate := role.RolloutStrategy.RollingUpdate
+		if rollingUpdate.Partition != nil {
+			updateStrategy.Partition = rollingUpdate.Partition
+		}
+		updateStrategy.MaxUnavailable = rollingUpdate.MaxUnavailable
+		updateStrategy.MaxSurge = rollingUpdate.MaxSurge
+		updateStrategy.Paused = rollingUpdate.Paused
+		updateStrategy.InPlaceUpdateStrategy = kruiseInPlaceUpdateStrategy(rollingUpdate)
+	}
+	if rollingUpdateStrategy != nil {
+		if rollingUpdateStrategy.Partition != nil {
+			updateStrategy.Partition = rollingUpdateStrategy.Partition
+		}
+		if rollingUpdateStrategy.MaxUnavailable != nil {
+			updateStrategy.MaxUnavailable = rollingUpdateStrategy.MaxUnavailable
+		}
+	}
+
+	spec := map[string]interface{}{
+		"replicas":        int64(*role.Replicas),
+		"minReadySeconds": int64(role.MinReadySeconds),
+	}
+	if err := setNestedObjects(spec, map[string]interface{}{
+		"selector":       &metav1.LabelSelector{MatchLabels: matchLabels},
+		"template":       podTemplateApplyConfiguration,
+		"updateStrategy": &updateStrategy,
+	}); err != nil {
+		return nil, err
+	}
+
+	return newKruiseWorkload(rbg, role, kruiseappsv1alpha1.GroupVersion.String(), "CloneSet",
+		labels.Merge(maps.Clone(role.Labels), cloneSetLabel), spec), nil
+}
+
+func (r *CloneSetReconciler) ConstructRoleStatus(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+) (workloadsv1alpha2.RoleStatus, error) {
+	cloneSet := &kruiseappsv1alpha1.CloneSet{}
+	if err := r.client.Get(
+		ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, cloneSet,
+	); err != nil {
+		return workloadsv1alpha2.RoleStatus{Name: role.Name}, err
+	}
+
+	return ConstructWorkloadRoleStatus(ctx, rbg, role,
+		*cloneSet.Spec.Replicas, cloneSet.Status.ReadyReplicas, cloneSet.Status.UpdatedReplicas,
+		cloneSet.Generation, cloneSet.Status.ObservedGeneration), nil
+}
+
+func (r *CloneSetReconciler) CheckWorkloadReady(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (bool, error) {
+	cloneSet := &kruiseappsv1alpha1.CloneSet{}
+	if err := r.client.Get(
+		ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, cloneSet,
+	); err != nil {
+		return false, err
+	}
+
+	// We don't check ready if workload is rolling update if maxSkew is set.
+	if utils.RoleInMaxSkewCoordinationV2(rbg, role.Name) &&
+		cloneSet.Status.UpdatedReplicas != cloneSet.Status.Replicas {
+		return true, nil
+	}
+	return cloneSet.Status.ReadyReplicas == *cloneSet.Spec.Replicas, nil
+}
+
+func (r *CloneSetReconciler) CleanupOrphanedWorkloads(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+) error {
+	if err := utils.CheckCrdExists(r.client, utils.KruiseCloneSetCrdName); err != nil {
+		log.FromContext(ctx).V(1).Info(fmt.Sprintf(
+			"CloneSetReconciler CleanupOrphanedWorkloads check cloneset crd failed: %s", err.Error()))
+		return nil
+	}
+	return CleanupOrphanedObjs(ctx, r.client, rbg, kruiseappsv1alpha1.GroupVersion.WithKind("CloneSet"))
+}
+
+func (r *CloneSetReconciler) RecreateWorkload(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) error {
+	return RecreateObj(ctx, r.client, rbg, role, kruiseappsv1alpha1.GroupVersion.WithKind("CloneSet"))
+}
+
+func semanticallyEqualCloneSet(oldCloneSet, newCloneSet *kruiseappsv1alpha1.CloneSet, checkStatus bool) (bool, error) {
+	if oldCloneSet == nil || oldCloneSet.UID == "" {
+		return false, errors.New("old cloneset not exist")
+	}
+	if newCloneSet == nil {
+		return false, fmt.Errorf("new cloneset is nil")
+	}
+
+	if equal, err := objectMetaEqual(oldCloneSet.ObjectMeta, newCloneSet.ObjectMeta); !equal {
+		return false, fmt.Errorf("objectMeta not equal: %s", err.Error())
+	}
+
+	if equal, err := cloneSetSpecEqual(oldCloneSet.Spec, newCloneSet.Spec); !equal {
+		return false, fmt.Errorf("spec not equal: %s", err.Error())
+	}
+
+	if checkStatus && !reflect.DeepEqual(oldCloneSet.Status, newCloneSet.Status) {
+		return false, fmt.Errorf("status not equal")
+	}
+	return true, nil
+}
+
+func cloneSetSpecEqual(spec1, spec2 kruiseappsv1alpha1.CloneSetSpec) (bool, error) {
+	if spec1.Replicas != nil && spec2.Replicas != nil && *spec1.Replicas != *spec2.Replicas {
+		return false, fmt.Errorf("replicas not equal, old: %d, new: %d", *spec1.Replicas, *spec2.Replicas)
+	}
+
+	if !reflect.DeepEqual(spec1.Selector, spec2.Selector) {
+		return false, fmt.Errorf("selector not equal, old: %v, new: %v", spec1.Selector, spec2.Selector)
+	}
+
+	if spec1.MinReadySeconds != spec2.MinReadySeconds {
+		return false, fmt.Errorf("minReadySeconds not equal, old: %d, new: %d", spec1.MinReadySeconds, spec2.MinReadySeconds)
+	}
+
+	// The update strategy is defaulted by OpenKruise, only the fields set by rbg are compared.
+	strategy1, strategy2 := spec1.UpdateStrategy, spec2.UpdateStrategy
+	if strategy1.Type != strategy2.Type || strategy1.Paused != strategy2.Paused ||
+		!defaultedFieldEqual(strategy1.Partition, strategy2.Partition) ||
+		!defaultedFieldEqual(strategy1.MaxUnavailable, strategy2.MaxUnavailable) ||
+		!defaultedFieldEqual(strategy1.MaxSurge, strategy2.MaxSurge) ||
+		!reflect.DeepEqual(strategy1.InPlaceUpdateStrategy, strategy2.InPlaceUpdateStrategy) {
+		return false, fmt.Errorf("updateStrategy not equal, old: %+v, new: %+v", strategy1, strategy2)
+	}
+
+	if equal, err := podTemplateSpecEqual(spec1.Template, spec2.Template); !equal {
+		return false, fmt.Errorf("podTemplateSpec not equal, %s", err.Error())
+	}
+	return true, nil
+}
+
+// kruisePodUpdatePolicy maps the update type of the role to the pod update policy of OpenKruise
+// workloads, pods are updated in place when possible unless told otherwise.
+func kruisePodUpdatePolicy(role *workloadsv1alpha2.RoleSpec) string {
+	if role.RolloutStrategy == nil || role.RolloutStrategy.RollingUpdate == nil {
+		return string(kruiseappsv1alpha1.InPlaceIfPossibleCloneSetUpdateStrategyType)
+	}
+	switch role.RolloutStrategy.RollingUpdate.Type {
+	case workloadsv1alpha2.RecreatePodUpdateStrategyType:
+		return string(kruiseappsv1alpha1.RecreateCloneSetUpdateStrategyType)
+	case workloadsv1alpha2.InPlaceOnlyUpdateStrategyType:
+		return string(kruiseappsv1alpha1.InPlaceOnlyCloneSetUpdateStrategyType)
+	default:
+		return string(kruiseappsv1alpha1.InPlaceIfPossibleCloneSetUpdateStrategyType)
+	}
+}
+
+func kruiseInPlaceUpdateStrategy(rollingUpdate *workloadsv1alpha2.RollingUpdate) *kruiseappspub.InPlaceUpdateStrategy {
+	if rollingUpdate.InPlaceUpdateStrategy == nil {
+		return nil
+	}
+	return &kruiseappspub.InPlaceUpdateStrategy{GracePeriodSeconds: rollingUpdate.InPlaceUpdateStrategy.GracePeriodSeconds}
+}
+
+// defaultedFieldEqual reports whether the workload already has the desired value of a field.
+// Fields rbg leaves unset are defaulted by OpenKruise and always match.
+func defaultedFieldEqual[T comparable](current, desired *T) bool {
+	return desired == nil || (current != nil && *current == *desired)
+}
+
+// setNestedObjects converts the given objects and sets them as fields of the unstructured content.
+func setNestedObjects(content map[string]interface{}, objs map[string]interface{}) error {
+	for field, obj := range objs {
+		value, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("converting %s to unstructured: %w", field, err)
+		}
+		content[field] = value
+	}
+	return nil
+}
+
+// newKruiseWorkload returns the workload of the role with the given kind and spec, controlled by the rbg.
+func newKruiseWorkload(
+	rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	apiVersion, kind string, workloadLabels map[string]string, spec map[string]interface{},
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(rbg.GetWorkloadName(role))
+	obj.SetNamespace(rbg.Namespace)
+	obj.SetLabels(workloadLabels)
+	obj.SetAnnotations(labels.Merge(maps.Clone(role.Annotations), rbg.GetCommonAnnotationsFromRole(role)))
+	obj.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion:         rbg.APIVersion,
+		Kind:               rbg.Kind,
+		Name:               rbg.Name,
+		UID:                rbg.GetUID(),
+		BlockOwnerDeletion: ptr.To(true),
+		Controller:         ptr.To(true),
+	}})
+	return obj
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	kruiseappsv1alpha1 "github.com/openkruise/kruise/apis/apps/v1alpha1"
+	kruiseappsv1beta1 "github.com/openkruise/kruise/apis/apps/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func newKruiseTestRBG(workloadType string) (*workloadsv1alpha2.RoleBasedGroup, *workloadsv1alpha2.RoleSpec) {
+	role := &workloadsv1alpha2.RoleSpec{
+		Name:     "decode",
+		Replicas: ptr.To(int32(3)),
+		Annotations: map[string]string{
+			constants.RoleWorkloadTypeAnnotationKey: workloadType,
+		},
+		Pattern: workloadsv1alpha2.Pattern{
+			StandalonePattern: &workloadsv1alpha2.StandalonePattern{
+				TemplateSource: workloadsv1alpha2.TemplateSource{
+					Template: &corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "engine", Image: "engine:v1"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	rbg := &workloadsv1alpha2.RoleBasedGroup{
+		TypeMeta: metav1.TypeMeta{APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rbg",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: workloadsv1alpha2.RoleBasedGroupSpec{Roles: []workloadsv1alpha2.RoleSpec{*role}},
+	}
+	return rbg, role
+}
+
+func newKruiseTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = apiextensionsv1.AddToScheme(scheme)
+	_ = kruiseappsv1alpha1.AddToScheme(scheme)
+	_ = kruiseappsv1beta1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	return scheme
+}
+
+func newEstablishedCRD(name string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestCloneSetReconciler_Reconciler(t *testing.T) {
+	ctx := context.TODO()
+	scheme := newKruiseTestScheme()
+	rbg, role := newKruiseTestRBG(constants.KruiseCloneSetWorkloadType)
+	key := types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := NewCloneSetReconciler(scheme, c)
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+
+	cloneSet := &kruiseappsv1alpha1.CloneSet{}
+	require.NoError(t, c.Get(ctx, key, cloneSet))
+	assert.Equal(t, int32(3), *cloneSet.Spec.Replicas)
+	assert.Equal(t, "engine:v1", cloneSet.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, rbg.GetCommonLabelsFromRole(role), cloneSet.Spec.Selector.MatchLabels)
+	assert.Equal(t, "rev-1", cloneSet.Labels[fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)])
+	assert.True(t, metav1.IsControlledBy(cloneSet, rbg))
+	// Pods are updated in place by default.
+	assert.Equal(t, kruiseappsv1alpha1.CloneSetUpdateStrategy{
+		Type:      kruiseappsv1alpha1.InPlaceIfPossibleCloneSetUpdateStrategyType,
+		Partition: ptr.To(intstr.FromInt32(0)),
+	}, cloneSet.Spec.UpdateStrategy)
+
+	svc := &corev1.Service{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: rbg.GetServiceName(role), Namespace: rbg.Namespace}, svc))
+
+	role.RolloutStrategy = &workloadsv1alpha2.RolloutStrategy{
+		Type: workloadsv1alpha2.RollingUpdateStrategyType,
+		RollingUpdate: &workloadsv1alpha2.RollingUpdate{
+			Type:                  workloadsv1alpha2.RecreatePodUpdateStrategyType,
+			Partition:             ptr.To(intstr.FromInt32(1)),
+			MaxUnavailable:        ptr.To(intstr.FromString("50%")),
+			MaxSurge:              ptr.To(intstr.FromInt32(1)),
+			InPlaceUpdateStrategy: &workloadsv1alpha2.InPlaceUpdateStrategy{GracePeriodSeconds: 10},
+		},
+	}
+	role.MinReadySeconds = 30
+	// The partition of a coordinated rolling update takes precedence.
+	coordination := &workloadsv1alpha2.RollingUpdate{Partition: ptr.To(intstr.FromInt32(2))}
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, coordination, "rev-2")))
+	require.NoError(t, c.Get(ctx, key, cloneSet))
+	assert.Equal(t, int32(30), cloneSet.Spec.MinReadySeconds)
+	strategy := cloneSet.Spec.UpdateStrategy
+	assert.Equal(t, kruiseappsv1alpha1.RecreateCloneSetUpdateStrategyType, strategy.Type)
+	assert.Equal(t, intstr.FromInt32(2), *strategy.Partition)
+	assert.Equal(t, intstr.FromString("50%"), *strategy.MaxUnavailable)
+	assert.Equal(t, intstr.FromInt32(1), *strategy.MaxSurge)
+	assert.Equal(t, int32(10), strategy.InPlaceUpdateStrategy.GracePeriodSeconds)
+}
+
+func TestSemanticallyEqualCloneSet(t *testing.T) {
+	newCloneSet := &kruiseappsv1alpha1.CloneSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg-decode", Labels: map[string]string{"a": "b"}},
+		Spec: kruiseappsv1alpha1.CloneSetSpec{
+			Replicas: ptr.To(int32(2)),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}},
+			UpdateStrategy: kruiseappsv1alpha1.CloneSetUpdateStrategy{
+				Type:      kruiseappsv1alpha1.InPlaceIfPossibleCloneSetUpdateStrategyType,
+				Partition: ptr.To(intstr.FromInt32(0)),
+			},
+		},
+	}
+	oldCloneSet := newCloneSet.DeepCopy()
+	oldCloneSet.UID = "uid"
+	// Fields defaulted by OpenKruise are not compared.
+	oldCloneSet.Spec.UpdateStrategy.MaxUnavailable = ptr.To(intstr.FromString("20%"))
+	oldCloneSet.Spec.UpdateStrategy.MaxSurge = ptr.To(intstr.FromInt32(0))
+
+	equal, err := semanticallyEqualCloneSet(oldCloneSet, newCloneSet, false)
+	assert.True(t, equal, err)
+
+	_, err = semanticallyEqualCloneSet(&kruiseappsv1alpha1.CloneSet{}, newCloneSet, false)
+	assert.EqualError(t, err, "old cloneset not exist")
+
+	newCloneSet.Spec.UpdateStrategy.Partition = ptr.To(intstr.FromInt32(1))
+	equal, _ = semanticallyEqualCloneSet(oldCloneSet, newCloneSet, false)
+	assert.False(t, equal)
+
+	newCloneSet.Spec.UpdateStrategy.Partition = ptr.To(intstr.FromInt32(0))
+	newCloneSet.Spec.Replicas = ptr.To(int32(3))
+	equal, _ = semanticallyEqualCloneSet(oldCloneSet, newCloneSet, false)
+	assert.False(t, equal)
+}
+
+func TestCloneSetReconciler_CleanupOrphanedWorkloads(t *testing.T) {
+	ctx := context.TODO()
+	scheme := newKruiseTestScheme()
+	rbg, role := newKruiseTestRBG(constants.KruiseCloneSetWorkloadType)
+	newCloneSet := func(name string) *kruiseappsv1alpha1.CloneSet {
+		return &kruiseappsv1alpha1.CloneSet{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: rbg.Namespace,
+			Labels:    map[string]string{constants.GroupNameLabelKey: rbg.Name},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup",
+				Name: rbg.Name, UID: rbg.UID, Controller: ptr.To(true),
+			}},
+		}}
+	}
+
+	// Nothing is listed without the CRD.
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newCloneSet("test-rbg-removed")).Build()
+	require.NoError(t, NewCloneSetReconciler(scheme, c).CleanupOrphanedWorkloads(ctx, rbg))
+
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newEstablishedCRD("clonesets.apps.kruise.io"),
+		newCloneSet(rbg.GetWorkloadName(role)), newCloneSet("test-rbg-removed"),
+	).Build()
+	require.NoError(t, NewCloneSetReconciler(scheme, c).CleanupOrphanedWorkloads(ctx, rbg))
+
+	cloneSets := &kruiseappsv1alpha1.CloneSetList{}
+	require.NoError(t, c.List(ctx, cloneSets, client.InNamespace(rbg.Namespace)))
+	require.Len(t, cloneSets.Items, 1)
+	assert.Equal(t, rbg.GetWorkloadName(role), cloneSets.Items[0].Name)
+}
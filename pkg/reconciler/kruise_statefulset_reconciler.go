@@ -0,0 +1,367 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+
+	kruiseappsv1beta1 "github.com/openkruise/kruise/apis/apps/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/scheduler"
+	"sigs.k8s.io/rbgs/pkg/utils"
+)
+
+// AdvancedStatefulSetReconciler reconciles roles backed by an OpenKruise Advanced StatefulSet,
+// which keeps the stable identities of a StatefulSet and updates its pods in place.
+type AdvancedStatefulSetReconciler struct {
+	scheme          *runtime.Scheme
+	client          client.Client
+	podGroupManager scheduler.PodGroupManager
+}
+
+var _ WorkloadReconciler = &AdvancedStatefulSetReconciler{}
+
+func NewAdvancedStatefulSetReconciler(scheme *runtime.Scheme, client client.Client) *AdvancedStatefulSetReconciler {
+	return &AdvancedStatefulSetReconciler{scheme: scheme, client: client}
+}
+
+// SetPodGroupManager implements PodGroupManagerSetter.
+func (r *AdvancedStatefulSetReconciler) SetPodGroupManager(m scheduler.PodGroupManager) {
+	r.podGroupManager = m
+}
+
+func (r *AdvancedStatefulSetReconciler) Validate(
+	ctx context.Context, role *workloadsv1alpha2.RoleSpec) error {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("start to validate role declaration")
+
+	return nil
+}
+
+func (r *AdvancedStatefulSetReconciler) Reconciler(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
+	result, err := r.reconcileStatefulSet(ctx, rbg, role, rollingUpdateStrategy, revisionKey)
+	if err != nil {
+		return ApplyResult{}, err
+	}
+
+	return result, NewServiceReconciler(r.client).reconcileHeadlessService(ctx, rbg, role)
+}
+
+func (r *AdvancedStatefulSetReconciler) reconcileStatefulSet(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("start to reconciling advanced sts workload")
+
+	oldSts := &kruiseappsv1beta1.StatefulSet{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, oldSts)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ApplyResult{}, err
+	}
+
+	stsObj, err := r.constructStatefulSet(ctx, rbg, role, oldSts, rollingUpdateStrategy, revisionKey)
+	if err != nil {
+		logger.Error(err, "Failed to construct advanced statefulset")
+		return ApplyResult{}, fmt.Errorf("%w: %w", ErrRenderWorkload, err)
+	}
+	newSts := &kruiseappsv1beta1.StatefulSet{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(stsObj.Object, newSts); err != nil {
+		return ApplyResult{}, fmt.Errorf("convert advanced sts error: %s", err.Error())
+	}
+
+	// the err value was used to pass the differences between the old and new objects,
+	// not to indicate an actual processing error.
+	semanticallyEqual, err := semanticallyEqualAdvancedStatefulSet(oldSts, newSts, false)
+	if err != nil {
+		logger.Info(fmt.Sprintf("advanced sts not equal, diff: %s", err.Error()))
+	}
+
+	roleHashKey := fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)
+	revisionHashEqual := newSts.Labels[roleHashKey] == oldSts.Labels[roleHashKey]
+	if !revisionHashEqual {
+		logger.Info(fmt.Sprintf("advanced sts hash not equal, old: %s, new: %s",
+			oldSts.Labels[roleHashKey], newSts.Labels[roleHashKey]))
+	}
+	if semanticallyEqual && revisionHashEqual {
+		logger.Info("advanced sts equal, skip reconcile")
+		return ApplyResult{}, nil
+	}
+
+	result, err := applyWorkload(ctx, r.client, rbg, oldSts, stsObj)
+	if err != nil {
+		logger.Error(err, "Failed to patch advanced statefulset")
+		return ApplyResult{}, err
+	}
+	return result, nil
+}
+
+// Render implements WorkloadRenderer.
+func (r *AdvancedStatefulSetReconciler) Render(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) ([]*unstructured.Unstructured, error) {
+	sts, err := r.constructStatefulSet(ctx, rbg, role, &kruiseappsv1beta1.StatefulSet{}, nil, revisionKey)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := renderHeadlessService(ctx, r.client, rbg, role, sts)
+	if err != nil {
+		return nil, err
+	}
+	return []*unstructured.Unstructured{sts, svc}, nil
+}
+
+// constructStatefulSet renders the advanced statefulset of the role as an unstructured object with
+// only the fields owned by rbg set. Unlike the StatefulSetReconciler, the partition is not stepped
+// by rbg: OpenKruise rolls out maxUnavailable pods at a time on its own.
+func (r *AdvancedStatefulSetReconciler) constructStatefulSet(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+	oldSts *kruiseappsv1beta1.StatefulSet,
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate,
+	revisionKey string,
+) (*unstructured.Unstructured, error) {
+	matchLabels := rbg.GetCommonLabelsFromRole(role)
+	if oldSts.UID != "" {
+		// do not update selector when workload exists
+		matchLabels = oldSts.Spec.Selector.MatchLabels
+	}
+
+	podReconciler := NewPodReconciler(r.scheme, r.client)
+	podReconciler.SetPodGroupManager(r.podGroupManager)
+	podTemplateApplyConfiguration, err := podReconciler.ConstructPodTemplateSpecApplyConfiguration(
+		ctx, rbg, role, maps.Clone(matchLabels),
+	)
+	if err != nil {
+		return nil, err
+	}
+	stsLabel := maps.Clone(matchLabels)
+	stsLabel[fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)] = revisionKey
+
+	svcName, err := utils.GetCompatibleHeadlessServiceName(ctx, r.client, rbg, role)
+	if err != nil {
+		return nil, err
+	}
+
+	updateStrategy, err := advancedStatefulSetUpdateStrategy(role, rollingUpdateStrategy)
+	if err != nil {
+		return nil, err
+	}
+	podManagementPolicy := appsv1.PodManagementPolicyType(role.PodManagementPolicy)
+	if podManagementPolicy == "" {
+		podManagementPolicy = appsv1.ParallelPodManagement
+	}
+
+	spec := map[string]interface{}{
+		"serviceName":         svcName,
+		"replicas":            int64(*role.Replicas),
+		"podManagementPolicy": string(podManagementPolicy),
+	}
+	if err := setNestedObjects(spec, map[string]interface{}{
+		"selector":       &metav1.LabelSelector{MatchLabels: matchLabels},
+		"template":       podTemplateApplyConfiguration,
+		"updateStrategy": updateStrategy,
+	}); err != nil {
+		return nil, err
+	}
+
+	return newKruiseWorkload(rbg, role, kruiseappsv1beta1.GroupVersion.String(), "StatefulSet",
+		labels.Merge(maps.Clone(role.Labels), stsLabel), spec), nil
+}
+
+// advancedStatefulSetUpdateStrategy returns the update strategy of the role, with the partition and
+// maxUnavailable of a coordinated rolling update taking precedence.
+func advancedStatefulSetUpdateStrategy(
+	role *workloadsv1alpha2.RoleSpec, rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate,
+) (*kruiseappsv1beta1.StatefulSetUpdateStrategy, error) {
+	if role.RolloutStrategy != nil && role.RolloutStrategy.Type == workloadsv1alpha2.OnDeleteStrategyType {
+		return &kruiseappsv1beta1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}, nil
+	}
+
+	rollingUpdate := &kruiseappsv1beta1.RollingUpdateStatefulSetStrategy{
+		PodUpdatePolicy: kruiseappsv1beta1.PodUpdateStrategyType(kruisePodUpdatePolicy(role)),
+	}
+	// The partition is always set so that lifting it ends a partitioned rollout.
+	partition := ptr.To(intstr.FromInt32(0))
+	if role.RolloutStrategy != nil && role.RolloutStrategy.RollingUpdate != nil {
+		roleRollingUpdate := role.RolloutStrategy.RollingUpdate
+		if roleRollingUpdate.Partition != nil {
+			partition = roleRollingUpdate.Partition
+		}
+		rollingUpdate.MaxUnavailable = roleRollingUpdate.MaxUnavailable
+		rollingUpdate.Paused = roleRollingUpdate.Paused
+		rollingUpdate.InPlaceUpdateStrategy = kruiseInPlaceUpdateStrategy(roleRollingUpdate)
+	}
+	if rollingUpdateStrategy != nil {
+		if rollingUpdateStrategy.Partition != nil {
+			partition = rollingUpdateStrategy.Partition
+		}
+		if rollingUpdateStrategy.MaxUnavailable != nil {
+			rollingUpdate.MaxUnavailable = rollingUpdateStrategy.MaxUnavailable
+		}
+	}
+	value, err := intstr.GetScaledValueFromIntOrPercent(partition, int(*role.Replicas), true)
+	if err != nil {
+		return nil, err
+	}
+	rollingUpdate.Partition = ptr.To(int32(value))
+	if role.MinReadySeconds > 0 {
+		rollingUpdate.MinReadySeconds = ptr.To(role.MinReadySeconds)
+	}
+
+	return &kruiseappsv1beta1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: rollingUpdate,
+	}, nil
+}
+
+func (r *AdvancedStatefulSetReconciler) ConstructRoleStatus(
+	ctx context.Context,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+) (workloadsv1alpha2.RoleStatus, error) {
+	sts := &kruiseappsv1beta1.StatefulSet{}
+	if err := r.client.Get(
+		ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, sts,
+	); err != nil {
+		return workloadsv1alpha2.RoleStatus{Name: role.Name}, err
+	}
+	return ConstructWorkloadRoleStatus(ctx, rbg, role,
+		*sts.Spec.Replicas, sts.Status.ReadyReplicas, sts.Status.UpdatedReplicas,
+		sts.Generation, sts.Status.ObservedGeneration), nil
+}
+
+func (r *AdvancedStatefulSetReconciler) CheckWorkloadReady(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (bool, error) {
+	sts := &kruiseappsv1beta1.StatefulSet{}
+	if err := r.client.Get(
+		ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, sts,
+	); err != nil {
+		return false, err
+	}
+
+	if utils.RoleInMaxSkewCoordinationV2(rbg, role.Name) &&
+		sts.Status.CurrentRevision != sts.Status.UpdateRevision {
+		return true, nil
+	}
+	return sts.Status.ReadyReplicas == *sts.Spec.Replicas, nil
+}
+
+func (r *AdvancedStatefulSetReconciler) CleanupOrphanedWorkloads(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup,
+) error {
+	if err := utils.CheckCrdExists(r.client, utils.KruiseStatefulSetCrdName); err != nil {
+		log.FromContext(ctx).V(1).Info(fmt.Sprintf(
+			"AdvancedStatefulSetReconciler CleanupOrphanedWorkloads check advanced sts crd failed: %s", err.Error()))
+		return nil
+	}
+	return CleanupOrphanedObjs(ctx, r.client, rbg, kruiseappsv1beta1.GroupVersion.WithKind("StatefulSet"))
+}
+
+func (r *AdvancedStatefulSetReconciler) RecreateWorkload(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) error {
+	return RecreateObj(ctx, r.client, rbg, role, kruiseappsv1beta1.GroupVersion.WithKind("StatefulSet"))
+}
+
+func semanticallyEqualAdvancedStatefulSet(oldSts, newSts *kruiseappsv1beta1.StatefulSet, checkStatus bool) (bool, error) {
+	if oldSts == nil || oldSts.UID == "" {
+		return false, errors.New("old advanced sts not exist")
+	}
+	if newSts == nil {
+		return false, fmt.Errorf("new advanced sts is nil")
+	}
+
+	if equal, err := objectMetaEqual(oldSts.ObjectMeta, newSts.ObjectMeta); !equal {
+		return false, fmt.Errorf("objectMeta not equal: %s", err.Error())
+	}
+
+	if equal, err := advancedStatefulSetSpecEqual(oldSts.Spec, newSts.Spec); !equal {
+		return false, fmt.Errorf("spec not equal: %s", err.Error())
+	}
+
+	if checkStatus && !reflect.DeepEqual(oldSts.Status, newSts.Status) {
+		return false, fmt.Errorf("status not equal")
+	}
+	return true, nil
+}
+
+func advancedStatefulSetSpecEqual(spec1, spec2 kruiseappsv1beta1.StatefulSetSpec) (bool, error) {
+	if spec1.Replicas != nil && spec2.Replicas != nil && *spec1.Replicas != *spec2.Replicas {
+		return false, fmt.Errorf("replicas not equal, old: %d, new: %d", *spec1.Replicas, *spec2.Replicas)
+	}
+
+	if !reflect.DeepEqual(spec1.Selector, spec2.Selector) {
+		return false, fmt.Errorf("selector not equal, old: %v, new: %v", spec1.Selector, spec2.Selector)
+	}
+
+	if spec1.ServiceName != spec2.ServiceName {
+		return false, fmt.Errorf("serviceName not equal, old: %s, new: %s", spec1.ServiceName, spec2.ServiceName)
+	}
+
+	if equal, err := advancedStatefulSetUpdateStrategyEqual(spec1.UpdateStrategy, spec2.UpdateStrategy); !equal {
+		return false, err
+	}
+
+	if equal, err := podTemplateSpecEqual(spec1.Template, spec2.Template); !equal {
+		return false, fmt.Errorf("podTemplateSpec not equal, %s", err.Error())
+	}
+	return true, nil
+}
+
+// advancedStatefulSetUpdateStrategyEqual compares the fields of the update strategy set by rbg, the
+// others are defaulted by OpenKruise.
+func advancedStatefulSetUpdateStrategyEqual(strategy1, strategy2 kruiseappsv1beta1.StatefulSetUpdateStrategy) (bool, error) {
+	notEqual := fmt.Errorf("updateStrategy not equal, old: %+v, new: %+v", strategy1, strategy2)
+	if strategy1.Type != strategy2.Type {
+		return false, notEqual
+	}
+	if strategy2.RollingUpdate == nil {
+		return true, nil
+	}
+	if strategy1.RollingUpdate == nil {
+		return false, notEqual
+	}
+	rollingUpdate1, rollingUpdate2 := strategy1.RollingUpdate, strategy2.RollingUpdate
+	if rollingUpdate1.PodUpdatePolicy != rollingUpdate2.PodUpdatePolicy || rollingUpdate1.Paused != rollingUpdate2.Paused ||
+		!defaultedFieldEqual(rollingUpdate1.Partition, rollingUpdate2.Partition) ||
+		!defaultedFieldEqual(rollingUpdate1.MaxUnavailable, rollingUpdate2.MaxUnavailable) ||
+		!defaultedFieldEqual(rollingUpdate1.MinReadySeconds, rollingUpdate2.MinReadySeconds) ||
+		!reflect.DeepEqual(rollingUpdate1.InPlaceUpdateStrategy, rollingUpdate2.InPlaceUpdateStrategy) {
+		return false, notEqual
+	}
+	return true, nil
+}
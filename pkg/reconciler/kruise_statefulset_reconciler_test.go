@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	kruiseappsv1beta1 "github.com/openkruise/kruise/apis/apps/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func TestAdvancedStatefulSetReconciler_Reconciler(t *testing.T) {
+	ctx := context.TODO()
+	scheme := newKruiseTestScheme()
+	rbg, role := newKruiseTestRBG(constants.KruiseStatefulSetWorkloadType)
+	key := types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := NewAdvancedStatefulSetReconciler(scheme, c)
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-1")))
+
+	sts := &kruiseappsv1beta1.StatefulSet{}
+	require.NoError(t, c.Get(ctx, key, sts))
+	assert.Equal(t, int32(3), *sts.Spec.Replicas)
+	assert.Equal(t, rbg.GetServiceName(role), sts.Spec.ServiceName)
+	assert.Equal(t, appsv1.ParallelPodManagement, sts.Spec.PodManagementPolicy)
+	assert.True(t, metav1.IsControlledBy(sts, rbg))
+	assert.Equal(t, kruiseappsv1beta1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &kruiseappsv1beta1.RollingUpdateStatefulSetStrategy{
+			Partition:       ptr.To(int32(0)),
+			PodUpdatePolicy: kruiseappsv1beta1.InPlaceIfPossiblePodUpdateStrategyType,
+		},
+	}, sts.Spec.UpdateStrategy)
+
+	role.PodManagementPolicy = constants.OrderedReadyPodManagement
+	role.MinReadySeconds = 30
+	role.RolloutStrategy = &workloadsv1alpha2.RolloutStrategy{
+		Type: workloadsv1alpha2.RollingUpdateStrategyType,
+		RollingUpdate: &workloadsv1alpha2.RollingUpdate{
+			Type:           workloadsv1alpha2.InPlaceOnlyUpdateStrategyType,
+			Partition:      ptr.To(intstr.FromString("50%")),
+			MaxUnavailable: ptr.To(intstr.FromInt32(2)),
+		},
+	}
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-2")))
+	require.NoError(t, c.Get(ctx, key, sts))
+	assert.Equal(t, appsv1.OrderedReadyPodManagement, sts.Spec.PodManagementPolicy)
+	assert.Equal(t, kruiseappsv1beta1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &kruiseappsv1beta1.RollingUpdateStatefulSetStrategy{
+			Partition:       ptr.To(int32(2)),
+			MaxUnavailable:  ptr.To(intstr.FromInt32(2)),
+			PodUpdatePolicy: kruiseappsv1beta1.InPlaceOnlyPodUpdateStrategyType,
+			MinReadySeconds: ptr.To(int32(30)),
+		},
+	}, sts.Spec.UpdateStrategy)
+
+	role.RolloutStrategy = &workloadsv1alpha2.RolloutStrategy{Type: workloadsv1alpha2.OnDeleteStrategyType}
+	require.NoError(t, applyErr(r.Reconciler(ctx, rbg, role, nil, "rev-3")))
+	require.NoError(t, c.Get(ctx, key, sts))
+	assert.Equal(t, appsv1.OnDeleteStatefulSetStrategyType, sts.Spec.UpdateStrategy.Type)
+	assert.Nil(t, sts.Spec.UpdateStrategy.RollingUpdate)
+}
+
+func TestAdvancedStatefulSetUpdateStrategyEqual(t *testing.T) {
+	desired := kruiseappsv1beta1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &kruiseappsv1beta1.RollingUpdateStatefulSetStrategy{
+			Partition:       ptr.To(int32(0)),
+			PodUpdatePolicy: kruiseappsv1beta1.InPlaceIfPossiblePodUpdateStrategyType,
+		},
+	}
+	current := *desired.DeepCopy()
+	current.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+	equal, err := advancedStatefulSetUpdateStrategyEqual(current, desired)
+	assert.True(t, equal, err)
+
+	desired.RollingUpdate.Partition = ptr.To(int32(1))
+	equal, _ = advancedStatefulSetUpdateStrategyEqual(current, desired)
+	assert.False(t, equal)
+
+	equal, _ = advancedStatefulSetUpdateStrategyEqual(kruiseappsv1beta1.StatefulSetUpdateStrategy{
+		Type: appsv1.RollingUpdateStatefulSetStrategyType}, desired)
+	assert.False(t, equal)
+}
+
+func TestAdvancedStatefulSetReconciler_CleanupOrphanedWorkloads(t *testing.T) {
+	ctx := context.TODO()
+	scheme := newKruiseTestScheme()
+	rbg, role := newKruiseTestRBG(constants.StatefulSetWorkloadType)
+	ownerRefs := []metav1.OwnerReference{{
+		APIVersion: "workloads.x-k8s.io/v1alpha2", Kind: "RoleBasedGroup",
+		Name: rbg.Name, UID: rbg.UID, Controller: ptr.To(true),
+	}}
+	objectMeta := metav1.ObjectMeta{
+		Name:            rbg.GetWorkloadName(role),
+		Namespace:       rbg.Namespace,
+		Labels:          map[string]string{constants.GroupNameLabelKey: rbg.Name},
+		OwnerReferences: ownerRefs,
+	}
+
+	// The role moved from an advanced statefulset to an apps/v1 one of the same name.
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newEstablishedCRD("statefulsets.apps.kruise.io"),
+		&kruiseappsv1beta1.StatefulSet{ObjectMeta: *objectMeta.DeepCopy()},
+		&appsv1.StatefulSet{ObjectMeta: *objectMeta.DeepCopy()},
+	).Build()
+	require.NoError(t, NewAdvancedStatefulSetReconciler(scheme, c).CleanupOrphanedWorkloads(ctx, rbg))
+	require.NoError(t, NewStatefulSetReconciler(scheme, c).CleanupOrphanedWorkloads(ctx, rbg))
+
+	advancedStsList := &kruiseappsv1beta1.StatefulSetList{}
+	require.NoError(t, c.List(ctx, advancedStsList, client.InNamespace(rbg.Namespace)))
+	assert.Empty(t, advancedStsList.Items)
+	stsList := &appsv1.StatefulSetList{}
+	require.NoError(t, c.List(ctx, stsList, client.InNamespace(rbg.Namespace)))
+	assert.Len(t, stsList.Items, 1)
+}
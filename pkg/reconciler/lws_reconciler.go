@@ -31,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -72,30 +73,30 @@ func (r *LeaderWorkerSetReconciler) Validate(
 
 func (r *LeaderWorkerSetReconciler) Reconciler(
 	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
-	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) error {
+	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error) {
 	logger := log.FromContext(ctx)
 	logger.V(1).Info("start to reconciling lws workload")
 
 	lwsApplyConfig, err := r.constructLWSApplyConfiguration(ctx, rbg, role, rollingUpdateStrategy, revisionKey)
 	if err != nil {
 		logger.Error(err, "Failed to construct lws apply configuration")
-		return err
+		return ApplyResult{}, fmt.Errorf("%w: %w", ErrRenderWorkload, err)
 	}
 	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(lwsApplyConfig)
 	if err != nil {
 		logger.Error(err, "Converting obj apply configuration to json")
-		return err
+		return ApplyResult{}, err
 	}
 	newLWS := &lwsv1.LeaderWorkerSet{}
 	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj, newLWS); err != nil {
 		logger.Error(err, "convert lwsApplyConfig to lws")
-		return err
+		return ApplyResult{}, err
 	}
 	oldLWS := &lwsv1.LeaderWorkerSet{}
 	err = r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, oldLWS)
 	if err != nil && !apierrors.IsNotFound(err) {
 		logger.Error(err, "get lws failed")
-		return err
+		return ApplyResult{}, err
 	}
 
 	// the err value was used to pass the differences between the old and new objects,
@@ -112,14 +113,30 @@ func (r *LeaderWorkerSetReconciler) Reconciler(
 	}
 	if semanticallyEqual && revisionHashEqual {
 		logger.Info("lws equal, skip reconcile")
-		return nil
+		return ApplyResult{}, nil
 	}
 
-	if err = utils.PatchObjectApplyConfiguration(ctx, r.client, lwsApplyConfig, utils.PatchSpec); err != nil {
+	result, err := applyWorkload(ctx, r.client, rbg, oldLWS, lwsApplyConfig)
+	if err != nil {
 		logger.Error(err, "Failed to patch lws apply configuration")
-		return err
+		return ApplyResult{}, err
 	}
-	return nil
+	return result, nil
+}
+
+// Render implements WorkloadRenderer.
+func (r *LeaderWorkerSetReconciler) Render(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) ([]*unstructured.Unstructured, error) {
+	lwsApplyConfig, err := r.constructLWSApplyConfiguration(ctx, rbg, role, nil, revisionKey)
+	if err != nil {
+		return nil, err
+	}
+	lws, err := applyConfigurationToUnstructured(lwsApplyConfig)
+	if err != nil {
+		return nil, err
+	}
+	return []*unstructured.Unstructured{lws}, nil
 }
 
 func (r *LeaderWorkerSetReconciler) ConstructRoleStatus(
@@ -287,11 +304,11 @@ func (r *LeaderWorkerSetReconciler) constructLWSApplyConfiguration(
 	if role.RestartPolicy == "None" {
 		restartPolicy = lwsv1.NoneRestartPolicy
 	} else {
-		// if role has RecreateRBGOnPodRestart or RecreateRoleInstanceOnPodRestart policy,
+		// if role has RecreateRBGOnPodRestart, RecreateRoleOnPodRestart or RecreateRoleInstanceOnPodRestart policy,
 		// set RecreateGroupOnPodRestart for lws
 		// it's safe to do so since
 		// 1. RecreateGroupOnPodRestart is the default restart policy for lws
-		// 2. RecreateRBGOnPodRestart will delete lws if pod recreated or containers restarted
+		// 2. RecreateRBGOnPodRestart and RecreateRoleOnPodRestart will delete lws if pod recreated or containers restarted
 		restartPolicy = lwsv1.RecreateGroupOnPodRestart
 	}
 
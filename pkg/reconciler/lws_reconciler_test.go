@@ -62,7 +62,7 @@ func TestLeaderWorkerSetReconciler_Reconciler(t *testing.T) {
 
 	// Test successful reconciliation
 	ctx := context.Background()
-	err := reconciler.Reconciler(ctx, rbg, &lwsRole, nil, expectedRevisionHash)
+	_, err := reconciler.Reconciler(ctx, rbg, &lwsRole, nil, expectedRevisionHash)
 	assert.NoError(t, err)
 
 	// Verify LWS was created
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+const (
+	// PodDeletionCostAnnotationKey ranks the pods of a ReplicaSet or CloneSet for a scale-down,
+	// the ready pods with the lowest cost are deleted first.
+	PodDeletionCostAnnotationKey = "controller.kubernetes.io/pod-deletion-cost"
+
+	engineMetricsTimeout = 2 * time.Second
+)
+
+var engineMetricsClient = &http.Client{Timeout: engineMetricsTimeout}
+
+// needsPodDeletionCosts reports whether the pods of role have to be ranked before the replicas of
+// its workload are reduced from current.
+func needsPodDeletionCosts(role *workloadsv1alpha2.RoleSpec, current *int32) bool {
+	return role.ScaleDownPolicy != nil && current != nil && role.Replicas != nil && *role.Replicas < *current
+}
+
+// setPodDeletionCosts writes the rank of every pod selected by selector to its deletion cost, following
+// the scale-down policy of role. The cheapest pod gets 0, so it is the first one the workload deletes.
+func setPodDeletionCosts(
+	ctx context.Context, c client.Client, role *workloadsv1alpha2.RoleSpec, namespace string, selector map[string]string,
+) error {
+	logger := log.FromContext(ctx)
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabels(selector)); err != nil {
+		return fmt.Errorf("failed to list pods of role %s: %w", role.Name, err)
+	}
+
+	type rankedPod struct {
+		pod  *corev1.Pod
+		cost float64
+	}
+	pods := make([]rankedPod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		var cost float64
+		switch role.ScaleDownPolicy.Type {
+		case workloadsv1alpha2.PodAgeScaleDownPolicy:
+			cost = float64(-pod.CreationTimestamp.UnixNano())
+		case workloadsv1alpha2.EngineLoadScaleDownPolicy:
+			load, err := ScrapeEngineLoad(ctx, pod, role.ScaleDownPolicy.EngineLoad)
+			if err != nil {
+				// An engine that cannot report its load is the first one to go.
+				logger.V(1).Info("Failed to read the engine load", "pod", pod.Name, "error", err.Error())
+				load = -1
+			}
+			cost = load
+		default:
+			return nil
+		}
+		pods = append(pods, rankedPod{pod: pod, cost: cost})
+	}
+	sort.SliceStable(pods, func(i, j int) bool { return pods[i].cost < pods[j].cost })
+
+	for rank, p := range pods {
+		value := strconv.Itoa(rank)
+		if p.pod.Annotations[PodDeletionCostAnnotationKey] == value {
+			continue
+		}
+		patch := client.MergeFrom(p.pod.DeepCopy())
+		if p.pod.Annotations == nil {
+			p.pod.Annotations = map[string]string{}
+		}
+		p.pod.Annotations[PodDeletionCostAnnotationKey] = value
+		if err := c.Patch(ctx, p.pod, patch); err != nil {
+			return fmt.Errorf("failed to set the deletion cost of pod %s: %w", p.pod.Name, err)
+		}
+	}
+	return nil
+}
+
+// ScrapeEngineLoad reads the metrics of the engine serving in pod and sums the series of the load gauge.
+func ScrapeEngineLoad(ctx context.Context, pod *corev1.Pod, metric *workloadsv1alpha2.EngineLoadMetric) (float64, error) {
+	if metric == nil {
+		return 0, fmt.Errorf("no engine load metric is configured")
+	}
+	if pod.Status.PodIP == "" {
+		return 0, fmt.Errorf("pod has no IP")
+	}
+	path := metric.Path
+	if path == "" {
+		path = "/metrics"
+	}
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(metric.Port))), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := engineMetricsClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse metrics from %s: %w", url, err)
+	}
+	family, ok := families[metric.Metric]
+	if !ok {
+		return 0, fmt.Errorf("metric %s not found at %s", metric.Metric, url)
+	}
+	var load float64
+	for _, m := range family.GetMetric() {
+		switch {
+		case m.GetGauge() != nil:
+			load += m.GetGauge().GetValue()
+		case m.GetCounter() != nil:
+			load += m.GetCounter().GetValue()
+		case m.GetUntyped() != nil:
+			load += m.GetUntyped().GetValue()
+		}
+	}
+	return load, nil
+}
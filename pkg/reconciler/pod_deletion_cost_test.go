@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+func newDeletionCostPod(name, ip string, created time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{"app": "engine"},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: corev1.PodStatus{PodIP: ip},
+	}
+}
+
+func getDeletionCosts(t *testing.T, c client.Client, names ...string) []string {
+	costs := make([]string, 0, len(names))
+	for _, name := range names {
+		pod := &corev1.Pod{}
+		assert.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: name}, pod))
+		costs = append(costs, pod.Annotations[PodDeletionCostAnnotationKey])
+	}
+	return costs
+}
+
+func TestNeedsPodDeletionCosts(t *testing.T) {
+	role := &workloadsv1alpha2.RoleSpec{Replicas: ptr.To[int32](2)}
+	assert.False(t, needsPodDeletionCosts(role, ptr.To[int32](3)))
+
+	role.ScaleDownPolicy = &workloadsv1alpha2.ScaleDownPolicy{Type: workloadsv1alpha2.PodAgeScaleDownPolicy}
+	assert.True(t, needsPodDeletionCosts(role, ptr.To[int32](3)))
+	assert.False(t, needsPodDeletionCosts(role, ptr.To[int32](2)))
+	assert.False(t, needsPodDeletionCosts(role, nil))
+}
+
+func TestSetPodDeletionCosts_PodAge(t *testing.T) {
+	now := time.Now()
+	c := fake.NewClientBuilder().WithObjects(
+		newDeletionCostPod("old", "", now.Add(-time.Hour)),
+		newDeletionCostPod("young", "", now),
+		newDeletionCostPod("middle", "", now.Add(-time.Minute)),
+	).Build()
+	role := &workloadsv1alpha2.RoleSpec{
+		Name:            "decode",
+		ScaleDownPolicy: &workloadsv1alpha2.ScaleDownPolicy{Type: workloadsv1alpha2.PodAgeScaleDownPolicy},
+	}
+
+	assert.NoError(t, setPodDeletionCosts(context.TODO(), c, role, "default", map[string]string{"app": "engine"}))
+	assert.Equal(t, []string{"2", "0", "1"}, getDeletionCosts(t, c, "old", "young", "middle"))
+}
+
+func TestSetPodDeletionCosts_EngineLoad(t *testing.T) {
+	// The pods are told apart by the loopback address they are scraped on.
+	loads := map[string]string{"127.0.0.1": "7", "127.0.0.2": "1"}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		host, _, _ := net.SplitHostPort(req.Host)
+		load, ok := loads[host]
+		if !ok || req.URL.Path != "/metrics" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "# TYPE vllm:num_requests_running gauge\n"+
+			"vllm:num_requests_running{model=\"a\"} %s\nvllm:num_requests_running{model=\"b\"} 1\n", load)
+	}))
+	listener, err := net.Listen("tcp", "0.0.0.0:0")
+	if err != nil {
+		t.Skipf("cannot listen on all interfaces: %v", err)
+	}
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+	_, portValue, err := net.SplitHostPort(listener.Addr().String())
+	assert.NoError(t, err)
+	port, err := strconv.Atoi(portValue)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	c := fake.NewClientBuilder().WithObjects(
+		newDeletionCostPod("busy", "127.0.0.1", now),
+		newDeletionCostPod("idle", "127.0.0.2", now),
+		newDeletionCostPod("broken", "127.0.0.3", now),
+		newDeletionCostPod("pending", "", now),
+	).Build()
+	metric := &workloadsv1alpha2.EngineLoadMetric{Port: int32(port), Path: "/metrics", Metric: "vllm:num_requests_running"}
+	role := &workloadsv1alpha2.RoleSpec{
+		Name: "decode",
+		ScaleDownPolicy: &workloadsv1alpha2.ScaleDownPolicy{
+			Type:       workloadsv1alpha2.EngineLoadScaleDownPolicy,
+			EngineLoad: metric,
+		},
+	}
+
+	load, err := ScrapeEngineLoad(context.TODO(), newDeletionCostPod("busy", "127.0.0.1", now), metric)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(8), load)
+
+	// The engines that cannot report their load are deleted first, then the least busy ones.
+	assert.NoError(t, setPodDeletionCosts(context.TODO(), c, role, "default", map[string]string{"app": "engine"}))
+	costs := getDeletionCosts(t, c, "busy", "idle", "broken", "pending")
+	assert.Equal(t, []string{"3", "2"}, costs[:2])
+	assert.ElementsMatch(t, []string{"0", "1"}, costs[2:])
+}
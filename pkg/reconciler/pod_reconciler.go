@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 
 	corev1 "k8s.io/api/core/v1"
@@ -73,7 +74,7 @@ func (r *PodReconciler) ConstructPodTemplateSpecApplyConfiguration(
 		podTemplateSpec = podTmpls[0]
 	} else {
 		// KEP-8: Resolve role template using shared helper (supports both templateRef and inline template)
-		resolvedTemplate, err := role.GetResolvedTemplate(rbg)
+		resolvedTemplate, err := utils.ResolveRoleTemplate(ctx, r.client, rbg, role)
 		if err != nil {
 			return nil, err
 		}
@@ -83,6 +84,16 @@ func (r *PodReconciler) ConstructPodTemplateSpecApplyConfiguration(
 	if podAnnotations == nil {
 		podAnnotations = make(map[string]string)
 	}
+	// The priority class of the role wins over the template's. The priority value is resolved
+	// from the class by the admission controller and rejected when it does not match it.
+	if role.PriorityClassName != "" {
+		podTemplateSpec.Spec.PriorityClassName = role.PriorityClassName
+		podTemplateSpec.Spec.Priority = nil
+	}
+	// Propagate the restart marker so that bumping it on the role rolls its pods.
+	if restartedAt := role.Annotations[constants.RoleRestartedAtAnnotationKey]; restartedAt != "" {
+		podAnnotations[constants.RoleRestartedAtAnnotationKey] = restartedAt
+	}
 	// inject objects
 	injector := discovery.NewDefaultInjector(r.scheme, r.client)
 	if r.injectObjects == nil {
@@ -124,6 +135,30 @@ func (r *PodReconciler) ConstructPodTemplateSpecApplyConfiguration(
 		}
 	}
 
+	// Set co-location topology
+	if topologyKey, found := rbg.GetColocationKey(); found {
+		if podAnnotations[constants.DisableColocationAnnotationKey] == "" {
+			err := setColocationAffinity(
+				&podTemplateSpec, rbg.GenGroupUniqueKey(), topologyKey, constants.GroupUIDLabelKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Set the placement policy of the group
+	if policy := rbg.Spec.PlacementPolicy; policy != nil {
+		setPlacementPolicy(&podTemplateSpec, rbg.GenGroupUniqueKey(), role.Name, policy)
+	}
+
+	// Pods of a group with staged admission are not scheduled before the controller removes their gate.
+	if rbg.UsesStagedAdmission() && !slices.ContainsFunc(podTemplateSpec.Spec.SchedulingGates,
+		func(gate corev1.PodSchedulingGate) bool { return gate.Name == constants.StagedAdmissionSchedulingGate }) {
+		podTemplateSpec.Spec.SchedulingGates = append(podTemplateSpec.Spec.SchedulingGates,
+			corev1.PodSchedulingGate{Name: constants.StagedAdmissionSchedulingGate})
+	}
+
 	// construct pod template spec configuration
 	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&podTemplateSpec)
 	if err != nil {
@@ -141,6 +176,18 @@ func (r *PodReconciler) ConstructPodTemplateSpecApplyConfiguration(
 	}
 
 	podTemplateApplyConfiguration.WithLabels(podLabels).WithAnnotations(podAnnotations)
+	// Groups created by a BlueGreen RBGSet label their pods with the revision they were created from,
+	// the Service of the set selects the pods of the serving revision.
+	if revision := rbg.Labels[constants.GroupSetRevisionLabelKey]; revision != "" {
+		podTemplateApplyConfiguration.WithLabels(map[string]string{
+			constants.GroupSetNameLabelKey:     rbg.Labels[constants.GroupSetNameLabelKey],
+			constants.GroupSetRevisionLabelKey: revision,
+		})
+	}
+	// The InferencePool of spec.networking selects the pods of its roles by the name of the pool.
+	if pool := rbg.GetInferencePool(role.Name); pool != nil {
+		podTemplateApplyConfiguration.WithLabels(map[string]string{constants.InferencePoolLabelKey: pool.Name})
+	}
 	return podTemplateApplyConfiguration, nil
 }
 
@@ -218,6 +265,107 @@ func setExclusiveAffinities(pod *corev1.PodTemplateSpec,
 	return nil
 }
 
+// setColocationAffinity sets the pod affinity that keeps the pods of a group in one
+// topology domain, e.g. prefill and decode in the zone their KV cache is transferred in.
+func setColocationAffinity(pod *corev1.PodTemplateSpec,
+	uniqueKey string,
+	topologyKey string,
+	podAffinityKey string) error {
+	if len(topologyKey) == 0 {
+		return fmt.Errorf("topology key can't be nil")
+	}
+	term := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      podAffinityKey,
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   []string{uniqueKey},
+				},
+			},
+		},
+		TopologyKey: topologyKey,
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.PodAffinity == nil {
+		pod.Spec.Affinity.PodAffinity = &corev1.PodAffinity{}
+	}
+	for _, existing := range pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+		if reflect.DeepEqual(existing, term) {
+			return nil
+		}
+	}
+	pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution =
+		append(pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+	return nil
+}
+
+// setPlacementPolicy spreads the pods of the role over the topology domains of the policy and keeps
+// the pods of the co-located roles of the group in one domain.
+func setPlacementPolicy(pod *corev1.PodTemplateSpec,
+	uniqueKey string,
+	roleName string,
+	policy *workloadsv1alpha2.PlacementPolicy) {
+	topologyKey := policy.TopologyKey
+	if topologyKey == "" {
+		topologyKey = corev1.LabelHostname
+	}
+
+	if policy.SpreadRolesAcrossNodes {
+		constraint := corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					constants.GroupUIDLabelKey: uniqueKey,
+					constants.RoleNameLabelKey: roleName,
+				},
+			},
+		}
+		if !slices.ContainsFunc(pod.Spec.TopologySpreadConstraints, func(existing corev1.TopologySpreadConstraint) bool {
+			return reflect.DeepEqual(existing, constraint)
+		}) {
+			pod.Spec.TopologySpreadConstraints = append(pod.Spec.TopologySpreadConstraints, constraint)
+		}
+	}
+
+	if slices.Contains(policy.CoLocateRoles, roleName) {
+		// The first pod matches its own term, so it is placed freely and the others follow it.
+		term := corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      constants.GroupUIDLabelKey,
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{uniqueKey},
+					},
+					{
+						Key:      constants.RoleNameLabelKey,
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   slices.Clone(policy.CoLocateRoles),
+					},
+				},
+			},
+			TopologyKey: topologyKey,
+		}
+		if pod.Spec.Affinity == nil {
+			pod.Spec.Affinity = &corev1.Affinity{}
+		}
+		if pod.Spec.Affinity.PodAffinity == nil {
+			pod.Spec.Affinity.PodAffinity = &corev1.PodAffinity{}
+		}
+		terms := pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+		if !slices.ContainsFunc(terms, func(existing corev1.PodAffinityTerm) bool {
+			return reflect.DeepEqual(existing, term)
+		}) {
+			pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(terms, term)
+		}
+	}
+}
+
 func exclusiveAffinityApplied(podTemplateSpec corev1.PodTemplateSpec, topologyKey string) bool {
 	if podTemplateSpec.Spec.Affinity == nil ||
 		podTemplateSpec.Spec.Affinity.PodAffinity == nil ||
@@ -273,6 +421,11 @@ func podSpecEqual(spec1, spec2 corev1.PodSpec) (bool, error) {
 		return false, fmt.Errorf("podTemplate volumes not equal: %s", err.Error())
 	}
 
+	if spec1.PriorityClassName != spec2.PriorityClassName {
+		return false, fmt.Errorf("podTemplate priorityClassName not equal: %s != %s",
+			spec1.PriorityClassName, spec2.PriorityClassName)
+	}
+
 	return true, nil
 }
 
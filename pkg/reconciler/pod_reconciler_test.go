@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
@@ -129,6 +130,20 @@ func Test_podSpecEqual(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "unequal pod specs with different priority classes",
+			args: args{
+				spec1: corev1.PodSpec{
+					Containers:        []corev1.Container{{Name: "container1", Image: "nginx:1.20"}},
+					PriorityClassName: "high",
+				},
+				spec2: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "container1", Image: "nginx:1.20"}},
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(
@@ -578,6 +593,199 @@ func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration(t *testing.T)
 	}
 }
 
+func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration_RestartedAt(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewPodReconciler(scheme, client)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "test-ns").Obj()
+	role := &rbg.Spec.Roles[0]
+	role.Annotations = map[string]string{constants.RoleRestartedAtAnnotationKey: "2026-01-02T15:04:05Z"}
+
+	result, err := reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-02T15:04:05Z", result.Annotations[constants.RoleRestartedAtAnnotationKey])
+}
+
+func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration_GroupSetRevision(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewPodReconciler(scheme, client)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "test-ns").Obj()
+	rbg.Labels = map[string]string{constants.GroupSetNameLabelKey: "test-rbgset"}
+	role := &rbg.Spec.Roles[0]
+
+	result, err := reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.NotContains(t, result.Labels, constants.GroupSetNameLabelKey, "only BlueGreen groups label their pods")
+
+	rbg.Labels[constants.GroupSetRevisionLabelKey] = "5d8f7c"
+	result, err = reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-rbgset", result.Labels[constants.GroupSetNameLabelKey])
+	assert.Equal(t, "5d8f7c", result.Labels[constants.GroupSetRevisionLabelKey])
+}
+
+func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration_InferencePool(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewPodReconciler(scheme, client)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "test-ns").Obj()
+	role := &rbg.Spec.Roles[0]
+
+	result, err := reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.NotContains(t, result.Labels, constants.InferencePoolLabelKey)
+
+	rbg.Spec.Networking = &workloadsv1alpha2.NetworkingPolicy{InferencePools: []workloadsv1alpha2.InferencePoolSpec{
+		{Name: "llm", Roles: []string{role.Name}, TargetPort: 8000},
+	}}
+	result, err = reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "llm", result.Labels[constants.InferencePoolLabelKey])
+}
+
+func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration_PriorityClassName(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewPodReconciler(scheme, client)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "test-ns").Obj()
+	role := &rbg.Spec.Roles[0]
+	role.StandalonePattern.Template.Spec.PriorityClassName = "batch"
+	role.StandalonePattern.Template.Spec.Priority = ptr.To[int32](100)
+
+	result, err := reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "batch", *result.Spec.PriorityClassName)
+
+	role.PriorityClassName = "inference"
+	result, err = reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "inference", *result.Spec.PriorityClassName)
+	assert.Nil(t, result.Spec.Priority)
+}
+
+func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration_Colocation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewPodReconciler(scheme, client)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "test-ns").
+		WithAnnotations(map[string]string{constants.GroupColocationTopologyKey: "topology.kubernetes.io/zone"}).Obj()
+	role := &rbg.Spec.Roles[0]
+
+	result, err := reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	terms := result.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if assert.Len(t, terms, 1) {
+		assert.Equal(t, "topology.kubernetes.io/zone", *terms[0].TopologyKey)
+		expression := terms[0].LabelSelector.MatchExpressions[0]
+		assert.Equal(t, constants.GroupUIDLabelKey, *expression.Key)
+		assert.Equal(t, []string{rbg.GenGroupUniqueKey()}, expression.Values)
+	}
+	assert.Nil(t, result.Spec.Affinity.PodAntiAffinity)
+
+	role.StandalonePattern.Template.Annotations = map[string]string{constants.DisableColocationAnnotationKey: "true"}
+	result, err = reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result.Spec.Affinity)
+}
+
+func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration_StagedAdmission(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewPodReconciler(scheme, client)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "test-ns").Obj()
+	role := &rbg.Spec.Roles[0]
+
+	result, err := reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Spec.SchedulingGates)
+
+	gates := func() []string {
+		result, err := reconciler.ConstructPodTemplateSpecApplyConfiguration(context.Background(), rbg, role, nil)
+		assert.NoError(t, err)
+		var names []string
+		for _, gate := range result.Spec.SchedulingGates {
+			names = append(names, *gate.Name)
+		}
+		return names
+	}
+
+	// The gate is added next to the gates of the template, unless the template has it already.
+	rbg.Annotations = map[string]string{constants.StagedAdmissionAnnotationKey: "true"}
+	role.StandalonePattern.Template.Spec.SchedulingGates = []corev1.PodSchedulingGate{{Name: "example.com/quota"}}
+	assert.Equal(t, []string{"example.com/quota", constants.StagedAdmissionSchedulingGate}, gates())
+	role.StandalonePattern.Template.Spec.SchedulingGates = []corev1.PodSchedulingGate{
+		{Name: constants.StagedAdmissionSchedulingGate}, {Name: "example.com/quota"},
+	}
+	assert.Equal(t, []string{constants.StagedAdmissionSchedulingGate, "example.com/quota"}, gates())
+}
+
+func Test_setColocationAffinity(t *testing.T) {
+	pod := &corev1.PodTemplateSpec{}
+	assert.NoError(t, setColocationAffinity(pod, "abcd1234", "topology.kubernetes.io/zone", constants.GroupUIDLabelKey))
+	// Applying the affinity again must not duplicate the term.
+	assert.NoError(t, setColocationAffinity(pod, "abcd1234", "topology.kubernetes.io/zone", constants.GroupUIDLabelKey))
+	assert.Len(t, pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, 1)
+	assert.Nil(t, pod.Spec.Affinity.PodAntiAffinity)
+
+	assert.Error(t, setColocationAffinity(pod, "abcd1234", "", constants.GroupUIDLabelKey))
+}
+
+func Test_setPlacementPolicy(t *testing.T) {
+	policy := &workloadsv1alpha2.PlacementPolicy{SpreadRolesAcrossNodes: true, CoLocateRoles: []string{"prefill", "decode"}}
+	pod := &corev1.PodTemplateSpec{}
+	setPlacementPolicy(pod, "abcd1234", "decode", policy)
+	// Applying the policy again must not duplicate the constraints.
+	setPlacementPolicy(pod, "abcd1234", "decode", policy)
+
+	if assert.Len(t, pod.Spec.TopologySpreadConstraints, 1) {
+		constraint := pod.Spec.TopologySpreadConstraints[0]
+		assert.Equal(t, corev1.LabelHostname, constraint.TopologyKey)
+		assert.Equal(t, corev1.ScheduleAnyway, constraint.WhenUnsatisfiable)
+		assert.Equal(t, map[string]string{
+			constants.GroupUIDLabelKey: "abcd1234",
+			constants.RoleNameLabelKey: "decode",
+		}, constraint.LabelSelector.MatchLabels)
+	}
+	terms := pod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if assert.Len(t, terms, 1) {
+		assert.Equal(t, corev1.LabelHostname, terms[0].TopologyKey)
+		assert.Equal(t, []string{"prefill", "decode"}, terms[0].LabelSelector.MatchExpressions[1].Values)
+	}
+
+	// Roles that are not co-located are only spread.
+	pod = &corev1.PodTemplateSpec{}
+	setPlacementPolicy(pod, "abcd1234", "router",
+		&workloadsv1alpha2.PlacementPolicy{TopologyKey: "topology.kubernetes.io/zone", SpreadRolesAcrossNodes: true,
+			CoLocateRoles: []string{"prefill", "decode"}})
+	assert.Equal(t, "topology.kubernetes.io/zone", pod.Spec.TopologySpreadConstraints[0].TopologyKey)
+	assert.Nil(t, pod.Spec.Affinity)
+}
+
 func TestPodReconciler_ConstructPodTemplateSpecApplyConfiguration_WithInjectors(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
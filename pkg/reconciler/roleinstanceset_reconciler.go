@@ -22,6 +22,7 @@ import (
 	"maps"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -68,48 +69,49 @@ func (r *RoleInstanceSetReconciler) Validate(
 func (r *RoleInstanceSetReconciler) Reconciler(
 	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
 	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string,
-) error {
-	if err := r.reconcileRoleInstanceSet(ctx, rbg, role, rollingUpdateStrategy, revisionKey); err != nil {
-		return err
+) (ApplyResult, error) {
+	result, err := r.reconcileRoleInstanceSet(ctx, rbg, role, rollingUpdateStrategy, revisionKey)
+	if err != nil {
+		return ApplyResult{}, err
 	}
-	return NewServiceReconciler(r.client).reconcileHeadlessService(ctx, rbg, role)
+	return result, NewServiceReconciler(r.client).reconcileHeadlessService(ctx, rbg, role)
 }
 
 func (r *RoleInstanceSetReconciler) reconcileRoleInstanceSet(
 	ctx context.Context,
 	rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
 	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string,
-) error {
+) (ApplyResult, error) {
 	logger := log.FromContext(ctx)
 	logger.V(1).Info("start to reconciling roleinstanceset workload")
 
 	rollingStrategy, err := validateRolloutStrategy(role.RolloutStrategy, int(*role.Replicas))
 	if err != nil {
 		logger.Error(err, "Invalid rollout strategy")
-		return err
+		return ApplyResult{}, err
 	}
 	role.RolloutStrategy = rollingStrategy
 
 	oldRoleInstanceSet := &workloadsv1alpha2.RoleInstanceSet{}
 	err = r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, oldRoleInstanceSet)
 	if err != nil && !apierrors.IsNotFound(err) {
-		return err
+		return ApplyResult{}, err
 	}
 
 	roleInstanceSetApplyConfig, err := r.constructRoleInstanceSetApplyConfiguration(ctx, rbg, role, rollingUpdateStrategy, revisionKey, oldRoleInstanceSet)
 	if err != nil {
 		logger.Error(err, "Failed to construct roleInstanceSet apply configuration")
-		return err
+		return ApplyResult{}, fmt.Errorf("%w: %w", ErrRenderWorkload, err)
 	}
 	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(roleInstanceSetApplyConfig)
 	if err != nil {
 		logger.Error(err, "Converting obj apply configuration to json.")
-		return err
+		return ApplyResult{}, err
 	}
 
 	newRoleInstanceSet := &workloadsv1alpha2.RoleInstanceSet{}
 	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj, newRoleInstanceSet); err != nil {
-		return fmt.Errorf("convert roleInstanceSet ApplyConfig to roleInstanceSet error: %s", err.Error())
+		return ApplyResult{}, fmt.Errorf("convert roleInstanceSet ApplyConfig to roleInstanceSet error: %s", err.Error())
 	}
 
 	roleHashKey := fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)
@@ -123,12 +125,40 @@ func (r *RoleInstanceSetReconciler) reconcileRoleInstanceSet(
 		)
 	}
 
-	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, roleInstanceSetApplyConfig, utils.PatchSpec); err != nil {
+	result, err := applyWorkload(ctx, r.client, rbg, oldRoleInstanceSet, roleInstanceSetApplyConfig)
+	if err != nil {
 		logger.Error(err, "Failed to patch roleInstanceSet apply configuration")
-		return err
+		return ApplyResult{}, err
 	}
 
-	return nil
+	return result, nil
+}
+
+// Render implements WorkloadRenderer.
+func (r *RoleInstanceSetReconciler) Render(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) ([]*unstructured.Unstructured, error) {
+	rollingStrategy, err := validateRolloutStrategy(role.RolloutStrategy, int(*role.Replicas))
+	if err != nil {
+		return nil, err
+	}
+	role.RolloutStrategy = rollingStrategy
+
+	roleInstanceSetApplyConfig, err := r.constructRoleInstanceSetApplyConfiguration(
+		ctx, rbg, role, nil, revisionKey, &workloadsv1alpha2.RoleInstanceSet{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	roleInstanceSet, err := applyConfigurationToUnstructured(roleInstanceSetApplyConfig)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := renderHeadlessService(ctx, r.client, rbg, role, roleInstanceSet)
+	if err != nil {
+		return nil, err
+	}
+	return []*unstructured.Unstructured{roleInstanceSet, svc}, nil
 }
 
 func (r *RoleInstanceSetReconciler) constructRoleInstanceSetApplyConfiguration(
@@ -155,11 +185,11 @@ func (r *RoleInstanceSetReconciler) constructRoleInstanceSetApplyConfiguration(
 	if role.RestartPolicy == "None" {
 		restartPolicy = workloadsv1alpha2.NoneRoleInstanceRestartPolicy
 	} else {
-		// if role has RecreateRBGOnPodRestart or RecreateRoleInstanceOnPodRestart policy,
+		// if role has RecreateRBGOnPodRestart, RecreateRoleOnPodRestart or RecreateRoleInstanceOnPodRestart policy,
 		// set RecreateRoleInstanceOnPodRestart for lws
 		// it's safe to do so since
 		// 1. RecreateRoleInstanceOnPodRestart is the default restart policy for lws
-		// 2. RecreateRBGOnPodRestart will delete lws if pod recreated or containers restarted
+		// 2. RecreateRBGOnPodRestart and RecreateRoleOnPodRestart will delete lws if pod recreated or containers restarted
 		restartPolicy = workloadsv1alpha2.RoleInstanceRestartPolicyType(workloadsv1alpha2.RecreateRoleInstanceOnPodRestart)
 	}
 	roleInstanceTemplateConfig := workloadsv1alpha2client.RoleInstanceTemplate().
@@ -316,7 +346,7 @@ func (r *RoleInstanceSetReconciler) constructRoleInstanceTemplateByLeaderWorkerP
 	}
 
 	// Resolve baseTemplate using shared helper (supports both templateRef and inline template)
-	baseTemplate, err := role.GetResolvedTemplate(rbg)
+	baseTemplate, err := utils.ResolveRoleTemplate(ctx, r.client, rbg, role)
 	if err != nil {
 		logger.Error(err, "failed to resolve base template", "rbg", keyOfRbg(rbg))
 		return err
@@ -191,7 +191,7 @@ func TestRoleInstanceSetReconciler_LeaderWorkerPattern_WithTemplateRef(t *testin
 
 			// Test reconciliation
 			ctx := context.Background()
-			err := reconciler.Reconciler(ctx, rbg, &role, nil, expectedRevisionHash)
+			_, err := reconciler.Reconciler(ctx, rbg, &role, nil, expectedRevisionHash)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -268,7 +268,7 @@ func TestRoleInstanceSetReconciler_LeaderWorkerPattern_TemplateIsolation(t *test
 
 	// Test reconciliation
 	ctx := context.Background()
-	err := reconciler.Reconciler(ctx, rbg, &role, nil, "test-revision")
+	_, err := reconciler.Reconciler(ctx, rbg, &role, nil, "test-revision")
 	assert.NoError(t, err)
 
 	// Verify RoleInstanceSet was created
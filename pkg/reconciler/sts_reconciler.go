@@ -28,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -75,49 +76,53 @@ func (r *StatefulSetReconciler) Validate(
 func (r *StatefulSetReconciler) Reconciler(
 	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
 	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string,
-) error {
-	if err := r.reconcileStatefulSet(ctx, rbg, role, rollingUpdateStrategy, revisionKey); err != nil {
-		return err
+) (ApplyResult, error) {
+	if recreating, err := r.recreateForPodManagementPolicy(ctx, rbg, role); err != nil || recreating {
+		return ApplyResult{}, err
+	}
+	result, err := r.reconcileStatefulSet(ctx, rbg, role, rollingUpdateStrategy, revisionKey)
+	if err != nil {
+		return ApplyResult{}, err
 	}
 
-	return NewServiceReconciler(r.client).reconcileHeadlessService(ctx, rbg, role)
+	return result, NewServiceReconciler(r.client).reconcileHeadlessService(ctx, rbg, role)
 }
 
 func (r *StatefulSetReconciler) reconcileStatefulSet(
 	ctx context.Context,
 	rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
 	rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string,
-) error {
+) (ApplyResult, error) {
 	logger := log.FromContext(ctx)
 	logger.V(1).Info("start to reconciling sts workload")
 
 	rollingStrategy, err := validateRolloutStrategy(role.RolloutStrategy, int(*role.Replicas))
 	if err != nil {
 		logger.Error(err, "Invalid rollout strategy")
-		return err
+		return ApplyResult{}, err
 	}
 	role.RolloutStrategy = rollingStrategy
 
 	oldSts := &appsv1.StatefulSet{}
 	err = r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, oldSts)
 	if err != nil && !apierrors.IsNotFound(err) {
-		return err
+		return ApplyResult{}, err
 	}
 
 	stsApplyConfig, err := r.constructStatefulSetApplyConfiguration(ctx, rbg, role, oldSts, revisionKey)
 	if err != nil {
 		logger.Error(err, "Failed to construct statefulset apply configuration")
-		return err
+		return ApplyResult{}, fmt.Errorf("%w: %w", ErrRenderWorkload, err)
 	}
 	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(stsApplyConfig)
 	if err != nil {
 		logger.Error(err, "Converting obj apply configuration to json.")
-		return err
+		return ApplyResult{}, err
 	}
 
 	newSts := &appsv1.StatefulSet{}
 	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj, newSts); err != nil {
-		return fmt.Errorf("convert stsApplyConfig to sts error: %s", err.Error())
+		return ApplyResult{}, fmt.Errorf("convert stsApplyConfig to sts error: %s", err.Error())
 	}
 
 	// the err value was used to pass the differences between the old and new objects,
@@ -138,16 +143,99 @@ func (r *StatefulSetReconciler) reconcileStatefulSet(
 		)
 	}
 
-	stsUpdated := !semanticallyEqual || !revisionHashEqual
-	partition, replicas, err := r.rollingUpdateParameters(ctx, role, oldSts, stsUpdated, rollingUpdateStrategy)
-	if err != nil {
-		return err
+	// With OnDelete the pods are only replaced when deleted, there is no partition to step.
+	partition, replicas := int32(0), *role.Replicas
+	if role.RolloutStrategy.Type != workloadsv1alpha2.OnDeleteStrategyType {
+		stsUpdated := !semanticallyEqual || !revisionHashEqual
+		partition, replicas, err = r.rollingUpdateParameters(ctx, role, oldSts, stsUpdated, rollingUpdateStrategy)
+		if err != nil {
+			return ApplyResult{}, err
+		}
 	}
 
-	if semanticallyEqual && revisionHashEqual && partition == *oldSts.Spec.UpdateStrategy.RollingUpdate.Partition &&
+	if semanticallyEqual && revisionHashEqual && updateStrategyEqual(oldSts, role, partition) &&
 		*oldSts.Spec.Replicas == *role.Replicas {
 		logger.Info("sts equal, skip reconcile")
-		return nil
+		return ApplyResult{}, nil
+	}
+
+	stsApplyConfig = withStatefulSetUpdateStrategy(stsApplyConfig, role, partition, replicas)
+	result, err := applyWorkload(ctx, r.client, rbg, oldSts, stsApplyConfig)
+	if err != nil {
+		logger.Error(err, "Failed to patch statefulset apply configuration")
+		return ApplyResult{}, err
+	}
+
+	return result, nil
+}
+
+// statefulSetPodManagementPolicy returns the pod management policy of the role, Parallel by default so that
+// all the replicas of a role start at once.
+func statefulSetPodManagementPolicy(role *workloadsv1alpha2.RoleSpec) appsv1.PodManagementPolicyType {
+	if role.PodManagementPolicy == "" {
+		return appsv1.ParallelPodManagement
+	}
+	return appsv1.PodManagementPolicyType(role.PodManagementPolicy)
+}
+
+// recreateForPodManagementPolicy deletes the statefulset of the role without its pods when its pod
+// management policy, which is immutable, differs from the one of the role. The statefulset created again
+// with the new policy adopts the running pods, which are not restarted. It reports whether the
+// statefulset is being deleted, the deletion event requeues the group then.
+func (r *StatefulSetReconciler) recreateForPodManagementPolicy(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (bool, error) {
+	logger := log.FromContext(ctx)
+	sts := &appsv1.StatefulSet{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: rbg.GetWorkloadName(role), Namespace: rbg.Namespace}, sts)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if sts.DeletionTimestamp != nil {
+		logger.Info("Waiting for the statefulset to be deleted", "sts", sts.Name)
+		return true, nil
+	}
+	policy := statefulSetPodManagementPolicy(role)
+	// The API server always defaults the policy, an empty one is not known.
+	if sts.Spec.PodManagementPolicy == "" || sts.Spec.PodManagementPolicy == policy {
+		return false, nil
+	}
+
+	logger.Info("Recreating statefulset to change its pod management policy, keeping its pods",
+		"sts", sts.Name, "old", sts.Spec.PodManagementPolicy, "new", policy)
+	err = r.client.Delete(ctx, sts, client.PropagationPolicy(v1.DeletePropagationOrphan),
+		client.Preconditions{UID: &sts.UID})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("delete sts %s to change its pod management policy error: %w", sts.Name, err)
+	}
+	return true, nil
+}
+
+// updateStrategyEqual reports whether the statefulset already runs the update strategy of the role
+// at the given partition.
+func updateStrategyEqual(sts *appsv1.StatefulSet, role *workloadsv1alpha2.RoleSpec, partition int32) bool {
+	strategy := sts.Spec.UpdateStrategy
+	if string(strategy.Type) != string(role.RolloutStrategy.Type) {
+		return false
+	}
+	if strategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return true
+	}
+	return strategy.RollingUpdate != nil && strategy.RollingUpdate.Partition != nil &&
+		*strategy.RollingUpdate.Partition == partition
+}
+
+// withStatefulSetUpdateStrategy sets the replicas and the update strategy of the role.
+func withStatefulSetUpdateStrategy(
+	stsApplyConfig *appsapplyv1.StatefulSetApplyConfiguration, role *workloadsv1alpha2.RoleSpec, partition, replicas int32,
+) *appsapplyv1.StatefulSetApplyConfiguration {
+	if role.RolloutStrategy.Type == workloadsv1alpha2.OnDeleteStrategyType {
+		return stsApplyConfig.WithSpec(
+			stsApplyConfig.Spec.WithReplicas(replicas).
+				WithUpdateStrategy(
+					appsapplyv1.StatefulSetUpdateStrategy().WithType(appsv1.OnDeleteStatefulSetStrategyType),
+				),
+		)
 	}
 
 	rollingUpdate := appsapplyv1.RollingUpdateStatefulSetStrategy().WithPartition(partition)
@@ -155,7 +243,7 @@ func (r *StatefulSetReconciler) reconcileStatefulSet(
 		rollingUpdate = rollingUpdate.WithMaxUnavailable(*role.RolloutStrategy.RollingUpdate.MaxUnavailable)
 	}
 
-	stsApplyConfig = stsApplyConfig.WithSpec(
+	return stsApplyConfig.WithSpec(
 		stsApplyConfig.Spec.WithReplicas(replicas).
 			WithUpdateStrategy(
 				appsapplyv1.StatefulSetUpdateStrategy().
@@ -163,13 +251,38 @@ func (r *StatefulSetReconciler) reconcileStatefulSet(
 					WithRollingUpdate(rollingUpdate),
 			),
 	)
+}
 
-	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, stsApplyConfig, utils.PatchSpec); err != nil {
-		logger.Error(err, "Failed to patch statefulset apply configuration")
-		return err
+// Render implements WorkloadRenderer.
+func (r *StatefulSetReconciler) Render(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+) ([]*unstructured.Unstructured, error) {
+	rollingStrategy, err := validateRolloutStrategy(role.RolloutStrategy, int(*role.Replicas))
+	if err != nil {
+		return nil, err
 	}
+	role.RolloutStrategy = rollingStrategy
 
-	return nil
+	stsApplyConfig, err := r.constructStatefulSetApplyConfiguration(ctx, rbg, role, &appsv1.StatefulSet{}, revisionKey)
+	if err != nil {
+		return nil, err
+	}
+	partition, replicas := int32(0), *role.Replicas
+	if role.RolloutStrategy.Type != workloadsv1alpha2.OnDeleteStrategyType {
+		partition, replicas, err = r.rollingUpdateParameters(ctx, role, nil, true, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sts, err := applyConfigurationToUnstructured(withStatefulSetUpdateStrategy(stsApplyConfig, role, partition, replicas))
+	if err != nil {
+		return nil, err
+	}
+	svc, err := renderHeadlessService(ctx, r.client, rbg, role, sts)
+	if err != nil {
+		return nil, err
+	}
+	return []*unstructured.Unstructured{sts, svc}, nil
 }
 
 // Rolling update will always wait for the former replica to be ready then process the next one,
@@ -485,7 +598,7 @@ func (r *StatefulSetReconciler) constructStatefulSetApplyConfiguration(
 				WithReplicas(*role.Replicas).
 				WithTemplate(podTemplateApplyConfiguration).
 				WithMinReadySeconds(role.MinReadySeconds).
-				WithPodManagementPolicy(appsv1.ParallelPodManagement).
+				WithPodManagementPolicy(statefulSetPodManagementPolicy(role)).
 				WithSelector(
 					metaapplyv1.LabelSelector().
 						WithMatchLabels(matchLabels),
@@ -624,6 +737,9 @@ func statefulSetStatusEqual(oldStatus, newStatus appsv1.StatefulSetStatus) (bool
 func validateRolloutStrategy(
 	rollingStrategy *workloadsv1alpha2.RolloutStrategy, replicas int,
 ) (*workloadsv1alpha2.RolloutStrategy, error) {
+	if rollingStrategy != nil && rollingStrategy.Type == workloadsv1alpha2.OnDeleteStrategyType {
+		return rollingStrategy, nil
+	}
 	if rollingStrategy == nil || rollingStrategy.RollingUpdate == nil {
 		return &workloadsv1alpha2.RolloutStrategy{
 			Type: workloadsv1alpha2.RollingUpdateStrategyType,
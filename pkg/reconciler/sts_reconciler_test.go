@@ -27,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -95,7 +96,7 @@ func TestStatefulSetReconciler_Reconciler(t *testing.T) {
 					scheme: scheme,
 					client: client,
 				}
-				err := r.Reconciler(context.Background(), tt.rbg, tt.role, nil, expectedRevisionHash)
+				_, err := r.Reconciler(context.Background(), tt.rbg, tt.role, nil, expectedRevisionHash)
 				if tt.expectErr {
 					assert.Error(t, err)
 				} else {
@@ -129,6 +130,116 @@ func TestStatefulSetReconciler_Reconciler(t *testing.T) {
 	}
 }
 
+func TestStatefulSetReconciler_OnDelete(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	role := wrappersv2.BuildStandaloneRole("test-role").WithReplicas(3).WithWorkload("apps/v1", "StatefulSet").Obj()
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &StatefulSetReconciler{scheme: scheme, client: client}
+	getSts := func() *appsv1.StatefulSet {
+		sts := &appsv1.StatefulSet{}
+		err := client.Get(context.Background(), types.NamespacedName{Name: rbg.GetWorkloadName(&role), Namespace: rbg.Namespace}, sts)
+		assert.NoError(t, err)
+		return sts
+	}
+
+	// A role starting with the default rolling update switches to OnDelete.
+	assert.NoError(t, applyErr(r.Reconciler(context.Background(), rbg, role.DeepCopy(), nil, expectedRevisionHash)))
+	assert.Equal(t, appsv1.RollingUpdateStatefulSetStrategyType, getSts().Spec.UpdateStrategy.Type)
+
+	role.RolloutStrategy = &workloadsv1alpha2.RolloutStrategy{Type: workloadsv1alpha2.OnDeleteStrategyType}
+	assert.NoError(t, applyErr(r.Reconciler(context.Background(), rbg, role.DeepCopy(), nil, expectedRevisionHash)))
+	sts := getSts()
+	assert.Equal(t, appsv1.OnDeleteStatefulSetStrategyType, sts.Spec.UpdateStrategy.Type)
+	assert.Nil(t, sts.Spec.UpdateStrategy.RollingUpdate)
+	assert.Equal(t, int32(3), *sts.Spec.Replicas)
+
+	// A new revision is applied to the template without surge or partition.
+	assert.NoError(t, applyErr(r.Reconciler(context.Background(), rbg, role.DeepCopy(), nil, "new-revision")))
+	sts = getSts()
+	assert.Equal(t, "new-revision", sts.Labels[fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, role.Name)])
+	assert.Equal(t, appsv1.OnDeleteStatefulSetStrategyType, sts.Spec.UpdateStrategy.Type)
+	assert.Equal(t, int32(3), *sts.Spec.Replicas)
+
+	objs, err := r.Render(context.Background(), rbg, role.DeepCopy(), expectedRevisionHash)
+	assert.NoError(t, err)
+	strategy, _, _ := unstructured.NestedStringMap(objs[0].Object, "spec", "updateStrategy")
+	assert.Equal(t, map[string]string{"type": "OnDelete"}, strategy)
+}
+
+func TestStatefulSetReconciler_ApplyResult(t *testing.T) {
+	old := workloadApplyExpectations
+	defer func() { workloadApplyExpectations = old }()
+	workloadApplyExpectations = &applyExpectations{applied: map[string]appliedSpec{}}
+
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	ctx := context.Background()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	role := wrappersv2.BuildStandaloneRole("test-role").WithReplicas(3).WithWorkload("apps/v1", "StatefulSet").Obj()
+	r := &StatefulSetReconciler{scheme: scheme, client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+
+	result, err := r.Reconciler(ctx, rbg, role.DeepCopy(), nil, expectedRevisionHash)
+	assert.NoError(t, err)
+	assert.Equal(t, ApplyResult{Created: true}, result)
+
+	// Nothing is applied when nothing changed.
+	result, err = r.Reconciler(ctx, rbg, role.DeepCopy(), nil, expectedRevisionHash)
+	assert.NoError(t, err)
+	assert.Equal(t, ApplyResult{}, result)
+	assert.False(t, result.Scaled())
+
+	role.Replicas = ptr.To[int32](5)
+	result, err = r.Reconciler(ctx, rbg, role.DeepCopy(), nil, expectedRevisionHash)
+	assert.NoError(t, err)
+	assert.Equal(t, ApplyResult{PreviousReplicas: 3, Replicas: 5}, result)
+	assert.True(t, result.Scaled())
+}
+
+func TestStatefulSetReconciler_PodManagementPolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	ctx := context.Background()
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").Obj()
+	role := wrappersv2.BuildStandaloneRole("test-role").WithReplicas(2).WithWorkload("apps/v1", "StatefulSet").Obj()
+	existing := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            rbg.GetWorkloadName(&role),
+			Namespace:       rbg.Namespace,
+			UID:             "sts-uid",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rbg, rbg.GroupVersionKind())},
+		},
+		Spec: appsv1.StatefulSetSpec{Replicas: ptr.To[int32](2), PodManagementPolicy: appsv1.ParallelPodManagement},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	r := &StatefulSetReconciler{scheme: scheme, client: client}
+	key := types.NamespacedName{Name: existing.Name, Namespace: existing.Namespace}
+
+	// The policy is immutable, the statefulset is deleted without its pods first.
+	role.PodManagementPolicy = constants.OrderedReadyPodManagement
+	assert.NoError(t, applyErr(r.Reconciler(ctx, rbg, role.DeepCopy(), nil, expectedRevisionHash)))
+	assert.True(t, apierrors.IsNotFound(client.Get(ctx, key, &appsv1.StatefulSet{})))
+
+	// It is then created again with the policy of the role.
+	assert.NoError(t, applyErr(r.Reconciler(ctx, rbg, role.DeepCopy(), nil, expectedRevisionHash)))
+	sts := &appsv1.StatefulSet{}
+	assert.NoError(t, client.Get(ctx, key, sts))
+	assert.Equal(t, appsv1.OrderedReadyPodManagement, sts.Spec.PodManagementPolicy)
+
+	role.PodManagementPolicy = ""
+	assert.Equal(t, appsv1.ParallelPodManagement, statefulSetPodManagementPolicy(&role))
+}
+
 func TestStatefulSetReconciler_CheckWorkloadReady(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = appsv1.AddToScheme(scheme)
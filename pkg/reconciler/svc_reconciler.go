@@ -21,9 +21,13 @@ import (
 	"fmt"
 	"reflect"
 
+	kruiseappsv1alpha1 "github.com/openkruise/kruise/apis/apps/v1alpha1"
+	kruiseappsv1beta1 "github.com/openkruise/kruise/apis/apps/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	coreapplyv1 "k8s.io/client-go/applyconfigurations/core/v1"
@@ -89,7 +93,7 @@ func (r *ServiceReconciler) reconcileHeadlessService(
 
 	logger.V(1).Info(fmt.Sprintf("svc not equal, diff: %s", err.Error()))
 
-	if err := utils.PatchObjectApplyConfiguration(ctx, r.client, svcApplyConfig, utils.PatchSpec); err != nil {
+	if _, err := applyWorkload(ctx, r.client, rbg, oldSvc, svcApplyConfig); err != nil {
 		logger.Error(err, "Failed to patch svc apply configuration")
 		return err
 	}
@@ -133,6 +137,21 @@ func (r *ServiceReconciler) constructServiceApplyConfiguration(
 	return serviceConfig, nil
 }
 
+// renderHeadlessService renders the headless service of a role owned by the given rendered workload.
+func renderHeadlessService(
+	ctx context.Context,
+	c client.Client,
+	rbg *workloadsv1alpha2.RoleBasedGroup,
+	role *workloadsv1alpha2.RoleSpec,
+	workload *unstructured.Unstructured,
+) (*unstructured.Unstructured, error) {
+	svcApplyConfig, err := NewServiceReconciler(c).constructServiceApplyConfiguration(ctx, rbg, role, workload)
+	if err != nil {
+		return nil, fmt.Errorf("constructServiceApplyConfiguration error: %s", err.Error())
+	}
+	return applyConfigurationToUnstructured(svcApplyConfig)
+}
+
 func (r *ServiceReconciler) getObjectByKind(
 	ctx context.Context,
 	rbg *workloadsv1alpha2.RoleBasedGroup,
@@ -149,6 +168,18 @@ func (r *ServiceReconciler) getObjectByKind(
 		obj := &appsv1.StatefulSet{}
 		err := r.client.Get(ctx, types.NamespacedName{Name: workloadName, Namespace: rbg.Namespace}, obj)
 		return obj, err
+	case constants.DeploymentWorkloadType:
+		obj := &appsv1.Deployment{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: workloadName, Namespace: rbg.Namespace}, obj)
+		return obj, err
+	case constants.KruiseCloneSetWorkloadType:
+		obj := &kruiseappsv1alpha1.CloneSet{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: workloadName, Namespace: rbg.Namespace}, obj)
+		return obj, err
+	case constants.KruiseStatefulSetWorkloadType:
+		obj := &kruiseappsv1beta1.StatefulSet{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: workloadName, Namespace: rbg.Namespace}, obj)
+		return obj, err
 	default:
 		return nil, fmt.Errorf("unsupported workload type: %s", role.GetWorkloadType())
 	}
@@ -171,5 +202,22 @@ func semanticallyEqualService(svc1, svc2 *corev1.Service) (bool, error) {
 		return false, fmt.Errorf("selector not equal, old: %v, new: %v", svc1.Spec.Selector, svc2.Spec.Selector)
 	}
 
+	// The service is owned by the workload of the role, a recreated workload takes it over.
+	if !ownerUIDsEqual(svc1.OwnerReferences, svc2.OwnerReferences) {
+		return false, fmt.Errorf("owner not equal, old: %v, new: %v", svc1.OwnerReferences, svc2.OwnerReferences)
+	}
+
 	return true, nil
 }
+
+func ownerUIDsEqual(refs1, refs2 []metav1.OwnerReference) bool {
+	if len(refs1) != len(refs2) {
+		return false
+	}
+	for i := range refs1 {
+		if refs1[i].UID != refs2[i].UID {
+			return false
+		}
+	}
+	return true
+}
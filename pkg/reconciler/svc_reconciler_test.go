@@ -46,6 +46,7 @@ func TestServiceReconciler_reconcileHeadlessService(t *testing.T) {
 		[]workloadsv1alpha2.RoleSpec{
 			wrappersv2.BuildStandaloneRole("test-role-statefulset").WithWorkload("apps/v1", "StatefulSet").Obj(),
 			wrappersv2.BuildStandaloneRole("test-role-roleinstanceset").WithWorkload("workloads.x-k8s.io/v1alpha2", "RoleInstanceSet").Obj(),
+			wrappersv2.BuildStandaloneRole("test-role-deployment").WithWorkload("apps/v1", "Deployment").Obj(),
 		},
 	).Obj()
 
@@ -73,11 +74,23 @@ func TestServiceReconciler_reconcileHeadlessService(t *testing.T) {
 		},
 	}
 
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rbg.GetWorkloadName(&rbg.Spec.Roles[2]),
+			Namespace: rbg.Namespace,
+			UID:       "test-deployment",
+		},
+	}
+
 	// Add common labels method mock if needed
 	rbg.ObjectMeta.Labels = map[string]string{"app": "test"}
 
 	// Create fake client
-	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(rbg, statefulset, roleInstanceSet).Build()
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(rbg, statefulset, roleInstanceSet, deployment).Build()
 
 	reconciler := NewServiceReconciler(cl)
 
@@ -136,6 +149,18 @@ func TestSemanticallyEqualService(t *testing.T) {
 		assert.Contains(t, err.Error(), "selector not equal")
 	})
 
+	t.Run("services differ in owner", func(t *testing.T) {
+		svc1 := baseSvc.DeepCopy()
+		svc2 := baseSvc.DeepCopy()
+		svc1.OwnerReferences = []metav1.OwnerReference{{Kind: "Deployment", Name: "test-rbg-test-role", UID: "old"}}
+		svc2.OwnerReferences = []metav1.OwnerReference{{Kind: "Deployment", Name: "test-rbg-test-role", UID: "new"}}
+
+		equal, err := semanticallyEqualService(svc1, svc2)
+		assert.False(t, equal)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "owner not equal")
+	})
+
 	t.Run("one service is nil", func(t *testing.T) {
 		equal, err := semanticallyEqualService(nil, baseSvc)
 		assert.False(t, equal)
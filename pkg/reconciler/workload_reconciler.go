@@ -21,9 +21,13 @@ import (
 	"fmt"
 	"reflect"
 
+	kruiseappsv1alpha1 "github.com/openkruise/kruise/apis/apps/v1alpha1"
+	kruiseappsv1beta1 "github.com/openkruise/kruise/apis/apps/v1beta1"
 	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
@@ -31,11 +35,25 @@ import (
 	"sigs.k8s.io/rbgs/pkg/scheduler"
 )
 
+// ApplyResult tells how applying the workload of a role changed it: whether the workload was created,
+// or the spec replicas it had before and has after the apply when they changed. The zero value means
+// the workload was left as it was, or was changed without being scaled.
+type ApplyResult struct {
+	Created          bool
+	PreviousReplicas int32
+	Replicas         int32
+}
+
+// Scaled reports whether the apply changed the replicas of an existing workload.
+func (r ApplyResult) Scaled() bool {
+	return r.PreviousReplicas != r.Replicas
+}
+
 type WorkloadReconciler interface {
 	Validate(ctx context.Context, role *workloadsv1alpha2.RoleSpec) error
 	Reconciler(
 		ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
-		rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) error
+		rollingUpdateStrategy *workloadsv1alpha2.RollingUpdate, revisionKey string) (ApplyResult, error)
 	ConstructRoleStatus(
 		ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
 	) (workloadsv1alpha2.RoleStatus, error)
@@ -46,6 +64,16 @@ type WorkloadReconciler interface {
 	RecreateWorkload(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec) error
 }
 
+// WorkloadRenderer is an optional interface implemented by WorkloadReconcilers that can
+// render the objects they would create for a role, such as the workload and its headless
+// service, without applying them. Existing objects in the cluster are not taken into
+// account, so the result is what a fresh rbg would get.
+type WorkloadRenderer interface {
+	Render(
+		ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec, revisionKey string,
+	) ([]*unstructured.Unstructured, error)
+}
+
 // PodGroupManagerSetter is an optional interface implemented by WorkloadReconcilers
 // that support injecting a PodGroupManager for gang-scheduling label injection.
 type PodGroupManagerSetter interface {
@@ -64,6 +92,12 @@ func NewWorkloadReconciler(
 		return NewLeaderWorkerSetReconciler(scheme, client), nil
 	case workload.String() == constants.RoleInstanceSetWorkloadType:
 		return NewRoleInstanceSetReconciler(scheme, client), nil
+	case workload.String() == constants.JobWorkloadType:
+		return NewJobReconciler(scheme, client), nil
+	case workload.String() == constants.KruiseCloneSetWorkloadType:
+		return NewCloneSetReconciler(scheme, client), nil
+	case workload.String() == constants.KruiseStatefulSetWorkloadType:
+		return NewAdvancedStatefulSetReconciler(scheme, client), nil
 	default:
 		return nil, fmt.Errorf("unsupported workload type: %s", workload.String())
 	}
@@ -96,6 +130,30 @@ func WorkloadEqual(obj1, obj2 interface{}) (bool, error) {
 			}
 			return false, fmt.Errorf("roleInstanceSet generation or status not equal")
 		}
+	case *batchv1.Job:
+		if o2, ok := obj2.(*batchv1.Job); ok {
+			if o1.Generation == o2.Generation && reflect.DeepEqual(o1.Status, o2.Status) {
+				return true, nil
+			}
+			return false, fmt.Errorf("job generation or status not equal")
+		}
+	case *kruiseappsv1alpha1.CloneSet:
+		if o2, ok := obj2.(*kruiseappsv1alpha1.CloneSet); ok {
+			if equal, err := semanticallyEqualCloneSet(o1, o2, true); !equal {
+				return false, fmt.Errorf("cloneset not equal, error: %s", err.Error())
+			}
+			return true, nil
+		}
+	case *kruiseappsv1beta1.StatefulSet:
+		if o2, ok := obj2.(*kruiseappsv1beta1.StatefulSet); ok {
+			if equal, err := semanticallyEqualAdvancedStatefulSet(o1, o2, true); !equal {
+				return false, fmt.Errorf("advanced sts: %s/%s not equal, error: %s", o1.Namespace, o1.Name, err.Error())
+			}
+			if o1.Generation != o2.Generation {
+				return false, fmt.Errorf("advanced sts: %s/%s generation not equal", o1.Namespace, o1.Name)
+			}
+			return true, nil
+		}
 	case *lwsv1.LeaderWorkerSet:
 		if o2, ok := obj2.(*lwsv1.LeaderWorkerSet); ok {
 			if equal, err := semanticallyEqualLeaderWorkerSet(o1, o2, true); !equal {
@@ -107,3 +165,12 @@ func WorkloadEqual(obj1, obj2 interface{}) (bool, error) {
 
 	return false, fmt.Errorf("not support workload: %v", reflect.TypeOf(obj1))
 }
+
+// applyConfigurationToUnstructured converts an apply configuration into the object it describes.
+func applyConfigurationToUnstructured(applyConfig interface{}) (*unstructured.Unstructured, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(applyConfig)
+	if err != nil {
+		return nil, fmt.Errorf("converting apply configuration to unstructured: %w", err)
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package reconciler
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,6 +30,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	lwsv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 )
 
@@ -513,3 +518,69 @@ func TestWorkloadReconcilerInterface(t *testing.T) {
 		}
 	}
 }
+
+// TestWorkloadRenderer tests that every reconciler renders the objects it would create for a role
+func TestWorkloadRenderer(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = appsv1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = lwsv1.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	tests := []struct {
+		workloadType  string
+		expectedKinds []string
+	}{
+		{workloadType: constants.DeploymentWorkloadType, expectedKinds: []string{"Deployment", "Service"}},
+		{workloadType: constants.StatefulSetWorkloadType, expectedKinds: []string{"StatefulSet", "Service"}},
+		{workloadType: constants.LeaderWorkerSetWorkloadType, expectedKinds: []string{"LeaderWorkerSet"}},
+		{workloadType: constants.RoleInstanceSetWorkloadType, expectedKinds: []string{"RoleInstanceSet", "Service"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.workloadType, func(t *testing.T) {
+			role := workloadsv1alpha2.RoleSpec{
+				Name:        "worker",
+				Replicas:    ptr.To(int32(2)),
+				Annotations: map[string]string{constants.RoleWorkloadTypeAnnotationKey: tt.workloadType},
+				Pattern: workloadsv1alpha2.Pattern{
+					StandalonePattern: &workloadsv1alpha2.StandalonePattern{
+						TemplateSource: workloadsv1alpha2.TemplateSource{
+							Template: &corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nginx"}}},
+							},
+						},
+					},
+				},
+			}
+			if tt.workloadType == constants.LeaderWorkerSetWorkloadType {
+				role.Pattern = workloadsv1alpha2.Pattern{
+					LeaderWorkerPattern: &workloadsv1alpha2.LeaderWorkerPattern{
+						Size:           ptr.To(int32(2)),
+						TemplateSource: role.StandalonePattern.TemplateSource,
+					},
+				}
+			}
+			rbg := &workloadsv1alpha2.RoleBasedGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rbg", Namespace: "default"},
+				Spec:       workloadsv1alpha2.RoleBasedGroupSpec{Roles: []workloadsv1alpha2.RoleSpec{role}},
+			}
+
+			r, err := NewWorkloadReconciler(role.GetWorkloadSpec(), scheme, fakeClient)
+			require.NoError(t, err)
+			renderer, ok := r.(WorkloadRenderer)
+			require.True(t, ok)
+			objs, err := renderer.Render(context.TODO(), rbg, &rbg.Spec.Roles[0], "revision")
+			require.NoError(t, err)
+
+			kinds := make([]string, 0, len(objs))
+			for _, obj := range objs {
+				kinds = append(kinds, obj.GetKind())
+				assert.Equal(t, "default", obj.GetNamespace())
+			}
+			assert.Equal(t, tt.expectedKinds, kinds)
+			assert.Equal(t, "test-rbg-worker", objs[0].GetName())
+			assert.Equal(t, "revision", objs[0].GetLabels()[fmt.Sprintf(constants.RoleRevisionLabelKeyFmt, "worker")])
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schedulinggate releases the pods of RoleBasedGroups with staged admission, which are created
+// with the rbg.workloads.x-k8s.io/staged-admission scheduling gate.
+//
+// The roles whose dependencies are ready are admitted together: their pods are released once the group
+// is no longer suspended or waiting for its admission by Kueue, and all of their pods are created. Until
+// then none of them is scheduled, so a gang whose remaining pods are rejected, e.g. by a ResourceQuota,
+// does not hold GPUs that other gangs need to start. Roles waiting for their dependencies are admitted
+// the same way once the dependencies are ready.
+package schedulinggate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	"sigs.k8s.io/rbgs/pkg/dependency"
+)
+
+// Result is the outcome of releasing the pods of a group.
+type Result struct {
+	// Released is the number of pods whose gate was removed.
+	Released int
+	// Gated is the number of pods still holding the gate.
+	Gated int
+	// Reason tells why the gated pods are held back.
+	Reason string
+}
+
+// Manager removes the staged admission gate from the pods of RoleBasedGroups.
+type Manager struct {
+	scheme *runtime.Scheme
+	client client.Client
+}
+
+// New returns a new Manager.
+func New(scheme *runtime.Scheme, c client.Client) *Manager {
+	return &Manager{scheme: scheme, client: c}
+}
+
+// HasGate returns true if the pod holds the staged admission gate.
+func HasGate(pod *corev1.Pod) bool {
+	return slices.ContainsFunc(pod.Spec.SchedulingGates, isStagedAdmissionGate)
+}
+
+func isStagedAdmissionGate(gate corev1.PodSchedulingGate) bool {
+	return gate.Name == constants.StagedAdmissionSchedulingGate
+}
+
+// Release removes the gate from the pods of the roles of the group that can be admitted. The pods of
+// a group without staged admission, e.g. after the annotation was removed, are released right away.
+func (m *Manager) Release(ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup) (*Result, error) {
+	pods := &corev1.PodList{}
+	if err := m.client.List(ctx, pods, client.InNamespace(rbg.Namespace),
+		client.MatchingLabels{constants.GroupNameLabelKey: rbg.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list pods of group %s: %w", rbg.Name, err)
+	}
+	created := map[string]int32{}
+	gated := map[string][]*corev1.Pod{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		role := pod.Labels[constants.RoleNameLabelKey]
+		created[role]++
+		if HasGate(pod) {
+			gated[role] = append(gated[role], pod)
+		}
+	}
+	result := &Result{}
+	if len(gated) == 0 {
+		return result, nil
+	}
+
+	admitted, reason, err := m.admittedRoles(ctx, rbg, created)
+	if err != nil {
+		return nil, err
+	}
+	for role, rolePods := range gated {
+		if !admitted[role] {
+			result.Gated += len(rolePods)
+			continue
+		}
+		for _, pod := range rolePods {
+			if err := m.removeGate(ctx, pod); err != nil {
+				return result, err
+			}
+			result.Released++
+		}
+	}
+	if result.Gated > 0 {
+		result.Reason = reason
+	}
+	return result, nil
+}
+
+// admittedRoles returns the roles whose pods can be released, and why the others are held back.
+func (m *Manager) admittedRoles(
+	ctx context.Context, rbg *workloadsv1alpha2.RoleBasedGroup, created map[string]int32,
+) (map[string]bool, string, error) {
+	admitted := map[string]bool{}
+	if !rbg.UsesStagedAdmission() {
+		for _, role := range rbg.Spec.Roles {
+			admitted[role.Name] = true
+		}
+		return admitted, "", nil
+	}
+	if rbg.IsSuspended() ||
+		apimeta.IsStatusConditionTrue(rbg.Status.Conditions, string(workloadsv1alpha2.RoleBasedGroupSuspended)) {
+		return admitted, "the group is suspended or waits for its admission", nil
+	}
+
+	dependencyManager := dependency.NewDefaultDependencyManager(m.scheme, m.client)
+	var waiting, missing []string
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		unready, err := dependencyManager.UnreadyDependencies(ctx, rbg, role)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(unready) > 0 {
+			waiting = append(waiting, role.Name)
+			continue
+		}
+		admitted[role.Name] = true
+		expected, ok := expectedPods(rbg, role)
+		switch {
+		case !ok:
+			missing = append(missing, role.Name+" (no workload)")
+		case created[role.Name] < expected:
+			missing = append(missing, fmt.Sprintf("%s (%d/%d)", role.Name, created[role.Name], expected))
+		}
+	}
+
+	var reasons []string
+	if len(missing) > 0 {
+		// The roles able to start are admitted together, none of them before all of their pods exist.
+		admitted = map[string]bool{}
+		sort.Strings(missing)
+		reasons = append(reasons, "not all pods are created for roles "+strings.Join(missing, ", "))
+	}
+	if len(waiting) > 0 {
+		sort.Strings(waiting)
+		reasons = append(reasons, "roles "+strings.Join(waiting, ", ")+" wait for their dependencies")
+	}
+	return admitted, strings.Join(reasons, "; "), nil
+}
+
+// expectedPods returns the number of pods the workload of the role runs, and false if the workload
+// did not report its replicas yet. The pods of a completed one-shot role are not waited for.
+func expectedPods(rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec) (int32, bool) {
+	status, found := rbg.GetRoleStatus(role.Name)
+	if !found {
+		return 0, false
+	}
+	if apimeta.IsStatusConditionTrue(status.Conditions, string(workloadsv1alpha2.RoleComplete)) {
+		return 0, true
+	}
+	return status.Replicas * podsPerReplica(role), true
+}
+
+// podsPerReplica returns the number of pods of a replica of the role.
+func podsPerReplica(role *workloadsv1alpha2.RoleSpec) int32 {
+	if size := role.GetLeaderWorkerSize(); size != nil {
+		return *size
+	}
+	if pattern := role.GetCustomComponentsPattern(); pattern != nil {
+		var pods int32
+		for _, component := range pattern.Components {
+			if component.Size != nil {
+				pods += *component.Size
+			} else {
+				pods++
+			}
+		}
+		return pods
+	}
+	return 1
+}
+
+// removeGate removes the staged admission gate from the pod, keeping its other gates.
+func (m *Manager) removeGate(ctx context.Context, pod *corev1.Pod) error {
+	patch := client.MergeFrom(pod.DeepCopy())
+	pod.Spec.SchedulingGates = slices.DeleteFunc(pod.Spec.SchedulingGates, isStagedAdmissionGate)
+	if err := m.client.Patch(ctx, pod, patch); err != nil {
+		return fmt.Errorf("failed to remove the scheduling gate of pod %s: %w", pod.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulinggate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+	wrappersv2 "sigs.k8s.io/rbgs/test/wrappers/v1alpha2"
+)
+
+func gatedPod(role string, index int) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("test-rbg-%s-%d", role, index),
+			Namespace: "default",
+			Labels: map[string]string{
+				constants.GroupNameLabelKey: "test-rbg",
+				constants.RoleNameLabelKey:  role,
+			},
+		},
+		Spec: corev1.PodSpec{
+			SchedulingGates: []corev1.PodSchedulingGate{
+				{Name: "example.com/quota"}, {Name: constants.StagedAdmissionSchedulingGate},
+			},
+		},
+	}
+}
+
+func TestManager_Release(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithAnnotations(map[string]string{constants.StagedAdmissionAnnotationKey: "true"}).
+		WithRoles([]workloadsv1alpha2.RoleSpec{
+			wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).WithWorkload("apps/v1", "StatefulSet").Obj(),
+			wrappersv2.BuildLeaderWorkerRole("decode").WithReplicas(1).Obj(),
+			wrappersv2.BuildStandaloneRole("router").WithReplicas(1).WithWorkload("apps/v1", "StatefulSet").
+				WithDependencies([]string{"prefill"}).Obj(),
+		}).Obj()
+	rbg.Spec.Roles[1].LeaderWorkerPattern.Size = ptr.To[int32](2)
+	rbg.Status.RoleStatuses = []workloadsv1alpha2.RoleStatus{
+		{Name: "prefill", Replicas: 2}, {Name: "decode", Replicas: 1},
+	}
+	prefill := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rbg-prefill", Namespace: "default"},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](2)},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(prefill, gatedPod("prefill", 0), gatedPod("prefill", 1), gatedPod("decode", 0)).Build()
+	m := New(scheme, c)
+	gates := func(name string) []string {
+		pod := &corev1.Pod{}
+		require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: name}, pod))
+		var names []string
+		for _, gate := range pod.Spec.SchedulingGates {
+			names = append(names, gate.Name)
+		}
+		return names
+	}
+
+	// A suspended group keeps all of its pods gated.
+	rbg.Spec.Suspend = ptr.To(true)
+	result, err := m.Release(context.TODO(), rbg)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Gated: 3, Reason: "the group is suspended or waits for its admission"}, result)
+
+	// The roles able to start wait for the second pod of the leader-worker role.
+	rbg.Spec.Suspend = nil
+	result, err = m.Release(context.TODO(), rbg)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{
+		Gated:  3,
+		Reason: "not all pods are created for roles decode (1/2); roles router wait for their dependencies",
+	}, result)
+
+	// Once all of their pods are created, they are released together.
+	require.NoError(t, c.Create(context.TODO(), gatedPod("decode", 1)))
+	result, err = m.Release(context.TODO(), rbg)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Released: 4}, result)
+	assert.Equal(t, []string{"example.com/quota"}, gates("test-rbg-prefill-0"))
+	assert.Equal(t, []string{"example.com/quota"}, gates("test-rbg-decode-1"))
+
+	// The pods of the dependent role are released once its dependency is ready and its pods exist.
+	require.NoError(t, c.Create(context.TODO(), gatedPod("router", 0)))
+	result, err = m.Release(context.TODO(), rbg)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Gated: 1, Reason: "roles router wait for their dependencies"}, result)
+
+	prefill.Status.Replicas, prefill.Status.ReadyReplicas = 2, 2
+	require.NoError(t, c.Status().Update(context.TODO(), prefill))
+	rbg.Status.RoleStatuses = append(rbg.Status.RoleStatuses, workloadsv1alpha2.RoleStatus{Name: "router", Replicas: 1})
+	result, err = m.Release(context.TODO(), rbg)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Released: 1}, result)
+	assert.Equal(t, []string{"example.com/quota"}, gates("test-rbg-router-0"))
+}
+
+func TestManager_Release_WithoutStagedAdmission(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+
+	// The pods left gated after the annotation was removed are released right away.
+	rbg := wrappersv2.BuildBasicRoleBasedGroup("test-rbg", "default").
+		WithRoles([]workloadsv1alpha2.RoleSpec{wrappersv2.BuildStandaloneRole("prefill").WithReplicas(2).Obj()}).Obj()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gatedPod("prefill", 0)).Build()
+	result, err := New(scheme, c).Release(context.TODO(), rbg)
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Released: 1}, result)
+
+	pods := &corev1.PodList{}
+	require.NoError(t, c.List(context.TODO(), pods, client.InNamespace("default")))
+	assert.False(t, HasGate(&pods.Items[0]))
+}
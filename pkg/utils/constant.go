@@ -23,6 +23,12 @@ const (
 	// LwsCrdName is LWS CRD name
 	LwsCrdName = "leaderworkersets.leaderworkerset.x-k8s.io"
 
+	// KruiseCloneSetCrdName is OpenKruise CloneSet CRD name
+	KruiseCloneSetCrdName = "clonesets.apps.kruise.io"
+
+	// KruiseStatefulSetCrdName is OpenKruise Advanced StatefulSet CRD name
+	KruiseStatefulSetCrdName = "statefulsets.apps.kruise.io"
+
 	// RbgCRDName is rbg crd name
 	RbgCRDName = "rolebasedgroups.workloads.x-k8s.io"
 
@@ -128,6 +128,22 @@ func ContainerRestarted(pod *corev1.Pod) bool {
 	return false
 }
 
+// PodRestartCount returns the number of restarts of the containers of the pod, the engine runtime
+// container left out as in ContainerRestarted.
+func PodRestartCount(pod *corev1.Pod) int32 {
+	var count int32
+	for i := range pod.Status.InitContainerStatuses {
+		count += pod.Status.InitContainerStatuses[i].RestartCount
+	}
+	for i := range pod.Status.ContainerStatuses {
+		if pod.Status.ContainerStatuses[i].Name == PatioRuntimeContainerName {
+			continue
+		}
+		count += pod.Status.ContainerStatuses[i].RestartCount
+	}
+	return count
+}
+
 // PodDeleted checks if the worker pod has been deleted
 func PodDeleted(pod *corev1.Pod) bool {
 	return pod == nil || pod.DeletionTimestamp != nil
@@ -241,3 +257,38 @@ func GetPodInactiveReason(pod *corev1.Pod) string {
 	}
 	return "Unknown"
 }
+
+// PodSpecGPUs sums the GPU-like extended resources requested by the containers of a pod spec.
+// Extended resources may be set as limits only, in which case the request defaults to the limit.
+func PodSpecGPUs(spec *corev1.PodSpec) map[corev1.ResourceName]int64 {
+	totals := map[corev1.ResourceName]int64{}
+	for _, c := range spec.Containers {
+		for name, quantity := range c.Resources.Limits {
+			if _, ok := c.Resources.Requests[name]; !ok && IsGPUResource(name) {
+				totals[name] += quantity.Value()
+			}
+		}
+		for name, quantity := range c.Resources.Requests {
+			if IsGPUResource(name) {
+				totals[name] += quantity.Value()
+			}
+		}
+	}
+	return totals
+}
+
+// NodeGPUs returns the GPU-like extended resources the node can allocate to pods.
+func NodeGPUs(node *corev1.Node) map[corev1.ResourceName]int64 {
+	totals := map[corev1.ResourceName]int64{}
+	for name, quantity := range node.Status.Allocatable {
+		if IsGPUResource(name) && !quantity.IsZero() {
+			totals[name] = quantity.Value()
+		}
+	}
+	return totals
+}
+
+// IsGPUResource reports whether the resource is a GPU-like extended resource, e.g. nvidia.com/gpu.
+func IsGPUResource(name corev1.ResourceName) bool {
+	return strings.Contains(strings.ToLower(string(name)), "gpu")
+}
@@ -657,6 +657,21 @@ func TestPodDeleted(t *testing.T) {
 	}
 }
 
+func TestPodRestartCount(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{Name: "init", RestartCount: 1}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "engine", RestartCount: 2},
+				{Name: PatioRuntimeContainerName, RestartCount: 5},
+			},
+		},
+	}
+	if count := PodRestartCount(pod); count != 3 {
+		t.Errorf("PodRestartCount() = %d, want 3", count)
+	}
+}
+
 func TestPodBecameInactive(t *testing.T) {
 	now := metav1.Now()
 
@@ -42,6 +42,10 @@ import (
 
 const (
 	DefaultRevisionHistoryLimit = 5
+
+	// clusterRoleTemplatesRevisionKey is the key of the templates of the ClusterRoleTemplates
+	// referenced by the roles in the data of a revision.
+	clusterRoleTemplatesRevisionKey = "clusterRoleTemplates"
 )
 
 // ListRevisions lists all ControllerRevisions matching selector and owned by parent or no other
@@ -182,7 +186,11 @@ func NewRevision(ctx context.Context, client client.Client,
 		revision = currentRevision.Revision + 1
 	}
 
-	rawPatch, err := getRBGPatch(rbg)
+	clusterTemplates, err := GetClusterRoleTemplates(ctx, client, rbg)
+	if err != nil {
+		return nil, err
+	}
+	rawPatch, err := getRBGPatch(rbg, clusterTemplates)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +216,9 @@ func NewRevision(ctx context.Context, client client.Client,
 		return nil, err
 	}
 	cr.Labels[constants.GroupRevisionLabelKey] = rgbHash
+	if cause, ok := rbg.Annotations[constants.ChangeCauseAnnotationKey]; ok {
+		cr.Annotations = map[string]string{constants.ChangeCauseAnnotationKey: cause}
+	}
 	cr.Name = revisionName(rbg.Name, rgbHash, revision)
 	return cr, nil
 }
@@ -246,6 +257,7 @@ func GetRolesRevisionHash(revision *appsv1.ControllerRevision) (map[string]strin
 		return nil, fmt.Errorf("roles not found or wrong type")
 	}
 
+	clusterTemplateLookup, _ := obj[clusterRoleTemplatesRevisionKey].(map[string]interface{})
 	templateLookup := make(map[string]map[string]interface{})
 	if rawTemplates, ok := spec["roleTemplates"]; ok {
 		templateSlice, ok := rawTemplates.([]interface{})
@@ -306,7 +318,18 @@ func GetRolesRevisionHash(revision *appsv1.ControllerRevision) (map[string]strin
 			}
 		}
 
-		if templateRef != nil {
+		if templateRef != nil && templateRef["kind"] == workloadsv1alpha2.ClusterRoleTemplateKind {
+			templateName, _ := templateRef["name"].(string)
+			templateSpec, found := clusterTemplateLookup[templateName]
+			if !found {
+				return nil, fmt.Errorf("role references unknown clusterRoleTemplate %q", templateName)
+			}
+			templateBytes, err := json.Marshal(templateSpec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal clusterRoleTemplate %q: %w", templateName, err)
+			}
+			hf.Write(templateBytes)
+		} else if templateRef != nil {
 			if templateName, ok := templateRef["name"].(string); ok && templateName != "" {
 				template, found := templateLookup[templateName]
 				if !found {
@@ -333,7 +356,9 @@ func GetRolesRevisionHash(revision *appsv1.ControllerRevision) (map[string]strin
 // previous version.
 // Note: This approach creates a copy of the original RBG object before performing the serialization.
 // In the serialized output, the replica count for each role will be set to the default value of 1.
-func getRBGPatch(rbg *workloadsv1alpha2.RoleBasedGroup) ([]byte, error) {
+func getRBGPatch(
+	rbg *workloadsv1alpha2.RoleBasedGroup, clusterTemplates map[string]*workloadsv1alpha2.ClusterRoleTemplate,
+) ([]byte, error) {
 	clone := rbg.DeepCopy()
 	for i := range clone.Spec.Roles {
 		clone.Spec.Roles[i].Replicas = nil
@@ -371,6 +396,16 @@ func getRBGPatch(rbg *workloadsv1alpha2.RoleBasedGroup) ([]byte, error) {
 
 	objCopy["spec"] = specCopy
 
+	// ClusterRoleTemplates are not part of the group, their templates are recorded next to its spec so
+	// that changing one rolls the roles referencing it like changing a RoleTemplate.
+	if len(clusterTemplates) > 0 {
+		templates := make(map[string]interface{}, len(clusterTemplates))
+		for name, template := range clusterTemplates {
+			templates[name] = template.Spec.Template
+		}
+		objCopy[clusterRoleTemplatesRevisionKey] = templates
+	}
+
 	return json.Marshal(objCopy)
 }
 
@@ -390,6 +425,16 @@ func hashRevision(revision *appsv1.ControllerRevision) (string, error) {
 	return rand.SafeEncodeString(fmt.Sprint(hf.Sum32())), nil
 }
 
+// HashObject hashes obj using FNV hashing, e.g. to derive the revision of a template.
+// The returned hash will be a safe encoded string to avoid bad words.
+func HashObject(obj interface{}) (string, error) {
+	hf := fnv.New32a()
+	if err := deepHashObject(hf, obj); err != nil {
+		return "", err
+	}
+	return rand.SafeEncodeString(fmt.Sprint(hf.Sum32())), nil
+}
+
 func deepHashObject(hasher hash.Hash, objectToWrite interface{}) error {
 	hasher.Reset()
 	printer := spew.ConfigState{
@@ -461,7 +461,7 @@ func TestGetPatchAndRestore(t *testing.T) {
 			},
 		},
 	}
-	patchV1, _ := getRBGPatch(v1)
+	patchV1, _ := getRBGPatch(v1, nil)
 
 	v2 := v1.DeepCopy()
 	v2.Spec.Roles[0].Replicas = ptr.To(int32(2))
@@ -591,6 +591,18 @@ func TestNewRevision(t *testing.T) {
 		assert.Contains(t, revision1.Labels, constants.GroupRevisionLabelKey)
 		assert.Equal(t, rbg.Name, revision1.Labels[constants.GroupNameLabelKey])
 	}
+	assert.Empty(t, revision1.Annotations)
+
+	t.Run("change cause is recorded", func(t *testing.T) {
+		rbgWithCause := getRBG()
+		rbgWithCause.Annotations[constants.ChangeCauseAnnotationKey] = "scale out decode"
+
+		revision, err := NewRevision(ctx, client, rbgWithCause, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "scale out decode", revision.Annotations[constants.ChangeCauseAnnotationKey])
+		// The change cause is metadata only and must not affect the revision hash.
+		assert.Equal(t, revision1.Name, revision.Name)
+	})
 }
 
 func TestGetRolesRevisionHash(t *testing.T) {
@@ -682,6 +694,51 @@ func TestRoleTemplateUpdatesAffectRevisionAndRoleHash(t *testing.T) {
 	assert.NotEqual(t, initialHash, hashes2["prefill"], "role hash should change when referenced template changes")
 }
 
+func TestClusterRoleTemplateUpdatesAffectRevisionAndRoleHash(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = workloadsv1alpha2.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	ctx := context.Background()
+
+	rbg := getRBGWithRoleTemplates()
+	rbg.Spec.Roles[0].StandalonePattern.TemplateRef.Name = "golden"
+	rbg.Spec.Roles[0].StandalonePattern.TemplateRef.Kind = workloadsv1alpha2.ClusterRoleTemplateKind
+	clusterTemplate := &workloadsv1alpha2.ClusterRoleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "golden"},
+		Spec: workloadsv1alpha2.ClusterRoleTemplateSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "nginx", Image: "nginx:1.0"}}},
+			},
+		},
+	}
+
+	_, err := NewRevision(ctx, fake.NewClientBuilder().WithScheme(scheme).Build(), rbg, nil)
+	assert.ErrorContains(t, err, `clusterRoleTemplate "golden" not found`)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(clusterTemplate).Build()
+	revision1, err := NewRevision(ctx, client, rbg, nil)
+	assert.NoError(t, err)
+	hashes1, err := GetRolesRevisionHash(revision1)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hashes1["prefill"])
+
+	clusterTemplate.Spec.Template.Spec.Containers[0].Image = "nginx:2.0"
+	assert.NoError(t, client.Update(ctx, clusterTemplate))
+	revision2, err := NewRevision(ctx, client, rbg, revision1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, revision1.Data.Raw, revision2.Data.Raw, "clusterRoleTemplate change should impact revision payload")
+
+	hashes2, err := GetRolesRevisionHash(revision2)
+	assert.NoError(t, err)
+	assert.NotEqual(t, hashes1["prefill"], hashes2["prefill"], "role hash should change when referenced template changes")
+
+	// The recorded templates do not leak into a group restored from the revision.
+	restored, err := ApplyRevision(rbg, revision2)
+	assert.NoError(t, err)
+	assert.Equal(t, workloadsv1alpha2.ClusterRoleTemplateKind, restored.Spec.Roles[0].StandalonePattern.TemplateRef.Kind)
+	assert.Equal(t, "golden", restored.Spec.Roles[0].StandalonePattern.TemplateRef.Name)
+}
+
 func getRBG() *workloadsv1alpha2.RoleBasedGroup {
 	return &workloadsv1alpha2.RoleBasedGroup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The RBG Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
+)
+
+// GetClusterRoleTemplates returns the ClusterRoleTemplates referenced by the roles of rbg, by name.
+func GetClusterRoleTemplates(
+	ctx context.Context, c client.Reader, rbg *workloadsv1alpha2.RoleBasedGroup,
+) (map[string]*workloadsv1alpha2.ClusterRoleTemplate, error) {
+	var templates map[string]*workloadsv1alpha2.ClusterRoleTemplate
+	for i := range rbg.Spec.Roles {
+		role := &rbg.Spec.Roles[i]
+		if !role.UsesClusterRoleTemplate() {
+			continue
+		}
+		name := role.GetEffectiveTemplateName()
+		if _, ok := templates[name]; ok {
+			continue
+		}
+		template, err := getClusterRoleTemplate(ctx, c, name)
+		if err != nil {
+			return nil, fmt.Errorf("spec.roles[%d].templateRef.name: %w", i, err)
+		}
+		if templates == nil {
+			templates = map[string]*workloadsv1alpha2.ClusterRoleTemplate{}
+		}
+		templates[name] = template
+	}
+	return templates, nil
+}
+
+// ResolveRoleTemplate resolves the base template of a role like RoleSpec.GetResolvedTemplate, reading
+// the ClusterRoleTemplate the role references, if any.
+func ResolveRoleTemplate(
+	ctx context.Context, c client.Reader, rbg *workloadsv1alpha2.RoleBasedGroup, role *workloadsv1alpha2.RoleSpec,
+) (corev1.PodTemplateSpec, error) {
+	if !role.UsesClusterRoleTemplate() {
+		return role.GetResolvedTemplate(rbg)
+	}
+	name := role.GetEffectiveTemplateName()
+	template, err := getClusterRoleTemplate(ctx, c, name)
+	if err != nil {
+		return corev1.PodTemplateSpec{}, err
+	}
+	return role.ResolveTemplate(rbg, map[string]*workloadsv1alpha2.ClusterRoleTemplate{name: template})
+}
+
+func getClusterRoleTemplate(
+	ctx context.Context, c client.Reader, name string,
+) (*workloadsv1alpha2.ClusterRoleTemplate, error) {
+	template := &workloadsv1alpha2.ClusterRoleTemplate{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("clusterRoleTemplate %q not found", name)
+		}
+		return nil, fmt.Errorf("failed to get clusterRoleTemplate %q: %w", name, err)
+	}
+	return template, nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 )
 
@@ -209,37 +211,67 @@ func PrettyJson(object interface{}) string {
 	return string(b)
 }
 
-func FilterSystemAnnotations(annotations map[string]string) map[string]string {
-	if annotations == nil {
-		return nil
+var (
+	// systemLabelPrefixes are the label prefixes managed by the controller and Kubernetes,
+	// ignored when comparing the desired and the current metadata of a workload.
+	systemLabelPrefixes = []string{"app.kubernetes.io/", "rolebasedgroup.workloads.x-k8s.io/"}
+	// systemAnnotationPrefixes are the annotation prefixes ignored the same way.
+	systemAnnotationPrefixes = []string{
+		"deployment.kubernetes.io/revision", "rolebasedgroup.workloads.x-k8s.io/", "app.kubernetes.io/",
+		constants.WorkloadSpecHashAnnotationKey,
 	}
 
-	filtered := make(map[string]string)
-	for k, v := range annotations {
-		if !strings.HasPrefix(k, "deployment.kubernetes.io/revision") &&
-			!strings.HasPrefix(k, "rolebasedgroup.workloads.x-k8s.io/") &&
-			!strings.HasPrefix(k, "app.kubernetes.io/") {
-			filtered[k] = v
+	ignoredLabelPrefixes      = systemLabelPrefixes
+	ignoredAnnotationPrefixes = systemAnnotationPrefixes
+)
+
+// SetIgnoredMetadataPrefixes extends the system prefixes with the label and annotation prefixes
+// injected by the admission controllers of the cluster, e.g. Istio or cost tooling, so that they do
+// not make the workloads look out of date. It must be called before the controllers start.
+func SetIgnoredMetadataPrefixes(labelPrefixes, annotationPrefixes []string) {
+	ignoredLabelPrefixes = append(slices.Clone(systemLabelPrefixes), nonEmpty(labelPrefixes)...)
+	ignoredAnnotationPrefixes = append(slices.Clone(systemAnnotationPrefixes), nonEmpty(annotationPrefixes)...)
+}
+
+func nonEmpty(values []string) []string {
+	var result []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
 		}
 	}
-	return filtered
+	return result
+}
+
+func hasAnyPrefix(key string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func FilterSystemAnnotations(annotations map[string]string) map[string]string {
+	return filterByPrefixes(annotations, ignoredAnnotationPrefixes)
 }
 
 func FilterSystemLabels(labels map[string]string) map[string]string {
-	if labels == nil {
+	return filterByPrefixes(labels, ignoredLabelPrefixes)
+}
+
+func filterByPrefixes(m map[string]string, prefixes []string) map[string]string {
+	if m == nil {
 		return nil
 	}
 
 	filtered := make(map[string]string)
-	for k, v := range labels {
-
-		if !strings.HasPrefix(k, "app.kubernetes.io/") &&
-			!strings.HasPrefix(k, "rolebasedgroup.workloads.x-k8s.io/") {
+	for k, v := range m {
+		if !hasAnyPrefix(k, prefixes) {
 			filtered[k] = v
 		}
 	}
 	return filtered
-
 }
 
 func FilterSystemEnvs(envs []corev1.EnvVar) []corev1.EnvVar {
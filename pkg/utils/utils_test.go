@@ -236,6 +236,28 @@ func TestFilterSystemLabels(t *testing.T) {
 	}
 }
 
+func TestSetIgnoredMetadataPrefixes(t *testing.T) {
+	defer SetIgnoredMetadataPrefixes(nil, nil)
+
+	SetIgnoredMetadataPrefixes([]string{"istio.io/", " "}, []string{"sidecar.istio.io/", "cost.example.com/"})
+	assert.Equal(t, map[string]string{"user.label": "v"}, FilterSystemLabels(map[string]string{
+		"istio.io/rev":           "default",
+		"app.kubernetes.io/name": "test-app",
+		"user.label":             "v",
+	}))
+	assert.Equal(t, map[string]string{"istio.io/rev": "default"}, FilterSystemAnnotations(map[string]string{
+		"sidecar.istio.io/status":           "{}",
+		"cost.example.com/center":           "ml",
+		"deployment.kubernetes.io/revision": "2",
+		"istio.io/rev":                      "default",
+	}))
+
+	SetIgnoredMetadataPrefixes(nil, nil)
+	assert.Equal(t, map[string]string{"istio.io/rev": "default"}, FilterSystemLabels(map[string]string{
+		"istio.io/rev": "default",
+	}))
+}
+
 func TestFilterSystemEnvs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -23,7 +23,9 @@ import (
 	"reflect"
 	"time"
 
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +45,12 @@ const (
 	WebhookCertSecretName = "rbgs-webhook-cert"
 	// WebhookCertDir is the directory where TLS certificate files are written for the webhook server.
 	WebhookCertDir = "/tmp/k8s-webhook-server/certs"
+	// ValidatingWebhookConfigurationName is the name of the ValidatingWebhookConfiguration
+	// that registers the admission webhooks served by the controller.
+	ValidatingWebhookConfigurationName = "rbgs-validating-webhook-configuration"
+	// MutatingWebhookConfigurationName is the name of the MutatingWebhookConfiguration
+	// that registers the defaulting webhooks served by the controller.
+	MutatingWebhookConfigurationName = "rbgs-mutating-webhook-configuration"
 )
 
 // CertManager generates self-signed TLS certificates and keeps CRD conversion
@@ -165,6 +173,81 @@ func (m *CertManager) patchOneCRD(ctx context.Context, crdName string, caCert []
 	return nil
 }
 
+// PatchValidatingWebhookCABundle patches clientConfig.caBundle on every webhook of the
+// named ValidatingWebhookConfiguration with the given CA certificate. A missing
+// configuration is skipped, so deployments without admission webhooks keep working.
+func (m *CertManager) PatchValidatingWebhookCABundle(ctx context.Context, name string, caCert []byte) error {
+	return m.patchWebhookConfigurationWithRetry(ctx, &admissionregistrationv1.ValidatingWebhookConfiguration{}, name, caCert)
+}
+
+// PatchMutatingWebhookCABundle is PatchValidatingWebhookCABundle for a MutatingWebhookConfiguration.
+func (m *CertManager) PatchMutatingWebhookCABundle(ctx context.Context, name string, caCert []byte) error {
+	return m.patchWebhookConfigurationWithRetry(ctx, &admissionregistrationv1.MutatingWebhookConfiguration{}, name, caCert)
+}
+
+// patchWebhookConfigurationWithRetry calls patchWebhookConfiguration with exponential backoff.
+func (m *CertManager) patchWebhookConfigurationWithRetry(ctx context.Context, config client.Object, name string, caCert []byte) error {
+	kind := reflect.TypeOf(config).Elem().Name()
+	delay := patchRetryBaseDelay
+	var lastErr error
+	for attempt := 1; attempt <= patchRetryAttempts; attempt++ {
+		if lastErr = m.patchWebhookConfiguration(ctx, config, kind, name, caCert); lastErr == nil {
+			return nil
+		}
+		if attempt == patchRetryAttempts {
+			break
+		}
+		certLog.Info("retrying caBundle patch", "kind", kind, "name", name, "attempt", attempt, "delay", delay, "error", lastErr)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled while retrying caBundle patch for %s: %w", name, ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return fmt.Errorf("patching caBundle on %s %s failed after %d attempts: %w", kind, name, patchRetryAttempts, lastErr)
+}
+
+func (m *CertManager) patchWebhookConfiguration(ctx context.Context, config client.Object, kind, name string, caCert []byte) error {
+	if err := m.client.Get(ctx, client.ObjectKey{Name: name}, config); err != nil {
+		if apierrors.IsNotFound(err) {
+			certLog.Info("webhook configuration not found, skipping caBundle patch", "kind", kind, "name", name)
+			return nil
+		}
+		return fmt.Errorf("getting %s %s: %w", kind, name, err)
+	}
+
+	patch := client.MergeFrom(config.DeepCopyObject().(client.Object))
+	var clientConfigs []*admissionregistrationv1.WebhookClientConfig
+	switch c := config.(type) {
+	case *admissionregistrationv1.ValidatingWebhookConfiguration:
+		for i := range c.Webhooks {
+			clientConfigs = append(clientConfigs, &c.Webhooks[i].ClientConfig)
+		}
+	case *admissionregistrationv1.MutatingWebhookConfiguration:
+		for i := range c.Webhooks {
+			clientConfigs = append(clientConfigs, &c.Webhooks[i].ClientConfig)
+		}
+	}
+	changed := false
+	for _, clientConfig := range clientConfigs {
+		if !reflect.DeepEqual(clientConfig.CABundle, caCert) {
+			clientConfig.CABundle = caCert
+			changed = true
+		}
+	}
+	if !changed {
+		certLog.V(1).Info("webhook configuration caBundle already up to date", "kind", kind, "name", name)
+		return nil
+	}
+	if err := m.client.Patch(ctx, config, patch); err != nil {
+		return fmt.Errorf("patching caBundle on %s %s: %w", kind, name, err)
+	}
+
+	certLog.Info("patched caBundle on webhook configuration", "kind", kind, "name", name)
+	return nil
+}
+
 // ConversionWebhookCRDs returns the names of the CRDs that use the conversion webhook.
 func ConversionWebhookCRDs() []string {
 	return []string{
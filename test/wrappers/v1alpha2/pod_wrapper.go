@@ -21,8 +21,10 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"sigs.k8s.io/rbgs/api/workloads/constants"
 	"sigs.k8s.io/rbgs/test/utils"
 )
 
@@ -49,6 +51,53 @@ func (podWrapper *PodWrapper) WithLabels(labels map[string]string) *PodWrapper {
 	return podWrapper
 }
 
+func (podWrapper *PodWrapper) WithNamespace(namespace string) *PodWrapper {
+	podWrapper.Namespace = namespace
+	return podWrapper
+}
+
+// WithRole labels the pod as a pod of the role of the rbg.
+func (podWrapper *PodWrapper) WithRole(rbgName, roleName string) *PodWrapper {
+	if podWrapper.Labels == nil {
+		podWrapper.Labels = make(map[string]string)
+	}
+	podWrapper.Labels[constants.GroupNameLabelKey] = rbgName
+	podWrapper.Labels[constants.RoleNameLabelKey] = roleName
+	return podWrapper
+}
+
+func (podWrapper *PodWrapper) WithNodeName(nodeName string) *PodWrapper {
+	podWrapper.Spec.NodeName = nodeName
+	return podWrapper
+}
+
+func (podWrapper *PodWrapper) WithContainers(containers ...corev1.Container) *PodWrapper {
+	podWrapper.Spec.Containers = containers
+	return podWrapper
+}
+
+// WithGPUs sets the nvidia.com/gpu limit of every container of the pod.
+func (podWrapper *PodWrapper) WithGPUs(gpus int64) *PodWrapper {
+	for i := range podWrapper.Spec.Containers {
+		container := &podWrapper.Spec.Containers[i]
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = corev1.ResourceList{}
+		}
+		container.Resources.Limits["nvidia.com/gpu"] = *resource.NewQuantity(gpus, resource.DecimalSI)
+	}
+	return podWrapper
+}
+
+func (podWrapper *PodWrapper) WithPhase(phase corev1.PodPhase) *PodWrapper {
+	podWrapper.Status.Phase = phase
+	return podWrapper
+}
+
+func (podWrapper *PodWrapper) WithPodIP(ip string) *PodWrapper {
+	podWrapper.Status.PodIP = ip
+	return podWrapper
+}
+
 func (podWrapper *PodWrapper) WithReadyCondition(ready bool) *PodWrapper {
 	var conditionStatus corev1.ConditionStatus
 	if ready {
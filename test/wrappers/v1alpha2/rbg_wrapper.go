@@ -17,7 +17,10 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"time"
+
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/rbgs/api/workloads/constants"
 	workloadsv1alpha2 "sigs.k8s.io/rbgs/api/workloads/v1alpha2"
 )
@@ -62,6 +65,31 @@ func (rbgWrapper *RoleBasedGroupWrapper) WithAnnotations(annotations map[string]
 	return rbgWrapper
 }
 
+func (rbgWrapper *RoleBasedGroupWrapper) WithLabels(labels map[string]string) *RoleBasedGroupWrapper {
+	rbgWrapper.Labels = labels
+	return rbgWrapper
+}
+
+func (rbgWrapper *RoleBasedGroupWrapper) WithUID(uid types.UID) *RoleBasedGroupWrapper {
+	rbgWrapper.UID = uid
+	return rbgWrapper
+}
+
+func (rbgWrapper *RoleBasedGroupWrapper) WithResourceVersion(resourceVersion string) *RoleBasedGroupWrapper {
+	rbgWrapper.ResourceVersion = resourceVersion
+	return rbgWrapper
+}
+
+func (rbgWrapper *RoleBasedGroupWrapper) WithGeneration(generation int64) *RoleBasedGroupWrapper {
+	rbgWrapper.Generation = generation
+	return rbgWrapper
+}
+
+func (rbgWrapper *RoleBasedGroupWrapper) WithCreationTimestamp(t time.Time) *RoleBasedGroupWrapper {
+	rbgWrapper.CreationTimestamp = v1.NewTime(t)
+	return rbgWrapper
+}
+
 func (rbgWrapper *RoleBasedGroupWrapper) WithRoles(roles []workloadsv1alpha2.RoleSpec) *RoleBasedGroupWrapper {
 	rbgWrapper.Spec.Roles = roles
 	return rbgWrapper
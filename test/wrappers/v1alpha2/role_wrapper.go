@@ -174,12 +174,32 @@ func (rw *LeaderWorkerRoleWrapper) WithSize(size int32) *LeaderWorkerRoleWrapper
 	return rw
 }
 
+func (rw *LeaderWorkerRoleWrapper) WithTemplate(template *corev1.PodTemplateSpec) *LeaderWorkerRoleWrapper {
+	rw.LeaderWorkerPattern.TemplateSource.Template = template
+	rw.LeaderWorkerPattern.TemplateSource.TemplateRef = nil
+	return rw
+}
+
 func (rw *LeaderWorkerRoleWrapper) WithTemplateRef(name string) *LeaderWorkerRoleWrapper {
 	rw.LeaderWorkerPattern.TemplateSource.TemplateRef = &workloadsv1alpha2.TemplateRef{Name: name}
 	rw.LeaderWorkerPattern.TemplateSource.Template = nil
 	return rw
 }
 
+func (rw *LeaderWorkerRoleWrapper) WithPatchRef(name string, patch *runtime.RawExtension) *LeaderWorkerRoleWrapper {
+	rw.LeaderWorkerPattern.TemplateSource.TemplateRef = &workloadsv1alpha2.TemplateRef{
+		Name:  name,
+		Patch: patch,
+	}
+	rw.LeaderWorkerPattern.TemplateSource.Template = nil
+	return rw
+}
+
+func (rw *LeaderWorkerRoleWrapper) WithWorkerTemplatePatch(patch *runtime.RawExtension) *LeaderWorkerRoleWrapper {
+	rw.LeaderWorkerPattern.WorkerTemplatePatch = patch
+	return rw
+}
+
 func (rw *LeaderWorkerRoleWrapper) WithWorkload(apiVersion, kind string) *LeaderWorkerRoleWrapper {
 	if rw.Annotations == nil {
 		rw.Annotations = make(map[string]string)